@@ -46,9 +46,13 @@ type Agent struct {
 	// user to override the final task status that would otherwise be used.
 	setEndTaskResp      func(*triggerEndTaskResp)
 	setEndTaskRespMutex sync.RWMutex
-	tracer              trace.Tracer
-	otelGrpcConn        *grpc.ClientConn
-	closers             []closerOp
+	// currentTaskContext is the context of the task that is currently running,
+	// which is used to report debugging information about the task.
+	currentTaskContext      *taskContext
+	currentTaskContextMutex sync.RWMutex
+	tracer                  trace.Tracer
+	otelGrpcConn            *grpc.ClientConn
+	closers                 []closerOp
 }
 
 // Options contains startup options for an Agent.
@@ -424,6 +428,8 @@ func (a *Agent) setupTask(agentCtx, setupCtx context.Context, initialTC *taskCon
 	a.setEndTaskResp = tc.setUserEndTaskResponse
 	a.setEndTaskRespMutex.Unlock()
 
+	a.setCurrentTaskContext(tc)
+
 	taskConfig, err := a.makeTaskConfig(setupCtx, tc)
 	if err != nil {
 		tc.logger = client.NewSingleChannelLogHarness("agent.error", a.defaultLogger)
@@ -589,6 +595,12 @@ func (a *Agent) runTask(ctx context.Context, tcInput *taskContext, nt *apimodels
 		return tc, shouldExit, errors.Wrap(err, "setting up task")
 	}
 
+	a.setupTaskCgroup(tc)
+	// The cgroup can only be removed after its processes have been killed.
+	defer a.cleanupTaskCgroup(tc)
+
+	a.startResourceUsageCollection(tskCtx, tc)
+
 	defer a.killProcs(ctx, tc, false, "task is finished")
 
 	grip.Info(message.Fields{
@@ -964,6 +976,9 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, status string,
 
 	a.killProcs(ctx, tc, false, "task is ending")
 
+	detail.CgroupStats = tc.getCgroupStats()
+	detail.ResourceUsage = tc.getResourceUsage(ctx, detail.CgroupStats)
+
 	if tc.logger != nil {
 		tc.logger.Execution().Infof("Sending final task status: '%s'.", detail.Status)
 		flushCtx, cancel := context.WithTimeout(ctx, time.Minute)
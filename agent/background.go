@@ -118,6 +118,11 @@ func (a *Agent) startIdleTimeoutWatcher(ctx context.Context, cancel context.Canc
 	defer ticker.Stop()
 
 	tc.logger.Execution().Info("Starting idle timeout watcher.")
+	tc.addTimeoutWatcher(idleTimeout, timeoutWatcherState{
+		startedAt:  time.Now(),
+		getTimeout: tc.getCurrentIdleTimeout,
+	})
+	defer tc.removeTimeoutWatcher(idleTimeout)
 
 	for {
 		select {
@@ -162,6 +167,11 @@ func (a *Agent) startTimeoutWatcher(ctx context.Context, operationCancel context
 	defer ticker.Stop()
 
 	opts.tc.logger.Execution().Infof("Starting %s timeout watcher.", opts.kind)
+	opts.tc.addTimeoutWatcher(opts.kind, timeoutWatcherState{
+		startedAt:  timeTickerStarted,
+		getTimeout: opts.getTimeout,
+	})
+	defer opts.tc.removeTimeoutWatcher(opts.kind)
 
 	for {
 		select {
@@ -0,0 +1,139 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+)
+
+// taskCgroup is the cgroup that a task's processes run in.
+type taskCgroup interface {
+	Path() string
+	Stats() (agentutil.CgroupStats, error)
+	Close() error
+}
+
+// setupTaskCgroup places the task into its own cgroup if the distro has task
+// cgroups enabled. If the cgroup cannot be created, the task runs without one.
+func (a *Agent) setupTaskCgroup(tc *taskContext) {
+	if tc.taskConfig == nil || tc.taskConfig.Distro == nil || !tc.taskConfig.Distro.TaskCgroupsEnabled {
+		return
+	}
+
+	limits := getTaskCgroupLimits(tc)
+	cg, err := agentutil.NewTaskCgroup(agentutil.DefaultCgroupRoot, tc.task.ID, limits)
+	if err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "creating task cgroup, task will run without resource limits"))
+		return
+	}
+
+	tc.logger.Execution().Infof("Running task in cgroup '%s' with memory limit %d MB, CPU limit %d%%, and process limit %d (0 means unlimited).",
+		cg.Path(), limits.MemoryLimitMB, limits.CPUPercent, limits.NumProcesses)
+	tc.setCgroup(cg)
+}
+
+// cleanupTaskCgroup removes the task's cgroup, if it has one.
+func (a *Agent) cleanupTaskCgroup(tc *taskContext) {
+	cg := tc.getCgroup()
+	if cg == nil {
+		return
+	}
+	if err := cg.Close(); err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "removing task cgroup"))
+	}
+	tc.setCgroup(nil)
+}
+
+// getTaskCgroupLimits returns the resource limits for the task's cgroup.
+// Limits from the project take precedence over the distro's defaults.
+func getTaskCgroupLimits(tc *taskContext) agentutil.CgroupLimits {
+	distroLimits := tc.taskConfig.Distro.TaskCgroupLimits
+	limits := agentutil.CgroupLimits{
+		MemoryLimitMB: distroLimits.MemoryLimitMB,
+		CPUPercent:    distroLimits.CPUPercent,
+		NumProcesses:  distroLimits.NumProcesses,
+	}
+
+	projectLimits := tc.taskConfig.Project.CgroupLimits
+	if projectLimits == nil {
+		return limits
+	}
+	if projectLimits.MemoryLimitMB > 0 {
+		limits.MemoryLimitMB = projectLimits.MemoryLimitMB
+	}
+	if projectLimits.CPUPercent > 0 {
+		limits.CPUPercent = projectLimits.CPUPercent
+	}
+	if projectLimits.NumProcesses > 0 {
+		limits.NumProcesses = projectLimits.NumProcesses
+	}
+	return limits
+}
+
+// cgroupOOMTracker is a jasper.OOMTracker that detects OOM kills using the
+// task's cgroup rather than the system logs, so only OOM kills of the task's
+// own processes are reported. Since the cgroup only counts OOM kills, it
+// cannot report the PIDs of the killed processes.
+type cgroupOOMTracker struct {
+	cgroup   taskCgroup
+	baseline int
+	oomKills int
+}
+
+func newCgroupOOMTracker(cg taskCgroup) jasper.OOMTracker {
+	return &cgroupOOMTracker{cgroup: cg}
+}
+
+// Clear ignores any OOM kills in the cgroup that happened before now.
+func (t *cgroupOOMTracker) Clear(ctx context.Context) error {
+	stats, err := t.cgroup.Stats()
+	if err != nil {
+		return errors.Wrap(err, "getting cgroup stats")
+	}
+	t.baseline = int(stats.OOMKills)
+	t.oomKills = 0
+	return nil
+}
+
+// Check records the OOM kills in the cgroup since it was last cleared.
+func (t *cgroupOOMTracker) Check(ctx context.Context) error {
+	stats, err := t.cgroup.Stats()
+	if err != nil {
+		return errors.Wrap(err, "getting cgroup stats")
+	}
+	t.oomKills = int(stats.OOMKills) - t.baseline
+	return nil
+}
+
+// Report returns a description of the OOM kills found by the last check.
+func (t *cgroupOOMTracker) Report() ([]string, []int) {
+	if t.oomKills <= 0 {
+		return nil, nil
+	}
+	return []string{fmt.Sprintf("cgroup '%s' recorded %d process(es) killed by the OOM killer", t.cgroup.Path(), t.oomKills)}, nil
+}
+
+// getCgroupStats returns the resource usage of the task's cgroup, if it has
+// one.
+func (tc *taskContext) getCgroupStats() *apimodels.CgroupStats {
+	cg := tc.getCgroup()
+	if cg == nil {
+		return nil
+	}
+
+	stats, err := cg.Stats()
+	if err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "getting task cgroup stats"))
+		return nil
+	}
+
+	return &apimodels.CgroupStats{
+		PeakMemoryBytes: int64(stats.PeakMemoryBytes),
+		CPUTimeSecs:     float64(stats.CPUTimeUsecs) / 1e6,
+		OOMKills:        int(stats.OOMKills),
+	}
+}
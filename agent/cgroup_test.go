@@ -0,0 +1,100 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTaskCgroup struct {
+	stats  agentutil.CgroupStats
+	closed bool
+}
+
+func (cg *mockTaskCgroup) Path() string { return "/sys/fs/cgroup/evergreen/task" }
+
+func (cg *mockTaskCgroup) Stats() (agentutil.CgroupStats, error) { return cg.stats, nil }
+
+func (cg *mockTaskCgroup) Close() error {
+	cg.closed = true
+	return nil
+}
+
+func TestGetTaskCgroupLimits(t *testing.T) {
+	distroLimits := apimodels.CgroupLimits{
+		MemoryLimitMB: 1024,
+		CPUPercent:    100,
+		NumProcesses:  500,
+	}
+	t.Run("UsesDistroDefaults", func(t *testing.T) {
+		tc := &taskContext{taskConfig: &internal.TaskConfig{
+			Distro: &apimodels.DistroView{TaskCgroupsEnabled: true, TaskCgroupLimits: distroLimits},
+		}}
+		assert.Equal(t, agentutil.CgroupLimits{MemoryLimitMB: 1024, CPUPercent: 100, NumProcesses: 500}, getTaskCgroupLimits(tc))
+	})
+	t.Run("ProjectLimitsTakePrecedence", func(t *testing.T) {
+		tc := &taskContext{taskConfig: &internal.TaskConfig{
+			Distro:  &apimodels.DistroView{TaskCgroupsEnabled: true, TaskCgroupLimits: distroLimits},
+			Project: model.Project{CgroupLimits: &model.CgroupLimits{MemoryLimitMB: 2048, NumProcesses: 100}},
+		}}
+		assert.Equal(t, agentutil.CgroupLimits{MemoryLimitMB: 2048, CPUPercent: 100, NumProcesses: 100}, getTaskCgroupLimits(tc))
+	})
+}
+
+func TestCgroupOOMTracker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cg := &mockTaskCgroup{stats: agentutil.CgroupStats{OOMKills: 2}}
+	tracker := newCgroupOOMTracker(cg)
+
+	require.NoError(t, tracker.Clear(ctx))
+	require.NoError(t, tracker.Check(ctx))
+	lines, pids := tracker.Report()
+	assert.Empty(t, lines)
+	assert.Empty(t, pids)
+
+	cg.stats.OOMKills = 3
+	require.NoError(t, tracker.Check(ctx))
+	lines, pids = tracker.Report()
+	assert.Len(t, lines, 1)
+	assert.Empty(t, pids)
+}
+
+func TestTaskContextCgroup(t *testing.T) {
+	tc := &taskContext{
+		taskConfig: &internal.TaskConfig{},
+		logger:     client.NewSingleChannelLogHarness("test", send.MakeInternalLogger()),
+	}
+	assert.False(t, tc.oomTrackerEnabled(""))
+	assert.Nil(t, tc.getCgroupStats())
+
+	cg := &mockTaskCgroup{stats: agentutil.CgroupStats{
+		PeakMemoryBytes: 4096,
+		CPUTimeUsecs:    2500000,
+		OOMKills:        1,
+	}}
+	tc.setCgroup(cg)
+	assert.True(t, tc.oomTrackerEnabled(""))
+	assert.IsType(t, &cgroupOOMTracker{}, tc.oomTracker)
+
+	stats := tc.getCgroupStats()
+	require.NotNil(t, stats)
+	assert.EqualValues(t, 4096, stats.PeakMemoryBytes)
+	assert.Equal(t, 2.5, stats.CPUTimeSecs)
+	assert.Equal(t, 1, stats.OOMKills)
+
+	a := &Agent{}
+	a.cleanupTaskCgroup(tc)
+	assert.True(t, cg.closed)
+	assert.Nil(t, tc.getCgroup())
+	assert.NotNil(t, tc.oomTracker)
+}
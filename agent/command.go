@@ -94,6 +94,8 @@ func (a *Agent) runCommandsInBlock(ctx context.Context, tc *taskContext, cmdBloc
 		err = a.logPanic(tc.logger, pErr, err, op)
 	}()
 
+	tc.setCurrentBlock(cmdBlock.block)
+
 	legacyBlockName := a.blockToLegacyName(cmdBlock.block)
 	taskLogger.Infof("Running %s commands.", legacyBlockName)
 	start := time.Now()
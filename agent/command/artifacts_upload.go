@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// artifactsUploadVisibilities are the visibilities that uploaded artifacts can
+// have. Signed links are not supported because the app server authorizes all
+// downloads from the artifact bucket.
+var artifactsUploadVisibilities = []string{"", artifact.Public, artifact.Private, artifact.None}
+
+// artifactsUpload uploads files to the artifact bucket configured by the
+// Evergreen admins and attaches them to the task. Unlike s3.put, it does not
+// require the project to have its own bucket or credentials.
+type artifactsUpload struct {
+	// Files is a list of files to upload, using gitignore syntax.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// Prefix is an optional directory prefix to start file globbing in,
+	// relative to Evergreen's working directory. Artifacts are named by their
+	// path relative to this directory.
+	Prefix string `mapstructure:"prefix" plugin:"expand"`
+
+	// Visibility determines who can see the artifacts in the UI. It can be
+	// set to "public", "private", or "none".
+	Visibility string `mapstructure:"visibility" plugin:"expand"`
+
+	// ContentType is the MIME type of the artifacts. If unset, it is
+	// determined by each file's extension.
+	ContentType string `mapstructure:"content_type" plugin:"expand"`
+
+	// Optional, when set to true, causes this command to be skipped over
+	// without an error when no files match.
+	Optional bool `mapstructure:"optional"`
+
+	base
+}
+
+func artifactsUploadFactory() Command   { return &artifactsUpload{} }
+func (c *artifactsUpload) Name() string { return "artifacts.upload" }
+
+func (c *artifactsUpload) ParseParams(params map[string]interface{}) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	return c.validate()
+}
+
+func (c *artifactsUpload) validate() error {
+	catcher := grip.NewSimpleCatcher()
+	catcher.NewWhen(len(c.Files) == 0, "must specify at least one file pattern to upload")
+	catcher.ErrorfWhen(!utility.StringSliceContains(artifactsUploadVisibilities, c.Visibility), "invalid visibility setting '%s', allowed visibilities are: %s", c.Visibility, artifactsUploadVisibilities[1:])
+	return catcher.Resolve()
+}
+
+func (c *artifactsUpload) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if err := c.validate(); err != nil {
+		return errors.Wrap(err, "validating expanded params")
+	}
+
+	workDir := getWorkingDirectory(conf, c.Prefix)
+	b := utility.FileListBuilder{
+		WorkingDir: workDir,
+		Include:    utility.NewGitIgnoreFileMatcher(workDir, c.Files...),
+	}
+	files, err := b.Build()
+	if err != nil {
+		return errors.Wrap(err, "building wildcard paths")
+	}
+
+	if len(files) == 0 {
+		err = errors.New("expanded file specification had no items")
+		if c.Optional {
+			logger.Task().Error(err)
+			return nil
+		}
+		return err
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	for _, fn := range files {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while uploading artifacts")
+		}
+
+		file, err := c.uploadFile(ctx, comm, td, workDir, fn)
+		if err != nil {
+			return errors.Wrapf(err, "uploading artifact '%s'", fn)
+		}
+		logger.Task().Infof("Uploaded artifact '%s'.", file.Name)
+	}
+
+	logger.Task().Infof("'%s' uploaded %d artifacts to the task.", c.Name(), len(files))
+	return nil
+}
+
+func (c *artifactsUpload) uploadFile(ctx context.Context, comm client.Communicator, td client.TaskData, workDir, fn string) (*artifact.File, error) {
+	f, err := os.Open(filepath.Join(workDir, fn))
+	if err != nil {
+		return nil, errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting file info")
+	}
+
+	contentType := c.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(fn))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return comm.UploadArtifact(ctx, td, client.ArtifactUploadOptions{
+		Name:        filepath.ToSlash(fn),
+		Visibility:  c.Visibility,
+		ContentType: contentType,
+	}, info.Size(), f)
+}
@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactsUpload(t *testing.T) {
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer){
+		"ParseParamsSucceeds": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			require.NoError(t, cmd.ParseParams(map[string]interface{}{
+				"files":        []string{"*.txt"},
+				"visibility":   artifact.Private,
+				"content_type": "text/plain",
+			}))
+			assert.Equal(t, []string{"*.txt"}, cmd.Files)
+			assert.Equal(t, artifact.Private, cmd.Visibility)
+			assert.Equal(t, "text/plain", cmd.ContentType)
+		},
+		"ParseParamsFailsWithoutFiles": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			assert.Error(t, cmd.ParseParams(map[string]interface{}{}))
+		},
+		"ParseParamsFailsWithSignedVisibility": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			assert.Error(t, cmd.ParseParams(map[string]interface{}{
+				"files":      []string{"*.txt"},
+				"visibility": artifact.Signed,
+			}))
+		},
+		"UploadsMatchingFiles": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			require.NoError(t, os.MkdirAll(filepath.Join(conf.WorkDir, "src", "dir"), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "a.txt"), []byte("a"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "dir", "b.txt"), []byte("bb"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "src", "c.log"), []byte("c"), 0644))
+
+			cmd.Files = []string{"*.txt"}
+			cmd.Prefix = "src"
+			cmd.Visibility = artifact.Private
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+			uploaded := comm.UploadedArtifacts[conf.Task.Id]
+			require.Len(t, uploaded, 2)
+			assert.Equal(t, "a", string(uploaded["a.txt"]))
+			assert.Equal(t, "bb", string(uploaded["dir/b.txt"]))
+
+			attached := comm.AttachedFiles[conf.Task.Id]
+			require.Len(t, attached, 2)
+			for _, f := range attached {
+				assert.Equal(t, artifact.Private, f.Visibility)
+				assert.Contains(t, f.ContentType, "text/plain")
+			}
+		},
+		"UsesExplicitContentType": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "file"), []byte("data"), 0644))
+
+			cmd.Files = []string{"file"}
+			cmd.ContentType = "application/x-custom"
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+			attached := comm.AttachedFiles[conf.Task.Id]
+			require.Len(t, attached, 1)
+			assert.Equal(t, "application/x-custom", attached[0].ContentType)
+		},
+		"DefaultsToBinaryContentType": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "file"), []byte("data"), 0644))
+
+			cmd.Files = []string{"file"}
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+			attached := comm.AttachedFiles[conf.Task.Id]
+			require.Len(t, attached, 1)
+			assert.Equal(t, "application/octet-stream", attached[0].ContentType)
+		},
+		"FailsWithoutMatchingFiles": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			cmd.Files = []string{"*.txt"}
+			assert.Error(t, cmd.Execute(ctx, comm, logger, conf))
+		},
+		"SucceedsWithoutMatchingFilesWhenOptional": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			cmd.Files = []string{"*.txt"}
+			cmd.Optional = true
+			assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+			assert.Empty(t, comm.UploadedArtifacts[conf.Task.Id])
+		},
+		"ExpandsParams": func(ctx context.Context, t *testing.T, cmd *artifactsUpload, conf *internal.TaskConfig, comm *client.Mock, logger client.LoggerProducer) {
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "file.txt"), []byte("data"), 0644))
+			conf.Expansions.Put("file_name", "file.txt")
+			conf.Expansions.Put("visibility", artifact.None)
+
+			cmd.Files = []string{"${file_name}"}
+			cmd.Visibility = "${visibility}"
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+			attached := comm.AttachedFiles[conf.Task.Id]
+			require.Len(t, attached, 1)
+			assert.Equal(t, "file.txt", attached[0].Name)
+			assert.Equal(t, artifact.None, attached[0].Visibility)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			comm := client.NewMock("http://localhost.com")
+			conf := &internal.TaskConfig{
+				Expansions: util.Expansions{},
+				Task:       task.Task{Id: "task_id", Secret: "secret"},
+				Project:    model.Project{},
+				WorkDir:    t.TempDir(),
+			}
+			logger, err := comm.GetLoggerProducer(ctx, client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}, nil)
+			require.NoError(t, err)
+
+			cmd, ok := artifactsUploadFactory().(*artifactsUpload)
+			require.True(t, ok)
+
+			tCase(ctx, t, cmd, conf, comm, logger)
+		})
+	}
+}
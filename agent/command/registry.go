@@ -22,6 +22,7 @@ func init() {
 		"archive.zip_pack":                      zipArchiveCreateFactory,
 		"archive.zip_extract":                   zipExtractFactory,
 		"archive.auto_extract":                  autoExtractFactory,
+		"artifacts.upload":                      artifactsUploadFactory,
 		evergreen.AttachResultsCommandName:      attachResultsFactory,
 		evergreen.AttachXUnitResultsCommandName: xunitResultsFactory,
 		evergreen.AttachArtifactsCommandName:    attachArtifactsFactory,
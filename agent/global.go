@@ -36,6 +36,10 @@ const (
 	// to API server
 	defaultStatsInterval = time.Minute
 
+	// defaultResourceUsageInterval is the interval at which the agent samples
+	// the machine's resource usage for the task's resource usage summary.
+	defaultResourceUsageInterval = 10 * time.Second
+
 	// defaultCallbackTimeout specifies the duration after when the timeout
 	// block should time out and stop the current command.
 	defaultCallbackTimeout = 15 * time.Minute
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"sync"
@@ -374,6 +375,10 @@ func (c *baseCommunicator) GetLoggerProducer(ctx context.Context, td TaskData, c
 		}
 	}
 	underlying := []send.Sender{}
+	var senderStates []LogSenderState
+	senderStates = append(senderStates, makeLogSenderStates(config.Agent, taskoutput.TaskLogTypeAgent)...)
+	senderStates = append(senderStates, makeLogSenderStates(config.Task, taskoutput.TaskLogTypeTask)...)
+	senderStates = append(senderStates, makeLogSenderStates(config.System, taskoutput.TaskLogTypeSystem)...)
 
 	exec, senders, err := c.makeSender(ctx, td, config.Agent, config.SendToGlobalSender, taskoutput.TaskLogTypeAgent)
 	if err != nil {
@@ -396,9 +401,38 @@ func (c *baseCommunicator) GetLoggerProducer(ctx context.Context, td TaskData, c
 		task:                      logging.MakeGrip(task),
 		system:                    logging.MakeGrip(system),
 		underlyingBufferedSenders: underlying,
+		senderStates:              senderStates,
 	}, nil
 }
 
+// makeLogSenderStates returns the state of the senders that makeSender
+// creates for the given log options.
+func makeLogSenderStates(opts []LogOpts, logType taskoutput.TaskLogType) []LogSenderState {
+	var states []LogSenderState
+	for _, opt := range opts {
+		state := LogSenderState{
+			LogType:       string(logType),
+			Sender:        opt.Sender,
+			BufferSize:    defaultLogBufferSize,
+			FlushInterval: defaultLogBufferTime,
+		}
+		if opt.BufferSize > 0 {
+			state.BufferSize = opt.BufferSize
+		}
+		if opt.BufferDuration > 0 {
+			state.FlushInterval = opt.BufferDuration
+		}
+		if logType == taskoutput.TaskLogTypeSystem && opt.Sender == model.FileLogSender {
+			state.Sender = model.EvergreenLogSender
+		}
+		if state.Sender == "" {
+			state.Sender = model.EvergreenLogSender
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
 func (c *baseCommunicator) makeSender(ctx context.Context, td TaskData, opts []LogOpts, sendToGlobalSender bool, logType taskoutput.TaskLogType) (send.Sender, []send.Sender, error) {
 	levelInfo := send.LevelInfo{Default: level.Info, Threshold: level.Debug}
 	var senders []send.Sender
@@ -755,6 +789,41 @@ func (c *baseCommunicator) AttachFiles(ctx context.Context, taskData TaskData, t
 	return nil
 }
 
+// UploadArtifact streams an artifact to the app server, which stores it in the
+// artifact bucket and attaches it to the task.
+func (c *baseCommunicator) UploadArtifact(ctx context.Context, taskData TaskData, opts ArtifactUploadOptions, size int64, body io.Reader) (*artifact.File, error) {
+	query := url.Values{}
+	query.Set("name", opts.Name)
+	query.Set("visibility", opts.Visibility)
+	query.Set("content_type", opts.ContentType)
+	info := requestInfo{
+		method:   http.MethodPut,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("artifacts/upload?" + query.Encode())
+	r, err := c.createRequest(info, io.NopCloser(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	r.ContentLength = size
+	r.Header.Set(evergreen.ContentTypeHeader, "application/octet-stream")
+
+	resp, err := c.doRequest(ctx, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "uploading artifact '%s'", opts.Name)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "uploading artifact '%s'", opts.Name)
+	}
+
+	file := &artifact.File{}
+	if err = utility.ReadJSON(resp.Body, file); err != nil {
+		return nil, errors.Wrap(err, "reading uploaded artifact from response")
+	}
+	return file, nil
+}
+
 func (c *baseCommunicator) SetDownstreamParams(ctx context.Context, downstreamParams []patchmodel.Parameter, taskData TaskData) error {
 	info := requestInfo{
 		method:   http.MethodPost,
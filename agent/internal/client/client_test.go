@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/stretchr/testify/assert"
@@ -57,3 +60,60 @@ func TestLoggerClose(t *testing.T) {
 		assert.NoError(t, logger.Close())
 	})
 }
+
+func TestLoggerState(t *testing.T) {
+	server, _ := newMockServer(func(w http.ResponseWriter, _ *http.Request) {
+		data, err := json.Marshal(&task.Task{
+			Id:      "task",
+			Project: "project",
+			TaskOutputInfo: &taskoutput.TaskOutput{
+				TaskLogs: taskoutput.TaskLogOutput{
+					Version: 1,
+					BucketConfig: evergreen.BucketConfig{
+						Name: t.TempDir(),
+						Type: "local",
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	})
+	defer server.Close()
+
+	comm := NewHostCommunicator(server.URL, "host", "host_secret")
+	logger, err := comm.GetLoggerProducer(context.Background(), TaskData{ID: "task", Secret: "task_secret"}, &LoggerConfig{
+		Agent:  []LogOpts{{Sender: model.EvergreenLogSender}},
+		System: []LogOpts{{Sender: model.FileLogSender, Filepath: filepath.Join(t.TempDir(), "system.log")}},
+		Task:   []LogOpts{{Sender: model.EvergreenLogSender, BufferSize: 10, BufferDuration: time.Minute}},
+	})
+	require.NoError(t, err)
+
+	state := logger.State()
+	assert.False(t, state.Closed)
+	assert.Zero(t, state.LastFlushedAt)
+	require.Len(t, state.Senders, 3)
+	assert.Equal(t, LogSenderState{
+		LogType:       string(taskoutput.TaskLogTypeAgent),
+		Sender:        model.EvergreenLogSender,
+		BufferSize:    defaultLogBufferSize,
+		FlushInterval: defaultLogBufferTime,
+	}, state.Senders[0])
+	assert.Equal(t, LogSenderState{
+		LogType:       string(taskoutput.TaskLogTypeTask),
+		Sender:        model.EvergreenLogSender,
+		BufferSize:    10,
+		FlushInterval: time.Minute,
+	}, state.Senders[1])
+	assert.Equal(t, model.EvergreenLogSender, state.Senders[2].Sender, "system logs should not be sent to a file")
+
+	assert.NoError(t, logger.Flush(context.Background()))
+	state = logger.State()
+	assert.NotZero(t, state.LastFlushedAt)
+	assert.Empty(t, state.LastFlushError)
+
+	require.NoError(t, logger.Close())
+	assert.True(t, logger.State().Closed)
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"time"
 
 	"github.com/evergreen-ci/evergreen/apimodels"
@@ -96,6 +97,9 @@ type SharedCommunicator interface {
 	NewPush(context.Context, TaskData, *apimodels.S3CopyRequest) (*model.PushLog, error)
 	UpdatePushStatus(context.Context, TaskData, *model.PushLog) error
 	AttachFiles(context.Context, TaskData, []*artifact.File) error
+	// UploadArtifact uploads an artifact of the given size to the artifact
+	// bucket and attaches it to the task.
+	UploadArtifact(context.Context, TaskData, ArtifactUploadOptions, int64, io.Reader) (*artifact.File, error)
 	GetManifest(context.Context, TaskData) (*manifest.Manifest, error)
 	KeyValInc(context.Context, TaskData, *model.KeyVal) error
 
@@ -131,6 +135,15 @@ type TaskData struct {
 	OverrideValidation bool
 }
 
+// ArtifactUploadOptions describes an artifact to upload to the artifact
+// bucket.
+type ArtifactUploadOptions struct {
+	// Name is the path of the artifact relative to the task's artifacts.
+	Name        string
+	Visibility  string
+	ContentType string
+}
+
 type LoggerConfig struct {
 	System             []LogOpts
 	Agent              []LogOpts
@@ -168,4 +181,25 @@ type LoggerProducer interface {
 	Close() error
 	// Closed returns true if this logger has been closed, false otherwise.
 	Closed() bool
+	// State returns a snapshot of the current state of the logger, which is
+	// intended for debugging.
+	State() LoggerProducerState
+}
+
+// LoggerProducerState is a snapshot of the state of a LoggerProducer.
+type LoggerProducerState struct {
+	Closed bool `json:"closed"`
+	// LastFlushedAt is the last time the logs were explicitly flushed.
+	LastFlushedAt time.Time `json:"last_flushed_at,omitempty"`
+	// LastFlushError is the error from the last explicit flush, if any.
+	LastFlushError string           `json:"last_flush_error,omitempty"`
+	Senders        []LogSenderState `json:"senders,omitempty"`
+}
+
+// LogSenderState describes the configuration of a single log sender.
+type LogSenderState struct {
+	LogType       string        `json:"log_type"`
+	Sender        string        `json:"sender"`
+	BufferSize    int           `json:"buffer_size,omitempty"`
+	FlushInterval time.Duration `json:"flush_interval,omitempty"`
 }
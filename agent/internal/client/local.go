@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// errNotSupportedLocally is returned for operations that need an Evergreen
+// app server, which is not available when running a task locally.
+var errNotSupportedLocally = errors.New("operation is not supported when running a task locally")
+
+// Local is a Communicator for running a task on the local machine without an
+// Evergreen app server. It follows the Mock communicator, but logs data that
+// the task would have sent to the app server and fails operations that cannot
+// be done without one.
+type Local struct {
+	*Mock
+	logger grip.Journaler
+}
+
+// NewLocal returns a Communicator for running a task locally that logs to the
+// given logger.
+func NewLocal(logger grip.Journaler) *Local {
+	return &Local{
+		Mock:   NewMock(""),
+		logger: logger,
+	}
+}
+
+// GetCedarConfig returns an error because Cedar is not available locally.
+func (c *Local) GetCedarConfig(ctx context.Context) (*apimodels.CedarConfig, error) {
+	return nil, errors.Wrap(errNotSupportedLocally, "getting Cedar config")
+}
+
+// GetCedarGRPCConn returns an error because Cedar is not available locally.
+func (c *Local) GetCedarGRPCConn(ctx context.Context) (*grpc.ClientConn, error) {
+	return nil, errors.Wrap(errNotSupportedLocally, "getting Cedar gRPC connection")
+}
+
+// GetDataPipesConfig returns an error because Data-Pipes is not available
+// locally.
+func (c *Local) GetDataPipesConfig(ctx context.Context) (*apimodels.DataPipesConfig, error) {
+	return nil, errors.Wrap(errNotSupportedLocally, "getting Data-Pipes config")
+}
+
+// AttachFiles logs the task files that would have been attached to the task.
+func (c *Local) AttachFiles(ctx context.Context, td TaskData, taskFiles []*artifact.File) error {
+	for _, f := range taskFiles {
+		c.logger.Infof("Would attach file '%s' with link '%s' to the task.", f.Name, f.Link)
+	}
+	return c.Mock.AttachFiles(ctx, td, taskFiles)
+}
+
+// UploadArtifact returns an error because there is no artifact bucket
+// locally.
+func (c *Local) UploadArtifact(ctx context.Context, td TaskData, opts ArtifactUploadOptions, size int64, body io.Reader) (*artifact.File, error) {
+	return nil, errors.Wrapf(errNotSupportedLocally, "uploading artifact '%s'", opts.Name)
+}
+
+// SendTestLog logs the test log that would have been sent for the task.
+func (c *Local) SendTestLog(ctx context.Context, td TaskData, log *testlog.TestLog) (string, error) {
+	if log != nil {
+		c.logger.Infof("Would send test log '%s' with %d line(s) for the task.", log.Name, len(log.Lines))
+	}
+	return c.Mock.SendTestLog(ctx, td, log)
+}
+
+// GenerateTasks returns an error because generated tasks cannot be added to a
+// version locally.
+func (c *Local) GenerateTasks(ctx context.Context, td TaskData, jsonBytes []json.RawMessage) error {
+	return errors.Wrap(errNotSupportedLocally, "generating tasks")
+}
+
+// CreateHost returns an error because hosts cannot be created locally.
+func (c *Local) CreateHost(ctx context.Context, td TaskData, options apimodels.CreateHost) ([]string, error) {
+	return nil, errors.Wrap(errNotSupportedLocally, "creating host")
+}
+
+// CreateInstallationToken returns an error because GitHub app tokens can only
+// be created by the app server.
+func (c *Local) CreateInstallationToken(ctx context.Context, td TaskData, owner, repo string) (string, error) {
+	return "", errors.Wrap(errNotSupportedLocally, "creating GitHub installation token")
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/logging"
@@ -22,7 +23,10 @@ type logHarness struct {
 	system                    grip.Journaler
 	mu                        sync.RWMutex
 	underlyingBufferedSenders []send.Sender
+	senderStates              []LogSenderState
 	closed                    bool
+	lastFlushedAt             time.Time
+	lastFlushErr              error
 }
 
 func (l *logHarness) Execution() grip.Journaler { return l.execution }
@@ -45,7 +49,10 @@ func (l *logHarness) Flush(ctx context.Context) error {
 	catcher.Add(l.task.GetSender().Flush(ctx))
 	catcher.Add(l.system.GetSender().Flush(ctx))
 
-	return catcher.Resolve()
+	l.lastFlushedAt = time.Now()
+	l.lastFlushErr = catcher.Resolve()
+
+	return l.lastFlushErr
 }
 
 // Close closes all the task loggers and prevents further writes to it. Note
@@ -79,14 +86,32 @@ func (l *logHarness) Closed() bool {
 	return l.closed
 }
 
+func (l *logHarness) State() LoggerProducerState {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	state := LoggerProducerState{
+		Closed:        l.closed,
+		LastFlushedAt: l.lastFlushedAt,
+		Senders:       append([]LogSenderState{}, l.senderStates...),
+	}
+	if l.lastFlushErr != nil {
+		state.LastFlushError = l.lastFlushErr.Error()
+	}
+
+	return state
+}
+
 ////////////////////////////////////////////////////////////////////////
 //
 // Single Channel LoggerProducer
 
 type singleChannelLogHarness struct {
-	logger grip.Journaler
-	mu     sync.RWMutex
-	closed bool
+	logger        grip.Journaler
+	mu            sync.RWMutex
+	closed        bool
+	lastFlushedAt time.Time
+	lastFlushErr  error
 }
 
 // NewSingleChannelLogHarnness returns a log implementation that uses
@@ -118,7 +143,10 @@ func (l *singleChannelLogHarness) Flush(ctx context.Context) error {
 		return nil
 	}
 
-	return l.logger.GetSender().Flush(ctx)
+	l.lastFlushedAt = time.Now()
+	l.lastFlushErr = l.logger.GetSender().Flush(ctx)
+
+	return l.lastFlushErr
 }
 
 func (l *singleChannelLogHarness) Close() error {
@@ -141,3 +169,22 @@ func (l *singleChannelLogHarness) Closed() bool {
 	defer l.mu.RUnlock()
 	return l.closed
 }
+
+func (l *singleChannelLogHarness) State() LoggerProducerState {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	state := LoggerProducerState{
+		Closed:        l.closed,
+		LastFlushedAt: l.lastFlushedAt,
+		Senders: []LogSenderState{{
+			LogType: "all",
+			Sender:  l.logger.GetSender().Name(),
+		}},
+	}
+	if l.lastFlushErr != nil {
+		state.LastFlushError = l.lastFlushErr.Error()
+	}
+
+	return state
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -70,13 +72,16 @@ type Mock struct {
 
 	CedarGRPCConn *grpc.ClientConn
 
-	AttachedFiles    map[string][]*artifact.File
-	LogID            string
-	LocalTestResults []testresult.TestResult
-	ResultsService   string
-	ResultsFailed    bool
-	TestLogs         []*testlog.TestLog
-	TestLogCount     int
+	AttachedFiles map[string][]*artifact.File
+	// UploadedArtifacts maps task IDs to the contents of their uploaded
+	// artifacts by name.
+	UploadedArtifacts map[string]map[string][]byte
+	LogID             string
+	LocalTestResults  []testresult.TestResult
+	ResultsService    string
+	ResultsFailed     bool
+	TestLogs          []*testlog.TestLog
+	TestLogCount      int
 
 	taskLogs   map[string][]log.LogLine
 	PatchFiles map[string]string
@@ -97,14 +102,15 @@ type EndTaskResult struct {
 // NewMock returns a Communicator for testing.
 func NewMock(serverURL string) *Mock {
 	return &Mock{
-		maxAttempts:   defaultMaxAttempts,
-		timeoutStart:  defaultTimeoutStart,
-		timeoutMax:    defaultTimeoutMax,
-		taskLogs:      make(map[string][]log.LogLine),
-		PatchFiles:    make(map[string]string),
-		keyVal:        make(map[string]*serviceModel.KeyVal),
-		AttachedFiles: make(map[string][]*artifact.File),
-		serverURL:     serverURL,
+		maxAttempts:       defaultMaxAttempts,
+		timeoutStart:      defaultTimeoutStart,
+		timeoutMax:        defaultTimeoutMax,
+		taskLogs:          make(map[string][]log.LogLine),
+		PatchFiles:        make(map[string]string),
+		keyVal:            make(map[string]*serviceModel.KeyVal),
+		AttachedFiles:     make(map[string][]*artifact.File),
+		UploadedArtifacts: make(map[string]map[string][]byte),
+		serverURL:         serverURL,
 	}
 }
 
@@ -429,6 +435,36 @@ func (c *Mock) AttachFiles(ctx context.Context, td TaskData, taskFiles []*artifa
 	return nil
 }
 
+// UploadArtifact records the uploaded artifact's contents and attaches it to
+// the task.
+func (c *Mock) UploadArtifact(ctx context.Context, td TaskData, opts ArtifactUploadOptions, size int64, body io.Reader) (*artifact.File, error) {
+	content, err := io.ReadAll(io.LimitReader(body, size))
+	if err != nil {
+		return nil, errors.Wrap(err, "reading artifact")
+	}
+	if int64(len(content)) != size {
+		return nil, errors.Errorf("artifact had %d bytes but expected %d bytes", len(content), size)
+	}
+
+	file := &artifact.File{
+		Name:        opts.Name,
+		Link:        fmt.Sprintf("https://example.com/rest/v2/tasks/%s/artifacts/download?name=%s", td.ID, url.QueryEscape(opts.Name)),
+		Visibility:  opts.Visibility,
+		ContentType: opts.ContentType,
+		FileKey:     opts.Name,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.UploadedArtifacts[td.ID] == nil {
+		c.UploadedArtifacts[td.ID] = map[string][]byte{}
+	}
+	c.UploadedArtifacts[td.ID][opts.Name] = content
+	c.AttachedFiles[td.ID] = append(c.AttachedFiles[td.ID], file)
+
+	return file, nil
+}
+
 func (c *Mock) SetDownstreamParams(ctx context.Context, downstreamParams []patchmodel.Parameter, taskData TaskData) error {
 	c.DownstreamParams = downstreamParams
 	return nil
@@ -0,0 +1,197 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+)
+
+// LocalTaskOptions are the options for running a task locally without an
+// Evergreen app server.
+type LocalTaskOptions struct {
+	// Project is the parsed project configuration containing the task.
+	Project *model.Project
+	// BuildVariant is the name of the build variant to run the task in.
+	BuildVariant string
+	// TaskName is the name of the task to run.
+	TaskName string
+	// Expansions are additional expansions available to the task's commands.
+	// These take precedence over the default expansions.
+	Expansions map[string]string
+	// WorkingDirectory is the directory to run the task in. If it is not
+	// set, a temporary directory is created.
+	WorkingDirectory string
+	// Sender is where task logs are sent. If it is not set, logs are written
+	// to standard output.
+	Sender send.Sender
+}
+
+func (o *LocalTaskOptions) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.Project == nil, "must specify a project")
+	catcher.NewWhen(o.BuildVariant == "", "must specify a build variant")
+	catcher.NewWhen(o.TaskName == "", "must specify a task")
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if o.Project.FindBuildVariant(o.BuildVariant) == nil {
+		return errors.Errorf("build variant '%s' not found in project", o.BuildVariant)
+	}
+	if o.Project.FindProjectTask(o.TaskName) == nil {
+		return errors.Errorf("task '%s' not found in project", o.TaskName)
+	}
+	if o.Project.FindTaskForVariant(o.TaskName, o.BuildVariant) == nil {
+		return errors.Errorf("task '%s' does not run on build variant '%s'", o.TaskName, o.BuildVariant)
+	}
+	return nil
+}
+
+// RunLocalTask runs a task from the project on the local machine without an
+// Evergreen app server. The task's blocks are run in the same order as the
+// agent runs them, using the same command implementations, but operations that
+// require the app server (e.g. generate.tasks, host.create) fail. Unlike the
+// agent, it does not kill stray processes or clean up Docker state on the
+// local machine between blocks. It returns the final status of the task.
+func RunLocalTask(ctx context.Context, opts LocalTaskOptions) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", errors.Wrap(err, "invalid local task options")
+	}
+
+	workDir := opts.WorkingDirectory
+	if workDir == "" {
+		var err error
+		workDir, err = os.MkdirTemp("", "evg-local-task")
+		if err != nil {
+			return "", errors.Wrap(err, "creating working directory")
+		}
+	} else if err := os.MkdirAll(workDir, 0755); err != nil {
+		return "", errors.Wrapf(err, "creating working directory '%s'", workDir)
+	}
+
+	sender := opts.Sender
+	if sender == nil {
+		sender = send.MakePlainLogger()
+	}
+	logger := client.NewSingleChannelLogHarness("local", sender)
+	defer func() {
+		grip.Warning(errors.Wrap(logger.Close(), "closing local task logger"))
+	}()
+
+	tsk := &task.Task{
+		Id:           fmt.Sprintf("local_%s_%s", opts.BuildVariant, opts.TaskName),
+		DisplayName:  opts.TaskName,
+		BuildVariant: opts.BuildVariant,
+		Project:      opts.Project.Identifier,
+		Version:      "local",
+		Requester:    evergreen.PatchVersionRequester,
+	}
+	// For tasks in a task group, the build variant task unit has the name of
+	// the task group rather than the task.
+	if bvtu := opts.Project.FindTaskForVariant(opts.TaskName, opts.BuildVariant); bvtu.Name != opts.TaskName {
+		tsk.TaskGroup = bvtu.Name
+	}
+	projectRef := &model.ProjectRef{
+		Id:         opts.Project.Identifier,
+		Identifier: opts.Project.Identifier,
+	}
+
+	expansions := util.Expansions{}
+	expansions.Put("execution", "0")
+	expansions.Put("version_id", tsk.Version)
+	expansions.Put("task_id", tsk.Id)
+	expansions.Put("task_name", tsk.DisplayName)
+	expansions.Put("build_variant", tsk.BuildVariant)
+	expansions.Put("project", projectRef.Identifier)
+	expansions.Put("project_identifier", projectRef.Identifier)
+	expansions.Put("project_id", projectRef.Id)
+	expansions.Put("workdir", workDir)
+	expansions.Update(opts.Expansions)
+
+	taskConfig, err := internal.NewTaskConfig(workDir, &apimodels.DistroView{}, opts.Project, tsk, projectRef, nil, expansions)
+	if err != nil {
+		return "", errors.Wrap(err, "creating task config")
+	}
+
+	jpm, err := jasper.NewSynchronizedManager(false)
+	if err != nil {
+		return "", errors.Wrap(err, "creating process manager")
+	}
+	defer func() {
+		grip.Warning(errors.Wrap(jpm.Close(ctx), "closing process manager"))
+	}()
+
+	a := &Agent{
+		opts:           Options{WorkingDirectory: workDir},
+		comm:           client.NewLocal(logger.Execution()),
+		jasper:         jpm,
+		setEndTaskResp: func(*triggerEndTaskResp) {},
+		tracer:         otel.GetTracerProvider().Tracer("local_task"),
+	}
+	tc := &taskContext{
+		task:       client.TaskData{ID: tsk.Id},
+		taskConfig: taskConfig,
+		logger:     logger,
+		oomTracker: jasper.NewOOMTracker(),
+	}
+
+	logger.Task().Infof("Running task '%s' on build variant '%s' locally in directory '%s'.", tsk.DisplayName, tsk.BuildVariant, workDir)
+	start := time.Now()
+	status := a.runLocalTask(ctx, tc)
+	logger.Task().Infof("Task finished with status '%s' in %s.", status, time.Since(start).String())
+
+	return status, nil
+}
+
+// runLocalTask runs all of the task's blocks and returns the task status.
+func (a *Agent) runLocalTask(ctx context.Context, tc *taskContext) string {
+	execCtx, execCancel := context.WithTimeout(ctx, tc.getExecTimeout())
+	defer execCancel()
+
+	status := evergreen.TaskSucceeded
+	if err := a.runPreTaskCommands(execCtx, tc); err != nil {
+		status = evergreen.TaskFailed
+	} else if err := a.runTaskCommands(execCtx, tc); err != nil {
+		status = evergreen.TaskFailed
+	}
+
+	if errors.Is(execCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		tc.logger.Execution().Errorf("Task exceeded its exec timeout of %s.", tc.getExecTimeout().String())
+		tc.setTimedOut(true, execTimeout)
+		status = evergreen.TaskFailed
+		a.runTaskTimeoutCommands(ctx, tc)
+	}
+
+	// Post and teardown commands are run directly rather than through the
+	// agent's helpers, since those kill processes belonging to any agent on
+	// the machine.
+	if post, err := tc.getPost(); err != nil {
+		tc.logger.Execution().Error(errors.Wrap(err, "fetching post-task commands"))
+	} else if post.commands != nil {
+		if err := a.runCommandsInBlock(ctx, tc, *post); err != nil && post.canFailTask && status == evergreen.TaskSucceeded {
+			status = evergreen.TaskFailed
+		}
+	}
+
+	if teardownGroup, err := tc.getTeardownGroup(); err != nil {
+		tc.logger.Execution().Error(errors.Wrap(err, "fetching teardown-group commands"))
+	} else if teardownGroup.commands != nil {
+		_ = a.runCommandsInBlock(ctx, tc, *teardownGroup)
+	}
+
+	return status
+}
@@ -0,0 +1,125 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalTask(t *testing.T) {
+	const projYml = `
+pre:
+  - command: shell.exec
+    params:
+      script: echo "pre" > pre.txt
+
+functions:
+  write_file:
+    - command: shell.exec
+      params:
+        script: echo "${greeting} from ${task_name}" > ${file_name}
+
+tasks:
+  - name: succeeds
+    commands:
+      - func: write_file
+        vars:
+          file_name: main.txt
+  - name: fails
+    commands:
+      - command: shell.exec
+        params:
+          script: exit 1
+  - name: grouped
+    commands:
+      - func: write_file
+        vars:
+          file_name: grouped.txt
+
+task_groups:
+  - name: group
+    setup_group:
+      - command: shell.exec
+        params:
+          script: echo "setup" > setup_group.txt
+    teardown_group:
+      - command: shell.exec
+        params:
+          script: echo "teardown" > teardown_group.txt
+    tasks:
+      - grouped
+
+buildvariants:
+  - name: bv
+    tasks:
+      - name: succeeds
+      - name: fails
+      - name: group
+`
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var project model.Project
+	_, err := model.LoadProjectInto(ctx, []byte(projYml), &model.GetProjectOpts{}, "local_project", &project)
+	require.NoError(t, err)
+
+	for tName, tCase := range map[string]func(t *testing.T, opts LocalTaskOptions){
+		"RunsTaskWithExpansions": func(t *testing.T, opts LocalTaskOptions) {
+			opts.TaskName = "succeeds"
+			status, err := RunLocalTask(ctx, opts)
+			require.NoError(t, err)
+			assert.Equal(t, evergreen.TaskSucceeded, status)
+
+			out, err := os.ReadFile(filepath.Join(opts.WorkingDirectory, "main.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "hello from succeeds\n", string(out))
+			assert.FileExists(t, filepath.Join(opts.WorkingDirectory, "pre.txt"))
+		},
+		"FailsWhenCommandFails": func(t *testing.T, opts LocalTaskOptions) {
+			opts.TaskName = "fails"
+			status, err := RunLocalTask(ctx, opts)
+			require.NoError(t, err)
+			assert.Equal(t, evergreen.TaskFailed, status)
+		},
+		"RunsTaskGroupSetupAndTeardown": func(t *testing.T, opts LocalTaskOptions) {
+			opts.TaskName = "grouped"
+			status, err := RunLocalTask(ctx, opts)
+			require.NoError(t, err)
+			assert.Equal(t, evergreen.TaskSucceeded, status)
+
+			assert.FileExists(t, filepath.Join(opts.WorkingDirectory, "setup_group.txt"))
+			assert.FileExists(t, filepath.Join(opts.WorkingDirectory, "grouped.txt"))
+			assert.FileExists(t, filepath.Join(opts.WorkingDirectory, "teardown_group.txt"))
+			assert.NoFileExists(t, filepath.Join(opts.WorkingDirectory, "pre.txt"), "pre should not run for task group tasks")
+		},
+		"ErrorsForNonexistentTask": func(t *testing.T, opts LocalTaskOptions) {
+			opts.TaskName = "nonexistent"
+			_, err := RunLocalTask(ctx, opts)
+			assert.Error(t, err)
+		},
+		"ErrorsForTaskNotInVariant": func(t *testing.T, opts LocalTaskOptions) {
+			opts.TaskName = "succeeds"
+			opts.BuildVariant = "nonexistent"
+			_, err := RunLocalTask(ctx, opts)
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tCase(t, LocalTaskOptions{
+				Project:          &project,
+				BuildVariant:     "bv",
+				Expansions:       map[string]string{"greeting": "hello"},
+				WorkingDirectory: t.TempDir(),
+				Sender:           send.MakeInternalLogger(),
+			})
+		})
+	}
+}
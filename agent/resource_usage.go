@@ -0,0 +1,229 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// resourceCounters are cumulative I/O counters for the machine.
+type resourceCounters struct {
+	diskReadBytes        uint64
+	diskWriteBytes       uint64
+	networkBytesSent     uint64
+	networkBytesReceived uint64
+}
+
+// resourceSampler samples the machine's current resource usage.
+type resourceSampler interface {
+	// memoryUsed returns the memory currently in use on the machine.
+	memoryUsed(ctx context.Context) (uint64, error)
+	// cpuPercent returns the CPU utilization across all CPUs since the last
+	// call.
+	cpuPercent(ctx context.Context) (float64, error)
+	// counters returns the cumulative disk and network I/O counters.
+	counters(ctx context.Context) (resourceCounters, error)
+}
+
+// systemResourceSampler samples resource usage from the operating system.
+type systemResourceSampler struct{}
+
+func (systemResourceSampler) memoryUsed(ctx context.Context) (uint64, error) {
+	memStats, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting memory stats")
+	}
+	return memStats.Used, nil
+}
+
+func (systemResourceSampler) cpuPercent(ctx context.Context) (float64, error) {
+	util, err := cpu.PercentWithContext(ctx, 0, false)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting CPU util")
+	}
+	if len(util) != 1 {
+		return 0, errors.New("CPU util had an unexpected length")
+	}
+	return util[0], nil
+}
+
+func (systemResourceSampler) counters(ctx context.Context) (resourceCounters, error) {
+	var counters resourceCounters
+	catcher := grip.NewBasicCatcher()
+
+	diskCounters, err := disk.IOCountersWithContext(ctx)
+	catcher.Wrap(err, "getting disk stats")
+	for _, c := range diskCounters {
+		counters.diskReadBytes += c.ReadBytes
+		counters.diskWriteBytes += c.WriteBytes
+	}
+
+	netCounters, err := net.IOCountersWithContext(ctx, false)
+	catcher.Wrap(err, "getting network stats")
+	for _, c := range netCounters {
+		counters.networkBytesSent += c.BytesSent
+		counters.networkBytesReceived += c.BytesRecv
+	}
+
+	return counters, catcher.Resolve()
+}
+
+// resourceUsageCollector periodically samples the machine's resource usage
+// while a task runs and summarizes it when the task ends.
+type resourceUsageCollector struct {
+	sampler  resourceSampler
+	interval time.Duration
+
+	startedAt     time.Time
+	startCounters resourceCounters
+	peakMemory    uint64
+	cpuTotal      float64
+	cpuSamples    int
+	cancel        context.CancelFunc
+	mu            sync.Mutex
+}
+
+func newResourceUsageCollector(sampler resourceSampler, interval time.Duration) *resourceUsageCollector {
+	return &resourceUsageCollector{
+		sampler:  sampler,
+		interval: interval,
+	}
+}
+
+// start records the initial I/O counters and begins sampling in the
+// background until the context is done or the collector is stopped.
+func (c *resourceUsageCollector) start(ctx context.Context) error {
+	startCounters, err := c.sampler.counters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting initial resource counters")
+	}
+	// The first CPU sample only sets the baseline for the next one.
+	_, _ = c.sampler.cpuPercent(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.startedAt = time.Now()
+	c.startCounters = startCounters
+	c.cancel = cancel
+	c.mu.Unlock()
+
+	go c.sampleLoop(ctx)
+
+	return nil
+}
+
+func (c *resourceUsageCollector) sampleLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.sample(ctx)
+		}
+	}
+}
+
+// sample records the current memory and CPU usage.
+func (c *resourceUsageCollector) sample(ctx context.Context) {
+	memUsed, memErr := c.sampler.memoryUsed(ctx)
+	cpuPercent, cpuErr := c.sampler.cpuPercent(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if memErr == nil && memUsed > c.peakMemory {
+		c.peakMemory = memUsed
+	}
+	if cpuErr == nil {
+		c.cpuTotal += cpuPercent
+		c.cpuSamples++
+	}
+}
+
+// stop stops sampling and returns the summary of the resource usage since the
+// collector started.
+func (c *resourceUsageCollector) stop(ctx context.Context) (*apimodels.TaskResourceUsage, error) {
+	c.mu.Lock()
+	cancel := c.cancel
+	c.mu.Unlock()
+	if cancel == nil {
+		return nil, errors.New("resource usage collection was never started")
+	}
+	cancel()
+
+	// Take a final sample so that short tasks still get a summary.
+	c.sample(ctx)
+	endCounters, err := c.sampler.counters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting final resource counters")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	usage := &apimodels.TaskResourceUsage{
+		PeakMemoryBytes:      int64(c.peakMemory),
+		DiskReadBytes:        counterDelta(c.startCounters.diskReadBytes, endCounters.diskReadBytes),
+		DiskWriteBytes:       counterDelta(c.startCounters.diskWriteBytes, endCounters.diskWriteBytes),
+		NetworkBytesSent:     counterDelta(c.startCounters.networkBytesSent, endCounters.networkBytesSent),
+		NetworkBytesReceived: counterDelta(c.startCounters.networkBytesReceived, endCounters.networkBytesReceived),
+		DurationSecs:         time.Since(c.startedAt).Seconds(),
+	}
+	if c.cpuSamples > 0 {
+		usage.AvgCPUPercent = c.cpuTotal / float64(c.cpuSamples)
+	}
+
+	return usage, nil
+}
+
+// counterDelta returns the increase in a cumulative counter. Counters can
+// reset (e.g. if a device is removed), in which case there is no meaningful
+// delta.
+func counterDelta(start, end uint64) int64 {
+	if end < start {
+		return 0
+	}
+	return int64(end - start)
+}
+
+// startResourceUsageCollection starts collecting the resource usage summary
+// for the task.
+func (a *Agent) startResourceUsageCollection(ctx context.Context, tc *taskContext) {
+	collector := newResourceUsageCollector(systemResourceSampler{}, defaultResourceUsageInterval)
+	if err := collector.start(ctx); err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "starting resource usage collection"))
+		return
+	}
+	tc.setResourceUsageCollector(collector)
+}
+
+// getResourceUsage stops collecting the task's resource usage and returns the
+// summary, if it was collected. If the task ran in a cgroup, the cgroup's peak
+// memory is used instead since it only includes the task's processes.
+func (tc *taskContext) getResourceUsage(ctx context.Context, cgroupStats *apimodels.CgroupStats) *apimodels.TaskResourceUsage {
+	collector := tc.getResourceUsageCollector()
+	if collector == nil {
+		return nil
+	}
+
+	usage, err := collector.stop(ctx)
+	if err != nil {
+		tc.logger.Execution().Warning(errors.Wrap(err, "getting task resource usage"))
+		return nil
+	}
+	if cgroupStats != nil && cgroupStats.PeakMemoryBytes > 0 {
+		usage.PeakMemoryBytes = cgroupStats.PeakMemoryBytes
+	}
+
+	return usage
+}
@@ -0,0 +1,174 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResourceSampler struct {
+	memory      []uint64
+	cpu         []float64
+	counterVals []resourceCounters
+	countersErr error
+	mu          sync.Mutex
+}
+
+func (s *mockResourceSampler) memoryUsed(context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.memory) == 0 {
+		return 0, errors.New("no memory samples")
+	}
+	val := s.memory[0]
+	if len(s.memory) > 1 {
+		s.memory = s.memory[1:]
+	}
+	return val, nil
+}
+
+func (s *mockResourceSampler) cpuPercent(context.Context) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cpu) == 0 {
+		return 0, errors.New("no CPU samples")
+	}
+	val := s.cpu[0]
+	if len(s.cpu) > 1 {
+		s.cpu = s.cpu[1:]
+	}
+	return val, nil
+}
+
+func (s *mockResourceSampler) counters(context.Context) (resourceCounters, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.countersErr != nil {
+		return resourceCounters{}, s.countersErr
+	}
+	val := s.counterVals[0]
+	if len(s.counterVals) > 1 {
+		s.counterVals = s.counterVals[1:]
+	}
+	return val, nil
+}
+
+func TestResourceUsageCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("SummarizesSamples", func(t *testing.T) {
+		sampler := &mockResourceSampler{
+			memory: []uint64{100, 300, 200},
+			// The first CPU sample is discarded as the baseline.
+			cpu: []float64{99, 10, 30, 50},
+			counterVals: []resourceCounters{
+				{diskReadBytes: 10, diskWriteBytes: 20, networkBytesSent: 30, networkBytesReceived: 40},
+				{diskReadBytes: 110, diskWriteBytes: 220, networkBytesSent: 330, networkBytesReceived: 440},
+			},
+		}
+		c := newResourceUsageCollector(sampler, time.Hour)
+		require.NoError(t, c.start(ctx))
+		c.sample(ctx)
+		c.sample(ctx)
+
+		usage, err := c.stop(ctx)
+		require.NoError(t, err)
+		require.NotZero(t, usage)
+		assert.EqualValues(t, 300, usage.PeakMemoryBytes)
+		assert.Equal(t, 30.0, usage.AvgCPUPercent)
+		assert.EqualValues(t, 100, usage.DiskReadBytes)
+		assert.EqualValues(t, 200, usage.DiskWriteBytes)
+		assert.EqualValues(t, 300, usage.NetworkBytesSent)
+		assert.EqualValues(t, 400, usage.NetworkBytesReceived)
+		assert.True(t, usage.DurationSecs >= 0)
+	})
+	t.Run("SamplesPeriodically", func(t *testing.T) {
+		sampler := &mockResourceSampler{
+			memory:      []uint64{500},
+			cpu:         []float64{0, 20},
+			counterVals: []resourceCounters{{}},
+		}
+		c := newResourceUsageCollector(sampler, time.Millisecond)
+		require.NoError(t, c.start(ctx))
+		assert.Eventually(t, func() bool {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			return c.cpuSamples > 1
+		}, time.Second, time.Millisecond)
+
+		usage, err := c.stop(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 500, usage.PeakMemoryBytes)
+		assert.Equal(t, 20.0, usage.AvgCPUPercent)
+	})
+	t.Run("IgnoresCounterResets", func(t *testing.T) {
+		sampler := &mockResourceSampler{
+			memory: []uint64{1},
+			cpu:    []float64{1},
+			counterVals: []resourceCounters{
+				{diskReadBytes: 100},
+				{diskReadBytes: 50},
+			},
+		}
+		c := newResourceUsageCollector(sampler, time.Hour)
+		require.NoError(t, c.start(ctx))
+
+		usage, err := c.stop(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, usage.DiskReadBytes)
+	})
+	t.Run("FailsToStartWithoutCounters", func(t *testing.T) {
+		c := newResourceUsageCollector(&mockResourceSampler{countersErr: errors.New("error")}, time.Hour)
+		assert.Error(t, c.start(ctx))
+	})
+	t.Run("FailsToStopWithoutStarting", func(t *testing.T) {
+		c := newResourceUsageCollector(&mockResourceSampler{}, time.Hour)
+		_, err := c.stop(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestTaskContextResourceUsage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newCollector := func(t *testing.T) *resourceUsageCollector {
+		c := newResourceUsageCollector(&mockResourceSampler{
+			memory:      []uint64{1000},
+			cpu:         []float64{10},
+			counterVals: []resourceCounters{{}},
+		}, time.Hour)
+		require.NoError(t, c.start(ctx))
+		return c
+	}
+
+	t.Run("ReturnsNilWithoutCollector", func(t *testing.T) {
+		tc := &taskContext{logger: client.NewSingleChannelLogHarness("test", send.MakeInternalLogger())}
+		assert.Nil(t, tc.getResourceUsage(ctx, nil))
+	})
+	t.Run("ReturnsMachineUsage", func(t *testing.T) {
+		tc := &taskContext{logger: client.NewSingleChannelLogHarness("test", send.MakeInternalLogger())}
+		tc.setResourceUsageCollector(newCollector(t))
+
+		usage := tc.getResourceUsage(ctx, nil)
+		require.NotZero(t, usage)
+		assert.EqualValues(t, 1000, usage.PeakMemoryBytes)
+	})
+	t.Run("PrefersCgroupPeakMemory", func(t *testing.T) {
+		tc := &taskContext{logger: client.NewSingleChannelLogHarness("test", send.MakeInternalLogger())}
+		tc.setResourceUsageCollector(newCollector(t))
+
+		usage := tc.getResourceUsage(ctx, &apimodels.CgroupStats{PeakMemoryBytes: 10})
+		require.NotZero(t, usage)
+		assert.EqualValues(t, 10, usage.PeakMemoryBytes)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
@@ -37,6 +38,11 @@ func (a *Agent) startStatusServer(ctx context.Context, port int) error {
 	app.AddRoute("/task_status").Handler(a.endTaskHandler).Post()
 	app.AddRoute("/oom/clear").Handler(http.RedirectHandler("/jasper/v1/list/oom", http.StatusMovedPermanently).ServeHTTP).Delete()
 	app.AddRoute("/oom/check").Handler(http.RedirectHandler("/jasper/v1/list/oom", http.StatusMovedPermanently).ServeHTTP).Get()
+	app.AddRoute("/debug/" + apimodels.AgentDebugInfoTask).Handler(a.debugTaskHandler).Get()
+	app.AddRoute("/debug/" + apimodels.AgentDebugInfoProcesses).Handler(a.debugProcessesHandler).Get()
+	app.AddRoute("/debug/" + apimodels.AgentDebugInfoTimeouts).Handler(a.debugTimeoutsHandler).Get()
+	app.AddRoute("/debug/" + apimodels.AgentDebugInfoLogger).Handler(a.debugLoggerHandler).Get()
+	app.AddRoute("/debug/" + apimodels.AgentDebugInfoGoroutines).Handler(a.debugGoroutinesHandler).Get()
 
 	jpmapp := remote.NewRESTService(a.jasper).App(ctx)
 	jpmapp.SetPrefix("jasper")
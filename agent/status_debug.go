@@ -0,0 +1,237 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime/pprof"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+func (a *Agent) setCurrentTaskContext(tc *taskContext) {
+	a.currentTaskContextMutex.Lock()
+	defer a.currentTaskContextMutex.Unlock()
+	a.currentTaskContext = tc
+}
+
+func (a *Agent) getCurrentTaskContext() *taskContext {
+	a.currentTaskContextMutex.RLock()
+	defer a.currentTaskContextMutex.RUnlock()
+	return a.currentTaskContext
+}
+
+// debugTaskResponse describes the command that the agent is currently running.
+type debugTaskResponse struct {
+	TaskID             string    `json:"task_id,omitempty"`
+	Block              string    `json:"block,omitempty"`
+	Command            string    `json:"command,omitempty"`
+	CommandType        string    `json:"command_type,omitempty"`
+	CommandStartedAt   time.Time `json:"command_started_at,omitempty"`
+	CommandRuntimeSecs float64   `json:"command_runtime_secs,omitempty"`
+}
+
+// debugTaskHandler reports the task, block, and command that the agent is
+// currently running, as well as how long the command has been running.
+func (a *Agent) debugTaskHandler(w http.ResponseWriter, r *http.Request) {
+	resp := debugTaskResponse{}
+	if tc := a.getCurrentTaskContext(); tc != nil {
+		resp.TaskID = tc.task.ID
+		resp.Block = string(tc.getCurrentBlock())
+		if cmd := tc.getCurrentCommand(); cmd != nil {
+			resp.Command = cmd.FullDisplayName()
+			resp.CommandType = cmd.Type()
+			resp.CommandStartedAt = tc.getCurrentCommandStartedAt()
+			resp.CommandRuntimeSecs = time.Since(resp.CommandStartedAt).Seconds()
+		}
+	}
+
+	writeDebugJSON(w, resp)
+}
+
+// debugProcess describes a process managed by the agent's Jasper manager. It
+// intentionally omits the process environment, which may contain secrets.
+type debugProcess struct {
+	ID               string    `json:"id"`
+	PID              int       `json:"pid"`
+	Args             []string  `json:"args"`
+	WorkingDirectory string    `json:"working_directory,omitempty"`
+	Tags             []string  `json:"tags,omitempty"`
+	IsRunning        bool      `json:"is_running"`
+	Complete         bool      `json:"complete"`
+	ExitCode         int       `json:"exit_code"`
+	StartAt          time.Time `json:"start_at,omitempty"`
+	EndAt            time.Time `json:"end_at,omitempty"`
+}
+
+// debugProcessesResponse describes the processes spawned by the agent.
+type debugProcessesResponse struct {
+	// JasperProcesses are the processes started through the agent's Jasper
+	// manager.
+	JasperProcesses []debugProcess `json:"jasper_processes"`
+	// ProcessTree is the full tree of processes descending from the agent.
+	ProcessTree []*message.ProcessInfo `json:"ps_info"`
+}
+
+// debugProcessesHandler reports the processes that the agent has spawned.
+func (a *Agent) debugProcessesHandler(w http.ResponseWriter, r *http.Request) {
+	resp := debugProcessesResponse{JasperProcesses: []debugProcess{}}
+
+	procs, err := a.jasper.List(r.Context(), options.All)
+	if err != nil {
+		writeDebugError(w, errors.Wrap(err, "listing Jasper processes"))
+		return
+	}
+	for _, p := range procs {
+		info := p.Info(r.Context())
+		resp.JasperProcesses = append(resp.JasperProcesses, debugProcess{
+			ID:               info.ID,
+			PID:              info.PID,
+			Args:             info.Options.Args,
+			WorkingDirectory: info.Options.WorkingDirectory,
+			Tags:             p.GetTags(),
+			IsRunning:        info.IsRunning,
+			Complete:         info.Complete,
+			ExitCode:         info.ExitCode,
+			StartAt:          info.StartAt,
+			EndAt:            info.EndAt,
+		})
+	}
+	sort.Slice(resp.JasperProcesses, func(i, j int) bool {
+		return resp.JasperProcesses[i].StartAt.Before(resp.JasperProcesses[j].StartAt)
+	})
+
+	for _, p := range message.CollectProcessInfoSelfWithChildren() {
+		resp.ProcessTree = append(resp.ProcessTree, p.(*message.ProcessInfo))
+	}
+
+	writeDebugJSON(w, resp)
+}
+
+// debugTimeout describes a timeout that applies to the current task.
+type debugTimeout struct {
+	Type          string    `json:"type"`
+	StartedAt     time.Time `json:"started_at"`
+	TimeoutSecs   float64   `json:"timeout_secs"`
+	Deadline      time.Time `json:"deadline"`
+	RemainingSecs float64   `json:"remaining_secs"`
+}
+
+func newDebugTimeout(kind timeoutType, startedAt time.Time, timeout time.Duration) debugTimeout {
+	deadline := startedAt.Add(timeout)
+	return debugTimeout{
+		Type:          string(kind),
+		StartedAt:     startedAt,
+		TimeoutSecs:   timeout.Seconds(),
+		Deadline:      deadline,
+		RemainingSecs: time.Until(deadline).Seconds(),
+	}
+}
+
+// debugTimeoutsResponse describes the timeouts that currently apply to the
+// task.
+type debugTimeoutsResponse struct {
+	TaskID string `json:"task_id,omitempty"`
+	// Watchers are the timeout watchers that are currently running.
+	Watchers []debugTimeout `json:"watchers"`
+	// Heartbeat is the timeout after which the heartbeat will give up on the
+	// task.
+	Heartbeat   *debugTimeout `json:"heartbeat,omitempty"`
+	TimedOut    bool          `json:"timed_out"`
+	TimeoutType string        `json:"timeout_type,omitempty"`
+}
+
+// debugTimeoutsHandler reports the deadlines of the timeout watchers for the
+// current task.
+func (a *Agent) debugTimeoutsHandler(w http.ResponseWriter, r *http.Request) {
+	resp := debugTimeoutsResponse{Watchers: []debugTimeout{}}
+	tc := a.getCurrentTaskContext()
+	if tc == nil {
+		writeDebugJSON(w, resp)
+		return
+	}
+
+	resp.TaskID = tc.task.ID
+	for kind, watcher := range tc.getTimeoutWatchers() {
+		startedAt := watcher.startedAt
+		if kind == idleTimeout {
+			// The idle timeout resets every time the task logs a message.
+			startedAt = a.comm.LastMessageAt()
+		}
+		resp.Watchers = append(resp.Watchers, newDebugTimeout(kind, startedAt, watcher.getTimeout()))
+	}
+	sort.Slice(resp.Watchers, func(i, j int) bool {
+		return resp.Watchers[i].Deadline.Before(resp.Watchers[j].Deadline)
+	})
+
+	if heartbeatOpts := tc.getHeartbeatTimeout(); heartbeatOpts.getTimeout != nil {
+		kind := heartbeatOpts.kind
+		if kind == "" {
+			kind = "default"
+		}
+		heartbeat := newDebugTimeout(kind, heartbeatOpts.startAt, heartbeatOpts.getTimeout())
+		resp.Heartbeat = &heartbeat
+	}
+
+	tc.RLock()
+	resp.TimedOut = tc.timedOut()
+	resp.TimeoutType = string(tc.getTimeoutType())
+	tc.RUnlock()
+
+	writeDebugJSON(w, resp)
+}
+
+// debugLoggerResponse describes the state of the current task's logger.
+type debugLoggerResponse struct {
+	TaskID string                      `json:"task_id,omitempty"`
+	Logger *client.LoggerProducerState `json:"logger,omitempty"`
+}
+
+// debugLoggerHandler reports the state of the current task's buffered log
+// senders.
+func (a *Agent) debugLoggerHandler(w http.ResponseWriter, r *http.Request) {
+	resp := debugLoggerResponse{}
+	if tc := a.getCurrentTaskContext(); tc != nil {
+		resp.TaskID = tc.task.ID
+		tc.RLock()
+		logger := tc.logger
+		tc.RUnlock()
+		if logger != nil {
+			state := logger.State()
+			resp.Logger = &state
+		}
+	}
+
+	writeDebugJSON(w, resp)
+}
+
+// debugGoroutinesHandler writes the stack traces of all the agent's
+// goroutines.
+func (a *Agent) debugGoroutinesHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	grip.Error(errors.Wrap(pprof.Lookup("goroutine").WriteTo(w, 2), "writing goroutine dump"))
+}
+
+func writeDebugJSON(w http.ResponseWriter, resp interface{}) {
+	out, err := json.MarshalIndent(resp, " ", " ")
+	if err != nil {
+		writeDebugError(w, errors.Wrap(err, "marshalling JSON for debug response"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, err = w.Write(out)
+	grip.Error(errors.Wrap(err, "writing debug response"))
+}
+
+func writeDebugError(w http.ResponseWriter, err error) {
+	grip.Error(err)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError)
+	_, _ = w.Write([]byte(err.Error()))
+}
@@ -0,0 +1,153 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugHandlers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	getDebugInfo := func(t *testing.T, handler http.HandlerFunc, out interface{}) {
+		rw := httptest.NewRecorder()
+		handler(rw, httptest.NewRequest(http.MethodGet, "/debug", nil).WithContext(ctx))
+		require.Equal(t, http.StatusOK, rw.Code)
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), out))
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, a *Agent, tc *taskContext){
+		"TaskReportsCurrentCommand": func(t *testing.T, a *Agent, tc *taskContext) {
+			factory, ok := command.GetCommandFactory("setup.initial")
+			require.True(t, ok)
+			tc.setCurrentBlock(command.MainTaskBlock)
+			tc.setCurrentCommand(factory())
+
+			var resp debugTaskResponse
+			getDebugInfo(t, a.debugTaskHandler, &resp)
+			assert.Equal(t, "task_id", resp.TaskID)
+			assert.Equal(t, string(command.MainTaskBlock), resp.Block)
+			assert.Equal(t, "initial task setup", resp.Command)
+			assert.Equal(t, evergreen.CommandTypeSystem, resp.CommandType)
+			assert.NotZero(t, resp.CommandStartedAt)
+			assert.True(t, resp.CommandRuntimeSecs >= 0)
+		},
+		"TaskIsEmptyWithoutRunningTask": func(t *testing.T, a *Agent, tc *taskContext) {
+			a.setCurrentTaskContext(nil)
+
+			var resp debugTaskResponse
+			getDebugInfo(t, a.debugTaskHandler, &resp)
+			assert.Zero(t, resp)
+		},
+		"ProcessesReportsJasperProcesses": func(t *testing.T, a *Agent, tc *taskContext) {
+			proc, err := a.jasper.CreateProcess(ctx, &options.Create{Args: []string{"sleep", "10"}})
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, proc.Signal(ctx, 9))
+			}()
+
+			var resp debugProcessesResponse
+			getDebugInfo(t, a.debugProcessesHandler, &resp)
+			require.Len(t, resp.JasperProcesses, 1)
+			assert.Equal(t, proc.ID(), resp.JasperProcesses[0].ID)
+			assert.Equal(t, []string{"sleep", "10"}, resp.JasperProcesses[0].Args)
+			assert.True(t, resp.JasperProcesses[0].IsRunning)
+			assert.NotEmpty(t, resp.ProcessTree)
+		},
+		"TimeoutsReportsWatcherDeadlines": func(t *testing.T, a *Agent, tc *taskContext) {
+			startedAt := time.Now().Add(-time.Minute)
+			tc.addTimeoutWatcher(execTimeout, timeoutWatcherState{
+				startedAt:  startedAt,
+				getTimeout: func() time.Duration { return time.Hour },
+			})
+			tc.setHeartbeatTimeout(heartbeatTimeoutOptions{
+				startAt:    startedAt,
+				getTimeout: func() time.Duration { return 2 * time.Hour },
+				kind:       execTimeout,
+			})
+
+			var resp debugTimeoutsResponse
+			getDebugInfo(t, a.debugTimeoutsHandler, &resp)
+			require.Len(t, resp.Watchers, 1)
+			assert.Equal(t, string(execTimeout), resp.Watchers[0].Type)
+			assert.Equal(t, time.Hour.Seconds(), resp.Watchers[0].TimeoutSecs)
+			assert.WithinDuration(t, startedAt.Add(time.Hour), resp.Watchers[0].Deadline, time.Second)
+			assert.True(t, resp.Watchers[0].RemainingSecs < time.Hour.Seconds())
+			require.NotZero(t, resp.Heartbeat)
+			assert.Equal(t, string(execTimeout), resp.Heartbeat.Type)
+			assert.WithinDuration(t, startedAt.Add(2*time.Hour), resp.Heartbeat.Deadline, time.Second)
+			assert.False(t, resp.TimedOut)
+
+			tc.removeTimeoutWatcher(execTimeout)
+			tc.reachTimeOut(idleTimeout, time.Minute)
+			resp = debugTimeoutsResponse{}
+			getDebugInfo(t, a.debugTimeoutsHandler, &resp)
+			assert.Empty(t, resp.Watchers)
+			assert.True(t, resp.TimedOut)
+			assert.Equal(t, string(idleTimeout), resp.TimeoutType)
+		},
+		"TimeoutsReportsIdleDeadlineFromLastMessage": func(t *testing.T, a *Agent, tc *taskContext) {
+			tc.setIdleTimeout(time.Hour)
+			tc.addTimeoutWatcher(idleTimeout, timeoutWatcherState{
+				startedAt:  time.Now().Add(-24 * time.Hour),
+				getTimeout: tc.getCurrentIdleTimeout,
+			})
+			a.comm.UpdateLastMessageTime()
+
+			var resp debugTimeoutsResponse
+			getDebugInfo(t, a.debugTimeoutsHandler, &resp)
+			require.Len(t, resp.Watchers, 1)
+			assert.Equal(t, string(idleTimeout), resp.Watchers[0].Type)
+			assert.WithinDuration(t, a.comm.LastMessageAt().Add(time.Hour), resp.Watchers[0].Deadline, time.Second)
+		},
+		"LoggerReportsState": func(t *testing.T, a *Agent, tc *taskContext) {
+			require.NoError(t, tc.logger.Flush(ctx))
+
+			var resp debugLoggerResponse
+			getDebugInfo(t, a.debugLoggerHandler, &resp)
+			assert.Equal(t, "task_id", resp.TaskID)
+			require.NotZero(t, resp.Logger)
+			assert.False(t, resp.Logger.Closed)
+			assert.NotZero(t, resp.Logger.LastFlushedAt)
+			assert.NotEmpty(t, resp.Logger.Senders)
+		},
+		"GoroutinesWritesStackTraces": func(t *testing.T, a *Agent, tc *taskContext) {
+			rw := httptest.NewRecorder()
+			a.debugGoroutinesHandler(rw, httptest.NewRequest(http.MethodGet, "/debug/goroutines", nil))
+			assert.Equal(t, http.StatusOK, rw.Code)
+			assert.Contains(t, rw.Body.String(), "TestDebugHandlers")
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			jpm, err := jasper.NewSynchronizedManager(false)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, jpm.Close(ctx))
+			}()
+			a := &Agent{
+				comm:   client.NewMock("url"),
+				jasper: jpm,
+			}
+			tc := &taskContext{
+				task:   client.TaskData{ID: "task_id", Secret: "task_secret"},
+				logger: client.NewSingleChannelLogHarness("test", send.MakeInternalLogger()),
+			}
+			a.setCurrentTaskContext(tc)
+
+			tCase(t, a, tc)
+		})
+	}
+}
@@ -20,13 +20,22 @@ import (
 
 type taskContext struct {
 	currentCommand command.Command
-	logger         client.LoggerProducer
-	task           client.TaskData
-	ranSetupGroup  bool
-	taskConfig     *internal.TaskConfig
-	timeout        timeoutInfo
-	oomTracker     jasper.OOMTracker
-	traceID        string
+	// currentCommandStartedAt is when the current command started running.
+	currentCommandStartedAt time.Time
+	// currentBlock is the block of commands that is currently running.
+	currentBlock command.BlockType
+	// timeoutWatchers are the timeout watchers that are currently running,
+	// keyed by the kind of timeout they're waiting for.
+	timeoutWatchers map[timeoutType]timeoutWatcherState
+	logger          client.LoggerProducer
+	task            client.TaskData
+	ranSetupGroup   bool
+	taskConfig      *internal.TaskConfig
+	timeout         timeoutInfo
+	oomTracker      jasper.OOMTracker
+	cgroup          taskCgroup
+	resourceUsage   *resourceUsageCollector
+	traceID         string
 	// userEndTaskResp is the end task response that the user can define, which
 	// will overwrite the default end task response.
 	userEndTaskResp *triggerEndTaskResp
@@ -37,6 +46,7 @@ func (tc *taskContext) setCurrentCommand(command command.Command) {
 	tc.Lock()
 	defer tc.Unlock()
 	tc.currentCommand = command
+	tc.currentCommandStartedAt = time.Now()
 	if tc.logger != nil {
 		tc.logger.Execution().Infof("Current command set to %s (%s).", tc.currentCommand.FullDisplayName(), tc.currentCommand.Type())
 	}
@@ -48,6 +58,57 @@ func (tc *taskContext) getCurrentCommand() command.Command {
 	return tc.currentCommand
 }
 
+func (tc *taskContext) getCurrentCommandStartedAt() time.Time {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.currentCommandStartedAt
+}
+
+func (tc *taskContext) setCurrentBlock(block command.BlockType) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.currentBlock = block
+}
+
+func (tc *taskContext) getCurrentBlock() command.BlockType {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.currentBlock
+}
+
+// timeoutWatcherState is the state of a running timeout watcher.
+type timeoutWatcherState struct {
+	startedAt  time.Time
+	getTimeout func() time.Duration
+}
+
+func (tc *taskContext) addTimeoutWatcher(kind timeoutType, state timeoutWatcherState) {
+	tc.Lock()
+	defer tc.Unlock()
+	if tc.timeoutWatchers == nil {
+		tc.timeoutWatchers = map[timeoutType]timeoutWatcherState{}
+	}
+	tc.timeoutWatchers[kind] = state
+}
+
+func (tc *taskContext) removeTimeoutWatcher(kind timeoutType) {
+	tc.Lock()
+	defer tc.Unlock()
+	delete(tc.timeoutWatchers, kind)
+}
+
+// getTimeoutWatchers returns a copy of the currently running timeout
+// watchers.
+func (tc *taskContext) getTimeoutWatchers() map[timeoutType]timeoutWatcherState {
+	tc.RLock()
+	defer tc.RUnlock()
+	watchers := make(map[timeoutType]timeoutWatcherState, len(tc.timeoutWatchers))
+	for kind, state := range tc.timeoutWatchers {
+		watchers[kind] = state
+	}
+	return watchers
+}
+
 // setCurrentIdleTimeout sets the idle timeout for the current running command.
 // This timeout only applies to commands running in specific blocks where idle
 // timeout is allowed.
@@ -146,9 +207,44 @@ func (tc *taskContext) getOomTrackerInfo() *apimodels.OOMTrackerInfo {
 }
 
 func (tc *taskContext) oomTrackerEnabled(cloudProvider string) bool {
+	if tc.getCgroup() != nil {
+		return true
+	}
 	return tc.taskConfig.Project.OomTracker && !utility.StringSliceContains(evergreen.ProviderContainer, cloudProvider)
 }
 
+// setCgroup sets the cgroup that the task runs in. While the task has a
+// cgroup, OOM kills are detected using the cgroup instead of the system logs.
+func (tc *taskContext) setCgroup(cg taskCgroup) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	tc.cgroup = cg
+	if cg != nil {
+		tc.oomTracker = newCgroupOOMTracker(cg)
+	} else {
+		tc.oomTracker = jasper.NewOOMTracker()
+	}
+}
+
+func (tc *taskContext) getCgroup() taskCgroup {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.cgroup
+}
+
+func (tc *taskContext) setResourceUsageCollector(c *resourceUsageCollector) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.resourceUsage = c
+}
+
+func (tc *taskContext) getResourceUsageCollector() *resourceUsageCollector {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.resourceUsage
+}
+
 func (tc *taskContext) setIdleTimeout(dur time.Duration) {
 	tc.timeout.idleTimeoutDuration = dur
 }
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultCgroupRoot is the cgroup v2 directory under which the agent creates
+// a child cgroup for each task.
+const DefaultCgroupRoot = "/sys/fs/cgroup/evergreen"
+
+// ErrCgroupsUnsupported indicates that per-task cgroups cannot be used on the
+// current platform.
+var ErrCgroupsUnsupported = errors.New("cgroups are only supported on Linux")
+
+// CgroupLimits are the resource limits applied to a task's cgroup. A zero
+// value for any limit means that the resource is unlimited.
+type CgroupLimits struct {
+	// MemoryLimitMB is the maximum memory (in MB) that all processes in the
+	// cgroup may use before the kernel OOM kills them.
+	MemoryLimitMB int
+	// CPUPercent is the maximum CPU time the cgroup may use, as a percentage
+	// of a single CPU (e.g. 200 means two full CPUs).
+	CPUPercent int
+	// NumProcesses is the maximum number of processes in the cgroup.
+	NumProcesses int
+}
+
+// CgroupStats are the resource usage statistics accounted to a task's cgroup.
+type CgroupStats struct {
+	// PeakMemoryBytes is the highest memory usage recorded for the cgroup.
+	PeakMemoryBytes uint64
+	// CPUTimeUsecs is the total user and system CPU time used by the cgroup,
+	// in microseconds.
+	CPUTimeUsecs uint64
+	// OOMKills is the number of processes in the cgroup killed by the kernel
+	// OOM killer.
+	OOMKills uint64
+}
+
+// cgroupRegistry maps task IDs to the cgroup created for that task, so that
+// processes started by commands can be placed into their task's cgroup.
+type cgroupRegistry struct {
+	cgroups map[string]*TaskCgroup
+	mu      sync.Mutex
+}
+
+var taskCgroups = &cgroupRegistry{cgroups: map[string]*TaskCgroup{}}
+
+func (r *cgroupRegistry) add(taskID string, cg *TaskCgroup) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cgroups[taskID] = cg
+}
+
+func (r *cgroupRegistry) get(taskID string) *TaskCgroup {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cgroups[taskID]
+}
+
+func (r *cgroupRegistry) remove(taskID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cgroups, taskID)
+}
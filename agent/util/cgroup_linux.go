@@ -0,0 +1,203 @@
+//go:build linux
+
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	cgroupProcsFile          = "cgroup.procs"
+	cgroupControllersFile    = "cgroup.controllers"
+	cgroupSubtreeControlFile = "cgroup.subtree_control"
+	cgroupMemoryMaxFile      = "memory.max"
+	cgroupMemoryPeakFile     = "memory.peak"
+	cgroupMemoryCurrentFile  = "memory.current"
+	cgroupMemoryEventsFile   = "memory.events"
+	cgroupCPUMaxFile         = "cpu.max"
+	cgroupCPUStatFile        = "cpu.stat"
+	cgroupPidsMaxFile        = "pids.max"
+
+	// cgroupCPUPeriodUsecs is the scheduling period used when setting the CPU
+	// quota.
+	cgroupCPUPeriodUsecs = 100000
+)
+
+// TaskCgroup is a cgroup v2 group that holds the processes spawned by a
+// single task.
+type TaskCgroup struct {
+	taskID string
+	path   string
+}
+
+// NewTaskCgroup creates a cgroup for the task under the given cgroup v2 root
+// directory and applies the given resource limits to it. Processes tracked for
+// the task with TrackProcess are placed into the cgroup until it is closed.
+func NewTaskCgroup(root, taskID string, limits CgroupLimits) (*TaskCgroup, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(root), cgroupControllersFile)); err != nil {
+		return nil, errors.Wrapf(err, "checking for cgroup v2 hierarchy at '%s'", filepath.Dir(root))
+	}
+
+	// The controllers must be enabled for the children of both the parent of
+	// the root and the root itself for the task cgroup to use them.
+	controllers := []byte("+cpu +memory +pids")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating cgroup root '%s'", root)
+	}
+	for _, dir := range []string{filepath.Dir(root), root} {
+		if err := os.WriteFile(filepath.Join(dir, cgroupSubtreeControlFile), controllers, 0644); err != nil {
+			return nil, errors.Wrapf(err, "enabling cgroup controllers in '%s'", dir)
+		}
+	}
+
+	cg := &TaskCgroup{
+		taskID: taskID,
+		path:   filepath.Join(root, cgroupName(taskID)),
+	}
+	if err := os.Mkdir(cg.path, 0755); err != nil && !os.IsExist(err) {
+		return nil, errors.Wrapf(err, "creating cgroup '%s'", cg.path)
+	}
+	if err := cg.setLimits(limits); err != nil {
+		return nil, errors.Wrap(err, "setting cgroup limits")
+	}
+
+	taskCgroups.add(taskID, cg)
+
+	return cg, nil
+}
+
+// cgroupName returns a name for the task's cgroup that is safe to use as a
+// directory name.
+func cgroupName(taskID string) string {
+	return strings.NewReplacer("/", "_", ".", "_").Replace(taskID)
+}
+
+func (cg *TaskCgroup) setLimits(limits CgroupLimits) error {
+	if limits.MemoryLimitMB > 0 {
+		if err := cg.write(cgroupMemoryMaxFile, strconv.Itoa(limits.MemoryLimitMB*1024*1024)); err != nil {
+			return err
+		}
+	}
+	if limits.CPUPercent > 0 {
+		quota := limits.CPUPercent * cgroupCPUPeriodUsecs / 100
+		if err := cg.write(cgroupCPUMaxFile, fmt.Sprintf("%d %d", quota, cgroupCPUPeriodUsecs)); err != nil {
+			return err
+		}
+	}
+	if limits.NumProcesses > 0 {
+		if err := cg.write(cgroupPidsMaxFile, strconv.Itoa(limits.NumProcesses)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the path to the cgroup directory.
+func (cg *TaskCgroup) Path() string {
+	return cg.path
+}
+
+// AddProcess moves the process into the cgroup. Any processes that it
+// spawns afterwards are created in the same cgroup.
+func (cg *TaskCgroup) AddProcess(pid int) error {
+	return cg.write(cgroupProcsFile, strconv.Itoa(pid))
+}
+
+// Stats returns the resource usage accounted to the cgroup so far.
+func (cg *TaskCgroup) Stats() (CgroupStats, error) {
+	var stats CgroupStats
+	catcher := grip.NewBasicCatcher()
+
+	peak, err := cg.readUint(cgroupMemoryPeakFile)
+	if os.IsNotExist(errors.Cause(err)) {
+		// memory.peak is only available in newer kernels, so fall back to
+		// the current usage.
+		peak, err = cg.readUint(cgroupMemoryCurrentFile)
+	}
+	catcher.Wrap(err, "reading peak memory usage")
+	stats.PeakMemoryBytes = peak
+
+	cpuStat, err := cg.readKeyedUints(cgroupCPUStatFile)
+	catcher.Wrap(err, "reading CPU usage")
+	stats.CPUTimeUsecs = cpuStat["usage_usec"]
+
+	memoryEvents, err := cg.readKeyedUints(cgroupMemoryEventsFile)
+	catcher.Wrap(err, "reading memory events")
+	stats.OOMKills = memoryEvents["oom_kill"]
+
+	return stats, catcher.Resolve()
+}
+
+// Close stops tracking processes for the task and removes the cgroup. The
+// cgroup can only be removed once all of its processes have exited.
+func (cg *TaskCgroup) Close() error {
+	taskCgroups.remove(cg.taskID)
+	if err := os.Remove(cg.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "removing cgroup '%s'", cg.path)
+	}
+	return nil
+}
+
+func (cg *TaskCgroup) write(file, value string) error {
+	return errors.Wrapf(os.WriteFile(filepath.Join(cg.path, file), []byte(value), 0644), "writing cgroup file '%s'", file)
+}
+
+func (cg *TaskCgroup) readUint(file string) (uint64, error) {
+	b, err := os.ReadFile(filepath.Join(cg.path, file))
+	if err != nil {
+		return 0, errors.Wrapf(err, "reading cgroup file '%s'", file)
+	}
+	val, err := strconv.ParseUint(string(bytes.TrimSpace(b)), 10, 64)
+	return val, errors.Wrapf(err, "parsing cgroup file '%s'", file)
+}
+
+// readKeyedUints reads a cgroup file made up of lines of the form
+// "<key> <value>".
+func (cg *TaskCgroup) readKeyedUints(file string) (map[string]uint64, error) {
+	b, err := os.ReadFile(filepath.Join(cg.path, file))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading cgroup file '%s'", file)
+	}
+
+	vals := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		val, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing value for key '%s' in cgroup file '%s'", fields[0], file)
+		}
+		vals[fields[0]] = val
+	}
+
+	return vals, errors.Wrapf(scanner.Err(), "scanning cgroup file '%s'", file)
+}
+
+// trackCgroupProcess places the process into the task's cgroup, if the task
+// has one.
+func trackCgroupProcess(taskID string, pid int, logger grip.Journaler) {
+	cg := taskCgroups.get(taskID)
+	if cg == nil {
+		return
+	}
+	if err := cg.AddProcess(pid); err != nil {
+		logger.Errorf("Failed adding process with PID %d to cgroup '%s': %s.", pid, cg.path, err)
+		return
+	}
+	logger.Debugf("Added process with PID %d to cgroup '%s'.", pid, cg.path)
+}
@@ -0,0 +1,123 @@
+//go:build linux
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/grip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeFakeCgroupRoot creates a directory that mimics the layout of a cgroup
+// v2 hierarchy closely enough to create task cgroups in it.
+func makeFakeCgroupRoot(t *testing.T) string {
+	hierarchy := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(hierarchy, cgroupControllersFile), []byte("cpu memory pids"), 0644))
+	return filepath.Join(hierarchy, "evergreen")
+}
+
+func TestTaskCgroup(t *testing.T) {
+	t.Run("FailsWithoutCgroupV2Hierarchy", func(t *testing.T) {
+		cg, err := NewTaskCgroup(filepath.Join(t.TempDir(), "evergreen"), "task", CgroupLimits{})
+		assert.Error(t, err)
+		assert.Nil(t, cg)
+	})
+	t.Run("FailsWithoutTaskID", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "", CgroupLimits{})
+		assert.Error(t, err)
+		assert.Nil(t, cg)
+	})
+	t.Run("SetsLimits", func(t *testing.T) {
+		root := makeFakeCgroupRoot(t)
+		cg, err := NewTaskCgroup(root, "task.with/special_chars", CgroupLimits{
+			MemoryLimitMB: 10,
+			CPUPercent:    150,
+			NumProcesses:  20,
+		})
+		require.NoError(t, err)
+		defer taskCgroups.remove("task.with/special_chars")
+
+		assert.Equal(t, filepath.Join(root, "task_with_special_chars"), cg.Path())
+		for file, expected := range map[string]string{
+			cgroupMemoryMaxFile: "10485760",
+			cgroupCPUMaxFile:    "150000 100000",
+			cgroupPidsMaxFile:   "20",
+		} {
+			b, err := os.ReadFile(filepath.Join(cg.Path(), file))
+			require.NoError(t, err)
+			assert.Equal(t, expected, string(b), file)
+		}
+		for _, dir := range []string{filepath.Dir(root), root} {
+			b, err := os.ReadFile(filepath.Join(dir, cgroupSubtreeControlFile))
+			require.NoError(t, err)
+			assert.Equal(t, "+cpu +memory +pids", string(b))
+		}
+	})
+	t.Run("OmitsUnsetLimits", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "task", CgroupLimits{})
+		require.NoError(t, err)
+		defer taskCgroups.remove("task")
+
+		for _, file := range []string{cgroupMemoryMaxFile, cgroupCPUMaxFile, cgroupPidsMaxFile} {
+			_, err := os.Stat(filepath.Join(cg.Path(), file))
+			assert.True(t, os.IsNotExist(err), file)
+		}
+	})
+	t.Run("Stats", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "task", CgroupLimits{})
+		require.NoError(t, err)
+		defer taskCgroups.remove("task")
+
+		require.NoError(t, cg.write(cgroupMemoryPeakFile, "2048\n"))
+		require.NoError(t, cg.write(cgroupCPUStatFile, "usage_usec 1500000\nuser_usec 1000000\nsystem_usec 500000\n"))
+		require.NoError(t, cg.write(cgroupMemoryEventsFile, "low 0\nhigh 0\nmax 3\noom 2\noom_kill 1\n"))
+
+		stats, err := cg.Stats()
+		require.NoError(t, err)
+		assert.EqualValues(t, 2048, stats.PeakMemoryBytes)
+		assert.EqualValues(t, 1500000, stats.CPUTimeUsecs)
+		assert.EqualValues(t, 1, stats.OOMKills)
+	})
+	t.Run("StatsFallsBackToCurrentMemory", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "task", CgroupLimits{})
+		require.NoError(t, err)
+		defer taskCgroups.remove("task")
+
+		require.NoError(t, cg.write(cgroupMemoryCurrentFile, "1024\n"))
+		require.NoError(t, cg.write(cgroupCPUStatFile, "usage_usec 0\n"))
+		require.NoError(t, cg.write(cgroupMemoryEventsFile, "oom_kill 0\n"))
+
+		stats, err := cg.Stats()
+		require.NoError(t, err)
+		assert.EqualValues(t, 1024, stats.PeakMemoryBytes)
+	})
+	t.Run("TrackProcessAddsToRegisteredCgroup", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "task", CgroupLimits{})
+		require.NoError(t, err)
+		defer taskCgroups.remove("task")
+
+		TrackProcess("task", 1234, grip.NewJournaler("test"))
+		b, err := os.ReadFile(filepath.Join(cg.Path(), cgroupProcsFile))
+		require.NoError(t, err)
+		assert.Equal(t, "1234", string(b))
+
+		TrackProcess("other_task", 5678, grip.NewJournaler("test"))
+		b, err = os.ReadFile(filepath.Join(cg.Path(), cgroupProcsFile))
+		require.NoError(t, err)
+		assert.Equal(t, "1234", string(b))
+	})
+	t.Run("CloseUnregistersCgroup", func(t *testing.T) {
+		cg, err := NewTaskCgroup(makeFakeCgroupRoot(t), "task", CgroupLimits{})
+		require.NoError(t, err)
+		require.NotNil(t, taskCgroups.get("task"))
+
+		assert.NoError(t, cg.Close())
+		assert.Nil(t, taskCgroups.get("task"))
+		_, err = os.Stat(cg.Path())
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,29 @@
+//go:build !linux
+
+package util
+
+import "github.com/mongodb/grip"
+
+// TaskCgroup is a cgroup v2 group that holds the processes spawned by a
+// single task. It is only supported on Linux.
+type TaskCgroup struct{}
+
+// NewTaskCgroup always returns ErrCgroupsUnsupported because cgroups are only
+// available on Linux.
+func NewTaskCgroup(root, taskID string, limits CgroupLimits) (*TaskCgroup, error) {
+	return nil, ErrCgroupsUnsupported
+}
+
+// Path returns the path to the cgroup directory.
+func (cg *TaskCgroup) Path() string { return "" }
+
+// AddProcess is not supported outside of Linux.
+func (cg *TaskCgroup) AddProcess(pid int) error { return ErrCgroupsUnsupported }
+
+// Stats is not supported outside of Linux.
+func (cg *TaskCgroup) Stats() (CgroupStats, error) { return CgroupStats{}, ErrCgroupsUnsupported }
+
+// Close is a no-op outside of Linux.
+func (cg *TaskCgroup) Close() error { return nil }
+
+func trackCgroupProcess(taskID string, pid int, logger grip.Journaler) {}
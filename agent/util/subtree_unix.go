@@ -22,9 +22,12 @@ const (
 	contextTimeout         = 10 * time.Minute
 )
 
-// TrackProcess is a noop by default if we don't need to do any special
-// bookkeeping up-front.
-func TrackProcess(key string, pid int, logger grip.Journaler) {}
+// TrackProcess places the process into the task's cgroup if the task is
+// running in one. Otherwise, it is a noop because no special bookkeeping is
+// needed up-front.
+func TrackProcess(key string, pid int, logger grip.Journaler) {
+	trackCgroupProcess(key, pid, logger)
+}
 
 // KillSpawnedProcs kills processes that descend from the agent and waits
 // for them to terminate.
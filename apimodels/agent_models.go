@@ -58,15 +58,17 @@ type TaskTestResultsInfo struct {
 // TaskEndDetail contains data sent from the agent to the API server after each task run.
 // This should be used to store data relating to what happened when the task ran
 type TaskEndDetail struct {
-	Status          string          `bson:"status,omitempty" json:"status,omitempty"`
-	Type            string          `bson:"type,omitempty" json:"type,omitempty"`
-	Description     string          `bson:"desc,omitempty" json:"desc,omitempty"`
-	TimedOut        bool            `bson:"timed_out,omitempty" json:"timed_out,omitempty"`
-	TimeoutType     string          `bson:"timeout_type,omitempty" json:"timeout_type,omitempty"`
-	TimeoutDuration time.Duration   `bson:"timeout_duration,omitempty" json:"timeout_duration,omitempty" swaggertype:"primitive,integer"`
-	OOMTracker      *OOMTrackerInfo `bson:"oom_killer,omitempty" json:"oom_killer,omitempty"`
-	Modules         ModuleCloneInfo `bson:"modules,omitempty" json:"modules,omitempty"`
-	TraceID         string          `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
+	Status          string             `bson:"status,omitempty" json:"status,omitempty"`
+	Type            string             `bson:"type,omitempty" json:"type,omitempty"`
+	Description     string             `bson:"desc,omitempty" json:"desc,omitempty"`
+	TimedOut        bool               `bson:"timed_out,omitempty" json:"timed_out,omitempty"`
+	TimeoutType     string             `bson:"timeout_type,omitempty" json:"timeout_type,omitempty"`
+	TimeoutDuration time.Duration      `bson:"timeout_duration,omitempty" json:"timeout_duration,omitempty" swaggertype:"primitive,integer"`
+	OOMTracker      *OOMTrackerInfo    `bson:"oom_killer,omitempty" json:"oom_killer,omitempty"`
+	CgroupStats     *CgroupStats       `bson:"cgroup_stats,omitempty" json:"cgroup_stats,omitempty"`
+	ResourceUsage   *TaskResourceUsage `bson:"resource_usage,omitempty" json:"resource_usage,omitempty"`
+	Modules         ModuleCloneInfo    `bson:"modules,omitempty" json:"modules,omitempty"`
+	TraceID         string             `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
 }
 
 type OOMTrackerInfo struct {
@@ -74,6 +76,42 @@ type OOMTrackerInfo struct {
 	Pids     []int `bson:"pids" json:"pids"`
 }
 
+// CgroupStats contains the resource usage accounted to the cgroup that a task
+// ran in.
+type CgroupStats struct {
+	// PeakMemoryBytes is the peak memory usage of all the task's processes.
+	PeakMemoryBytes int64 `bson:"peak_memory_bytes" json:"peak_memory_bytes"`
+	// CPUTimeSecs is the total CPU time used by all the task's processes.
+	CPUTimeSecs float64 `bson:"cpu_time_secs" json:"cpu_time_secs"`
+	// OOMKills is the number of the task's processes that were killed by the
+	// kernel OOM killer.
+	OOMKills int `bson:"oom_kills" json:"oom_kills"`
+}
+
+// TaskResourceUsage is a summary of the resources used on the machine while a
+// task ran.
+type TaskResourceUsage struct {
+	// PeakMemoryBytes is the peak memory usage. If the task ran in a cgroup,
+	// this is the peak resident memory of the task's processes; otherwise, it
+	// is the peak memory used on the whole machine.
+	PeakMemoryBytes int64 `bson:"peak_memory_bytes" json:"peak_memory_bytes"`
+	// AvgCPUPercent is the average CPU utilization of the machine across all
+	// CPUs.
+	AvgCPUPercent float64 `bson:"avg_cpu_percent" json:"avg_cpu_percent"`
+	// DiskReadBytes is the number of bytes read from all disks.
+	DiskReadBytes int64 `bson:"disk_read_bytes" json:"disk_read_bytes"`
+	// DiskWriteBytes is the number of bytes written to all disks.
+	DiskWriteBytes int64 `bson:"disk_write_bytes" json:"disk_write_bytes"`
+	// NetworkBytesSent is the number of bytes sent over all network
+	// interfaces.
+	NetworkBytesSent int64 `bson:"network_bytes_sent" json:"network_bytes_sent"`
+	// NetworkBytesReceived is the number of bytes received over all network
+	// interfaces.
+	NetworkBytesReceived int64 `bson:"network_bytes_received" json:"network_bytes_received"`
+	// DurationSecs is how long resource usage was measured for.
+	DurationSecs float64 `bson:"duration_secs" json:"duration_secs"`
+}
+
 type LogInfo struct {
 	Command string `bson:"command" json:"command"`
 	URL     string `bson:"url" json:"url"`
@@ -339,6 +377,20 @@ type GeneratePollResponse struct {
 type DistroView struct {
 	CloneMethod         string `json:"clone_method"`
 	DisableShallowClone bool   `json:"disable_shallow_clone"`
+	// TaskCgroupsEnabled indicates whether each task should run in its own
+	// cgroup.
+	TaskCgroupsEnabled bool `json:"task_cgroups_enabled"`
+	// TaskCgroupLimits are the distro's default resource limits for a task's
+	// cgroup.
+	TaskCgroupLimits CgroupLimits `json:"task_cgroup_limits"`
+}
+
+// CgroupLimits are the resource limits applied to a task's cgroup. A zero
+// value for any limit means it is unlimited.
+type CgroupLimits struct {
+	MemoryLimitMB int `json:"memory_limit_mb,omitempty"`
+	CPUPercent    int `json:"cpu_percent,omitempty"`
+	NumProcesses  int `json:"num_processes,omitempty"`
 }
 
 // ExpansionsAndVars represents expansions, project variables, and parameters
@@ -353,3 +405,23 @@ type ExpansionsAndVars struct {
 	// PrivateVars contain the project private variables.
 	PrivateVars map[string]bool `json:"private_vars"`
 }
+
+// Kinds of debugging information that the agent's status server can report
+// at the route /debug/{kind}.
+const (
+	AgentDebugInfoTask       = "task"
+	AgentDebugInfoProcesses  = "processes"
+	AgentDebugInfoTimeouts   = "timeouts"
+	AgentDebugInfoLogger     = "logger"
+	AgentDebugInfoGoroutines = "goroutines"
+)
+
+// AgentDebugInfoKinds are all the kinds of debugging information that the
+// agent's status server can report.
+var AgentDebugInfoKinds = []string{
+	AgentDebugInfoTask,
+	AgentDebugInfoProcesses,
+	AgentDebugInfoTimeouts,
+	AgentDebugInfoLogger,
+	AgentDebugInfoGoroutines,
+}
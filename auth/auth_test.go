@@ -28,6 +28,11 @@ func TestLoadUserManager(t *testing.T) {
 		Issuer:       "issuer",
 		UserGroup:    "user_group",
 	}
+	oidc := evergreen.OIDCConfig{
+		Issuer:       "https://oidc.example.com",
+		ClientID:     "client_id",
+		ClientSecret: "client_secret",
+	}
 	multi := evergreen.MultiAuthConfig{
 		ReadWrite: []string{evergreen.AuthLDAPKey},
 		ReadOnly:  []string{evergreen.AuthNaiveKey},
@@ -64,6 +69,13 @@ func TestLoadUserManager(t *testing.T) {
 	assert.True(t, info.CanReauthorize)
 	assert.NotNil(t, um, "a UserManager should be created if one AuthConfig type is Okta")
 
+	a = evergreen.AuthConfig{OIDC: &oidc}
+	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
+	assert.NoError(t, err, "a UserManager should be created if one AuthConfig type is OIDC")
+	assert.True(t, info.CanClearTokens)
+	assert.True(t, info.CanReauthorize)
+	assert.NotNil(t, um, "a UserManager should be created if one AuthConfig type is OIDC")
+
 	a = evergreen.AuthConfig{Naive: &naive}
 	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
 	assert.NoError(t, err, "a UserManager should be created if one AuthConfig type is Naive")
@@ -106,13 +118,22 @@ func TestLoadUserManager(t *testing.T) {
 	assert.True(t, info.CanReauthorize)
 	assert.NotNil(t, um)
 
+	a = evergreen.AuthConfig{PreferredType: evergreen.AuthOIDCKey, OIDC: &oidc, Github: &github}
+	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
+	assert.NoError(t, err)
+	assert.True(t, info.CanClearTokens)
+	assert.True(t, info.CanReauthorize)
+	assert.NotNil(t, um)
+	_, ok := um.(*oidcUserManager)
+	assert.True(t, ok)
+
 	a = evergreen.AuthConfig{PreferredType: evergreen.AuthGithubKey, Github: &github}
 	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
 	assert.NoError(t, err)
 	assert.False(t, info.CanClearTokens)
 	assert.False(t, info.CanReauthorize)
 	assert.NotNil(t, um)
-	_, ok := um.(*GithubUserManager)
+	_, ok = um.(*GithubUserManager)
 	assert.True(t, ok)
 
 	a = evergreen.AuthConfig{PreferredType: evergreen.AuthNaiveKey, Naive: &naive}
@@ -0,0 +1,666 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/gimlet/usercache"
+	"github.com/evergreen-ci/utility"
+	"github.com/golang-jwt/jwt"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcNonceCookieName        = "oidc-nonce"
+	oidcStateCookieName        = "oidc-state"
+	oidcCodeVerifierCookieName = "oidc-code-verifier"
+	oidcRequestURICookieName   = "oidc-original-request-uri"
+
+	oidcTemporaryCookieTTL = 10 * time.Minute
+	oidcLoginCookieTTL     = 365 * 24 * time.Hour
+	oidcDiscoveryPath      = "/.well-known/openid-configuration"
+)
+
+// oidcUserManager is a gimlet.UserManager that authenticates users against a
+// generic OpenID Connect identity provider using the authorization code flow
+// with PKCE.
+type oidcUserManager struct {
+	conf         evergreen.OIDCConfig
+	redirectURI  string
+	cookieDomain string
+	cache        usercache.Cache
+	// syncRoles updates the user's roles to match the roles mapped from their
+	// groups.
+	syncRoles func(u gimlet.User, groups []string) error
+
+	mu       sync.Mutex
+	provider *oidcProviderMetadata
+	keys     map[string]*rsa.PublicKey
+}
+
+// oidcProviderMetadata is the subset of the provider's discovery document
+// that's needed to log in users.
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcJSONWebKeySet struct {
+	Keys []oidcJSONWebKey `json:"keys"`
+}
+
+type oidcJSONWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+// NewOIDCUserManager returns a user manager for the OpenID Connect identity
+// provider. The provider's endpoints and signing keys are fetched lazily, so
+// creating the user manager does not require the provider to be reachable.
+func NewOIDCUserManager(conf *evergreen.OIDCConfig, evgURL, loginDomain string) (gimlet.UserManager, error) {
+	if conf == nil {
+		return nil, errors.New("OIDC configuration must not be nil")
+	}
+	if err := conf.ValidateAndDefault(); err != nil {
+		return nil, errors.Wrap(err, "invalid OIDC configuration")
+	}
+	expireAfter := time.Duration(conf.ExpireAfterMinutes) * time.Minute
+	cache, err := usercache.NewExternal(usercache.ExternalOptions{
+		PutUserGetToken: user.PutLoginCache,
+		GetUserByToken:  func(token string) (gimlet.User, bool, error) { return user.GetLoginCache(token, expireAfter) },
+		ClearUserToken: func(u gimlet.User, all bool) error {
+			if all {
+				return user.ClearAllLoginCaches()
+			}
+			return user.ClearLoginCache(u)
+		},
+		GetUserByID:     func(id string) (gimlet.User, bool, error) { return getUserByIdWithExpiration(id, expireAfter) },
+		GetOrCreateUser: getOrCreateUser,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating OIDC user cache")
+	}
+
+	m := newOIDCUserManager(*conf, strings.TrimRight(evgURL, "/")+"/login/redirect/callback", loginDomain, cache)
+	m.syncRoles = m.syncDBUserRoles
+	return m, nil
+}
+
+func newOIDCUserManager(conf evergreen.OIDCConfig, redirectURI, cookieDomain string, cache usercache.Cache) *oidcUserManager {
+	conf.Issuer = strings.TrimRight(conf.Issuer, "/")
+	return &oidcUserManager{
+		conf:         conf,
+		redirectURI:  redirectURI,
+		cookieDomain: cookieDomain,
+		cache:        cache,
+		syncRoles:    func(gimlet.User, []string) error { return nil },
+	}
+}
+
+func (m *oidcUserManager) GetUserByToken(_ context.Context, token string) (gimlet.User, error) {
+	u, valid, err := m.cache.Get(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting cached user")
+	}
+	if u == nil {
+		return nil, errors.New("user not found in cache")
+	}
+	if !valid {
+		if err := m.ReauthorizeUser(u); err != nil {
+			return u, gimlet.ErrNeedsReauthentication
+		}
+	}
+	return u, nil
+}
+
+func (m *oidcUserManager) CreateUserToken(string, string) (string, error) {
+	return "", errors.New("creating user tokens is not supported for OIDC")
+}
+
+func (m *oidcUserManager) GetLoginHandler(_ string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		provider, err := m.getProvider(r.Context())
+		if err != nil {
+			m.writeError(w, r, errors.Wrap(err, "getting OIDC provider configuration"))
+			return
+		}
+		nonce := utility.RandomString()
+		state := utility.RandomString()
+		verifier := oauth2.GenerateVerifier()
+
+		m.setTemporaryCookie(w, oidcNonceCookieName, nonce)
+		m.setTemporaryCookie(w, oidcStateCookieName, state)
+		m.setTemporaryCookie(w, oidcCodeVerifierCookieName, verifier)
+		m.setTemporaryCookie(w, oidcRequestURICookieName, sanitizeRedirect(r.URL.Query().Get("redirect")))
+
+		authURL := m.oauth2Config(provider).AuthCodeURL(state,
+			oauth2.S256ChallengeOption(verifier),
+			oauth2.SetAuthURLParam("nonce", nonce),
+		)
+		w.Header().Add("Cache-Control", "no-cache,no-store")
+		http.Redirect(w, r, authURL, http.StatusFound)
+	}
+}
+
+func (m *oidcUserManager) GetLoginCallbackHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if errCode := q.Get("error"); errCode != "" {
+			m.writeError(w, r, errors.Errorf("callback handler received error from OIDC provider: %s: %s", errCode, q.Get("error_description")))
+			return
+		}
+
+		cookies := map[string]string{}
+		for _, name := range []string{oidcNonceCookieName, oidcStateCookieName, oidcCodeVerifierCookieName, oidcRequestURICookieName} {
+			cookie, err := r.Cookie(name)
+			if err != nil {
+				m.writeError(w, r, errors.Wrapf(err, "getting cookie '%s'", name))
+				return
+			}
+			cookies[name] = cookie.Value
+		}
+		if q.Get("state") != cookies[oidcStateCookieName] {
+			m.writeError(w, r, errors.New("state value received from OIDC provider did not match expected state"))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), time.Minute)
+		defer cancel()
+		provider, err := m.getProvider(ctx)
+		if err != nil {
+			m.writeError(w, r, errors.Wrap(err, "getting OIDC provider configuration"))
+			return
+		}
+		client := utility.GetHTTPClient()
+		defer utility.PutHTTPClient(client)
+		token, err := m.oauth2Config(provider).Exchange(context.WithValue(ctx, oauth2.HTTPClient, client), q.Get("code"), oauth2.VerifierOption(cookies[oidcCodeVerifierCookieName]))
+		if err != nil {
+			m.writeError(w, r, errors.Wrap(err, "redeeming authorization code for tokens"))
+			return
+		}
+		claims, err := m.verifyIDToken(ctx, token, cookies[oidcNonceCookieName])
+		if err != nil {
+			m.writeError(w, r, errors.Wrap(err, "invalid ID token from OIDC provider"))
+			return
+		}
+		u, groups, err := m.makeUserFromClaims(claims, token.AccessToken, token.RefreshToken)
+		if err != nil {
+			m.writeError(w, r, err)
+			return
+		}
+
+		u, err = m.GetOrCreateUser(u)
+		if err != nil {
+			m.writeError(w, r, errors.Wrap(err, "getting existing user or creating new user"))
+			return
+		}
+		if err = m.syncRoles(u, groups); err != nil {
+			m.writeError(w, r, errors.Wrapf(err, "syncing roles for user '%s'", u.Username()))
+			return
+		}
+		loginToken, err := m.cache.Put(u)
+		if err != nil {
+			m.writeError(w, r, errors.Wrapf(err, "caching user '%s'", u.Username()))
+			return
+		}
+
+		for _, name := range []string{oidcNonceCookieName, oidcStateCookieName, oidcCodeVerifierCookieName, oidcRequestURICookieName} {
+			m.unsetTemporaryCookie(w, name)
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:     evergreen.AuthTokenCookie,
+			Path:     "/",
+			Value:    loginToken,
+			HttpOnly: true,
+			Expires:  time.Now().Add(oidcLoginCookieTTL),
+			Domain:   m.cookieDomain,
+		})
+		http.Redirect(w, r, cookies[oidcRequestURICookieName], http.StatusFound)
+	}
+}
+
+func (m *oidcUserManager) IsRedirect() bool { return true }
+
+// ReauthorizeUser refreshes the user's tokens. If the provider returns a new
+// ID token, it must belong to the same user, and the user's groups and roles
+// are checked again.
+func (m *oidcUserManager) ReauthorizeUser(u gimlet.User) error {
+	refreshToken := u.GetRefreshToken()
+	if refreshToken == "" {
+		return errors.Errorf("user '%s' cannot refresh tokens because refresh token is missing", u.Username())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	provider, err := m.getProvider(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting OIDC provider configuration")
+	}
+	client := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(client)
+	ts := m.oauth2Config(provider).TokenSource(context.WithValue(ctx, oauth2.HTTPClient, client), &oauth2.Token{
+		RefreshToken: refreshToken,
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+	token, err := ts.Token()
+	if err != nil {
+		return errors.Wrap(err, "refreshing authorization tokens")
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	opts, err := gimlet.NewBasicUserOptions(u.Username())
+	if err != nil {
+		return errors.Wrap(err, "creating user options")
+	}
+	var reauthorized gimlet.User = gimlet.NewBasicUser(opts.
+		Name(u.DisplayName()).
+		Email(u.Email()).
+		AccessToken(token.AccessToken).
+		RefreshToken(token.RefreshToken))
+	if _, ok := token.Extra("id_token").(string); ok {
+		claims, err := m.verifyIDToken(ctx, token, "")
+		if err != nil {
+			return errors.Wrap(err, "invalid refreshed ID token")
+		}
+		refreshed, groups, err := m.makeUserFromClaims(claims, token.AccessToken, token.RefreshToken)
+		if err != nil {
+			return errors.Wrap(err, "generating user from refreshed ID token")
+		}
+		if refreshed.Username() != u.Username() {
+			return errors.Errorf("user name '%s' from ID token did not match user name '%s' to reauthorize", refreshed.Username(), u.Username())
+		}
+		if err = m.syncRoles(u, groups); err != nil {
+			return errors.Wrapf(err, "syncing roles for user '%s'", u.Username())
+		}
+		reauthorized = refreshed
+	}
+
+	_, err = m.cache.Put(reauthorized)
+	return errors.Wrap(err, "updating reauthorized user in cache")
+}
+
+func (m *oidcUserManager) GetUserByID(id string) (gimlet.User, error) {
+	u, valid, err := m.cache.Find(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting user by ID")
+	}
+	if u == nil {
+		return nil, errors.New("user not found in cache")
+	}
+	if !valid {
+		if err := m.ReauthorizeUser(u); err != nil {
+			return u, gimlet.ErrNeedsReauthentication
+		}
+	}
+	return u, nil
+}
+
+func (m *oidcUserManager) GetOrCreateUser(u gimlet.User) (gimlet.User, error) {
+	return m.cache.GetOrCreate(u)
+}
+
+func (m *oidcUserManager) ClearUser(u gimlet.User, all bool) error {
+	return m.cache.Clear(u, all)
+}
+
+func (m *oidcUserManager) GetGroupsForUser(string) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *oidcUserManager) oauth2Config(provider *oidcProviderMetadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     m.conf.ClientID,
+		ClientSecret: m.conf.ClientSecret,
+		RedirectURL:  m.redirectURI,
+		Scopes:       m.conf.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.AuthorizationEndpoint,
+			TokenURL: provider.TokenEndpoint,
+		},
+	}
+}
+
+// getProvider returns the provider's discovery document, fetching it the
+// first time it's needed.
+func (m *oidcUserManager) getProvider(ctx context.Context) (*oidcProviderMetadata, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.provider != nil {
+		return m.provider, nil
+	}
+
+	provider := &oidcProviderMetadata{}
+	if err := getJSON(ctx, m.conf.Issuer+oidcDiscoveryPath, provider); err != nil {
+		return nil, errors.Wrap(err, "getting OIDC discovery document")
+	}
+	if strings.TrimRight(provider.Issuer, "/") != m.conf.Issuer {
+		return nil, errors.Errorf("issuer '%s' in discovery document does not match configured issuer '%s'", provider.Issuer, m.conf.Issuer)
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(provider.AuthorizationEndpoint == "", "discovery document is missing authorization endpoint")
+	catcher.NewWhen(provider.TokenEndpoint == "", "discovery document is missing token endpoint")
+	catcher.NewWhen(provider.JWKSURI == "", "discovery document is missing JWKS URI")
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+	m.provider = provider
+	return provider, nil
+}
+
+// getSigningKey returns the provider's public key with the given key ID. The
+// key set is refetched if the key isn't known, since the provider may have
+// rotated its keys.
+func (m *oidcUserManager) getSigningKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	provider, err := m.getProvider(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting OIDC provider configuration")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if key := m.findKey(keyID); key != nil {
+		return key, nil
+	}
+
+	keySet := &oidcJSONWebKeySet{}
+	if err = getJSON(ctx, provider.JWKSURI, keySet); err != nil {
+		return nil, errors.Wrap(err, "getting OIDC signing keys")
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range keySet.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := parseRSAJSONWebKey(jwk)
+		if err != nil {
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message": "skipping invalid OIDC signing key",
+				"key_id":  jwk.KeyID,
+				"issuer":  m.conf.Issuer,
+			}))
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+	m.keys = keys
+
+	if key := m.findKey(keyID); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("signing key '%s' not found", keyID)
+}
+
+// findKey returns the cached key with the given ID. If no key ID is given, the
+// only cached key is used.
+func (m *oidcUserManager) findKey(keyID string) *rsa.PublicKey {
+	if keyID == "" && len(m.keys) == 1 {
+		for _, key := range m.keys {
+			return key
+		}
+	}
+	return m.keys[keyID]
+}
+
+// verifyIDToken checks the signature and standard claims of the ID token
+// returned alongside the OAuth2 token and returns its claims. If a nonce is
+// given, it must match the token's nonce.
+func (m *oidcUserManager) verifyIDToken(ctx context.Context, token *oauth2.Token, nonce string) (jwt.MapClaims, error) {
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response is missing ID token")
+	}
+
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: []string{"RS256", "RS384", "RS512"}}
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		keyID, _ := t.Header["kid"].(string)
+		return m.getSigningKey(ctx, keyID)
+	}); err != nil {
+		return nil, errors.Wrap(err, "parsing ID token")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(!claims.VerifyIssuer(m.conf.Issuer, true), "ID token has wrong issuer")
+	catcher.NewWhen(!claims.VerifyAudience(m.conf.ClientID, true), "ID token has wrong audience")
+	catcher.NewWhen(!claims.VerifyExpiresAt(time.Now().Unix(), true), "ID token is expired")
+	if nonce != "" {
+		tokenNonce, _ := claims["nonce"].(string)
+		catcher.NewWhen(tokenNonce != nonce, "ID token has wrong nonce")
+	}
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+	return claims, nil
+}
+
+// makeUserFromClaims creates a user from the ID token claims and returns it
+// along with the user's groups. It errors if the user isn't in the required
+// user group.
+func (m *oidcUserManager) makeUserFromClaims(claims jwt.MapClaims, accessToken, refreshToken string) (gimlet.User, []string, error) {
+	id, _ := claims[m.conf.UsernameClaim].(string)
+	if id == "" {
+		return nil, nil, errors.Errorf("ID token is missing '%s' claim", m.conf.UsernameClaim)
+	}
+	if m.conf.UsernameClaim == "email" {
+		var err error
+		if id, err = m.usernameFromEmail(claims, id); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	groups := stringsFromClaim(claims[m.conf.GroupsClaim])
+	if m.conf.UserGroup != "" && !utility.StringSliceContains(groups, m.conf.UserGroup) {
+		return nil, nil, errors.Errorf("user '%s' is not in group '%s'", id, m.conf.UserGroup)
+	}
+
+	name, _ := claims["name"].(string)
+	if name == "" {
+		name, _ = claims["preferred_username"].(string)
+	}
+	email, _ := claims["email"].(string)
+
+	opts, err := gimlet.NewBasicUserOptions(id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "creating user options")
+	}
+	u := gimlet.NewBasicUser(opts.
+		Name(name).
+		Email(email).
+		AccessToken(accessToken).
+		RefreshToken(refreshToken).
+		Roles(m.mappedRoles(groups)...))
+	return u, groups, nil
+}
+
+// usernameFromEmail returns the user ID for the given email, which is the
+// email without its domain. Since users in other domains would get the same
+// ID, it errors unless the provider has verified the email and it's in the
+// configured email domain.
+func (m *oidcUserManager) usernameFromEmail(claims jwt.MapClaims, email string) (string, error) {
+	if !isTrueClaim(claims["email_verified"]) {
+		return "", errors.Errorf("email '%s' is not verified", email)
+	}
+	emailDomainStart := strings.LastIndex(email, "@")
+	if emailDomainStart <= 0 || m.conf.EmailDomain == "" || !strings.EqualFold(email[emailDomainStart+1:], m.conf.EmailDomain) {
+		return "", errors.Errorf("email '%s' is not in domain '%s'", email, m.conf.EmailDomain)
+	}
+	return email[:emailDomainStart], nil
+}
+
+// isTrueClaim returns whether the boolean claim is true. Some providers send
+// boolean claims as strings.
+func isTrueClaim(claim interface{}) bool {
+	switch v := claim.(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+// mappedRoles returns the roles granted to the given groups.
+func (m *oidcUserManager) mappedRoles(groups []string) []string {
+	var roles []string
+	for _, mapping := range m.conf.GroupRoles {
+		if !utility.StringSliceContains(groups, mapping.Group) {
+			continue
+		}
+		for _, role := range mapping.Roles {
+			if !utility.StringSliceContains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// syncDBUserRoles grants the user the roles mapped from their groups and
+// removes any mapped roles that they no longer have a group for. Roles that
+// aren't part of any group mapping are left alone.
+func (m *oidcUserManager) syncDBUserRoles(u gimlet.User, groups []string) error {
+	if len(m.conf.GroupRoles) == 0 {
+		return nil
+	}
+	dbUser, err := user.FindOneById(u.Username())
+	if err != nil {
+		return errors.Wrapf(err, "finding user '%s'", u.Username())
+	}
+	if dbUser == nil {
+		return errors.Errorf("user '%s' not found", u.Username())
+	}
+
+	granted := m.mappedRoles(groups)
+	catcher := grip.NewBasicCatcher()
+	for _, mapping := range m.conf.GroupRoles {
+		for _, role := range mapping.Roles {
+			hasRole := utility.StringSliceContains(dbUser.Roles(), role)
+			shouldHaveRole := utility.StringSliceContains(granted, role)
+			if shouldHaveRole && !hasRole {
+				catcher.Wrapf(dbUser.AddRole(role), "adding role '%s'", role)
+			} else if !shouldHaveRole && hasRole {
+				catcher.Wrapf(dbUser.RemoveRole(role), "removing role '%s'", role)
+			}
+		}
+	}
+	return catcher.Resolve()
+}
+
+func (m *oidcUserManager) setTemporaryCookie(w http.ResponseWriter, name, value string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Path:     "/",
+		Value:    value,
+		HttpOnly: true,
+		Expires:  time.Now().Add(oidcTemporaryCookieTTL),
+		Domain:   m.cookieDomain,
+	})
+}
+
+func (m *oidcUserManager) unsetTemporaryCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:   name,
+		Path:   "/",
+		Domain: m.cookieDomain,
+		Value:  "",
+		MaxAge: -1,
+	})
+}
+
+func (m *oidcUserManager) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	grip.Error(message.WrapError(err, message.Fields{
+		"message": "OIDC login failed",
+		"issuer":  m.conf.Issuer,
+		"request": gimlet.GetRequestID(r.Context()),
+	}))
+	gimlet.WriteResponse(w, gimlet.MakeTextErrorResponder(gimlet.ErrorResponse{
+		StatusCode: http.StatusInternalServerError,
+		Message:    err.Error(),
+	}))
+}
+
+// sanitizeRedirect only allows redirecting to a path on this site after
+// logging in.
+func sanitizeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+// stringsFromClaim returns the claim as a list of strings. Providers may send
+// a single-valued claim as a string rather than a list.
+func stringsFromClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func parseRSAJSONWebKey(jwk oidcJSONWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.N, "="))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.E, "="))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding exponent")
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 2 {
+		return nil, errors.New("key has invalid modulus or exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Add("Accept", "application/json")
+
+	client := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(client)
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "requesting '%s'", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("request to '%s' returned status %d", url, resp.StatusCode)
+	}
+	return errors.Wrapf(json.NewDecoder(resp.Body).Decode(out), "decoding response from '%s'", url)
+}
@@ -0,0 +1,385 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/gimlet/usercache"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// mockOIDCProvider is a minimal OpenID Connect provider that issues tokens for
+// a single user.
+type mockOIDCProvider struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	key           *rsa.PrivateKey
+	keyID         string
+	codeChallenge string
+	nonce         string
+	claims        jwt.MapClaims
+	refreshCount  int
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &mockOIDCProvider{
+		key:   key,
+		keyID: "key0",
+		claims: jwt.MapClaims{
+			"sub":            "1234",
+			"email":          "alice@example.com",
+			"email_verified": true,
+			"name":           "Alice",
+			"groups":         []string{"engineers", "admins"},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, oidcProviderMetadata{
+			Issuer:                p.URL,
+			AuthorizationEndpoint: p.URL + "/authorize",
+			TokenEndpoint:         p.URL + "/token",
+			JWKSURI:               p.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		writeJSON(t, w, oidcJSONWebKeySet{Keys: []oidcJSONWebKey{{
+			KeyType: "RSA",
+			KeyID:   p.keyID,
+			Use:     "sig",
+			N:       base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		var nonce string
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			verifier := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+			if r.Form.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(verifier[:]) != p.codeChallenge {
+				w.WriteHeader(http.StatusBadRequest)
+				writeJSON(t, w, map[string]string{"error": "invalid_grant"})
+				return
+			}
+			nonce = p.nonce
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh_token" {
+				w.WriteHeader(http.StatusBadRequest)
+				writeJSON(t, w, map[string]string{"error": "invalid_grant"})
+				return
+			}
+			p.refreshCount++
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		writeJSON(t, w, map[string]interface{}{
+			"access_token":  "access_token",
+			"refresh_token": "refresh_token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"id_token":      p.signIDToken(t, nonce),
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func (p *mockOIDCProvider) signIDToken(t *testing.T, nonce string) string {
+	claims := jwt.MapClaims{
+		"iss": p.URL,
+		"aud": "client_id",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.keyID
+	signed, err := token.SignedString(p.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, out interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(out))
+}
+
+// login goes through the login flow and returns the response from the
+// callback handler.
+func (p *mockOIDCProvider) login(t *testing.T, um *oidcUserManager, modifyCallback func(q url.Values)) *httptest.ResponseRecorder {
+	rw := httptest.NewRecorder()
+	um.GetLoginHandler("")(rw, httptest.NewRequest(http.MethodGet, "/login/redirect?redirect=/waterfall", nil))
+	require.Equal(t, http.StatusFound, rw.Code)
+
+	authURL, err := url.Parse(rw.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, p.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	authQuery := authURL.Query()
+	assert.Equal(t, "client_id", authQuery.Get("client_id"))
+	assert.Equal(t, "code", authQuery.Get("response_type"))
+	assert.Equal(t, "S256", authQuery.Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", authQuery.Get("scope"))
+	p.mu.Lock()
+	p.codeChallenge = authQuery.Get("code_challenge")
+	p.nonce = authQuery.Get("nonce")
+	p.mu.Unlock()
+
+	callbackQuery := url.Values{}
+	callbackQuery.Set("code", "code")
+	callbackQuery.Set("state", authQuery.Get("state"))
+	if modifyCallback != nil {
+		modifyCallback(callbackQuery)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/login/redirect/callback?"+callbackQuery.Encode(), nil)
+	for _, cookie := range rw.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	rw = httptest.NewRecorder()
+	um.GetLoginCallbackHandler()(rw, req)
+	return rw
+}
+
+func loginToken(rw *httptest.ResponseRecorder) string {
+	for _, cookie := range rw.Result().Cookies() {
+		if cookie.Name == evergreen.AuthTokenCookie {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+func TestOIDCUserManager(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager){
+		"LoginSucceeds": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+			assert.Equal(t, "/waterfall", rw.Header().Get("Location"))
+			token := loginToken(rw)
+			require.NotEmpty(t, token)
+
+			u, err := um.GetUserByToken(ctx, token)
+			require.NoError(t, err)
+			assert.Equal(t, "alice", u.Username())
+			assert.Equal(t, "Alice", u.DisplayName())
+			assert.Equal(t, "alice@example.com", u.Email())
+			assert.Equal(t, "access_token", u.GetAccessToken())
+			assert.Equal(t, "refresh_token", u.GetRefreshToken())
+			assert.Equal(t, []string{"superuser"}, u.Roles())
+		},
+		"LoginUsesConfiguredUsernameClaim": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			um.conf.UsernameClaim = "sub"
+			rw := p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+
+			u, err := um.GetUserByToken(ctx, loginToken(rw))
+			require.NoError(t, err)
+			assert.Equal(t, "1234", u.Username())
+		},
+		"LoginFailsWithUnverifiedEmail": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			p.claims["email_verified"] = false
+			rw := p.login(t, um, nil)
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "not verified")
+			assert.Empty(t, loginToken(rw))
+		},
+		"LoginFailsWithEmailInOtherDomain": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			p.claims["email"] = "alice@attacker.example.org"
+			rw := p.login(t, um, nil)
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "example.com")
+			assert.Empty(t, loginToken(rw))
+		},
+		"LoginFailsWithMismatchedState": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, func(q url.Values) { q.Set("state", "foo") })
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Empty(t, loginToken(rw))
+		},
+		"LoginFailsWithProviderError": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, func(q url.Values) { q.Set("error", "access_denied") })
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "access_denied")
+		},
+		"LoginFailsWithInvalidCode": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, func(q url.Values) { q.Set("code", "foo") })
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Empty(t, loginToken(rw))
+		},
+		"LoginFailsWithWrongAudience": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			p.claims["aud"] = "other_client"
+			rw := p.login(t, um, nil)
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "audience")
+		},
+		"LoginFailsWithWrongNonce": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			p.claims["nonce"] = "foo"
+			rw := p.login(t, um, nil)
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "nonce")
+		},
+		"VerifyIDTokenFailsWithUntrustedSigningKey": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			_, err := um.getSigningKey(ctx, p.keyID)
+			require.NoError(t, err)
+
+			untrustedKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			require.NoError(t, err)
+			p.key = untrustedKey
+			token := (&oauth2.Token{AccessToken: "access_token"}).WithExtra(map[string]interface{}{"id_token": p.signIDToken(t, "")})
+
+			_, err = um.verifyIDToken(ctx, token, "")
+			assert.Error(t, err)
+		},
+		"LoginFailsOutsideUserGroup": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			um.conf.UserGroup = "operators"
+			rw := p.login(t, um, nil)
+			assert.Equal(t, http.StatusInternalServerError, rw.Code)
+			assert.Contains(t, rw.Body.String(), "operators")
+		},
+		"LoginSucceedsAfterKeyRotation": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+
+			newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			require.NoError(t, err)
+			p.mu.Lock()
+			p.key = newKey
+			p.keyID = "key1"
+			p.mu.Unlock()
+
+			rw = p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+			assert.NotEmpty(t, loginToken(rw))
+		},
+		"LoginRedirectsOnlyWithinSite": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := httptest.NewRecorder()
+			um.GetLoginHandler("")(rw, httptest.NewRequest(http.MethodGet, "/login/redirect?redirect="+url.QueryEscape("https://evil.example.com"), nil))
+			require.Equal(t, http.StatusFound, rw.Code)
+			for _, cookie := range rw.Result().Cookies() {
+				if cookie.Name == oidcRequestURICookieName {
+					assert.Equal(t, "/", cookie.Value)
+				}
+			}
+		},
+		"ReauthorizeUserRefreshesTokens": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+			u, err := um.GetUserByToken(ctx, loginToken(rw))
+			require.NoError(t, err)
+
+			require.NoError(t, um.ReauthorizeUser(u))
+			assert.Equal(t, 1, p.refreshCount)
+		},
+		"ReauthorizeUserFailsForDifferentUser": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			rw := p.login(t, um, nil)
+			require.Equal(t, http.StatusFound, rw.Code, rw.Body.String())
+			u, err := um.GetUserByToken(ctx, loginToken(rw))
+			require.NoError(t, err)
+
+			p.claims["email"] = "bob@example.com"
+			assert.Error(t, um.ReauthorizeUser(u))
+		},
+		"ReauthorizeUserFailsWithoutRefreshToken": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			opts, err := gimlet.NewBasicUserOptions("alice")
+			require.NoError(t, err)
+			assert.Error(t, um.ReauthorizeUser(gimlet.NewBasicUser(opts)))
+			assert.Zero(t, p.refreshCount)
+		},
+		"DiscoveryFailsWithMismatchedIssuer": func(ctx context.Context, t *testing.T, p *mockOIDCProvider, um *oidcUserManager) {
+			um.conf.Issuer = p.URL + "/other"
+			_, err := um.getProvider(ctx)
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			p := newMockOIDCProvider(t)
+			defer p.Close()
+			conf := evergreen.OIDCConfig{
+				Issuer:        p.URL,
+				ClientID:      "client_id",
+				UsernameClaim: "email",
+				EmailDomain:   "example.com",
+				GroupRoles:    []evergreen.OIDCGroupRoles{{Group: "admins", Roles: []string{"superuser"}}},
+			}
+			require.NoError(t, conf.ValidateAndDefault())
+			um := newOIDCUserManager(conf, "https://evergreen.example.com/login/redirect/callback", "", usercache.NewInMemory(ctx, time.Hour))
+
+			testCase(ctx, t, p, um)
+		})
+	}
+}
+
+func TestOIDCSyncDBUserRoles(t *testing.T) {
+	require.NoError(t, db.Clear(user.Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(user.Collection))
+	}()
+
+	u := &user.DBUser{Id: "alice", SystemRoles: []string{"superuser", "project_admin", "unmanaged"}}
+	require.NoError(t, u.Insert())
+
+	um := newOIDCUserManager(evergreen.OIDCConfig{
+		GroupRoles: []evergreen.OIDCGroupRoles{
+			{Group: "admins", Roles: []string{"superuser"}},
+			{Group: "leads", Roles: []string{"project_admin"}},
+			{Group: "engineers", Roles: []string{"viewer"}},
+		},
+	}, "", "", nil)
+	require.NoError(t, um.syncDBUserRoles(u, []string{"leads", "engineers"}))
+
+	dbUser, err := user.FindOneById(u.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbUser)
+	assert.ElementsMatch(t, []string{"project_admin", "unmanaged", "viewer"}, dbUser.Roles())
+}
+
+func TestStringsFromClaim(t *testing.T) {
+	assert.Equal(t, []string{"a"}, stringsFromClaim("a"))
+	assert.Equal(t, []string{"a", "b"}, stringsFromClaim([]interface{}{"a", 1, "b"}))
+	assert.Empty(t, stringsFromClaim(nil))
+	assert.Empty(t, stringsFromClaim(5))
+}
+
+func TestSanitizeRedirect(t *testing.T) {
+	assert.Equal(t, "/waterfall", sanitizeRedirect("/waterfall"))
+	assert.Equal(t, "/", sanitizeRedirect(""))
+	assert.Equal(t, "/", sanitizeRedirect("https://evil.example.com"))
+	assert.Equal(t, "/", sanitizeRedirect("//evil.example.com"))
+	assert.Equal(t, "/", sanitizeRedirect("/\\evil.example.com"))
+}
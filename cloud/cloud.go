@@ -110,6 +110,16 @@ type BatchManager interface {
 	GetInstanceStatuses(context.Context, []host.Host) (map[string]CloudStatus, error)
 }
 
+// SnapshotManager is an interface for cloud providers that support taking
+// snapshots of volumes.
+type SnapshotManager interface {
+	// CreateSnapshot takes a snapshot of the snapshot's source volume and
+	// records the new snapshot in the DB.
+	CreateSnapshot(context.Context, *host.Snapshot) (*host.Snapshot, error)
+	// DeleteSnapshot deletes the snapshot from the cloud provider and the DB.
+	DeleteSnapshot(context.Context, *host.Snapshot) error
+}
+
 // ManagerOpts is a struct containing the fields needed to get a new cloud manager
 // of the proper type.
 type ManagerOpts struct {
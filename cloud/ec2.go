@@ -664,6 +664,12 @@ func (m *ec2Manager) ModifyHost(ctx context.Context, h *host.Host, opts host.Hos
 	if opts.NewName != "" {
 		catcher.Add(h.SetDisplayName(ctx, opts.NewName))
 	}
+	if opts.SleepSchedule != nil {
+		catcher.Wrapf(h.SetSleepSchedule(ctx, *opts.SleepSchedule, time.Now()), "setting sleep schedule for host '%s'", h.Id)
+	}
+	if !opts.KeepAwakeUntil.IsZero() {
+		catcher.Wrapf(h.SetSleepScheduleExemption(ctx, opts.KeepAwakeUntil, time.Now()), "keeping host '%s' awake", h.Id)
+	}
 	if opts.AttachVolume != "" {
 		volume, err := host.ValidateVolumeCanBeAttached(ctx, opts.AttachVolume)
 		if err != nil {
@@ -1088,6 +1094,10 @@ func (m *ec2Manager) CreateVolume(ctx context.Context, volume *host.Volume) (*ho
 		},
 	}
 
+	if volume.SnapshotID != "" {
+		input.SnapshotId = aws.String(volume.SnapshotID)
+	}
+
 	if volume.Throughput > 0 {
 		input.Throughput = aws.Int32(volume.Throughput)
 	}
@@ -1131,6 +1141,65 @@ func (m *ec2Manager) DeleteVolume(ctx context.Context, volume *host.Volume) erro
 	return errors.Wrapf(volume.Remove(), "deleting volume '%s' in DB", volume.ID)
 }
 
+// CreateSnapshot takes an EBS snapshot of the snapshot's source volume.
+func (m *ec2Manager) CreateSnapshot(ctx context.Context, snapshot *host.Snapshot) (*host.Snapshot, error) {
+	if err := m.client.Create(ctx, m.credentials, m.region); err != nil {
+		return nil, errors.Wrap(err, "creating client")
+	}
+	defer m.client.Close()
+
+	snapshotTags := []types.Tag{
+		{Key: aws.String(evergreen.TagOwner), Value: aws.String(snapshot.CreatedBy)},
+	}
+	if !snapshot.NoExpiration {
+		if snapshot.Expiration.IsZero() {
+			snapshot.Expiration = time.Now().Add(evergreen.DefaultSnapshotExpiration)
+		}
+		snapshotTags = append(snapshotTags, types.Tag{Key: aws.String(evergreen.TagExpireOn), Value: aws.String(snapshot.Expiration.Format(evergreen.ExpireOnFormat))})
+	}
+	resp, err := m.client.CreateSnapshot(ctx, &ec2.CreateSnapshotInput{
+		VolumeId:    aws.String(snapshot.SourceVolumeID),
+		Description: aws.String(snapshot.DisplayName),
+		TagSpecifications: []types.TagSpecification{
+			{ResourceType: types.ResourceTypeSnapshot, Tags: snapshotTags},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating snapshot of volume '%s' in client", snapshot.SourceVolumeID)
+	}
+	if resp.SnapshotId == nil {
+		return nil, errors.New("new snapshot returned by EC2 does not have an ID")
+	}
+
+	snapshot.ID = *resp.SnapshotId
+	snapshot.Region = m.region
+	if resp.VolumeSize != nil {
+		snapshot.Size = *resp.VolumeSize
+	}
+	if err = snapshot.Insert(); err != nil {
+		return nil, errors.Wrap(err, "creating snapshot in DB")
+	}
+
+	return snapshot, nil
+}
+
+// DeleteSnapshot deletes the EBS snapshot.
+func (m *ec2Manager) DeleteSnapshot(ctx context.Context, snapshot *host.Snapshot) error {
+	if err := m.client.Create(ctx, m.credentials, m.region); err != nil {
+		return errors.Wrap(err, "creating client")
+	}
+	defer m.client.Close()
+
+	_, err := m.client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+		SnapshotId: aws.String(snapshot.ID),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "deleting snapshot '%s' in client", snapshot.ID)
+	}
+
+	return errors.Wrapf(snapshot.Remove(), "deleting snapshot '%s' in DB", snapshot.ID)
+}
+
 func (m *ec2Manager) GetVolumeAttachment(ctx context.Context, volumeID string) (*VolumeAttachment, error) {
 	if err := m.client.Create(ctx, m.credentials, m.region); err != nil {
 		return nil, errors.Wrap(err, "creating client")
@@ -81,6 +81,12 @@ type AWSClient interface {
 	// DescribeVolumes is a wrapper for ec2.DescribeVolumes.
 	DescribeVolumes(context.Context, *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error)
 
+	// CreateSnapshot is a wrapper for ec2.CreateSnapshot.
+	CreateSnapshot(context.Context, *ec2.CreateSnapshotInput) (*ec2.CreateSnapshotOutput, error)
+
+	// DeleteSnapshot is a wrapper for ec2.DeleteSnapshot.
+	DeleteSnapshot(context.Context, *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error)
+
 	// DescribeSubnets is a wrapper for ec2.DescribeSubnets.
 	DescribeSubnets(context.Context, *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
 
@@ -476,6 +482,64 @@ func (c *awsClientImpl) DeleteVolume(ctx context.Context, input *ec2.DeleteVolum
 	return output, nil
 }
 
+// CreateSnapshot is a wrapper for ec2.CreateSnapshot.
+func (c *awsClientImpl) CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput) (*ec2.CreateSnapshotOutput, error) {
+	var output *ec2.CreateSnapshotOutput
+	var err error
+	err = utility.Retry(
+		ctx,
+		func() (bool, error) {
+			msg := makeAWSLogMessage("CreateSnapshot", fmt.Sprintf("%T", c), input)
+			output, err = c.client.CreateSnapshot(ctx, input)
+			if err != nil {
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) {
+					grip.Debug(message.WrapError(apiErr, msg))
+					if strings.Contains(apiErr.Error(), EC2InvalidParam) || strings.Contains(apiErr.Error(), EC2VolumeNotFound) {
+						return false, err
+					}
+				}
+				return true, err
+			}
+			grip.Info(msg)
+			return false, nil
+		}, awsClientDefaultRetryOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// DeleteSnapshot is a wrapper for ec2.DeleteSnapshot.
+func (c *awsClientImpl) DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error) {
+	var output *ec2.DeleteSnapshotOutput
+	var err error
+	err = utility.Retry(
+		ctx,
+		func() (bool, error) {
+			msg := makeAWSLogMessage("DeleteSnapshot", fmt.Sprintf("%T", c), input)
+			output, err = c.client.DeleteSnapshot(ctx, input)
+			if err != nil {
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) {
+					grip.Debug(message.WrapError(apiErr, msg))
+					if strings.Contains(apiErr.Error(), EC2SnapshotNotFound) {
+						return false, nil
+					}
+				}
+				return true, err
+			}
+			grip.Info(msg)
+			return false, nil
+		}, awsClientDefaultRetryOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // ModifyVolume is a wrapper for ec2.ModifyWrapper.
 func (c *awsClientImpl) ModifyVolume(ctx context.Context, input *ec2.ModifyVolumeInput) (*ec2.ModifyVolumeOutput, error) {
 	var output *ec2.ModifyVolumeOutput
@@ -1008,6 +1072,8 @@ type awsClientMock struct { //nolint
 	*ec2.DetachVolumeInput
 	*ec2.ModifyVolumeInput
 	*ec2.DescribeVolumesInput
+	*ec2.CreateSnapshotInput
+	*ec2.DeleteSnapshotInput
 	*ec2.DescribeSubnetsInput
 	*ec2.DescribeVpcsInput
 	*ec2.CreateKeyPairInput
@@ -1195,6 +1261,23 @@ func (c *awsClientMock) DescribeVolumes(ctx context.Context, input *ec2.Describe
 	}, nil
 }
 
+// CreateSnapshot is a mock for ec2.CreateSnapshot.
+func (c *awsClientMock) CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput) (*ec2.CreateSnapshotOutput, error) {
+	c.CreateSnapshotInput = input
+	return &ec2.CreateSnapshotOutput{
+		SnapshotId: aws.String("test-snapshot"),
+		VolumeId:   input.VolumeId,
+		VolumeSize: aws.Int32(10),
+		State:      types.SnapshotStatePending,
+	}, nil
+}
+
+// DeleteSnapshot is a mock for ec2.DeleteSnapshot.
+func (c *awsClientMock) DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput) (*ec2.DeleteSnapshotOutput, error) {
+	c.DeleteSnapshotInput = input
+	return nil, nil
+}
+
 // DescribeSubnets is a mock for ec2.DescribeSubnets.
 func (c *awsClientMock) DescribeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
 	c.DescribeSubnetsInput = input
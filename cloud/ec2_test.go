@@ -57,7 +57,7 @@ func TestEC2Suite(t *testing.T) {
 }
 
 func (s *EC2Suite) SetupTest() {
-	s.Require().NoError(db.ClearCollections(host.Collection, host.VolumesCollection, task.Collection, model.ProjectVarsCollection))
+	s.Require().NoError(db.ClearCollections(host.Collection, host.VolumesCollection, host.SnapshotsCollection, task.Collection, model.ProjectVarsCollection))
 	s.onDemandOpts = &EC2ManagerOptions{
 		client: &awsClientMock{},
 	}
@@ -1258,6 +1258,60 @@ func (s *EC2Suite) TestDeleteVolume() {
 	s.NoError(err)
 }
 
+func (s *EC2Suite) TestCreateSnapshot() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	snapshotMgr, ok := s.onDemandManager.(SnapshotManager)
+	s.Require().True(ok)
+	snapshot, err := snapshotMgr.CreateSnapshot(ctx, &host.Snapshot{
+		DisplayName:    "my-snapshot",
+		CreatedBy:      "user",
+		SourceVolumeID: "test-volume",
+	})
+	s.Require().NoError(err)
+	s.Equal("test-snapshot", snapshot.ID)
+	s.EqualValues(10, snapshot.Size)
+
+	manager, ok := s.onDemandManager.(*ec2Manager)
+	s.True(ok)
+	mock, ok := manager.client.(*awsClientMock)
+	s.True(ok)
+
+	input := *mock.CreateSnapshotInput
+	s.Equal("test-volume", *input.VolumeId)
+	s.Require().Len(input.TagSpecifications, 1)
+	s.Equal(types.ResourceTypeSnapshot, input.TagSpecifications[0].ResourceType)
+
+	foundSnapshot, err := host.FindSnapshotByID(snapshot.ID)
+	s.NoError(err)
+	s.Require().NotNil(foundSnapshot)
+	s.False(foundSnapshot.Expiration.IsZero())
+}
+
+func (s *EC2Suite) TestDeleteSnapshot() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	snapshot := &host.Snapshot{ID: "test-snapshot", SourceVolumeID: "test-volume"}
+	s.NoError(snapshot.Insert())
+	snapshotMgr, ok := s.onDemandManager.(SnapshotManager)
+	s.Require().True(ok)
+	s.NoError(snapshotMgr.DeleteSnapshot(ctx, snapshot))
+
+	manager, ok := s.onDemandManager.(*ec2Manager)
+	s.True(ok)
+	mock, ok := manager.client.(*awsClientMock)
+	s.True(ok)
+
+	input := *mock.DeleteSnapshotInput
+	s.Equal("test-snapshot", *input.SnapshotId)
+
+	foundSnapshot, err := host.FindSnapshotByID(snapshot.ID)
+	s.Nil(foundSnapshot)
+	s.NoError(err)
+}
+
 func (s *EC2Suite) TestAttachVolume() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -31,6 +31,7 @@ const (
 	EC2InsufficientCapacity = "InsufficientInstanceCapacity"
 	EC2InvalidParam         = "InvalidParameterValue"
 	EC2VolumeNotFound       = "InvalidVolume.NotFound"
+	EC2SnapshotNotFound     = "InvalidSnapshot.NotFound"
 	EC2VolumeResizeRate     = "VolumeModificationRateExceeded"
 	ec2TemplateNameExists   = "InvalidLaunchTemplateName.AlreadyExistsException"
 )
@@ -516,6 +517,9 @@ func validateEC2HostModifyOptions(h *host.Host, opts host.HostModifyOptions) err
 	if time.Until(h.ExpirationTime.Add(opts.AddHours)) > evergreen.MaxSpawnHostExpirationDurationHours {
 		return errors.Errorf("cannot extend host '%s' expiration by '%s' -- maximum host duration is limited to %s", h.Id, opts.AddHours.String(), evergreen.MaxSpawnHostExpirationDurationHours.String())
 	}
+	if err := host.ValidateSleepScheduleModification(h, opts); err != nil {
+		return errors.Wrap(err, "invalid sleep schedule")
+	}
 
 	return nil
 }
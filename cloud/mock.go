@@ -45,6 +45,11 @@ type MockVolume struct {
 	NoExpiration bool
 }
 
+type MockSnapshot struct {
+	SourceVolumeID string
+	Size           int32
+}
+
 type MockProvider interface {
 	Len() int
 	Reset()
@@ -137,6 +142,7 @@ func (m *mockState) IterInstances() <-chan MockInstance {
 type mockManager struct {
 	Instances map[string]MockInstance
 	Volumes   map[string]MockVolume
+	Snapshots map[string]MockSnapshot
 	mutex     *sync.RWMutex
 }
 
@@ -376,6 +382,35 @@ func (m *mockManager) DeleteVolume(ctx context.Context, volume *host.Volume) err
 	return errors.WithStack(volume.Remove())
 }
 
+func (m *mockManager) CreateSnapshot(ctx context.Context, snapshot *host.Snapshot) (*host.Snapshot, error) {
+	l := m.mutex
+	l.Lock()
+	defer l.Unlock()
+	if m.Snapshots == nil {
+		m.Snapshots = map[string]MockSnapshot{}
+	}
+	if snapshot.ID == "" {
+		snapshot.ID = primitive.NewObjectID().Hex()
+	}
+	m.Snapshots[snapshot.ID] = MockSnapshot{
+		SourceVolumeID: snapshot.SourceVolumeID,
+		Size:           snapshot.Size,
+	}
+	if err := snapshot.Insert(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return snapshot, nil
+}
+
+func (m *mockManager) DeleteSnapshot(ctx context.Context, snapshot *host.Snapshot) error {
+	l := m.mutex
+	l.Lock()
+	defer l.Unlock()
+	delete(m.Snapshots, snapshot.ID)
+	return errors.WithStack(snapshot.Remove())
+}
+
 func (m *mockManager) ModifyVolume(ctx context.Context, volume *host.Volume, opts *model.VolumeModifyOptions) error {
 	l := m.mutex
 	l.Lock()
@@ -0,0 +1,156 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/pkg/errors"
+)
+
+// GetSnapshotManager returns a cloud manager that can manage snapshots in the
+// given region.
+func GetSnapshotManager(ctx context.Context, region string) (SnapshotManager, error) {
+	provider := evergreen.ProviderNameEc2OnDemand
+	if os.Getenv("SETTINGS_OVERRIDE") != "" {
+		// Use the mock manager during integration tests
+		provider = evergreen.ProviderNameMock
+	}
+	mgrOpts := ManagerOpts{
+		Provider: provider,
+		Region:   region,
+	}
+	mgr, err := GetManager(ctx, evergreen.GetEnvironment(), mgrOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting cloud manager for region '%s'", region)
+	}
+	snapshotMgr, ok := mgr.(SnapshotManager)
+	if !ok {
+		return nil, errors.Errorf("cloud provider '%s' does not support snapshots", provider)
+	}
+	return snapshotMgr, nil
+}
+
+// CreateSnapshot takes a snapshot of the given volume on behalf of the user.
+func CreateSnapshot(ctx context.Context, vol *host.Volume, displayName, userID string) (*host.Snapshot, int, error) {
+	numSnapshots, err := host.CountSnapshotsForUser(userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrapf(err, "counting snapshots for user '%s'", userID)
+	}
+	if numSnapshots >= evergreen.DefaultMaxSnapshotsPerUser {
+		return nil, http.StatusBadRequest, errors.Errorf("user already has the max allowed number of snapshots (%d of %d)", numSnapshots, evergreen.DefaultMaxSnapshotsPerUser)
+	}
+
+	mgr, err := GetSnapshotManager(ctx, AztoRegion(vol.AvailabilityZone))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if displayName == "" {
+		displayName = vol.DisplayName
+	}
+	snapshot, err := mgr.CreateSnapshot(ctx, &host.Snapshot{
+		DisplayName:    displayName,
+		CreatedBy:      userID,
+		SourceVolumeID: vol.ID,
+		SourceHostID:   vol.Host,
+		Region:         AztoRegion(vol.AvailabilityZone),
+		Size:           vol.Size,
+	})
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrapf(err, "creating snapshot of volume '%s'", vol.ID)
+	}
+	return snapshot, http.StatusOK, nil
+}
+
+// SnapshotHostHomeVolume takes a snapshot of the host's home volume on behalf
+// of the user.
+func SnapshotHostHomeVolume(ctx context.Context, h *host.Host, displayName, userID string) (*host.Snapshot, int, error) {
+	if h.HomeVolumeID == "" {
+		return nil, http.StatusBadRequest, errors.Errorf("host '%s' does not have a home volume", h.Id)
+	}
+	vol, err := host.FindVolumeByID(h.HomeVolumeID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrapf(err, "getting home volume '%s'", h.HomeVolumeID)
+	}
+	if vol == nil {
+		return nil, http.StatusNotFound, errors.Errorf("home volume '%s' not found", h.HomeVolumeID)
+	}
+	if displayName == "" {
+		displayName = h.DisplayName
+	}
+	return CreateSnapshot(ctx, vol, displayName, userID)
+}
+
+// DeleteSnapshot deletes the snapshot from the cloud provider and the DB.
+func DeleteSnapshot(ctx context.Context, snapshotID string) (int, error) {
+	if snapshotID == "" {
+		return http.StatusBadRequest, errors.New("must specify snapshot ID")
+	}
+	snapshot, err := host.FindSnapshotByID(snapshotID)
+	if err != nil {
+		return http.StatusInternalServerError, errors.Wrapf(err, "getting snapshot '%s'", snapshotID)
+	}
+	if snapshot == nil {
+		return http.StatusNotFound, errors.Errorf("snapshot '%s' not found", snapshotID)
+	}
+	mgr, err := GetSnapshotManager(ctx, snapshot.Region)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if err = mgr.DeleteSnapshot(ctx, snapshot); err != nil {
+		return http.StatusInternalServerError, errors.Wrapf(err, "deleting snapshot '%s'", snapshot.ID)
+	}
+	return http.StatusOK, nil
+}
+
+// ValidateSnapshotForRestore checks that the user can restore a volume in the
+// given region from the snapshot and returns the snapshot.
+func ValidateSnapshotForRestore(snapshotID, userID, region string) (*host.Snapshot, error) {
+	snapshot, err := host.FindSnapshotByID(snapshotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting snapshot '%s'", snapshotID)
+	}
+	if snapshot == nil {
+		return nil, errors.Errorf("snapshot '%s' not found", snapshotID)
+	}
+	if snapshot.CreatedBy != userID {
+		return nil, errors.Errorf("snapshot '%s' is not owned by user '%s'", snapshotID, userID)
+	}
+	if region != "" && snapshot.Region != region {
+		return nil, errors.Errorf("cannot restore snapshot in region '%s' into region '%s'", snapshot.Region, region)
+	}
+	return snapshot, nil
+}
+
+// ApplyVolumeSnapshot validates that the volume's creator can restore the
+// volume from its snapshot, if it has one, and defaults the volume's size to
+// the size of the snapshot.
+func ApplyVolumeSnapshot(volume *host.Volume) error {
+	if volume.SnapshotID == "" {
+		return nil
+	}
+	var region string
+	if volume.AvailabilityZone != "" {
+		region = AztoRegion(volume.AvailabilityZone)
+	}
+	snapshot, err := ValidateSnapshotForRestore(volume.SnapshotID, volume.CreatedBy, region)
+	if err != nil {
+		return err
+	}
+	volume.Size, err = restoredVolumeSize(snapshot, volume.Size)
+	return err
+}
+
+// restoredVolumeSize returns the size of a volume restored from the snapshot,
+// defaulting to the size of the snapshot if no size is requested.
+func restoredVolumeSize(snapshot *host.Snapshot, requestedSize int32) (int32, error) {
+	if requestedSize == 0 {
+		return snapshot.Size, nil
+	}
+	if requestedSize < snapshot.Size {
+		return 0, errors.Errorf("volume size %d GB cannot be smaller than the snapshot size %d GB", requestedSize, snapshot.Size)
+	}
+	return requestedSize, nil
+}
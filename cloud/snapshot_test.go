@@ -0,0 +1,52 @@
+package cloud
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyVolumeSnapshot(t *testing.T) {
+	require.NoError(t, db.Clear(host.SnapshotsCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(host.SnapshotsCollection))
+	}()
+
+	snapshot := host.Snapshot{ID: "snapshot", CreatedBy: "me", Region: "us-east-1", Size: 20}
+	require.NoError(t, snapshot.Insert())
+
+	t.Run("NoSnapshotIsNoop", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", Size: 5}
+		assert.NoError(t, ApplyVolumeSnapshot(vol))
+		assert.EqualValues(t, 5, vol.Size)
+	})
+	t.Run("DefaultsToSnapshotSize", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", AvailabilityZone: "us-east-1a", SnapshotID: snapshot.ID}
+		assert.NoError(t, ApplyVolumeSnapshot(vol))
+		assert.EqualValues(t, 20, vol.Size)
+	})
+	t.Run("AllowsLargerSize", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", AvailabilityZone: "us-east-1a", SnapshotID: snapshot.ID, Size: 50}
+		assert.NoError(t, ApplyVolumeSnapshot(vol))
+		assert.EqualValues(t, 50, vol.Size)
+	})
+	t.Run("FailsWithSmallerSize", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", AvailabilityZone: "us-east-1a", SnapshotID: snapshot.ID, Size: 10}
+		assert.Error(t, ApplyVolumeSnapshot(vol))
+	})
+	t.Run("FailsForOtherUser", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "someone-else", AvailabilityZone: "us-east-1a", SnapshotID: snapshot.ID}
+		assert.Error(t, ApplyVolumeSnapshot(vol))
+	})
+	t.Run("FailsInOtherRegion", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", AvailabilityZone: "us-west-2a", SnapshotID: snapshot.ID}
+		assert.Error(t, ApplyVolumeSnapshot(vol))
+	})
+	t.Run("FailsForNonexistentSnapshot", func(t *testing.T) {
+		vol := &host.Volume{CreatedBy: "me", AvailabilityZone: "us-east-1a", SnapshotID: "nonexistent"}
+		assert.Error(t, ApplyVolumeSnapshot(vol))
+	})
+}
@@ -39,6 +39,7 @@ type SpawnOptions struct {
 	IsCluster             bool
 	HomeVolumeSize        int
 	HomeVolumeID          string
+	HomeVolumeSnapshotID  string
 	Expiration            *time.Time
 }
 
@@ -137,6 +138,23 @@ func CreateSpawnHost(ctx context.Context, so SpawnOptions, settings *evergreen.S
 			return nil, errors.Errorf("cannot use volume in zone '%s' with host in region '%s'", volume.AvailabilityZone, so.Region)
 		}
 	}
+	if so.HomeVolumeSnapshotID != "" {
+		if !so.IsVirtualWorkstation {
+			return nil, errors.New("can only restore a snapshot into the home volume of a virtual workstation")
+		}
+		if so.HomeVolumeID != "" {
+			return nil, errors.New("cannot restore a snapshot when using an existing home volume")
+		}
+		snapshot, err := ValidateSnapshotForRestore(so.HomeVolumeSnapshotID, so.UserName, so.Region)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		homeVolumeSize, err := restoredVolumeSize(snapshot, int32(so.HomeVolumeSize))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		so.HomeVolumeSize = int(homeVolumeSize)
+	}
 	if so.UseProjectSetupScript {
 		so.ProvisionOptions.SetupScript, err = model.GetSetupScriptForTask(ctx, so.ProvisionOptions.TaskId)
 		if err != nil {
@@ -191,6 +209,7 @@ func CreateSpawnHost(ctx context.Context, so SpawnOptions, settings *evergreen.S
 		IsCluster:            so.IsCluster,
 		HomeVolumeSize:       so.HomeVolumeSize,
 		HomeVolumeID:         so.HomeVolumeID,
+		HomeVolumeSnapshotID: so.HomeVolumeSnapshotID,
 		Region:               so.Region,
 	}
 
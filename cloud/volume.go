@@ -152,6 +152,9 @@ func DetachVolume(ctx context.Context, volumeId string) (int, error) {
 }
 
 func RequestNewVolume(ctx context.Context, volume host.Volume) (*host.Volume, int, error) {
+	if err := ApplyVolumeSnapshot(&volume); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
 	if volume.Size == 0 {
 		return nil, http.StatusBadRequest, errors.New("must specify volume size")
 	}
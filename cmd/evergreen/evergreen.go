@@ -47,6 +47,7 @@ func buildApp() *cli.App {
 		operations.Admin(),
 		operations.Host(),
 		operations.Volume(),
+		operations.Snapshot(),
 		operations.Notification(),
 		operations.Buildlogger(),
 
@@ -56,6 +57,7 @@ func buildApp() *cli.App {
 		operations.Pull(),
 		operations.Evaluate(),
 		operations.Validate(),
+		operations.RunLocal(),
 		operations.List(),
 		operations.LastGreen(),
 		operations.Subscriptions(),
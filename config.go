@@ -94,9 +94,12 @@ type Settings struct {
 	PodLifecycle        PodLifecycleConfig      `yaml:"pod_lifecycle" bson:"pod_lifecycle" json:"pod_lifecycle" id:"pod_lifecycle"`
 	PprofPort           string                  `yaml:"pprof_port" bson:"pprof_port" json:"pprof_port"`
 	ProjectCreation     ProjectCreationConfig   `yaml:"project_creation" bson:"project_creation" json:"project_creation" id:"project_creation"`
+	ProjectVars         ProjectVarsConfig       `yaml:"project_vars" bson:"project_vars" json:"project_vars" id:"project_vars"`
 	Providers           CloudProviders          `yaml:"providers" bson:"providers" json:"providers" id:"providers"`
+	RateLimit           RateLimitConfig         `yaml:"rate_limit" bson:"rate_limit" json:"rate_limit" id:"rate_limit"`
 	RepoTracker         RepoTrackerConfig       `yaml:"repotracker" bson:"repotracker" json:"repotracker" id:"repotracker"`
 	Scheduler           SchedulerConfig         `yaml:"scheduler" bson:"scheduler" json:"scheduler" id:"scheduler"`
+	SecretProviders     SecretProvidersConfig   `yaml:"secret_providers" bson:"secret_providers" json:"secret_providers" id:"secret_providers"`
 	ServiceFlags        ServiceFlags            `bson:"service_flags" json:"service_flags" id:"service_flags" yaml:"service_flags"`
 	SSHKeyDirectory     string                  `yaml:"ssh_key_directory" bson:"ssh_key_directory" json:"ssh_key_directory"`
 	SSHKeyPairs         []SSHKeyPair            `yaml:"ssh_key_pairs" bson:"ssh_key_pairs" json:"ssh_key_pairs"`
@@ -108,6 +111,7 @@ type Settings struct {
 	ShutdownWaitSeconds int                     `yaml:"shutdown_wait_seconds" bson:"shutdown_wait_seconds" json:"shutdown_wait_seconds"`
 	Tracer              TracerConfig            `yaml:"tracer" bson:"tracer" json:"tracer" id:"tracer"`
 	GitHubCheckRun      GitHubCheckRunConfig    `yaml:"github_check_run" bson:"github_check_run" json:"github_check_run" id:"github_check_run"`
+	Gitlab              GitlabConfig            `yaml:"gitlab" bson:"gitlab" json:"gitlab" id:"gitlab"`
 }
 
 func (c *Settings) SectionId() string { return ConfigDocID }
@@ -2,6 +2,7 @@ package evergreen
 
 import (
 	"context"
+	"strings"
 
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
@@ -23,6 +24,7 @@ type AuthUser struct {
 var (
 	AuthLDAPKey                    = bsonutil.MustHaveTag(AuthConfig{}, "LDAP")
 	AuthOktaKey                    = bsonutil.MustHaveTag(AuthConfig{}, "Okta")
+	AuthOIDCKey                    = bsonutil.MustHaveTag(AuthConfig{}, "OIDC")
 	AuthGithubKey                  = bsonutil.MustHaveTag(AuthConfig{}, "Github")
 	AuthNaiveKey                   = bsonutil.MustHaveTag(AuthConfig{}, "Naive")
 	AuthMultiKey                   = bsonutil.MustHaveTag(AuthConfig{}, "Multi")
@@ -57,6 +59,82 @@ type OktaConfig struct {
 	ExpireAfterMinutes int      `bson:"expire_after_minutes" json:"expire_after_minutes" yaml:"expire_after_minutes"`
 }
 
+// OIDCConfig contains settings for authenticating users with a generic OpenID
+// Connect identity provider.
+type OIDCConfig struct {
+	// Issuer is the URL of the identity provider. Its endpoints are found from
+	// the provider's discovery document at
+	// <issuer>/.well-known/openid-configuration.
+	Issuer       string   `bson:"issuer" json:"issuer" yaml:"issuer"`
+	ClientID     string   `bson:"client_id" json:"client_id" yaml:"client_id"`
+	ClientSecret string   `bson:"client_secret" json:"client_secret" yaml:"client_secret"`
+	Scopes       []string `bson:"scopes" json:"scopes" yaml:"scopes"`
+	// UsernameClaim is the ID token claim used as the user's ID. If it's the
+	// email claim, the email domain is removed, so the email must be verified
+	// and in EmailDomain.
+	UsernameClaim string `bson:"username_claim" json:"username_claim" yaml:"username_claim"`
+	// EmailDomain is the domain of the users' email addresses. It's required
+	// if the username claim is the email claim, since users in different
+	// domains would otherwise get the same ID.
+	EmailDomain string `bson:"email_domain" json:"email_domain" yaml:"email_domain"`
+	// GroupsClaim is the ID token claim containing the user's groups.
+	GroupsClaim string `bson:"groups_claim" json:"groups_claim" yaml:"groups_claim"`
+	// UserGroup, if set, is the group that users must belong to in order to
+	// log in.
+	UserGroup string `bson:"user_group" json:"user_group" yaml:"user_group"`
+	// GroupRoles maps the user's groups to the roles they're granted.
+	GroupRoles         []OIDCGroupRoles `bson:"group_roles" json:"group_roles" yaml:"group_roles"`
+	ExpireAfterMinutes int              `bson:"expire_after_minutes" json:"expire_after_minutes" yaml:"expire_after_minutes"`
+}
+
+// OIDCGroupRoles grants roles to the members of an identity provider group.
+type OIDCGroupRoles struct {
+	Group string   `bson:"group" json:"group" yaml:"group"`
+	Roles []string `bson:"roles" json:"roles" yaml:"roles"`
+}
+
+const (
+	defaultOIDCUsernameClaim      = "sub"
+	oidcEmailClaim                = "email"
+	defaultOIDCGroupsClaim        = "groups"
+	defaultOIDCExpireAfterMinutes = 60
+)
+
+// ValidateAndDefault checks that the OIDC settings are valid and sets defaults
+// for the unset claims, scopes and expiration.
+func (c *OIDCConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Issuer == "", "OIDC issuer must be specified")
+	catcher.NewWhen(c.ClientID == "", "OIDC client ID must be specified")
+	catcher.NewWhen(c.ExpireAfterMinutes < 0, "OIDC expiration cannot be negative")
+	for _, mapping := range c.GroupRoles {
+		catcher.NewWhen(mapping.Group == "", "OIDC group role mapping must specify a group")
+		catcher.ErrorfWhen(len(mapping.Roles) == 0, "OIDC group role mapping for group '%s' must specify at least one role", mapping.Group)
+	}
+	catcher.NewWhen(strings.Contains(c.EmailDomain, "@"), "OIDC email domain must not contain '@'")
+
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "email", "profile"}
+	} else if !utility.StringSliceContains(c.Scopes, "openid") {
+		c.Scopes = append([]string{"openid"}, c.Scopes...)
+	}
+	if c.UsernameClaim == "" {
+		c.UsernameClaim = defaultOIDCUsernameClaim
+		if c.EmailDomain != "" {
+			c.UsernameClaim = oidcEmailClaim
+		}
+	}
+	catcher.NewWhen(c.UsernameClaim == oidcEmailClaim && c.EmailDomain == "", "OIDC email domain must be specified to use the email claim as the username")
+	if c.GroupsClaim == "" {
+		c.GroupsClaim = defaultOIDCGroupsClaim
+	}
+	if c.ExpireAfterMinutes == 0 {
+		c.ExpireAfterMinutes = defaultOIDCExpireAfterMinutes
+	}
+
+	return catcher.Resolve()
+}
+
 // GithubAuthConfig contains settings for interacting with Github Authentication
 // including the ClientID, ClientSecret and CallbackUri which are given when
 // registering the application Furthermore,
@@ -86,6 +164,7 @@ func (c *MultiAuthConfig) IsZero() bool {
 type AuthConfig struct {
 	LDAP                    *LDAPConfig       `bson:"ldap,omitempty" json:"ldap" yaml:"ldap"`
 	Okta                    *OktaConfig       `bson:"okta,omitempty" json:"okta" yaml:"okta"`
+	OIDC                    *OIDCConfig       `bson:"oidc,omitempty" json:"oidc" yaml:"oidc"`
 	Naive                   *NaiveAuthConfig  `bson:"naive,omitempty" json:"naive" yaml:"naive"`
 	Github                  *GithubAuthConfig `bson:"github,omitempty" json:"github" yaml:"github"`
 	Multi                   *MultiAuthConfig  `bson:"multi" json:"multi" yaml:"multi"`
@@ -118,6 +197,7 @@ func (c *AuthConfig) Set(ctx context.Context) error {
 		"$set": bson.M{
 			AuthLDAPKey:                    c.LDAP,
 			AuthOktaKey:                    c.Okta,
+			AuthOIDCKey:                    c.OIDC,
 			AuthNaiveKey:                   c.Naive,
 			AuthGithubKey:                  c.Github,
 			AuthMultiKey:                   c.Multi,
@@ -151,16 +231,21 @@ func (c *AuthConfig) ValidateAndDefault() error {
 		"",
 		AuthLDAPKey,
 		AuthOktaKey,
+		AuthOIDCKey,
 		AuthNaiveKey,
 		AuthGithubKey,
 		AuthMultiKey}, c.PreferredType), "invalid auth type '%s'", c.PreferredType)
 
-	if c.LDAP == nil && c.Naive == nil && c.Github == nil && c.Okta == nil && c.Multi == nil {
+	if c.LDAP == nil && c.Naive == nil && c.Github == nil && c.Okta == nil && c.OIDC == nil && c.Multi == nil {
 		catcher.Add(errors.New("must specify one form of authentication"))
 	}
 
 	catcher.Add(c.checkDuplicateUsers())
 
+	if c.OIDC != nil {
+		catcher.Add(c.OIDC.ValidateAndDefault())
+	}
+
 	if c.Multi != nil {
 		seen := map[string]bool{}
 		kinds := append([]string{}, c.Multi.ReadWrite...)
@@ -172,6 +257,8 @@ func (c *AuthConfig) ValidateAndDefault() error {
 				catcher.NewWhen(c.LDAP == nil, "LDAP settings cannot be empty if using in multi auth")
 			case AuthOktaKey:
 				catcher.NewWhen(c.Okta == nil, "Okta settings cannot be empty if using in multi auth")
+			case AuthOIDCKey:
+				catcher.NewWhen(c.OIDC == nil, "OIDC settings cannot be empty if using in multi auth")
 			case AuthGithubKey:
 				catcher.NewWhen(c.Github == nil, "GitHub settings cannot be empty if using in multi auth")
 			case AuthNaiveKey:
@@ -32,9 +32,24 @@ func (b BucketType) validate() error {
 // Evergreen data bucket storage.
 type BucketsConfig struct {
 	LogBucket BucketConfig `bson:"log_bucket" json:"log_bucket" yaml:"log_bucket"`
+	// ArtifactBucket is the bucket that tasks upload artifacts to without
+	// needing their own credentials.
+	ArtifactBucket BucketConfig `bson:"artifact_bucket" json:"artifact_bucket" yaml:"artifact_bucket"`
+	// ArtifactProjectDailyQuotaMB is the maximum total size of artifacts that
+	// each project can upload to the artifact bucket per day. If zero, there
+	// is no quota.
+	ArtifactProjectDailyQuotaMB int `bson:"artifact_project_daily_quota_mb" json:"artifact_project_daily_quota_mb" yaml:"artifact_project_daily_quota_mb"`
+	// PatchDiffBucket is the bucket that patch diffs are stored in. If it is
+	// not set, patch diffs are stored in GridFS.
+	PatchDiffBucket BucketConfig `bson:"patch_diff_bucket" json:"patch_diff_bucket" yaml:"patch_diff_bucket"`
 }
 
-var bucketsConfigLogBucketKey = bsonutil.MustHaveTag(BucketsConfig{}, "LogBucket")
+var (
+	bucketsConfigLogBucketKey                   = bsonutil.MustHaveTag(BucketsConfig{}, "LogBucket")
+	bucketsConfigArtifactBucketKey              = bsonutil.MustHaveTag(BucketsConfig{}, "ArtifactBucket")
+	bucketsConfigArtifactProjectDailyQuotaMBKey = bsonutil.MustHaveTag(BucketsConfig{}, "ArtifactProjectDailyQuotaMB")
+	bucketsConfigPatchDiffBucketKey             = bsonutil.MustHaveTag(BucketsConfig{}, "PatchDiffBucket")
+)
 
 // BucketConfig represents the admin config for an individual bucket.
 type BucketConfig struct {
@@ -81,7 +96,10 @@ func (c *BucketsConfig) Set(ctx context.Context) error {
 
 	_, err := coll.UpdateOne(ctx, byId(c.SectionId()), bson.M{
 		"$set": bson.M{
-			bucketsConfigLogBucketKey: c.LogBucket,
+			bucketsConfigLogBucketKey:                   c.LogBucket,
+			bucketsConfigArtifactBucketKey:              c.ArtifactBucket,
+			bucketsConfigArtifactProjectDailyQuotaMBKey: c.ArtifactProjectDailyQuotaMB,
+			bucketsConfigPatchDiffBucketKey:             c.PatchDiffBucket,
 		},
 	}, options.Update().SetUpsert(true))
 
@@ -89,5 +107,14 @@ func (c *BucketsConfig) Set(ctx context.Context) error {
 }
 
 func (c *BucketsConfig) ValidateAndDefault() error {
-	return c.LogBucket.validate()
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(c.LogBucket.validate())
+	if c.ArtifactBucket.Name != "" {
+		catcher.Wrap(c.ArtifactBucket.validate(), "invalid artifact bucket")
+	}
+	if c.PatchDiffBucket.Name != "" {
+		catcher.Wrap(c.PatchDiffBucket.validate(), "invalid patch diff bucket")
+	}
+	catcher.NewWhen(c.ArtifactProjectDailyQuotaMB < 0, "artifact project daily quota cannot be negative")
+	return catcher.Resolve()
 }
@@ -11,11 +11,15 @@ import (
 )
 
 type CommitQueueConfig struct {
-	MergeTaskDistro            string `yaml:"merge_task_distro" bson:"merge_task_distro" json:"merge_task_distro"`
-	CommitterName              string `yaml:"committer_name" bson:"committer_name" json:"committer_name"`
-	CommitterEmail             string `yaml:"committer_email" bson:"committer_email" json:"committer_email"`
-	BatchSize                  int    `yaml:"batch_size" bson:"batch_size" json:"batch_size"`
-	MaxSystemFailedTaskRetries int    `yaml:"max_system_failed_task_retries" bson:"max_system_failed_task_retries" json:"max_system_failed_task_retries"`
+	MergeTaskDistro string `yaml:"merge_task_distro" bson:"merge_task_distro" json:"merge_task_distro"`
+	CommitterName   string `yaml:"committer_name" bson:"committer_name" json:"committer_name"`
+	CommitterEmail  string `yaml:"committer_email" bson:"committer_email" json:"committer_email"`
+	BatchSize       int    `yaml:"batch_size" bson:"batch_size" json:"batch_size"`
+	// BatchMode tests each batch of items with only the tasks of the last
+	// item in the batch, which includes the changes from every item, and
+	// bisects the batch to find the culprit when it fails.
+	BatchMode                  bool `yaml:"batch_mode" bson:"batch_mode" json:"batch_mode"`
+	MaxSystemFailedTaskRetries int  `yaml:"max_system_failed_task_retries" bson:"max_system_failed_task_retries" json:"max_system_failed_task_retries"`
 }
 
 var (
@@ -23,6 +27,7 @@ var (
 	committerNameKey              = bsonutil.MustHaveTag(CommitQueueConfig{}, "CommitterName")
 	committerEmailKey             = bsonutil.MustHaveTag(CommitQueueConfig{}, "CommitterEmail")
 	commitQueueBatchSizeKey       = bsonutil.MustHaveTag(CommitQueueConfig{}, "BatchSize")
+	commitQueueBatchModeKey       = bsonutil.MustHaveTag(CommitQueueConfig{}, "BatchMode")
 	maxSystemFailedTaskRetriesKey = bsonutil.MustHaveTag(CommitQueueConfig{}, "MaxSystemFailedTaskRetries")
 )
 
@@ -53,6 +58,7 @@ func (c *CommitQueueConfig) Set(ctx context.Context) error {
 			committerNameKey:              c.CommitterName,
 			committerEmailKey:             c.CommitterEmail,
 			commitQueueBatchSizeKey:       c.BatchSize,
+			commitQueueBatchModeKey:       c.BatchMode,
 			maxSystemFailedTaskRetriesKey: c.MaxSystemFailedTaskRetries,
 		},
 	}, options.Update().SetUpsert(true))
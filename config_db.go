@@ -120,6 +120,28 @@ var (
 
 	// GithubCheckRun keys
 	checkRunLimitKey = bsonutil.MustHaveTag(GitHubCheckRunConfig{}, "CheckRunLimit")
+
+	// Gitlab keys
+	gitlabURLKey           = bsonutil.MustHaveTag(GitlabConfig{}, "URL")
+	gitlabTokenKey         = bsonutil.MustHaveTag(GitlabConfig{}, "Token")
+	gitlabWebhookSecretKey = bsonutil.MustHaveTag(GitlabConfig{}, "WebhookSecret")
+
+	// RateLimit keys
+	rateLimitEnabledKey            = bsonutil.MustHaveTag(RateLimitConfig{}, "Enabled")
+	rateLimitRequestsPerMinuteKey  = bsonutil.MustHaveTag(RateLimitConfig{}, "RequestsPerMinute")
+	rateLimitBurstKey              = bsonutil.MustHaveTag(RateLimitConfig{}, "Burst")
+	rateLimitExemptServiceUsersKey = bsonutil.MustHaveTag(RateLimitConfig{}, "ExemptServiceUsers")
+	rateLimitExemptUsersKey        = bsonutil.MustHaveTag(RateLimitConfig{}, "ExemptUsers")
+	rateLimitRouteGroupsKey        = bsonutil.MustHaveTag(RateLimitConfig{}, "RouteGroups")
+
+	// SecretProviders keys
+	secretProvidersVaultKey               = bsonutil.MustHaveTag(SecretProvidersConfig{}, "Vault")
+	secretProvidersAWSSecretsManagerKey   = bsonutil.MustHaveTag(SecretProvidersConfig{}, "AWSSecretsManager")
+	secretProvidersFilePathKey            = bsonutil.MustHaveTag(SecretProvidersConfig{}, "FilePath")
+	secretProvidersProjectPathPrefixesKey = bsonutil.MustHaveTag(SecretProvidersConfig{}, "ProjectPathPrefixes")
+
+	// ProjectVars keys
+	projectVarsVersionHistoryLimitKey = bsonutil.MustHaveTag(ProjectVarsConfig{}, "VersionHistoryLimit")
 )
 
 func byId(id string) bson.M {
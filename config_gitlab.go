@@ -0,0 +1,92 @@
+package evergreen
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GitlabConfig configures the GitLab instance that projects hosted on GitLab
+// use for merge request patches, commit statuses, and polling.
+type GitlabConfig struct {
+	// URL is the base URL of the GitLab instance, e.g. https://gitlab.com.
+	URL string `bson:"url" json:"url" yaml:"url"`
+	// Token is an access token with the api and read_repository scopes.
+	Token string `bson:"token" json:"token" yaml:"token"`
+	// WebhookSecret is the secret token that GitLab sends with webhooks.
+	WebhookSecret string `bson:"webhook_secret" json:"webhook_secret" yaml:"webhook_secret"`
+}
+
+// SectionId returns the ID of this config section.
+func (c *GitlabConfig) SectionId() string { return "gitlab" }
+
+// Get populates the config from the database.
+func (c *GitlabConfig) Get(ctx context.Context) error {
+	res := GetEnvironment().DB().Collection(ConfigCollection).FindOne(ctx, byId(c.SectionId()))
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			*c = GitlabConfig{}
+			return nil
+		}
+		return errors.Wrapf(err, "getting config section '%s'", c.SectionId())
+	}
+
+	if err := res.Decode(&c); err != nil {
+		return errors.Wrapf(err, "decoding config section '%s'", c.SectionId())
+	}
+
+	return nil
+}
+
+// Set sets the document in the database to match the in-memory config struct.
+func (c *GitlabConfig) Set(ctx context.Context) error {
+	_, err := GetEnvironment().DB().Collection(ConfigCollection).UpdateOne(ctx, byId(c.SectionId()), bson.M{
+		"$set": bson.M{
+			gitlabURLKey:           c.URL,
+			gitlabTokenKey:         c.Token,
+			gitlabWebhookSecretKey: c.WebhookSecret,
+		},
+	}, options.Update().SetUpsert(true))
+	return errors.Wrapf(err, "updating config section '%s'", c.SectionId())
+}
+
+// ValidateAndDefault validates the GitLab configuration.
+func (c *GitlabConfig) ValidateAndDefault() error {
+	if c.URL == "" {
+		return nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return errors.Wrap(err, "invalid GitLab URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("GitLab URL '%s' must use http or https", c.URL)
+	}
+	c.URL = strings.TrimSuffix(c.URL, "/")
+	return nil
+}
+
+// IsConfigured returns whether Evergreen can communicate with GitLab.
+func (c *GitlabConfig) IsConfigured() bool {
+	return c.URL != "" && c.Token != ""
+}
+
+// CloneURL returns the URL to clone the GitLab project at the given path
+// (e.g. "group/subgroup/project") over HTTPS, authenticated with the token.
+func (c *GitlabConfig) CloneURL(projectPath string) string {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return ""
+	}
+	if c.Token != "" {
+		u.User = url.UserPassword("oauth2", c.Token)
+	}
+	u.Path = fmt.Sprintf("%s/%s.git", strings.TrimSuffix(u.Path, "/"), projectPath)
+	return u.String()
+}
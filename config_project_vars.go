@@ -0,0 +1,66 @@
+package evergreen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const defaultProjectVarsVersionHistoryLimit = 10
+
+// ProjectVarsConfig configures the version history of project variables.
+type ProjectVarsConfig struct {
+	// VersionHistoryLimit is the number of versions kept for each variable.
+	VersionHistoryLimit int `bson:"version_history_limit" json:"version_history_limit" yaml:"version_history_limit"`
+	// EncryptionKey is the key used to encrypt the previous values of
+	// private variables. If it's not set, the history of private variables
+	// is kept without their values, so they can't be rolled back. It's only
+	// read from the settings file, so it's never stored in the database
+	// alongside the values it encrypts or returned by the admin API.
+	EncryptionKey string `bson:"-" json:"-" yaml:"encryption_key"`
+}
+
+// SectionId returns the ID of this config section.
+func (c *ProjectVarsConfig) SectionId() string { return "project_vars" }
+
+// Get populates the config from the database.
+func (c *ProjectVarsConfig) Get(ctx context.Context) error {
+	res := GetEnvironment().DB().Collection(ConfigCollection).FindOne(ctx, byId(c.SectionId()))
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			*c = ProjectVarsConfig{}
+			return nil
+		}
+		return errors.Wrapf(err, "getting config section '%s'", c.SectionId())
+	}
+
+	if err := res.Decode(&c); err != nil {
+		return errors.Wrapf(err, "decoding config section '%s'", c.SectionId())
+	}
+
+	return nil
+}
+
+// Set sets the document in the database to match the in-memory config struct.
+func (c *ProjectVarsConfig) Set(ctx context.Context) error {
+	_, err := GetEnvironment().DB().Collection(ConfigCollection).UpdateOne(ctx, byId(c.SectionId()), bson.M{
+		"$set": bson.M{
+			projectVarsVersionHistoryLimitKey: c.VersionHistoryLimit,
+		},
+	}, options.Update().SetUpsert(true))
+	return errors.Wrapf(err, "updating config section '%s'", c.SectionId())
+}
+
+// ValidateAndDefault defaults the version history limit.
+func (c *ProjectVarsConfig) ValidateAndDefault() error {
+	if c.VersionHistoryLimit < 0 {
+		return errors.New("version history limit cannot be negative")
+	}
+	if c.VersionHistoryLimit == 0 {
+		c.VersionHistoryLimit = defaultProjectVarsVersionHistoryLimit
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package evergreen
+
+import (
+	"context"
+	"strings"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// DefaultRateLimitRouteGroup is the route group for requests that don't
+	// match any configured route group.
+	DefaultRateLimitRouteGroup = "default"
+
+	defaultRateLimitRequestsPerMinute = 600
+)
+
+// RateLimitConfig configures the request budgets for users of the REST API and
+// GraphQL. Each user has a token bucket per route group, which refills at the
+// group's rate and holds at most the group's burst of requests.
+type RateLimitConfig struct {
+	// Enabled is whether requests are rate limited.
+	Enabled bool `bson:"enabled" json:"enabled" yaml:"enabled"`
+	// RequestsPerMinute is the sustained request rate for routes that don't
+	// belong to a route group.
+	RequestsPerMinute int `bson:"requests_per_minute" json:"requests_per_minute" yaml:"requests_per_minute"`
+	// Burst is the number of requests that can be made at once for routes
+	// that don't belong to a route group. Defaults to the requests per
+	// minute.
+	Burst int `bson:"burst" json:"burst" yaml:"burst"`
+	// ExemptServiceUsers is whether service users are exempt from rate
+	// limiting.
+	ExemptServiceUsers bool `bson:"exempt_service_users" json:"exempt_service_users" yaml:"exempt_service_users"`
+	// ExemptUsers are users who are exempt from rate limiting.
+	ExemptUsers []string `bson:"exempt_users" json:"exempt_users" yaml:"exempt_users"`
+	// RouteGroups set separate limits for groups of routes. A request belongs
+	// to the first group with a matching path prefix.
+	RouteGroups []RateLimitRouteGroup `bson:"route_groups" json:"route_groups" yaml:"route_groups"`
+}
+
+// RateLimitRouteGroup is a group of routes that share a request budget.
+type RateLimitRouteGroup struct {
+	Name string `bson:"name" json:"name" yaml:"name"`
+	// PathPrefixes are the request paths that belong to the group, e.g.
+	// "/rest/v2/tasks" or "/graphql".
+	PathPrefixes      []string `bson:"path_prefixes" json:"path_prefixes" yaml:"path_prefixes"`
+	RequestsPerMinute int      `bson:"requests_per_minute" json:"requests_per_minute" yaml:"requests_per_minute"`
+	// Burst defaults to the requests per minute.
+	Burst int `bson:"burst" json:"burst" yaml:"burst"`
+}
+
+// SectionId returns the ID of this config section.
+func (c *RateLimitConfig) SectionId() string { return "rate_limit" }
+
+// Get populates the config from the database.
+func (c *RateLimitConfig) Get(ctx context.Context) error {
+	res := GetEnvironment().DB().Collection(ConfigCollection).FindOne(ctx, byId(c.SectionId()))
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			*c = RateLimitConfig{}
+			return nil
+		}
+		return errors.Wrapf(err, "getting config section '%s'", c.SectionId())
+	}
+
+	if err := res.Decode(&c); err != nil {
+		return errors.Wrapf(err, "decoding config section '%s'", c.SectionId())
+	}
+
+	return nil
+}
+
+// Set sets the document in the database to match the in-memory config struct.
+func (c *RateLimitConfig) Set(ctx context.Context) error {
+	_, err := GetEnvironment().DB().Collection(ConfigCollection).UpdateOne(ctx, byId(c.SectionId()), bson.M{
+		"$set": bson.M{
+			rateLimitEnabledKey:            c.Enabled,
+			rateLimitRequestsPerMinuteKey:  c.RequestsPerMinute,
+			rateLimitBurstKey:              c.Burst,
+			rateLimitExemptServiceUsersKey: c.ExemptServiceUsers,
+			rateLimitExemptUsersKey:        c.ExemptUsers,
+			rateLimitRouteGroupsKey:        c.RouteGroups,
+		},
+	}, options.Update().SetUpsert(true))
+	return errors.Wrapf(err, "updating config section '%s'", c.SectionId())
+}
+
+// ValidateAndDefault validates the route groups and defaults the limits.
+func (c *RateLimitConfig) ValidateAndDefault() error {
+	if c.RequestsPerMinute <= 0 {
+		c.RequestsPerMinute = defaultRateLimitRequestsPerMinute
+	}
+	if c.Burst <= 0 {
+		c.Burst = c.RequestsPerMinute
+	}
+
+	catcher := grip.NewBasicCatcher()
+	names := []string{DefaultRateLimitRouteGroup}
+	for i := range c.RouteGroups {
+		group := &c.RouteGroups[i]
+		if group.Name == "" {
+			catcher.Errorf("route group at index %d must have a name", i)
+			continue
+		}
+		catcher.ErrorfWhen(utility.StringSliceContains(names, group.Name), "route group name '%s' is used more than once or is reserved", group.Name)
+		names = append(names, group.Name)
+		catcher.ErrorfWhen(len(group.PathPrefixes) == 0, "route group '%s' must have at least one path prefix", group.Name)
+		for _, prefix := range group.PathPrefixes {
+			catcher.ErrorfWhen(!strings.HasPrefix(prefix, "/"), "path prefix '%s' for route group '%s' must start with '/'", prefix, group.Name)
+		}
+		if group.RequestsPerMinute <= 0 {
+			group.RequestsPerMinute = c.RequestsPerMinute
+		}
+		if group.Burst <= 0 {
+			group.Burst = group.RequestsPerMinute
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+// RouteGroup returns the route group that the request path belongs to. Paths
+// that don't belong to any configured group belong to the default group.
+func (c *RateLimitConfig) RouteGroup(path string) RateLimitRouteGroup {
+	for _, group := range c.RouteGroups {
+		for _, prefix := range group.PathPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return group
+			}
+		}
+	}
+	return RateLimitRouteGroup{
+		Name:              DefaultRateLimitRouteGroup,
+		RequestsPerMinute: c.RequestsPerMinute,
+		Burst:             c.Burst,
+	}
+}
+
+// IsExempt returns whether the user is exempt from rate limiting.
+func (c *RateLimitConfig) IsExempt(userID string, isServiceUser bool) bool {
+	if isServiceUser && c.ExemptServiceUsers {
+		return true
+	}
+	return utility.StringSliceContains(c.ExemptUsers, userID)
+}
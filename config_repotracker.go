@@ -14,6 +14,9 @@ type RepoTrackerConfig struct {
 	NumNewRepoRevisionsToFetch int `bson:"revs_to_fetch" json:"revs_to_fetch" yaml:"revs_to_fetch"`
 	MaxRepoRevisionsToSearch   int `bson:"max_revs_to_search" json:"max_revs_to_search" yaml:"max_revs_to_search"`
 	MaxConcurrentRequests      int `bson:"max_con_requests" json:"max_con_requests" yaml:"max_concurrent_requests"`
+	// AllowLocalGitRemotes allows projects to use a file:// URL or an absolute
+	// path on the app server as their git remote URL.
+	AllowLocalGitRemotes bool `bson:"allow_local_git_remotes" json:"allow_local_git_remotes" yaml:"allow_local_git_remotes"`
 }
 
 func (c *RepoTrackerConfig) SectionId() string { return "repotracker" }
@@ -38,9 +41,10 @@ func (c *RepoTrackerConfig) Get(ctx context.Context) error {
 func (c *RepoTrackerConfig) Set(ctx context.Context) error {
 	_, err := GetEnvironment().DB().Collection(ConfigCollection).UpdateOne(ctx, byId(c.SectionId()), bson.M{
 		"$set": bson.M{
-			"revs_to_fetch":      c.NumNewRepoRevisionsToFetch,
-			"max_revs_to_search": c.MaxRepoRevisionsToSearch,
-			"max_con_requests":   c.MaxConcurrentRequests,
+			"revs_to_fetch":           c.NumNewRepoRevisionsToFetch,
+			"max_revs_to_search":      c.MaxRepoRevisionsToSearch,
+			"max_con_requests":        c.MaxConcurrentRequests,
+			"allow_local_git_remotes": c.AllowLocalGitRemotes,
 		},
 	}, options.Update().SetUpsert(true))
 
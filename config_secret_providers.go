@@ -0,0 +1,165 @@
+package evergreen
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SecretProvidersConfig configures the external secret stores that project
+// variables can reference instead of storing their values in Evergreen.
+type SecretProvidersConfig struct {
+	// Vault configures a HashiCorp Vault (or Vault-compatible) KV store.
+	Vault VaultSecretProviderConfig `bson:"vault" json:"vault" yaml:"vault"`
+	// AWSSecretsManager configures AWS Secrets Manager.
+	AWSSecretsManager AWSSecretsManagerProviderConfig `bson:"aws_secrets_manager" json:"aws_secrets_manager" yaml:"aws_secrets_manager"`
+	// FilePath is the path to a local file of secrets. This should only be
+	// used for local development and testing.
+	FilePath string `bson:"file_path" json:"file_path" yaml:"file_path"`
+	// ProjectPathPrefixes are the secret paths that each project may
+	// reference. A project can't reference any secret unless it has at
+	// least one path prefix.
+	ProjectPathPrefixes []ProjectSecretPathPrefix `bson:"project_path_prefixes" json:"project_path_prefixes" yaml:"project_path_prefixes"`
+}
+
+// ProjectSecretPathPrefix allows a project or repo to reference the secrets
+// under a path prefix.
+type ProjectSecretPathPrefix struct {
+	// ProjectID is the ID (not the identifier, which project admins can
+	// change) of the project or repo.
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+	// PathPrefix is the path prefix of the secrets the project can
+	// reference. It only matches whole path segments, so "secret/data/app"
+	// allows "secret/data/app/db" but not "secret/data/app2".
+	PathPrefix string `bson:"path_prefix" json:"path_prefix" yaml:"path_prefix"`
+}
+
+// PathPrefixesForProjects returns the secret path prefixes that any of the
+// given projects or repos may reference.
+func (c *SecretProvidersConfig) PathPrefixesForProjects(projectIDs ...string) []string {
+	var prefixes []string
+	for _, p := range c.ProjectPathPrefixes {
+		if utility.StringSliceContains(projectIDs, p.ProjectID) {
+			prefixes = append(prefixes, p.PathPrefix)
+		}
+	}
+	return prefixes
+}
+
+// VaultSecretProviderConfig configures access to a Vault KV store.
+type VaultSecretProviderConfig struct {
+	// URL is the base URL of the Vault server, e.g. https://vault.example.com.
+	URL string `bson:"url" json:"url" yaml:"url"`
+	// Token is the Vault token used to read secrets.
+	Token string `bson:"token" json:"token" yaml:"token"`
+	// Namespace is the optional Vault Enterprise namespace.
+	Namespace string `bson:"namespace" json:"namespace" yaml:"namespace"`
+	// KVMounts are the mount paths of the KV secrets engines that secrets
+	// can be read from. Paths under any other mount (such as auth/ or sys/)
+	// are rejected. Defaults to "secret".
+	KVMounts []string `bson:"kv_mounts" json:"kv_mounts" yaml:"kv_mounts"`
+}
+
+// IsConfigured returns whether Evergreen can read secrets from Vault.
+func (c *VaultSecretProviderConfig) IsConfigured() bool {
+	return c.URL != "" && c.Token != ""
+}
+
+// AWSSecretsManagerProviderConfig configures access to AWS Secrets Manager.
+// Credentials come from the default AWS credential chain.
+type AWSSecretsManagerProviderConfig struct {
+	Enabled bool   `bson:"enabled" json:"enabled" yaml:"enabled"`
+	Region  string `bson:"region" json:"region" yaml:"region"`
+	// Role is an optional IAM role to assume to read secrets.
+	Role string `bson:"role" json:"role" yaml:"role"`
+}
+
+// SectionId returns the ID of this config section.
+func (c *SecretProvidersConfig) SectionId() string { return "secret_providers" }
+
+// Get populates the config from the database.
+func (c *SecretProvidersConfig) Get(ctx context.Context) error {
+	res := GetEnvironment().DB().Collection(ConfigCollection).FindOne(ctx, byId(c.SectionId()))
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			*c = SecretProvidersConfig{}
+			return nil
+		}
+		return errors.Wrapf(err, "getting config section '%s'", c.SectionId())
+	}
+
+	if err := res.Decode(&c); err != nil {
+		return errors.Wrapf(err, "decoding config section '%s'", c.SectionId())
+	}
+
+	return nil
+}
+
+// Set sets the document in the database to match the in-memory config struct.
+func (c *SecretProvidersConfig) Set(ctx context.Context) error {
+	_, err := GetEnvironment().DB().Collection(ConfigCollection).UpdateOne(ctx, byId(c.SectionId()), bson.M{
+		"$set": bson.M{
+			secretProvidersVaultKey:               c.Vault,
+			secretProvidersAWSSecretsManagerKey:   c.AWSSecretsManager,
+			secretProvidersFilePathKey:            c.FilePath,
+			secretProvidersProjectPathPrefixesKey: c.ProjectPathPrefixes,
+		},
+	}, options.Update().SetUpsert(true))
+	return errors.Wrapf(err, "updating config section '%s'", c.SectionId())
+}
+
+// DefaultVaultKVMount is the mount path of the KV secrets engine that Vault
+// enables by default.
+const DefaultVaultKVMount = "secret"
+
+// ValidateAndDefault validates the Vault URL and KV mounts and the project
+// path prefixes.
+func (c *SecretProvidersConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	for i, p := range c.ProjectPathPrefixes {
+		catcher.ErrorfWhen(p.ProjectID == "", "project path prefix at index %d must have a project ID", i)
+		catcher.Wrapf(validateSecretPath(p.PathPrefix), "project path prefix for project '%s'", p.ProjectID)
+		c.ProjectPathPrefixes[i].PathPrefix = strings.Trim(p.PathPrefix, "/")
+	}
+	if c.Vault.URL == "" {
+		return catcher.Resolve()
+	}
+	u, err := url.Parse(c.Vault.URL)
+	if err != nil {
+		catcher.Wrap(err, "invalid Vault URL")
+		return catcher.Resolve()
+	}
+	catcher.ErrorfWhen(u.Scheme != "http" && u.Scheme != "https", "Vault URL '%s' must use http or https", c.Vault.URL)
+	c.Vault.URL = strings.TrimSuffix(c.Vault.URL, "/")
+
+	if len(c.Vault.KVMounts) == 0 {
+		c.Vault.KVMounts = []string{DefaultVaultKVMount}
+	}
+	for i, mount := range c.Vault.KVMounts {
+		catcher.Wrap(validateSecretPath(mount), "Vault KV mount")
+		c.Vault.KVMounts[i] = strings.Trim(mount, "/")
+	}
+	return catcher.Resolve()
+}
+
+// validateSecretPath checks that the secret path is nonempty and has no
+// empty, "." or ".." segments.
+func validateSecretPath(path string) error {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return errors.New("secret path cannot be empty")
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errors.Errorf("secret path '%s' cannot contain empty, '.' or '..' segments", path)
+		}
+	}
+	return nil
+}
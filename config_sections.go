@@ -39,8 +39,11 @@ func NewConfigSections() ConfigSections {
 		&NotifyConfig{},
 		&PodLifecycleConfig{},
 		&ProjectCreationConfig{},
+		&ProjectVarsConfig{},
+		&RateLimitConfig{},
 		&RepoTrackerConfig{},
 		&SchedulerConfig{},
+		&SecretProvidersConfig{},
 		&ServiceFlags{},
 		&SlackConfig{},
 		&SplunkConfig{},
@@ -51,6 +54,7 @@ func NewConfigSections() ConfigSections {
 		&SpawnHostConfig{},
 		&TracerConfig{},
 		&GitHubCheckRunConfig{},
+		&GitlabConfig{},
 	}
 
 	sectionMap := make(map[string]ConfigSection, len(sections))
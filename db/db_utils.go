@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"time"
@@ -353,10 +354,8 @@ func WriteGridFile(fsPrefix, name string, source io.Reader) error {
 }
 
 // GetGridFile returns a ReadCloser for a file stored with the given name under the GridFS prefix.
-func GetGridFile(fsPrefix, name string) (io.ReadCloser, error) {
+func GetGridFile(ctx context.Context, fsPrefix, name string) (io.ReadCloser, error) {
 	env := evergreen.GetEnvironment()
-	ctx, cancel := env.Context()
-	defer cancel()
 	bucket, err := pail.NewGridFSBucketWithClient(ctx, env.Client(), pail.GridFSOptions{
 		Database: env.DB().Name(),
 		Name:     fsPrefix,
@@ -369,6 +368,23 @@ func GetGridFile(fsPrefix, name string) (io.ReadCloser, error) {
 	return bucket.Get(ctx, name)
 }
 
+// DeleteGridFile deletes the file stored with the given name under the GridFS
+// prefix.
+func DeleteGridFile(fsPrefix, name string) error {
+	env := evergreen.GetEnvironment()
+	ctx, cancel := env.Context()
+	defer cancel()
+	bucket, err := pail.NewGridFSBucketWithClient(ctx, env.Client(), pail.GridFSOptions{
+		Database: env.DB().Name(),
+		Name:     fsPrefix,
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "problem constructing bucket access")
+	}
+	return errors.Wrap(bucket.Remove(ctx, name), "problem deleting file")
+}
+
 func ClearGridCollections(fsPrefix string) error {
 	return ClearCollections(fmt.Sprintf("%s.files", fsPrefix), fmt.Sprintf("%s.chunks", fsPrefix))
 }
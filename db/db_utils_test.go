@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"io"
 	"strings"
 	"testing"
@@ -83,7 +84,7 @@ func TestDBUtils(t *testing.T) {
 			So(Clear("testfiles.files"), ShouldBeNil)
 			id := mgobson.NewObjectId().Hex()
 			So(WriteGridFile("testfiles", id, strings.NewReader(id)), ShouldBeNil)
-			file, err := GetGridFile("testfiles", id)
+			file, err := GetGridFile(context.Background(), "testfiles", id)
 			So(err, ShouldBeNil)
 			raw, err := io.ReadAll(file)
 			So(err, ShouldBeNil)
@@ -457,7 +458,7 @@ func TestClearGridFSCollections(t *testing.T) {
 
 	assert.NoError(WriteGridFile("testfiles", "test.txt", strings.NewReader("lorem ipsum")))
 
-	reader, err := GetGridFile("testfiles", "test.txt")
+	reader, err := GetGridFile(context.Background(), "testfiles", "test.txt")
 	assert.NoError(err)
 	defer reader.Close()
 
@@ -468,7 +469,7 @@ func TestClearGridFSCollections(t *testing.T) {
 
 	assert.NoError(ClearGridCollections("testfiles"))
 
-	reader, err = GetGridFile("testfiles", "test.txt")
+	reader, err = GetGridFile(context.Background(), "testfiles", "test.txt")
 	assert.Error(err)
 	assert.Nil(reader)
 }
@@ -14,6 +14,7 @@ const (
 	User            = "mci"
 	GithubPatchUser = "github_pull_request"
 	GithubMergeUser = "github_merge_queue"
+	GitlabPatchUser = "gitlab_merge_request"
 	ParentPatchUser = "parent_patch"
 
 	HostRunning       = "running"
@@ -232,6 +233,10 @@ const (
 	// GenerateTasksActivator represents the activator for tasks that have been
 	// generated by a task generator.
 	GenerateTasksActivator = "generate-tasks-activator"
+	// CommitQueueBatchActivator represents the activator for commit queue
+	// tasks that are activated or deactivated when the commit queue picks
+	// which version tests a batch of items.
+	CommitQueueBatchActivator = "commit-queue-batch-activator"
 
 	// StaleContainerTaskMonitor is the special name representing the unit
 	// responsible for monitoring container tasks that have not dispatched but
@@ -284,6 +289,8 @@ const (
 	DefaultMaxVolumeSizePerUser         = 500
 	DefaultUnexpirableHostsPerUser      = 1
 	DefaultUnexpirableVolumesPerUser    = 1
+	DefaultSnapshotExpiration           = 24 * time.Hour * 30
+	DefaultMaxSnapshotsPerUser          = 5
 
 	// host resource tag names
 	TagName             = "name"
@@ -625,6 +632,7 @@ const (
 	MergeTestRequester          = "merge_test"           // Evergreen commit queue
 	AdHocRequester              = "ad_hoc"               // periodic build
 	GithubMergeRequester        = "github_merge_request" // GitHub merge queue
+	GitlabMRRequester           = "gitlab_merge_request" // GitLab merge request
 )
 
 var AllRequesterTypes = []string{
@@ -636,6 +644,7 @@ var AllRequesterTypes = []string{
 	MergeTestRequester,
 	AdHocRequester,
 	GithubMergeRequester,
+	GitlabMRRequester,
 }
 
 // Constants related to requester types.
@@ -661,7 +670,8 @@ func (r UserRequester) Validate() error {
 		TriggerUserRequester,
 		MergeTestUserRequester,
 		AdHocUserRequester,
-		GithubMergeUserRequester:
+		GithubMergeUserRequester,
+		GitlabMRUserRequester:
 		return nil
 	default:
 		return errors.Errorf("invalid user requester '%s'", r)
@@ -682,6 +692,7 @@ const (
 	MergeTestUserRequester          UserRequester = "commit_queue"
 	AdHocUserRequester              UserRequester = "ad_hoc"
 	GithubMergeUserRequester        UserRequester = "github_merge_queue"
+	GitlabMRUserRequester           UserRequester = "gitlab_mr"
 )
 
 var AllUserRequesterTypes = []UserRequester{
@@ -693,6 +704,7 @@ var AllUserRequesterTypes = []UserRequester{
 	MergeTestUserRequester,
 	AdHocUserRequester,
 	GithubMergeUserRequester,
+	GitlabMRUserRequester,
 }
 
 // InternalRequesterToUserRequester translates an internal requester type to a
@@ -715,6 +727,8 @@ func InternalRequesterToUserRequester(requester string) UserRequester {
 		return AdHocUserRequester
 	case GithubMergeRequester:
 		return GithubMergeUserRequester
+	case GitlabMRRequester:
+		return GitlabMRUserRequester
 	default:
 		return ""
 	}
@@ -740,6 +754,8 @@ func UserRequesterToInternalRequester(requester UserRequester) string {
 		return AdHocRequester
 	case GithubMergeUserRequester:
 		return GithubMergeRequester
+	case GitlabMRUserRequester:
+		return GitlabMRRequester
 	default:
 		return ""
 	}
@@ -773,12 +789,13 @@ const (
 	SenderJIRAComment
 	SenderEmail
 	SenderGeneric
+	SenderGitlabStatus
 )
 
 func (k SenderKey) Validate() error {
 	switch k {
 	case SenderGithubStatus, SenderEvergreenWebhook, SenderSlack, SenderJIRAComment, SenderJIRAIssue,
-		SenderEmail, SenderGeneric:
+		SenderEmail, SenderGeneric, SenderGitlabStatus:
 		return nil
 	default:
 		return errors.New("invalid sender defined")
@@ -801,6 +818,8 @@ func (k SenderKey) String() string {
 		return "jira-issue"
 	case SenderGeneric:
 		return "generic"
+	case SenderGitlabStatus:
+		return "gitlab-status"
 	default:
 		return "<error:unknown>"
 	}
@@ -829,6 +848,7 @@ var (
 		GithubPRRequester,
 		MergeTestRequester,
 		GithubMergeRequester,
+		GitlabMRRequester,
 	}
 
 	SystemActivators = []string{
@@ -839,6 +859,7 @@ var (
 		ElapsedBuildActivator,
 		ElapsedTaskActivator,
 		GenerateTasksActivator,
+		CommitQueueBatchActivator,
 	}
 
 	// UpHostStatus is a list of all host statuses that are considered up.
@@ -1017,13 +1038,17 @@ func IsSystemActivator(caller string) bool {
 }
 
 func IsPatchRequester(requester string) bool {
-	return requester == PatchVersionRequester || IsGitHubPatchRequester(requester)
+	return requester == PatchVersionRequester || IsGitHubPatchRequester(requester) || IsGitlabPatchRequester(requester)
 }
 
 func IsGitHubPatchRequester(requester string) bool {
 	return requester == GithubPRRequester || requester == MergeTestRequester || requester == GithubMergeRequester
 }
 
+func IsGitlabPatchRequester(requester string) bool {
+	return requester == GitlabMRRequester
+}
+
 func IsGitTagRequester(requester string) bool {
 	return requester == GitTagRequester
 }
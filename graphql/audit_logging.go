@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+)
+
+// auditResourceArgs are the mutation arguments identifying the resource that a
+// mutation acts on, in order of precedence.
+var auditResourceArgs = []string{"taskId", "versionId", "patchId", "buildId", "hostId", "volumeId", "distroId", "projectId", "projectIdentifier", "repoId", "userId"}
+
+// auditRedactedInputMutations are the mutations whose input objects are
+// redacted from audit events. Their inputs hold secrets, such as distro setup
+// scripts, project variables and spawn host scripts, under names that
+// redaction can't recognize.
+var auditRedactedInputMutations = []string{
+	"migrateVolume",
+	"saveDistro",
+	"saveProjectSettingsForSection",
+	"saveRepoSettingsForSection",
+	"spawnHost",
+}
+
+// AuditLogging is a graphql extension that records an audit event for every
+// mutation, including the user who made it and its redacted arguments.
+type AuditLogging struct{}
+
+func (AuditLogging) ExtensionName() string {
+	return "AuditLogging"
+}
+
+func (AuditLogging) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (AuditLogging) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil || fieldCtx.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	res, err := next(ctx)
+
+	usr, ok := gimlet.GetUser(ctx).(*user.DBUser)
+	if !ok || usr == nil {
+		return res, err
+	}
+	data := event.AuditEventData{
+		Actor:     usr.Id,
+		Operation: fieldCtx.Field.Name,
+		Params:    auditParams(fieldCtx.Field.Name, fieldCtx.Args),
+	}
+	if t := usr.AccessToken(); t != nil {
+		data.AccessTokenID = t.ID
+	}
+	if err != nil {
+		data.Error = err.Error()
+	}
+	grip.Error(message.WrapError(event.LogAuditEvent(event.EventTypeAuditGraphQLMutation, auditResourceID(fieldCtx.Args), data), message.Fields{
+		"message":  "could not log audit event",
+		"user":     usr.Id,
+		"mutation": fieldCtx.Field.Name,
+		"request":  gimlet.GetRequestID(ctx),
+	}))
+
+	return res, err
+}
+
+// logVariablesReadAuditEvent records an audit event for the user in the
+// context reading the variables of the given project or repo.
+func logVariablesReadAuditEvent(ctx context.Context, projectOrRepoID string) {
+	usr, ok := gimlet.GetUser(ctx).(*user.DBUser)
+	if !ok || usr == nil {
+		return
+	}
+	operation := "vars"
+	if fieldCtx := graphql.GetFieldContext(ctx); fieldCtx != nil {
+		operation = fieldCtx.Object + "." + fieldCtx.Field.Name
+	}
+	data := event.AuditEventData{
+		Actor:     usr.Id,
+		Operation: operation,
+	}
+	if t := usr.AccessToken(); t != nil {
+		data.AccessTokenID = t.ID
+	}
+	grip.Error(message.WrapError(event.LogAuditEvent(event.EventTypeAuditVariablesRead, projectOrRepoID, data), message.Fields{
+		"message":   "could not log audit event",
+		"user":      usr.Id,
+		"field":     operation,
+		"resource":  projectOrRepoID,
+		"request":   gimlet.GetRequestID(ctx),
+		"operation": "audit",
+	}))
+}
+
+// auditParams converts the mutation arguments into generic parameters so that
+// they can be redacted and stored. Input objects are redacted entirely for the
+// audit redacted input mutations.
+func auditParams(mutation string, args map[string]interface{}) map[string]interface{} {
+	if len(args) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(args)
+	if err != nil {
+		return nil
+	}
+	params := map[string]interface{}{}
+	if err := json.Unmarshal(b, &params); err != nil {
+		return nil
+	}
+	if utility.StringSliceContains(auditRedactedInputMutations, mutation) {
+		for name, val := range params {
+			switch val.(type) {
+			case map[string]interface{}, []interface{}:
+				params[name] = event.AuditRedactedValue
+			}
+		}
+	}
+	return params
+}
+
+func auditResourceID(args map[string]interface{}) string {
+	for _, name := range auditResourceArgs {
+		switch id := args[name].(type) {
+		case string:
+			if id != "" {
+				return id
+			}
+		case *string:
+			if id != nil && *id != "" {
+				return *id
+			}
+		}
+	}
+	return ""
+}
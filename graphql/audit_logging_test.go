@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditResourceID(t *testing.T) {
+	assert.Equal(t, "t1", auditResourceID(map[string]interface{}{"taskId": "t1", "versionId": "v1"}))
+	assert.Equal(t, "v1", auditResourceID(map[string]interface{}{"taskId": "", "versionId": utility.ToStringPtr("v1")}))
+	assert.Equal(t, "", auditResourceID(map[string]interface{}{"taskId": (*string)(nil), "priority": 10}))
+}
+
+func TestAuditParams(t *testing.T) {
+	type input struct {
+		Name     string  `json:"name"`
+		Priority *int    `json:"priority"`
+		Secret   *string `json:"secret,omitempty"`
+	}
+	params := auditParams("setTaskPriority", map[string]interface{}{
+		"opts": input{Name: "n", Priority: utility.ToIntPtr(5)},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"opts": map[string]interface{}{"name": "n", "priority": float64(5)},
+	}, params)
+	assert.Nil(t, auditParams("setTaskPriority", nil))
+
+	params = auditParams("saveDistro", map[string]interface{}{
+		"opts":    map[string]interface{}{"distro": map[string]interface{}{"setup": "export TOKEN=abc"}},
+		"section": "GENERAL",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"opts":    event.AuditRedactedValue,
+		"section": "GENERAL",
+	}, params, "input objects should be redacted for mutations with secret inputs")
+}
@@ -139,6 +139,7 @@ type ComplexityRoot struct {
 		RootDir               func(childComplexity int) int
 		ServiceUser           func(childComplexity int) int
 		ShellPath             func(childComplexity int) int
+		TaskCgroups           func(childComplexity int) int
 	}
 
 	Build struct {
@@ -209,10 +210,12 @@ type ComplexityRoot struct {
 	}
 
 	CommitQueueParams struct {
-		Enabled     func(childComplexity int) int
-		MergeMethod func(childComplexity int) int
-		MergeQueue  func(childComplexity int) int
-		Message     func(childComplexity int) int
+		Enabled            func(childComplexity int) int
+		FlakyTestThreshold func(childComplexity int) int
+		MergeMethod        func(childComplexity int) int
+		MergeQueue         func(childComplexity int) int
+		Message            func(childComplexity int) int
+		RequiredChecks     func(childComplexity int) int
 	}
 
 	ContainerPool struct {
@@ -440,6 +443,7 @@ type ComplexityRoot struct {
 		NoExpiration          func(childComplexity int) int
 		Provider              func(childComplexity int) int
 		RunningTask           func(childComplexity int) int
+		SleepSchedule         func(childComplexity int) int
 		StartedBy             func(childComplexity int) int
 		Status                func(childComplexity int) int
 		Tag                   func(childComplexity int) int
@@ -593,6 +597,14 @@ type ComplexityRoot struct {
 		Revision        func(childComplexity int) int
 	}
 
+	MergeQueueRequiredChecks struct {
+		BranchRegex  func(childComplexity int) int
+		TaskRegex    func(childComplexity int) int
+		TaskTags     func(childComplexity int) int
+		VariantRegex func(childComplexity int) int
+		VariantTags  func(childComplexity int) int
+	}
+
 	MetadataLink struct {
 		Source func(childComplexity int) int
 		Text   func(childComplexity int) int
@@ -625,6 +637,7 @@ type ComplexityRoot struct {
 		CreateDistro                  func(childComplexity int, opts CreateDistroInput) int
 		CreateProject                 func(childComplexity int, project model.APIProjectRef, requestS3Creds *bool) int
 		CreatePublicKey               func(childComplexity int, publicKeyInput PublicKeyInput) int
+		CreateSnapshot                func(childComplexity int, createSnapshotInput CreateSnapshotInput) int
 		DeactivateStepbackTask        func(childComplexity int, projectID string, buildVariantName string, taskName string) int
 		DefaultSectionToRepo          func(childComplexity int, projectID string, section ProjectSettingsSection) int
 		DeleteDistro                  func(childComplexity int, opts DeleteDistroInput) int
@@ -644,11 +657,14 @@ type ComplexityRoot struct {
 		RemoveFavoriteProject         func(childComplexity int, identifier string) int
 		RemoveItemFromCommitQueue     func(childComplexity int, commitQueueID string, issue string) int
 		RemovePublicKey               func(childComplexity int, keyName string) int
+		RemoveSnapshot                func(childComplexity int, snapshotID string) int
 		RemoveVolume                  func(childComplexity int, volumeID string) int
 		ReprovisionToNew              func(childComplexity int, hostIds []string) int
 		RestartJasper                 func(childComplexity int, hostIds []string) int
 		RestartTask                   func(childComplexity int, taskID string, failedOnly bool) int
 		RestartVersions               func(childComplexity int, versionID string, abort bool, versionsToRestart []*model1.VersionToRestart) int
+		RollbackProjectVar            func(childComplexity int, projectID string, varName string, version int) int
+		RollbackRepoVar               func(childComplexity int, id string, varName string, version int) int
 		SaveDistro                    func(childComplexity int, opts SaveDistroInput) int
 		SaveProjectSettingsForSection func(childComplexity int, projectSettings *model.APIProjectSettings, section ProjectSettingsSection) int
 		SaveRepoSettingsForSection    func(childComplexity int, repoSettings *model.APIProjectSettings, section ProjectSettingsSection) int
@@ -695,6 +711,8 @@ type ComplexityRoot struct {
 		SpawnHostExpirationID func(childComplexity int) int
 		SpawnHostOutcome      func(childComplexity int) int
 		SpawnHostOutcomeID    func(childComplexity int) int
+		StepbackCulprit       func(childComplexity int) int
+		StepbackCulpritID     func(childComplexity int) int
 	}
 
 	OomTrackerInfo struct {
@@ -738,6 +756,9 @@ type ComplexityRoot struct {
 		ProjectId               func(childComplexity int) int
 		ProjectIdentifier       func(childComplexity int) int
 		ProjectMetadata         func(childComplexity int) int
+		SkippedTasks            func(childComplexity int) int
+		Stack                   func(childComplexity int) int
+		StackPatches            func(childComplexity int) int
 		Status                  func(childComplexity int) int
 		TaskCount               func(childComplexity int) int
 		TaskStatuses            func(childComplexity int) int
@@ -901,16 +922,17 @@ type ComplexityRoot struct {
 	}
 
 	ProjectAlias struct {
-		Alias       func(childComplexity int) int
-		Description func(childComplexity int) int
-		GitTag      func(childComplexity int) int
-		ID          func(childComplexity int) int
-		Parameters  func(childComplexity int) int
-		RemotePath  func(childComplexity int) int
-		Task        func(childComplexity int) int
-		TaskTags    func(childComplexity int) int
-		Variant     func(childComplexity int) int
-		VariantTags func(childComplexity int) int
+		Alias          func(childComplexity int) int
+		Description    func(childComplexity int) int
+		GitTag         func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Parameters     func(childComplexity int) int
+		RemotePath     func(childComplexity int) int
+		SmartSelection func(childComplexity int) int
+		Task           func(childComplexity int) int
+		TaskTags       func(childComplexity int) int
+		Variant        func(childComplexity int) int
+		VariantTags    func(childComplexity int) int
 	}
 
 	ProjectBanner struct {
@@ -957,6 +979,20 @@ type ComplexityRoot struct {
 		Vars                  func(childComplexity int) int
 	}
 
+	ProjectVarVersion struct {
+		AdminOnly      func(childComplexity int) int
+		CanRollback    func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		Deleted        func(childComplexity int) int
+		ExternalSecret func(childComplexity int) int
+		Name           func(childComplexity int) int
+		Private        func(childComplexity int) int
+		ProjectID      func(childComplexity int) int
+		User           func(childComplexity int) int
+		Value          func(childComplexity int) int
+		Version        func(childComplexity int) int
+	}
+
 	ProjectVars struct {
 		AdminOnlyVars func(childComplexity int) int
 		PrivateVars   func(childComplexity int) int
@@ -989,15 +1025,18 @@ type ComplexityRoot struct {
 		MainlineCommits          func(childComplexity int, options MainlineCommitsOptions, buildVariantOptions *BuildVariantOptions) int
 		MyHosts                  func(childComplexity int) int
 		MyPublicKeys             func(childComplexity int) int
+		MySnapshots              func(childComplexity int) int
 		MyVolumes                func(childComplexity int) int
 		Patch                    func(childComplexity int, id string) int
 		Pod                      func(childComplexity int, podID string) int
 		Project                  func(childComplexity int, projectIdentifier string) int
 		ProjectEvents            func(childComplexity int, identifier string, limit *int, before *time.Time) int
 		ProjectSettings          func(childComplexity int, identifier string) int
+		ProjectVarVersions       func(childComplexity int, projectID string, varName string) int
 		Projects                 func(childComplexity int) int
 		RepoEvents               func(childComplexity int, id string, limit *int, before *time.Time) int
 		RepoSettings             func(childComplexity int, id string) int
+		RepoVarVersions          func(childComplexity int, id string, varName string) int
 		SpruceConfig             func(childComplexity int) int
 		SubnetAvailabilityZones  func(childComplexity int) int
 		Task                     func(childComplexity int, taskID string, execution *int) int
@@ -1013,10 +1052,12 @@ type ComplexityRoot struct {
 	}
 
 	RepoCommitQueueParams struct {
-		Enabled     func(childComplexity int) int
-		MergeMethod func(childComplexity int) int
-		MergeQueue  func(childComplexity int) int
-		Message     func(childComplexity int) int
+		Enabled            func(childComplexity int) int
+		FlakyTestThreshold func(childComplexity int) int
+		MergeMethod        func(childComplexity int) int
+		MergeQueue         func(childComplexity int) int
+		Message            func(childComplexity int) int
+		RequiredChecks     func(childComplexity int) int
 	}
 
 	RepoRef struct {
@@ -1119,10 +1160,44 @@ type ComplexityRoot struct {
 		MergeBaseRevision func(childComplexity int) int
 	}
 
+	SkippedTask struct {
+		Reason   func(childComplexity int) int
+		TaskName func(childComplexity int) int
+		Variant  func(childComplexity int) int
+	}
+
 	SlackConfig struct {
 		Name func(childComplexity int) int
 	}
 
+	SleepSchedule struct {
+		DailyStartTime         func(childComplexity int) int
+		DailyStopTime          func(childComplexity int) int
+		NextStartTime          func(childComplexity int) int
+		NextStopTime           func(childComplexity int) int
+		TemporarilyExemptUntil func(childComplexity int) int
+		TimeZone               func(childComplexity int) int
+		WholeWeekdaysOff       func(childComplexity int) int
+	}
+
+	SmartSelection struct {
+		MandatoryTasks func(childComplexity int) int
+		MaxTasks       func(childComplexity int) int
+	}
+
+	Snapshot struct {
+		CreatedBy      func(childComplexity int) int
+		CreationTime   func(childComplexity int) int
+		DisplayName    func(childComplexity int) int
+		Expiration     func(childComplexity int) int
+		ID             func(childComplexity int) int
+		NoExpiration   func(childComplexity int) int
+		Region         func(childComplexity int) int
+		Size           func(childComplexity int) int
+		SourceHostID   func(childComplexity int) int
+		SourceVolumeID func(childComplexity int) int
+	}
+
 	Source struct {
 		Author    func(childComplexity int) int
 		Requester func(childComplexity int) int
@@ -1148,11 +1223,26 @@ type ComplexityRoot struct {
 		Ui             func(childComplexity int) int
 	}
 
+	StackInfo struct {
+		Commit        func(childComplexity int) int
+		ID            func(childComplexity int) int
+		ParentPatchID func(childComplexity int) int
+		Position      func(childComplexity int) int
+		Revision      func(childComplexity int) int
+	}
+
 	StatusCount struct {
 		Count  func(childComplexity int) int
 		Status func(childComplexity int) int
 	}
 
+	StepbackCulprit struct {
+		Revision  func(childComplexity int) int
+		TaskId    func(childComplexity int) int
+		TestName  func(childComplexity int) int
+		VersionId func(childComplexity int) int
+	}
+
 	Subscriber struct {
 		EmailSubscriber       func(childComplexity int) int
 		GithubCheckSubscriber func(childComplexity int) int
@@ -1233,6 +1323,7 @@ type ComplexityRoot struct {
 		SpawnHostLink           func(childComplexity int) int
 		StartTime               func(childComplexity int) int
 		Status                  func(childComplexity int) int
+		StepbackCulprit         func(childComplexity int) int
 		TaskFiles               func(childComplexity int) int
 		TaskGroup               func(childComplexity int) int
 		TaskGroupMaxHosts       func(childComplexity int) int
@@ -1248,6 +1339,13 @@ type ComplexityRoot struct {
 		JiraCustomFields  func(childComplexity int) int
 	}
 
+	TaskCgroupSettings struct {
+		CPUPercent    func(childComplexity int) int
+		Enabled       func(childComplexity int) int
+		MemoryLimitMB func(childComplexity int) int
+		NumProcesses  func(childComplexity int) int
+	}
+
 	TaskContainerCreationOpts struct {
 		Arch       func(childComplexity int) int
 		CPU        func(childComplexity int) int
@@ -1258,13 +1356,14 @@ type ComplexityRoot struct {
 	}
 
 	TaskEndDetail struct {
-		Description func(childComplexity int) int
-		OOMTracker  func(childComplexity int) int
-		Status      func(childComplexity int) int
-		TimedOut    func(childComplexity int) int
-		TimeoutType func(childComplexity int) int
-		TraceID     func(childComplexity int) int
-		Type        func(childComplexity int) int
+		Description   func(childComplexity int) int
+		OOMTracker    func(childComplexity int) int
+		ResourceUsage func(childComplexity int) int
+		Status        func(childComplexity int) int
+		TimedOut      func(childComplexity int) int
+		TimeoutType   func(childComplexity int) int
+		TraceID       func(childComplexity int) int
+		Type          func(childComplexity int) int
 	}
 
 	TaskEventLogData struct {
@@ -1336,6 +1435,16 @@ type ComplexityRoot struct {
 		Version          func(childComplexity int) int
 	}
 
+	TaskResourceUsage struct {
+		AvgCPUPercent        func(childComplexity int) int
+		DiskReadBytes        func(childComplexity int) int
+		DiskWriteBytes       func(childComplexity int) int
+		DurationSecs         func(childComplexity int) int
+		NetworkBytesReceived func(childComplexity int) int
+		NetworkBytesSent     func(childComplexity int) int
+		PeakMemoryBytes      func(childComplexity int) int
+	}
+
 	TaskSpecifier struct {
 		PatchAlias   func(childComplexity int) int
 		TaskRegex    func(childComplexity int) int
@@ -1647,15 +1756,19 @@ type MutationResolver interface {
 	ForceRepotrackerRun(ctx context.Context, projectID string) (bool, error)
 	PromoteVarsToRepo(ctx context.Context, projectID string, varNames []string) (bool, error)
 	RemoveFavoriteProject(ctx context.Context, identifier string) (*model.APIProjectRef, error)
+	RollbackProjectVar(ctx context.Context, projectID string, varName string, version int) (bool, error)
+	RollbackRepoVar(ctx context.Context, id string, varName string, version int) (bool, error)
 	SaveProjectSettingsForSection(ctx context.Context, projectSettings *model.APIProjectSettings, section ProjectSettingsSection) (*model.APIProjectSettings, error)
 	SaveRepoSettingsForSection(ctx context.Context, repoSettings *model.APIProjectSettings, section ProjectSettingsSection) (*model.APIProjectSettings, error)
 	SetLastRevision(ctx context.Context, opts SetLastRevisionInput) (*SetLastRevisionPayload, error)
 	AttachVolumeToHost(ctx context.Context, volumeAndHost VolumeHost) (bool, error)
+	CreateSnapshot(ctx context.Context, createSnapshotInput CreateSnapshotInput) (*model.APISnapshot, error)
 	DetachVolumeFromHost(ctx context.Context, volumeID string) (bool, error)
 	EditSpawnHost(ctx context.Context, spawnHost *EditSpawnHostInput) (*model.APIHost, error)
 	MigrateVolume(ctx context.Context, volumeID string, spawnHostInput *SpawnHostInput) (bool, error)
 	SpawnHost(ctx context.Context, spawnHostInput *SpawnHostInput) (*model.APIHost, error)
 	SpawnVolume(ctx context.Context, spawnVolumeInput SpawnVolumeInput) (bool, error)
+	RemoveSnapshot(ctx context.Context, snapshotID string) (bool, error)
 	RemoveVolume(ctx context.Context, volumeID string) (bool, error)
 	UpdateSpawnHostStatus(ctx context.Context, hostID string, action SpawnHostStatusActions) (*model.APIHost, error)
 	UpdateVolume(ctx context.Context, updateVolumeInput UpdateVolumeInput) (bool, error)
@@ -1690,6 +1803,8 @@ type PatchResolver interface {
 	ProjectIdentifier(ctx context.Context, obj *model.APIPatch) (string, error)
 	ProjectMetadata(ctx context.Context, obj *model.APIPatch) (*model.APIProjectRef, error)
 
+	StackPatches(ctx context.Context, obj *model.APIPatch) ([]*model.APIPatch, error)
+
 	TaskCount(ctx context.Context, obj *model.APIPatch) (*int, error)
 
 	TaskStatuses(ctx context.Context, obj *model.APIPatch) ([]string, error)
@@ -1757,11 +1872,14 @@ type QueryResolver interface {
 	Projects(ctx context.Context) ([]*GroupedProjects, error)
 	ProjectEvents(ctx context.Context, identifier string, limit *int, before *time.Time) (*ProjectEvents, error)
 	ProjectSettings(ctx context.Context, identifier string) (*model.APIProjectSettings, error)
+	ProjectVarVersions(ctx context.Context, projectID string, varName string) ([]*model.APIProjectVarVersion, error)
 	RepoEvents(ctx context.Context, id string, limit *int, before *time.Time) (*ProjectEvents, error)
 	RepoSettings(ctx context.Context, id string) (*model.APIProjectSettings, error)
+	RepoVarVersions(ctx context.Context, id string, varName string) ([]*model.APIProjectVarVersion, error)
 	ViewableProjectRefs(ctx context.Context) ([]*GroupedProjects, error)
 	MyHosts(ctx context.Context) ([]*model.APIHost, error)
 	MyVolumes(ctx context.Context) ([]*model.APIVolume, error)
+	MySnapshots(ctx context.Context) ([]*model.APISnapshot, error)
 	LogkeeperBuildMetadata(ctx context.Context, buildID string) (*plank.Build, error)
 	Task(ctx context.Context, taskID string, execution *int) (*model.APITask, error)
 	TaskAllExecutions(ctx context.Context, taskID string) ([]*model.APITask, error)
@@ -1839,6 +1957,7 @@ type TaskResolver interface {
 	SpawnHostLink(ctx context.Context, obj *model.APITask) (*string, error)
 
 	Status(ctx context.Context, obj *model.APITask) (string, error)
+
 	TaskFiles(ctx context.Context, obj *model.APITask) (*TaskFiles, error)
 
 	TaskLogs(ctx context.Context, obj *model.APITask) (*TaskLogs, error)
@@ -2164,6 +2283,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BootstrapSettings.ShellPath(childComplexity), true
 
+	case "BootstrapSettings.taskCgroups":
+		if e.complexity.BootstrapSettings.TaskCgroups == nil {
+			break
+		}
+
+		return e.complexity.BootstrapSettings.TaskCgroups(childComplexity), true
+
 	case "Build.actualMakespan":
 		if e.complexity.Build.ActualMakespan == nil {
 			break
@@ -2430,6 +2556,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CommitQueueParams.Enabled(childComplexity), true
 
+	case "CommitQueueParams.flakyTestThreshold":
+		if e.complexity.CommitQueueParams.FlakyTestThreshold == nil {
+			break
+		}
+
+		return e.complexity.CommitQueueParams.FlakyTestThreshold(childComplexity), true
+
 	case "CommitQueueParams.mergeMethod":
 		if e.complexity.CommitQueueParams.MergeMethod == nil {
 			break
@@ -2451,6 +2584,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CommitQueueParams.Message(childComplexity), true
 
+	case "CommitQueueParams.requiredChecks":
+		if e.complexity.CommitQueueParams.RequiredChecks == nil {
+			break
+		}
+
+		return e.complexity.CommitQueueParams.RequiredChecks(childComplexity), true
+
 	case "ContainerPool.distro":
 		if e.complexity.ContainerPool.Distro == nil {
 			break
@@ -3389,6 +3529,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Host.RunningTask(childComplexity), true
 
+	case "Host.sleepSchedule":
+		if e.complexity.Host.SleepSchedule == nil {
+			break
+		}
+
+		return e.complexity.Host.SleepSchedule(childComplexity), true
+
 	case "Host.startedBy":
 		if e.complexity.Host.StartedBy == nil {
 			break
@@ -4047,6 +4194,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Manifest.Revision(childComplexity), true
 
+	case "MergeQueueRequiredChecks.branchRegex":
+		if e.complexity.MergeQueueRequiredChecks.BranchRegex == nil {
+			break
+		}
+
+		return e.complexity.MergeQueueRequiredChecks.BranchRegex(childComplexity), true
+
+	case "MergeQueueRequiredChecks.taskRegex":
+		if e.complexity.MergeQueueRequiredChecks.TaskRegex == nil {
+			break
+		}
+
+		return e.complexity.MergeQueueRequiredChecks.TaskRegex(childComplexity), true
+
+	case "MergeQueueRequiredChecks.taskTags":
+		if e.complexity.MergeQueueRequiredChecks.TaskTags == nil {
+			break
+		}
+
+		return e.complexity.MergeQueueRequiredChecks.TaskTags(childComplexity), true
+
+	case "MergeQueueRequiredChecks.variantRegex":
+		if e.complexity.MergeQueueRequiredChecks.VariantRegex == nil {
+			break
+		}
+
+		return e.complexity.MergeQueueRequiredChecks.VariantRegex(childComplexity), true
+
+	case "MergeQueueRequiredChecks.variantTags":
+		if e.complexity.MergeQueueRequiredChecks.VariantTags == nil {
+			break
+		}
+
+		return e.complexity.MergeQueueRequiredChecks.VariantTags(childComplexity), true
+
 	case "MetadataLink.source":
 		if e.complexity.MetadataLink.Source == nil {
 			break
@@ -4261,6 +4443,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreatePublicKey(childComplexity, args["publicKeyInput"].(PublicKeyInput)), true
 
+	case "Mutation.createSnapshot":
+		if e.complexity.Mutation.CreateSnapshot == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createSnapshot_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateSnapshot(childComplexity, args["createSnapshotInput"].(CreateSnapshotInput)), true
+
 	case "Mutation.deactivateStepbackTask":
 		if e.complexity.Mutation.DeactivateStepbackTask == nil {
 			break
@@ -4489,6 +4683,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RemovePublicKey(childComplexity, args["keyName"].(string)), true
 
+	case "Mutation.removeSnapshot":
+		if e.complexity.Mutation.RemoveSnapshot == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeSnapshot_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveSnapshot(childComplexity, args["snapshotId"].(string)), true
+
 	case "Mutation.removeVolume":
 		if e.complexity.Mutation.RemoveVolume == nil {
 			break
@@ -4549,6 +4755,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RestartVersions(childComplexity, args["versionId"].(string), args["abort"].(bool), args["versionsToRestart"].([]*model1.VersionToRestart)), true
 
+	case "Mutation.rollbackProjectVar":
+		if e.complexity.Mutation.RollbackProjectVar == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rollbackProjectVar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RollbackProjectVar(childComplexity, args["projectId"].(string), args["varName"].(string), args["version"].(int)), true
+
+	case "Mutation.rollbackRepoVar":
+		if e.complexity.Mutation.RollbackRepoVar == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rollbackRepoVar_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RollbackRepoVar(childComplexity, args["id"].(string), args["varName"].(string), args["version"].(int)), true
+
 	case "Mutation.saveDistro":
 		if e.complexity.Mutation.SaveDistro == nil {
 			break
@@ -4918,6 +5148,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Notifications.SpawnHostOutcomeID(childComplexity), true
 
+	case "Notifications.stepbackCulprit":
+		if e.complexity.Notifications.StepbackCulprit == nil {
+			break
+		}
+
+		return e.complexity.Notifications.StepbackCulprit(childComplexity), true
+
+	case "Notifications.stepbackCulpritId":
+		if e.complexity.Notifications.StepbackCulpritID == nil {
+			break
+		}
+
+		return e.complexity.Notifications.StepbackCulpritID(childComplexity), true
+
 	case "OomTrackerInfo.detected":
 		if e.complexity.OomTrackerInfo.Detected == nil {
 			break
@@ -5135,6 +5379,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Patch.ProjectMetadata(childComplexity), true
 
+	case "Patch.skippedTasks":
+		if e.complexity.Patch.SkippedTasks == nil {
+			break
+		}
+
+		return e.complexity.Patch.SkippedTasks(childComplexity), true
+
+	case "Patch.stack":
+		if e.complexity.Patch.Stack == nil {
+			break
+		}
+
+		return e.complexity.Patch.Stack(childComplexity), true
+
+	case "Patch.stackPatches":
+		if e.complexity.Patch.StackPatches == nil {
+			break
+		}
+
+		return e.complexity.Patch.StackPatches(childComplexity), true
+
 	case "Patch.status":
 		if e.complexity.Patch.Status == nil {
 			break
@@ -6023,6 +6288,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ProjectAlias.RemotePath(childComplexity), true
 
+	case "ProjectAlias.smartSelection":
+		if e.complexity.ProjectAlias.SmartSelection == nil {
+			break
+		}
+
+		return e.complexity.ProjectAlias.SmartSelection(childComplexity), true
+
 	case "ProjectAlias.task":
 		if e.complexity.ProjectAlias.Task == nil {
 			break
@@ -6212,6 +6484,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ProjectSettings.Vars(childComplexity), true
 
+	case "ProjectVarVersion.adminOnly":
+		if e.complexity.ProjectVarVersion.AdminOnly == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.AdminOnly(childComplexity), true
+
+	case "ProjectVarVersion.canRollback":
+		if e.complexity.ProjectVarVersion.CanRollback == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.CanRollback(childComplexity), true
+
+	case "ProjectVarVersion.createdAt":
+		if e.complexity.ProjectVarVersion.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.CreatedAt(childComplexity), true
+
+	case "ProjectVarVersion.deleted":
+		if e.complexity.ProjectVarVersion.Deleted == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.Deleted(childComplexity), true
+
+	case "ProjectVarVersion.externalSecret":
+		if e.complexity.ProjectVarVersion.ExternalSecret == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.ExternalSecret(childComplexity), true
+
+	case "ProjectVarVersion.name":
+		if e.complexity.ProjectVarVersion.Name == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.Name(childComplexity), true
+
+	case "ProjectVarVersion.private":
+		if e.complexity.ProjectVarVersion.Private == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.Private(childComplexity), true
+
+	case "ProjectVarVersion.projectId":
+		if e.complexity.ProjectVarVersion.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.ProjectID(childComplexity), true
+
+	case "ProjectVarVersion.user":
+		if e.complexity.ProjectVarVersion.User == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.User(childComplexity), true
+
+	case "ProjectVarVersion.value":
+		if e.complexity.ProjectVarVersion.Value == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.Value(childComplexity), true
+
+	case "ProjectVarVersion.version":
+		if e.complexity.ProjectVarVersion.Version == nil {
+			break
+		}
+
+		return e.complexity.ProjectVarVersion.Version(childComplexity), true
+
 	case "ProjectVars.adminOnlyVars":
 		if e.complexity.ProjectVars.AdminOnlyVars == nil {
 			break
@@ -6462,6 +6811,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.MyPublicKeys(childComplexity), true
 
+	case "Query.mySnapshots":
+		if e.complexity.Query.MySnapshots == nil {
+			break
+		}
+
+		return e.complexity.Query.MySnapshots(childComplexity), true
+
 	case "Query.myVolumes":
 		if e.complexity.Query.MyVolumes == nil {
 			break
@@ -6529,6 +6885,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.ProjectSettings(childComplexity, args["identifier"].(string)), true
 
+	case "Query.projectVarVersions":
+		if e.complexity.Query.ProjectVarVersions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_projectVarVersions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProjectVarVersions(childComplexity, args["projectId"].(string), args["varName"].(string)), true
+
 	case "Query.projects":
 		if e.complexity.Query.Projects == nil {
 			break
@@ -6560,6 +6928,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.RepoSettings(childComplexity, args["id"].(string)), true
 
+	case "Query.repoVarVersions":
+		if e.complexity.Query.RepoVarVersions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_repoVarVersions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RepoVarVersions(childComplexity, args["id"].(string), args["varName"].(string)), true
+
 	case "Query.spruceConfig":
 		if e.complexity.Query.SpruceConfig == nil {
 			break
@@ -6681,6 +7061,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RepoCommitQueueParams.Enabled(childComplexity), true
 
+	case "RepoCommitQueueParams.flakyTestThreshold":
+		if e.complexity.RepoCommitQueueParams.FlakyTestThreshold == nil {
+			break
+		}
+
+		return e.complexity.RepoCommitQueueParams.FlakyTestThreshold(childComplexity), true
+
 	case "RepoCommitQueueParams.mergeMethod":
 		if e.complexity.RepoCommitQueueParams.MergeMethod == nil {
 			break
@@ -6702,6 +7089,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RepoCommitQueueParams.Message(childComplexity), true
 
+	case "RepoCommitQueueParams.requiredChecks":
+		if e.complexity.RepoCommitQueueParams.RequiredChecks == nil {
+			break
+		}
+
+		return e.complexity.RepoCommitQueueParams.RequiredChecks(childComplexity), true
+
 	case "RepoRef.admins":
 		if e.complexity.RepoRef.Admins == nil {
 			break
@@ -7171,6 +7565,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SetLastRevisionPayload.MergeBaseRevision(childComplexity), true
 
+	case "SkippedTask.reason":
+		if e.complexity.SkippedTask.Reason == nil {
+			break
+		}
+
+		return e.complexity.SkippedTask.Reason(childComplexity), true
+
+	case "SkippedTask.taskName":
+		if e.complexity.SkippedTask.TaskName == nil {
+			break
+		}
+
+		return e.complexity.SkippedTask.TaskName(childComplexity), true
+
+	case "SkippedTask.variant":
+		if e.complexity.SkippedTask.Variant == nil {
+			break
+		}
+
+		return e.complexity.SkippedTask.Variant(childComplexity), true
+
 	case "SlackConfig.name":
 		if e.complexity.SlackConfig.Name == nil {
 			break
@@ -7178,6 +7593,139 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SlackConfig.Name(childComplexity), true
 
+	case "SleepSchedule.dailyStartTime":
+		if e.complexity.SleepSchedule.DailyStartTime == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.DailyStartTime(childComplexity), true
+
+	case "SleepSchedule.dailyStopTime":
+		if e.complexity.SleepSchedule.DailyStopTime == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.DailyStopTime(childComplexity), true
+
+	case "SleepSchedule.nextStartTime":
+		if e.complexity.SleepSchedule.NextStartTime == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.NextStartTime(childComplexity), true
+
+	case "SleepSchedule.nextStopTime":
+		if e.complexity.SleepSchedule.NextStopTime == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.NextStopTime(childComplexity), true
+
+	case "SleepSchedule.temporarilyExemptUntil":
+		if e.complexity.SleepSchedule.TemporarilyExemptUntil == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.TemporarilyExemptUntil(childComplexity), true
+
+	case "SleepSchedule.timeZone":
+		if e.complexity.SleepSchedule.TimeZone == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.TimeZone(childComplexity), true
+
+	case "SleepSchedule.wholeWeekdaysOff":
+		if e.complexity.SleepSchedule.WholeWeekdaysOff == nil {
+			break
+		}
+
+		return e.complexity.SleepSchedule.WholeWeekdaysOff(childComplexity), true
+
+	case "SmartSelection.mandatoryTasks":
+		if e.complexity.SmartSelection.MandatoryTasks == nil {
+			break
+		}
+
+		return e.complexity.SmartSelection.MandatoryTasks(childComplexity), true
+
+	case "SmartSelection.maxTasks":
+		if e.complexity.SmartSelection.MaxTasks == nil {
+			break
+		}
+
+		return e.complexity.SmartSelection.MaxTasks(childComplexity), true
+
+	case "Snapshot.createdBy":
+		if e.complexity.Snapshot.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.CreatedBy(childComplexity), true
+
+	case "Snapshot.creationTime":
+		if e.complexity.Snapshot.CreationTime == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.CreationTime(childComplexity), true
+
+	case "Snapshot.displayName":
+		if e.complexity.Snapshot.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.DisplayName(childComplexity), true
+
+	case "Snapshot.expiration":
+		if e.complexity.Snapshot.Expiration == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.Expiration(childComplexity), true
+
+	case "Snapshot.id":
+		if e.complexity.Snapshot.ID == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.ID(childComplexity), true
+
+	case "Snapshot.noExpiration":
+		if e.complexity.Snapshot.NoExpiration == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.NoExpiration(childComplexity), true
+
+	case "Snapshot.region":
+		if e.complexity.Snapshot.Region == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.Region(childComplexity), true
+
+	case "Snapshot.size":
+		if e.complexity.Snapshot.Size == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.Size(childComplexity), true
+
+	case "Snapshot.sourceHostID":
+		if e.complexity.Snapshot.SourceHostID == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.SourceHostID(childComplexity), true
+
+	case "Snapshot.sourceVolumeID":
+		if e.complexity.Snapshot.SourceVolumeID == nil {
+			break
+		}
+
+		return e.complexity.Snapshot.SourceVolumeID(childComplexity), true
+
 	case "Source.author":
 		if e.complexity.Source.Author == nil {
 			break
@@ -7290,6 +7838,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SpruceConfig.Ui(childComplexity), true
 
+	case "StackInfo.commit":
+		if e.complexity.StackInfo.Commit == nil {
+			break
+		}
+
+		return e.complexity.StackInfo.Commit(childComplexity), true
+
+	case "StackInfo.id":
+		if e.complexity.StackInfo.ID == nil {
+			break
+		}
+
+		return e.complexity.StackInfo.ID(childComplexity), true
+
+	case "StackInfo.parentPatchId":
+		if e.complexity.StackInfo.ParentPatchID == nil {
+			break
+		}
+
+		return e.complexity.StackInfo.ParentPatchID(childComplexity), true
+
+	case "StackInfo.position":
+		if e.complexity.StackInfo.Position == nil {
+			break
+		}
+
+		return e.complexity.StackInfo.Position(childComplexity), true
+
+	case "StackInfo.revision":
+		if e.complexity.StackInfo.Revision == nil {
+			break
+		}
+
+		return e.complexity.StackInfo.Revision(childComplexity), true
+
 	case "StatusCount.count":
 		if e.complexity.StatusCount.Count == nil {
 			break
@@ -7304,6 +7887,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StatusCount.Status(childComplexity), true
 
+	case "StepbackCulprit.revision":
+		if e.complexity.StepbackCulprit.Revision == nil {
+			break
+		}
+
+		return e.complexity.StepbackCulprit.Revision(childComplexity), true
+
+	case "StepbackCulprit.taskId":
+		if e.complexity.StepbackCulprit.TaskId == nil {
+			break
+		}
+
+		return e.complexity.StepbackCulprit.TaskId(childComplexity), true
+
+	case "StepbackCulprit.testName":
+		if e.complexity.StepbackCulprit.TestName == nil {
+			break
+		}
+
+		return e.complexity.StepbackCulprit.TestName(childComplexity), true
+
+	case "StepbackCulprit.versionId":
+		if e.complexity.StepbackCulprit.VersionId == nil {
+			break
+		}
+
+		return e.complexity.StepbackCulprit.VersionId(childComplexity), true
+
 	case "Subscriber.emailSubscriber":
 		if e.complexity.Subscriber.EmailSubscriber == nil {
 			break
@@ -7815,6 +8426,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Task.Status(childComplexity), true
 
+	case "Task.stepbackCulprit":
+		if e.complexity.Task.StepbackCulprit == nil {
+			break
+		}
+
+		return e.complexity.Task.StepbackCulprit(childComplexity), true
+
 	case "Task.taskFiles":
 		if e.complexity.Task.TaskFiles == nil {
 			break
@@ -7890,6 +8508,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskAnnotationSettings.JiraCustomFields(childComplexity), true
 
+	case "TaskCgroupSettings.cpuPercent":
+		if e.complexity.TaskCgroupSettings.CPUPercent == nil {
+			break
+		}
+
+		return e.complexity.TaskCgroupSettings.CPUPercent(childComplexity), true
+
+	case "TaskCgroupSettings.enabled":
+		if e.complexity.TaskCgroupSettings.Enabled == nil {
+			break
+		}
+
+		return e.complexity.TaskCgroupSettings.Enabled(childComplexity), true
+
+	case "TaskCgroupSettings.memoryLimitMb":
+		if e.complexity.TaskCgroupSettings.MemoryLimitMB == nil {
+			break
+		}
+
+		return e.complexity.TaskCgroupSettings.MemoryLimitMB(childComplexity), true
+
+	case "TaskCgroupSettings.numProcesses":
+		if e.complexity.TaskCgroupSettings.NumProcesses == nil {
+			break
+		}
+
+		return e.complexity.TaskCgroupSettings.NumProcesses(childComplexity), true
+
 	case "TaskContainerCreationOpts.arch":
 		if e.complexity.TaskContainerCreationOpts.Arch == nil {
 			break
@@ -7946,6 +8592,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskEndDetail.OOMTracker(childComplexity), true
 
+	case "TaskEndDetail.resourceUsage":
+		if e.complexity.TaskEndDetail.ResourceUsage == nil {
+			break
+		}
+
+		return e.complexity.TaskEndDetail.ResourceUsage(childComplexity), true
+
 	case "TaskEndDetail.status":
 		if e.complexity.TaskEndDetail.Status == nil {
 			break
@@ -8296,6 +8949,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskQueueItem.Version(childComplexity), true
 
+	case "TaskResourceUsage.avgCpuPercent":
+		if e.complexity.TaskResourceUsage.AvgCPUPercent == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.AvgCPUPercent(childComplexity), true
+
+	case "TaskResourceUsage.diskReadBytes":
+		if e.complexity.TaskResourceUsage.DiskReadBytes == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.DiskReadBytes(childComplexity), true
+
+	case "TaskResourceUsage.diskWriteBytes":
+		if e.complexity.TaskResourceUsage.DiskWriteBytes == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.DiskWriteBytes(childComplexity), true
+
+	case "TaskResourceUsage.durationSecs":
+		if e.complexity.TaskResourceUsage.DurationSecs == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.DurationSecs(childComplexity), true
+
+	case "TaskResourceUsage.networkBytesReceived":
+		if e.complexity.TaskResourceUsage.NetworkBytesReceived == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.NetworkBytesReceived(childComplexity), true
+
+	case "TaskResourceUsage.networkBytesSent":
+		if e.complexity.TaskResourceUsage.NetworkBytesSent == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.NetworkBytesSent(childComplexity), true
+
+	case "TaskResourceUsage.peakMemoryBytes":
+		if e.complexity.TaskResourceUsage.PeakMemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.PeakMemoryBytes(childComplexity), true
+
 	case "TaskSpecifier.patchAlias":
 		if e.complexity.TaskSpecifier.PatchAlias == nil {
 			break
@@ -9395,6 +10097,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCopyProjectInput,
 		ec.unmarshalInputCreateDistroInput,
 		ec.unmarshalInputCreateProjectInput,
+		ec.unmarshalInputCreateSnapshotInput,
 		ec.unmarshalInputDeleteDistroInput,
 		ec.unmarshalInputDispatcherSettingsInput,
 		ec.unmarshalInputDisplayTask,
@@ -9415,6 +10118,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputJiraFieldInput,
 		ec.unmarshalInputJiraIssueSubscriberInput,
 		ec.unmarshalInputMainlineCommitsOptions,
+		ec.unmarshalInputMergeQueueRequiredChecksInput,
 		ec.unmarshalInputMetadataLinkInput,
 		ec.unmarshalInputMoveProjectInput,
 		ec.unmarshalInputNotificationsInput,
@@ -9439,12 +10143,15 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputSaveDistroInput,
 		ec.unmarshalInputSelectorInput,
 		ec.unmarshalInputSetLastRevisionInput,
+		ec.unmarshalInputSleepScheduleInput,
+		ec.unmarshalInputSmartSelectionInput,
 		ec.unmarshalInputSortOrder,
 		ec.unmarshalInputSpawnHostInput,
 		ec.unmarshalInputSpawnVolumeInput,
 		ec.unmarshalInputSubscriberInput,
 		ec.unmarshalInputSubscriptionInput,
 		ec.unmarshalInputTaskAnnotationSettingsInput,
+		ec.unmarshalInputTaskCgroupSettingsInput,
 		ec.unmarshalInputTaskFilterOptions,
 		ec.unmarshalInputTaskSpecifierInput,
 		ec.unmarshalInputTaskSyncOptionsInput,
@@ -9957,6 +10664,21 @@ func (ec *executionContext) field_Mutation_createPublicKey_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createSnapshot_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 CreateSnapshotInput
+	if tmp, ok := rawArgs["createSnapshotInput"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createSnapshotInput"))
+		arg0, err = ec.unmarshalNCreateSnapshotInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCreateSnapshotInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["createSnapshotInput"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deactivateStepbackTask_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -10497,6 +11219,21 @@ func (ec *executionContext) field_Mutation_removePublicKey_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_removeSnapshot_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["snapshotId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("snapshotId"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["snapshotId"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_removeVolume_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -10599,6 +11336,106 @@ func (ec *executionContext) field_Mutation_restartVersions_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_rollbackProjectVar_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["projectId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			access, err := ec.unmarshalNProjectSettingsAccess2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectSettingsAccess(ctx, "EDIT")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.RequireProjectAccess == nil {
+				return nil, errors.New("directive requireProjectAccess is not implemented")
+			}
+			return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, access)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(string); ok {
+			arg0 = data
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+		}
+	}
+	args["projectId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["varName"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("varName"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["varName"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["version"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["version"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rollbackRepoVar_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			access, err := ec.unmarshalNProjectSettingsAccess2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectSettingsAccess(ctx, "EDIT")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.RequireProjectAccess == nil {
+				return nil, errors.New("directive requireProjectAccess is not implemented")
+			}
+			return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, access)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(string); ok {
+			arg0 = data
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+		}
+	}
+	args["id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["varName"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("varName"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["varName"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["version"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["version"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_saveDistro_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -11627,6 +12464,47 @@ func (ec *executionContext) field_Query_projectSettings_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_projectVarVersions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["projectId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			access, err := ec.unmarshalNProjectSettingsAccess2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectSettingsAccess(ctx, "EDIT")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.RequireProjectAccess == nil {
+				return nil, errors.New("directive requireProjectAccess is not implemented")
+			}
+			return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, access)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(string); ok {
+			arg0 = data
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+		}
+	}
+	args["projectId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["varName"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("varName"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["varName"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_project_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -11726,6 +12604,47 @@ func (ec *executionContext) field_Query_repoSettings_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_repoVarVersions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			access, err := ec.unmarshalNProjectSettingsAccess2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProjectSettingsAccess(ctx, "EDIT")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.RequireProjectAccess == nil {
+				return nil, errors.New("directive requireProjectAccess is not implemented")
+			}
+			return ec.directives.RequireProjectAccess(ctx, rawArgs, directive0, access)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(string); ok {
+			arg0 = data
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp))
+		}
+	}
+	args["id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["varName"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("varName"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["varName"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_taskAllExecutions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -13307,6 +14226,60 @@ func (ec *executionContext) fieldContext_BootstrapSettings_shellPath(ctx context
 	return fc, nil
 }
 
+func (ec *executionContext) _BootstrapSettings_taskCgroups(ctx context.Context, field graphql.CollectedField, obj *model.APIBootstrapSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BootstrapSettings_taskCgroups(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskCgroups, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.APITaskCgroupSettings)
+	fc.Result = res
+	return ec.marshalNTaskCgroupSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCgroupSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BootstrapSettings_taskCgroups(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BootstrapSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cpuPercent":
+				return ec.fieldContext_TaskCgroupSettings_cpuPercent(ctx, field)
+			case "enabled":
+				return ec.fieldContext_TaskCgroupSettings_enabled(ctx, field)
+			case "memoryLimitMb":
+				return ec.fieldContext_TaskCgroupSettings_memoryLimitMb(ctx, field)
+			case "numProcesses":
+				return ec.fieldContext_TaskCgroupSettings_numProcesses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskCgroupSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Build_id(ctx context.Context, field graphql.CollectedField, obj *model.APIBuild) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Build_id(ctx, field)
 	if err != nil {
@@ -14867,6 +15840,12 @@ func (ec *executionContext) fieldContext_CommitQueueItem_patch(ctx context.Conte
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -15013,6 +15992,47 @@ func (ec *executionContext) fieldContext_CommitQueueParams_enabled(ctx context.C
 	return fc, nil
 }
 
+func (ec *executionContext) _CommitQueueParams_flakyTestThreshold(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommitQueueParams_flakyTestThreshold(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FlakyTestThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CommitQueueParams_flakyTestThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CommitQueueParams",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CommitQueueParams_mergeMethod(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CommitQueueParams_mergeMethod(ctx, field)
 	if err != nil {
@@ -15145,6 +16165,59 @@ func (ec *executionContext) fieldContext_CommitQueueParams_message(ctx context.C
 	return fc, nil
 }
 
+func (ec *executionContext) _CommitQueueParams_requiredChecks(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CommitQueueParams_requiredChecks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequiredChecks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.APIMergeQueueRequiredChecks)
+	fc.Result = res
+	return ec.marshalOMergeQueueRequiredChecks2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecksᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CommitQueueParams_requiredChecks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CommitQueueParams",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "branchRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_branchRegex(ctx, field)
+			case "taskRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_taskRegex(ctx, field)
+			case "taskTags":
+				return ec.fieldContext_MergeQueueRequiredChecks_taskTags(ctx, field)
+			case "variantRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_variantRegex(ctx, field)
+			case "variantTags":
+				return ec.fieldContext_MergeQueueRequiredChecks_variantTags(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MergeQueueRequiredChecks", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ContainerPool_id(ctx context.Context, field graphql.CollectedField, obj *model.APIContainerPool) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_ContainerPool_id(ctx, field)
 	if err != nil {
@@ -16008,6 +17081,8 @@ func (ec *executionContext) fieldContext_Distro_bootstrapSettings(ctx context.Co
 				return ec.fieldContext_BootstrapSettings_serviceUser(ctx, field)
 			case "shellPath":
 				return ec.fieldContext_BootstrapSettings_shellPath(ctx, field)
+			case "taskCgroups":
+				return ec.fieldContext_BootstrapSettings_taskCgroups(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type BootstrapSettings", field.Name)
 		},
@@ -19957,6 +21032,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(ctx context.C
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -21463,6 +22540,63 @@ func (ec *executionContext) fieldContext_Host_runningTask(ctx context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Host_sleepSchedule(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Host_sleepSchedule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SleepSchedule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APISleepSchedule)
+	fc.Result = res
+	return ec.marshalOSleepSchedule2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISleepSchedule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Host_sleepSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Host",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "dailyStartTime":
+				return ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
+			case "dailyStopTime":
+				return ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
+			case "nextStartTime":
+				return ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
+			case "nextStopTime":
+				return ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
+			case "temporarilyExemptUntil":
+				return ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
+			case "timeZone":
+				return ec.fieldContext_SleepSchedule_timeZone(ctx, field)
+			case "wholeWeekdaysOff":
+				return ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SleepSchedule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Host_startedBy(ctx context.Context, field graphql.CollectedField, obj *model.APIHost) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Host_startedBy(ctx, field)
 	if err != nil {
@@ -23402,6 +24536,8 @@ func (ec *executionContext) fieldContext_HostsResponse_hosts(ctx context.Context
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -25008,6 +26144,8 @@ func (ec *executionContext) fieldContext_LogkeeperBuild_task(ctx context.Context
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -26011,6 +27149,214 @@ func (ec *executionContext) fieldContext_Manifest_revision(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _MergeQueueRequiredChecks_branchRegex(ctx context.Context, field graphql.CollectedField, obj *model.APIMergeQueueRequiredChecks) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MergeQueueRequiredChecks_branchRegex(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BranchRegex, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MergeQueueRequiredChecks_branchRegex(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeQueueRequiredChecks",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeQueueRequiredChecks_taskRegex(ctx context.Context, field graphql.CollectedField, obj *model.APIMergeQueueRequiredChecks) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MergeQueueRequiredChecks_taskRegex(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskRegex, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MergeQueueRequiredChecks_taskRegex(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeQueueRequiredChecks",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeQueueRequiredChecks_taskTags(ctx context.Context, field graphql.CollectedField, obj *model.APIMergeQueueRequiredChecks) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MergeQueueRequiredChecks_taskTags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskTags, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MergeQueueRequiredChecks_taskTags(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeQueueRequiredChecks",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeQueueRequiredChecks_variantRegex(ctx context.Context, field graphql.CollectedField, obj *model.APIMergeQueueRequiredChecks) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MergeQueueRequiredChecks_variantRegex(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VariantRegex, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MergeQueueRequiredChecks_variantRegex(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeQueueRequiredChecks",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MergeQueueRequiredChecks_variantTags(ctx context.Context, field graphql.CollectedField, obj *model.APIMergeQueueRequiredChecks) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MergeQueueRequiredChecks_variantTags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VariantTags, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MergeQueueRequiredChecks_variantTags(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MergeQueueRequiredChecks",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _MetadataLink_url(ctx context.Context, field graphql.CollectedField, obj *model.APIMetadataLink) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_MetadataLink_url(ctx, field)
 	if err != nil {
@@ -27238,6 +28584,12 @@ func (ec *executionContext) fieldContext_Mutation_enqueuePatch(ctx context.Conte
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -27359,6 +28711,12 @@ func (ec *executionContext) fieldContext_Mutation_setPatchVisibility(ctx context
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -27480,6 +28838,12 @@ func (ec *executionContext) fieldContext_Mutation_schedulePatch(ctx context.Cont
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -27730,6 +29094,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -29211,6 +30577,116 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_rollbackProjectVar(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_rollbackProjectVar(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RollbackProjectVar(rctx, fc.Args["projectId"].(string), fc.Args["varName"].(string), fc.Args["version"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_rollbackProjectVar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rollbackProjectVar_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_rollbackRepoVar(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_rollbackRepoVar(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RollbackRepoVar(rctx, fc.Args["id"].(string), fc.Args["varName"].(string), fc.Args["version"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_rollbackRepoVar(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rollbackRepoVar_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_saveProjectSettingsForSection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_saveProjectSettingsForSection(ctx, field)
 	if err != nil {
@@ -29459,6 +30935,83 @@ func (ec *executionContext) fieldContext_Mutation_attachVolumeToHost(ctx context
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_createSnapshot(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createSnapshot(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateSnapshot(rctx, fc.Args["createSnapshotInput"].(CreateSnapshotInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.APISnapshot)
+	fc.Result = res
+	return ec.marshalNSnapshot2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshot(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createSnapshot(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Snapshot_id(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Snapshot_createdBy(ctx, field)
+			case "creationTime":
+				return ec.fieldContext_Snapshot_creationTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Snapshot_displayName(ctx, field)
+			case "expiration":
+				return ec.fieldContext_Snapshot_expiration(ctx, field)
+			case "noExpiration":
+				return ec.fieldContext_Snapshot_noExpiration(ctx, field)
+			case "region":
+				return ec.fieldContext_Snapshot_region(ctx, field)
+			case "size":
+				return ec.fieldContext_Snapshot_size(ctx, field)
+			case "sourceHostID":
+				return ec.fieldContext_Snapshot_sourceHostID(ctx, field)
+			case "sourceVolumeID":
+				return ec.fieldContext_Snapshot_sourceVolumeID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Snapshot", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createSnapshot_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_detachVolumeFromHost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_detachVolumeFromHost(ctx, field)
 	if err != nil {
@@ -29587,6 +31140,8 @@ func (ec *executionContext) fieldContext_Mutation_editSpawnHost(ctx context.Cont
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -29747,6 +31302,8 @@ func (ec *executionContext) fieldContext_Mutation_spawnHost(ctx context.Context,
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -29834,6 +31391,61 @@ func (ec *executionContext) fieldContext_Mutation_spawnVolume(ctx context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_removeSnapshot(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removeSnapshot(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveSnapshot(rctx, fc.Args["snapshotId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_removeSnapshot(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeSnapshot_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_removeVolume(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_removeVolume(ctx, field)
 	if err != nil {
@@ -29962,6 +31574,8 @@ func (ec *executionContext) fieldContext_Mutation_updateSpawnHostStatus(ctx cont
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -30216,6 +31830,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -30417,6 +32033,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -30618,6 +32236,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -30819,6 +32439,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -31020,6 +32642,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -31221,6 +32845,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -32461,6 +34087,88 @@ func (ec *executionContext) fieldContext_Notifications_spawnHostOutcomeId(ctx co
 	return fc, nil
 }
 
+func (ec *executionContext) _Notifications_stepbackCulprit(ctx context.Context, field graphql.CollectedField, obj *model.APINotificationPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notifications_stepbackCulprit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepbackCulprit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Notifications_stepbackCulprit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Notifications",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Notifications_stepbackCulpritId(ctx context.Context, field graphql.CollectedField, obj *model.APINotificationPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notifications_stepbackCulpritId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepbackCulpritID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Notifications_stepbackCulpritId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Notifications",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _OomTrackerInfo_detected(ctx context.Context, field graphql.CollectedField, obj *model.APIOomTrackerInfo) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_OomTrackerInfo_detected(ctx, field)
 	if err != nil {
@@ -33258,6 +34966,12 @@ func (ec *executionContext) fieldContext_Patch_childPatches(ctx context.Context,
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -34024,6 +35738,227 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Patch_skippedTasks(ctx context.Context, field graphql.CollectedField, obj *model.APIPatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Patch_skippedTasks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SkippedTasks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.APISkippedTask)
+	fc.Result = res
+	return ec.marshalNSkippedTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISkippedTaskᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Patch_skippedTasks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "reason":
+				return ec.fieldContext_SkippedTask_reason(ctx, field)
+			case "taskName":
+				return ec.fieldContext_SkippedTask_taskName(ctx, field)
+			case "variant":
+				return ec.fieldContext_SkippedTask_variant(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SkippedTask", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patch_stack(ctx context.Context, field graphql.CollectedField, obj *model.APIPatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Patch_stack(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Stack, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIStackInfo)
+	fc.Result = res
+	return ec.marshalOStackInfo2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIStackInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Patch_stack(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StackInfo_id(ctx, field)
+			case "commit":
+				return ec.fieldContext_StackInfo_commit(ctx, field)
+			case "parentPatchId":
+				return ec.fieldContext_StackInfo_parentPatchId(ctx, field)
+			case "position":
+				return ec.fieldContext_StackInfo_position(ctx, field)
+			case "revision":
+				return ec.fieldContext_StackInfo_revision(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StackInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patch_stackPatches(ctx context.Context, field graphql.CollectedField, obj *model.APIPatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Patch_stackPatches(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Patch().StackPatches(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIPatch)
+	fc.Result = res
+	return ec.marshalNPatch2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPatchᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Patch_stackPatches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patch",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patch_id(ctx, field)
+			case "activated":
+				return ec.fieldContext_Patch_activated(ctx, field)
+			case "alias":
+				return ec.fieldContext_Patch_alias(ctx, field)
+			case "author":
+				return ec.fieldContext_Patch_author(ctx, field)
+			case "authorDisplayName":
+				return ec.fieldContext_Patch_authorDisplayName(ctx, field)
+			case "baseTaskStatuses":
+				return ec.fieldContext_Patch_baseTaskStatuses(ctx, field)
+			case "builds":
+				return ec.fieldContext_Patch_builds(ctx, field)
+			case "canEnqueueToCommitQueue":
+				return ec.fieldContext_Patch_canEnqueueToCommitQueue(ctx, field)
+			case "childPatchAliases":
+				return ec.fieldContext_Patch_childPatchAliases(ctx, field)
+			case "childPatches":
+				return ec.fieldContext_Patch_childPatches(ctx, field)
+			case "commitQueuePosition":
+				return ec.fieldContext_Patch_commitQueuePosition(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Patch_createTime(ctx, field)
+			case "description":
+				return ec.fieldContext_Patch_description(ctx, field)
+			case "duration":
+				return ec.fieldContext_Patch_duration(ctx, field)
+			case "githash":
+				return ec.fieldContext_Patch_githash(ctx, field)
+			case "hidden":
+				return ec.fieldContext_Patch_hidden(ctx, field)
+			case "moduleCodeChanges":
+				return ec.fieldContext_Patch_moduleCodeChanges(ctx, field)
+			case "parameters":
+				return ec.fieldContext_Patch_parameters(ctx, field)
+			case "patchNumber":
+				return ec.fieldContext_Patch_patchNumber(ctx, field)
+			case "patchTriggerAliases":
+				return ec.fieldContext_Patch_patchTriggerAliases(ctx, field)
+			case "project":
+				return ec.fieldContext_Patch_project(ctx, field)
+			case "projectID":
+				return ec.fieldContext_Patch_projectID(ctx, field)
+			case "projectIdentifier":
+				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
+			case "projectMetadata":
+				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
+			case "status":
+				return ec.fieldContext_Patch_status(ctx, field)
+			case "taskCount":
+				return ec.fieldContext_Patch_taskCount(ctx, field)
+			case "tasks":
+				return ec.fieldContext_Patch_tasks(ctx, field)
+			case "taskStatuses":
+				return ec.fieldContext_Patch_taskStatuses(ctx, field)
+			case "time":
+				return ec.fieldContext_Patch_time(ctx, field)
+			case "variants":
+				return ec.fieldContext_Patch_variants(ctx, field)
+			case "variantsTasks":
+				return ec.fieldContext_Patch_variantsTasks(ctx, field)
+			case "versionFull":
+				return ec.fieldContext_Patch_versionFull(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Patch_status(ctx context.Context, field graphql.CollectedField, obj *model.APIPatch) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Patch_status(ctx, field)
 	if err != nil {
@@ -35212,6 +37147,12 @@ func (ec *executionContext) fieldContext_Patches_patches(ctx context.Context, fi
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -36552,6 +38493,8 @@ func (ec *executionContext) fieldContext_Pod_task(ctx context.Context, field gra
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -37087,6 +39030,8 @@ func (ec *executionContext) fieldContext_PodEventLogData_task(ctx context.Contex
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -37941,12 +39886,16 @@ func (ec *executionContext) fieldContext_Project_commitQueue(ctx context.Context
 			switch field.Name {
 			case "enabled":
 				return ec.fieldContext_CommitQueueParams_enabled(ctx, field)
+			case "flakyTestThreshold":
+				return ec.fieldContext_CommitQueueParams_flakyTestThreshold(ctx, field)
 			case "mergeMethod":
 				return ec.fieldContext_CommitQueueParams_mergeMethod(ctx, field)
 			case "mergeQueue":
 				return ec.fieldContext_CommitQueueParams_mergeQueue(ctx, field)
 			case "message":
 				return ec.fieldContext_CommitQueueParams_message(ctx, field)
+			case "requiredChecks":
+				return ec.fieldContext_CommitQueueParams_requiredChecks(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CommitQueueParams", field.Name)
 		},
@@ -40236,6 +42185,53 @@ func (ec *executionContext) fieldContext_ProjectAlias_parameters(ctx context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _ProjectAlias_smartSelection(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectAlias) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectAlias_smartSelection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SmartSelection, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APISmartSelection)
+	fc.Result = res
+	return ec.marshalOSmartSelection2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISmartSelection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProjectAlias_smartSelection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectAlias",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "maxTasks":
+				return ec.fieldContext_SmartSelection_maxTasks(ctx, field)
+			case "mandatoryTasks":
+				return ec.fieldContext_SmartSelection_mandatoryTasks(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SmartSelection", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ProjectBanner_text(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectBanner) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_ProjectBanner_text(ctx, field)
 	if err != nil {
@@ -40706,6 +42702,8 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_aliases(ctx contex
 				return ec.fieldContext_ProjectAlias_variantTags(ctx, field)
 			case "parameters":
 				return ec.fieldContext_ProjectAlias_parameters(ctx, field)
+			case "smartSelection":
+				return ec.fieldContext_ProjectAlias_smartSelection(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ProjectAlias", field.Name)
 		},
@@ -41246,6 +43244,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_aliases(ctx context.Con
 				return ec.fieldContext_ProjectAlias_variantTags(ctx, field)
 			case "parameters":
 				return ec.fieldContext_ProjectAlias_parameters(ctx, field)
+			case "smartSelection":
+				return ec.fieldContext_ProjectAlias_smartSelection(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ProjectAlias", field.Name)
 		},
@@ -41564,8 +43564,8 @@ func (ec *executionContext) fieldContext_ProjectSettings_vars(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _ProjectVars_adminOnlyVars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProjectVars_adminOnlyVars(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_adminOnly(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_adminOnly(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41578,7 +43578,7 @@ func (ec *executionContext) _ProjectVars_adminOnlyVars(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProjectVars().AdminOnlyVars(rctx, obj)
+		return obj.AdminOnly, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41590,26 +43590,26 @@ func (ec *executionContext) _ProjectVars_adminOnlyVars(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProjectVars_adminOnlyVars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_adminOnly(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProjectVars",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProjectVars_privateVars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProjectVars_privateVars(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_canRollback(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_canRollback(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41622,7 +43622,7 @@ func (ec *executionContext) _ProjectVars_privateVars(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProjectVars().PrivateVars(rctx, obj)
+		return obj.CanRollback, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41634,26 +43634,26 @@ func (ec *executionContext) _ProjectVars_privateVars(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProjectVars_privateVars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_canRollback(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProjectVars",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProjectVars_vars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProjectVars_vars(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41666,7 +43666,7 @@ func (ec *executionContext) _ProjectVars_vars(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Vars, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41675,26 +43675,26 @@ func (ec *executionContext) _ProjectVars_vars(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(map[string]string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOStringMap2map(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProjectVars_vars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProjectVars",
+		Object:     "ProjectVarVersion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type StringMap does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PublicKey_key(ctx context.Context, field graphql.CollectedField, obj *model.APIPubKey) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PublicKey_key(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_deleted(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_deleted(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41707,7 +43707,7 @@ func (ec *executionContext) _PublicKey_key(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Deleted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41719,26 +43719,26 @@ func (ec *executionContext) _PublicKey_key(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PublicKey_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_deleted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PublicKey",
+		Object:     "ProjectVarVersion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PublicKey_name(ctx context.Context, field graphql.CollectedField, obj *model.APIPubKey) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PublicKey_name(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_externalSecret(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_externalSecret(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41751,7 +43751,7 @@ func (ec *executionContext) _PublicKey_name(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ExternalSecret, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41763,26 +43763,26 @@ func (ec *executionContext) _PublicKey_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PublicKey_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_externalSecret(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PublicKey",
+		Object:     "ProjectVarVersion",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_bbGetCreatedTickets(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_bbGetCreatedTickets(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_name(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41795,7 +43795,7 @@ func (ec *executionContext) _Query_bbGetCreatedTickets(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BbGetCreatedTickets(rctx, fc.Args["taskId"].(string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41807,43 +43807,26 @@ func (ec *executionContext) _Query_bbGetCreatedTickets(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*thirdparty.JiraTicket)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNJiraTicket2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicketᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_bbGetCreatedTickets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "fields":
-				return ec.fieldContext_JiraTicket_fields(ctx, field)
-			case "key":
-				return ec.fieldContext_JiraTicket_key(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_bbGetCreatedTickets_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_buildBaron(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_buildBaron(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_private(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_private(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41856,7 +43839,7 @@ func (ec *executionContext) _Query_buildBaron(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BuildBaron(rctx, fc.Args["taskId"].(string), fc.Args["execution"].(int))
+		return obj.Private, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41868,45 +43851,70 @@ func (ec *executionContext) _Query_buildBaron(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*BuildBaron)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNBuildBaron2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐBuildBaron(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_buildBaron(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_private(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "bbTicketCreationDefined":
-				return ec.fieldContext_BuildBaron_bbTicketCreationDefined(ctx, field)
-			case "buildBaronConfigured":
-				return ec.fieldContext_BuildBaron_buildBaronConfigured(ctx, field)
-			case "searchReturnInfo":
-				return ec.fieldContext_BuildBaron_searchReturnInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BuildBaron", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProjectVarVersion_projectId(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_projectId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_buildBaron_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProjectID, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProjectVarVersion_projectId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProjectVarVersion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_awsRegions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_awsRegions(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_user(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41919,7 +43927,7 @@ func (ec *executionContext) _Query_awsRegions(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AwsRegions(rctx)
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41928,17 +43936,17 @@ func (ec *executionContext) _Query_awsRegions(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_awsRegions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -41946,8 +43954,8 @@ func (ec *executionContext) fieldContext_Query_awsRegions(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_clientConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_clientConfig(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_value(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41960,7 +43968,7 @@ func (ec *executionContext) _Query_clientConfig(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ClientConfig(rctx)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41969,32 +43977,26 @@ func (ec *executionContext) _Query_clientConfig(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIClientConfig)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOClientConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIClientConfig(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_clientConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "clientBinaries":
-				return ec.fieldContext_ClientConfig_clientBinaries(ctx, field)
-			case "latestRevision":
-				return ec.fieldContext_ClientConfig_latestRevision(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ClientConfig", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_instanceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_instanceTypes(ctx, field)
+func (ec *executionContext) _ProjectVarVersion_version(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVarVersion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVarVersion_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42007,7 +44009,7 @@ func (ec *executionContext) _Query_instanceTypes(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().InstanceTypes(rctx)
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42019,26 +44021,26 @@ func (ec *executionContext) _Query_instanceTypes(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_instanceTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVarVersion_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVarVersion",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_spruceConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_spruceConfig(ctx, field)
+func (ec *executionContext) _ProjectVars_adminOnlyVars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVars_adminOnlyVars(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42051,57 +44053,38 @@ func (ec *executionContext) _Query_spruceConfig(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SpruceConfig(rctx)
+		return ec.resolvers.ProjectVars().AdminOnlyVars(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIAdminSettings)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOSpruceConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIAdminSettings(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_spruceConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVars_adminOnlyVars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVars",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "banner":
-				return ec.fieldContext_SpruceConfig_banner(ctx, field)
-			case "bannerTheme":
-				return ec.fieldContext_SpruceConfig_bannerTheme(ctx, field)
-			case "containerPools":
-				return ec.fieldContext_SpruceConfig_containerPools(ctx, field)
-			case "githubOrgs":
-				return ec.fieldContext_SpruceConfig_githubOrgs(ctx, field)
-			case "jira":
-				return ec.fieldContext_SpruceConfig_jira(ctx, field)
-			case "keys":
-				return ec.fieldContext_SpruceConfig_keys(ctx, field)
-			case "providers":
-				return ec.fieldContext_SpruceConfig_providers(ctx, field)
-			case "slack":
-				return ec.fieldContext_SpruceConfig_slack(ctx, field)
-			case "spawnHost":
-				return ec.fieldContext_SpruceConfig_spawnHost(ctx, field)
-			case "ui":
-				return ec.fieldContext_SpruceConfig_ui(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SpruceConfig", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_subnetAvailabilityZones(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_subnetAvailabilityZones(ctx, field)
+func (ec *executionContext) _ProjectVars_privateVars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVars_privateVars(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42114,7 +44097,7 @@ func (ec *executionContext) _Query_subnetAvailabilityZones(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SubnetAvailabilityZones(rctx)
+		return ec.resolvers.ProjectVars().PrivateVars(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42131,9 +44114,9 @@ func (ec *executionContext) _Query_subnetAvailabilityZones(ctx context.Context,
 	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_subnetAvailabilityZones(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVars_privateVars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVars",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -42144,8 +44127,8 @@ func (ec *executionContext) fieldContext_Query_subnetAvailabilityZones(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_distro(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_distro(ctx, field)
+func (ec *executionContext) _ProjectVars_vars(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectVars) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProjectVars_vars(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42158,7 +44141,7 @@ func (ec *executionContext) _Query_distro(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Distro(rctx, fc.Args["distroId"].(string))
+		return obj.Vars, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42167,28 +44150,520 @@ func (ec *executionContext) _Query_distro(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIDistro)
+	res := resTmp.(map[string]string)
 	fc.Result = res
-	return ec.marshalODistro2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistro(ctx, field.Selections, res)
+	return ec.marshalOStringMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_distro(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProjectVars_vars(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ProjectVars",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "aliases":
-				return ec.fieldContext_Distro_aliases(ctx, field)
-			case "arch":
-				return ec.fieldContext_Distro_arch(ctx, field)
-			case "authorizedKeysFile":
-				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
-			case "bootstrapSettings":
-				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
-			case "cloneMethod":
+			return nil, errors.New("field of type StringMap does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicKey_key(ctx context.Context, field graphql.CollectedField, obj *model.APIPubKey) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicKey_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicKey_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicKey",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicKey_name(ctx context.Context, field graphql.CollectedField, obj *model.APIPubKey) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicKey_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicKey_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicKey",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_bbGetCreatedTickets(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_bbGetCreatedTickets(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BbGetCreatedTickets(rctx, fc.Args["taskId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*thirdparty.JiraTicket)
+	fc.Result = res
+	return ec.marshalNJiraTicket2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicketᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_bbGetCreatedTickets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "fields":
+				return ec.fieldContext_JiraTicket_fields(ctx, field)
+			case "key":
+				return ec.fieldContext_JiraTicket_key(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_bbGetCreatedTickets_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_buildBaron(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_buildBaron(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BuildBaron(rctx, fc.Args["taskId"].(string), fc.Args["execution"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*BuildBaron)
+	fc.Result = res
+	return ec.marshalNBuildBaron2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐBuildBaron(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_buildBaron(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bbTicketCreationDefined":
+				return ec.fieldContext_BuildBaron_bbTicketCreationDefined(ctx, field)
+			case "buildBaronConfigured":
+				return ec.fieldContext_BuildBaron_buildBaronConfigured(ctx, field)
+			case "searchReturnInfo":
+				return ec.fieldContext_BuildBaron_searchReturnInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BuildBaron", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_buildBaron_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_awsRegions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_awsRegions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AwsRegions(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_awsRegions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_clientConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_clientConfig(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ClientConfig(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIClientConfig)
+	fc.Result = res
+	return ec.marshalOClientConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIClientConfig(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_clientConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "clientBinaries":
+				return ec.fieldContext_ClientConfig_clientBinaries(ctx, field)
+			case "latestRevision":
+				return ec.fieldContext_ClientConfig_latestRevision(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClientConfig", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_instanceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_instanceTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().InstanceTypes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_instanceTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_spruceConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_spruceConfig(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SpruceConfig(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIAdminSettings)
+	fc.Result = res
+	return ec.marshalOSpruceConfig2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIAdminSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_spruceConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "banner":
+				return ec.fieldContext_SpruceConfig_banner(ctx, field)
+			case "bannerTheme":
+				return ec.fieldContext_SpruceConfig_bannerTheme(ctx, field)
+			case "containerPools":
+				return ec.fieldContext_SpruceConfig_containerPools(ctx, field)
+			case "githubOrgs":
+				return ec.fieldContext_SpruceConfig_githubOrgs(ctx, field)
+			case "jira":
+				return ec.fieldContext_SpruceConfig_jira(ctx, field)
+			case "keys":
+				return ec.fieldContext_SpruceConfig_keys(ctx, field)
+			case "providers":
+				return ec.fieldContext_SpruceConfig_providers(ctx, field)
+			case "slack":
+				return ec.fieldContext_SpruceConfig_slack(ctx, field)
+			case "spawnHost":
+				return ec.fieldContext_SpruceConfig_spawnHost(ctx, field)
+			case "ui":
+				return ec.fieldContext_SpruceConfig_ui(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SpruceConfig", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_subnetAvailabilityZones(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_subnetAvailabilityZones(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SubnetAvailabilityZones(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_subnetAvailabilityZones(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_distro(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_distro(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Distro(rctx, fc.Args["distroId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIDistro)
+	fc.Result = res
+	return ec.marshalODistro2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistro(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_distro(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "aliases":
+				return ec.fieldContext_Distro_aliases(ctx, field)
+			case "arch":
+				return ec.fieldContext_Distro_arch(ctx, field)
+			case "authorizedKeysFile":
+				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
+			case "bootstrapSettings":
+				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
+			case "cloneMethod":
 				return ec.fieldContext_Distro_cloneMethod(ctx, field)
 			case "containerPool":
 				return ec.fieldContext_Distro_containerPool(ctx, field)
@@ -42579,6 +45054,8 @@ func (ec *executionContext) fieldContext_Query_host(ctx context.Context, field g
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -42943,6 +45420,12 @@ func (ec *executionContext) fieldContext_Query_patch(ctx context.Context, field
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -43373,6 +45856,85 @@ func (ec *executionContext) fieldContext_Query_projectSettings(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_projectVarVersions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_projectVarVersions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProjectVarVersions(rctx, fc.Args["projectId"].(string), fc.Args["varName"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIProjectVarVersion)
+	fc.Result = res
+	return ec.marshalNProjectVarVersion2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVarVersionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_projectVarVersions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "adminOnly":
+				return ec.fieldContext_ProjectVarVersion_adminOnly(ctx, field)
+			case "canRollback":
+				return ec.fieldContext_ProjectVarVersion_canRollback(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ProjectVarVersion_createdAt(ctx, field)
+			case "deleted":
+				return ec.fieldContext_ProjectVarVersion_deleted(ctx, field)
+			case "externalSecret":
+				return ec.fieldContext_ProjectVarVersion_externalSecret(ctx, field)
+			case "name":
+				return ec.fieldContext_ProjectVarVersion_name(ctx, field)
+			case "private":
+				return ec.fieldContext_ProjectVarVersion_private(ctx, field)
+			case "projectId":
+				return ec.fieldContext_ProjectVarVersion_projectId(ctx, field)
+			case "user":
+				return ec.fieldContext_ProjectVarVersion_user(ctx, field)
+			case "value":
+				return ec.fieldContext_ProjectVarVersion_value(ctx, field)
+			case "version":
+				return ec.fieldContext_ProjectVarVersion_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProjectVarVersion", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_projectVarVersions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_repoEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_repoEvents(ctx, field)
 	if err != nil {
@@ -43501,6 +46063,85 @@ func (ec *executionContext) fieldContext_Query_repoSettings(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_repoVarVersions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_repoVarVersions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RepoVarVersions(rctx, fc.Args["id"].(string), fc.Args["varName"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APIProjectVarVersion)
+	fc.Result = res
+	return ec.marshalNProjectVarVersion2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVarVersionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_repoVarVersions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "adminOnly":
+				return ec.fieldContext_ProjectVarVersion_adminOnly(ctx, field)
+			case "canRollback":
+				return ec.fieldContext_ProjectVarVersion_canRollback(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ProjectVarVersion_createdAt(ctx, field)
+			case "deleted":
+				return ec.fieldContext_ProjectVarVersion_deleted(ctx, field)
+			case "externalSecret":
+				return ec.fieldContext_ProjectVarVersion_externalSecret(ctx, field)
+			case "name":
+				return ec.fieldContext_ProjectVarVersion_name(ctx, field)
+			case "private":
+				return ec.fieldContext_ProjectVarVersion_private(ctx, field)
+			case "projectId":
+				return ec.fieldContext_ProjectVarVersion_projectId(ctx, field)
+			case "user":
+				return ec.fieldContext_ProjectVarVersion_user(ctx, field)
+			case "value":
+				return ec.fieldContext_ProjectVarVersion_value(ctx, field)
+			case "version":
+				return ec.fieldContext_ProjectVarVersion_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProjectVarVersion", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_repoVarVersions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_viewableProjectRefs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_viewableProjectRefs(ctx, field)
 	if err != nil {
@@ -43626,6 +46267,8 @@ func (ec *executionContext) fieldContext_Query_myHosts(ctx context.Context, fiel
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -43721,8 +46364,8 @@ func (ec *executionContext) fieldContext_Query_myVolumes(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_logkeeperBuildMetadata(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_logkeeperBuildMetadata(ctx, field)
+func (ec *executionContext) _Query_mySnapshots(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_mySnapshots(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43735,7 +46378,7 @@ func (ec *executionContext) _Query_logkeeperBuildMetadata(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LogkeeperBuildMetadata(rctx, fc.Args["buildId"].(string))
+		return ec.resolvers.Query().MySnapshots(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43747,12 +46390,12 @@ func (ec *executionContext) _Query_logkeeperBuildMetadata(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*plank.Build)
+	res := resTmp.([]*model.APISnapshot)
 	fc.Result = res
-	return ec.marshalNLogkeeperBuild2ᚖgithubᚗcomᚋevergreenᚑciᚋplankᚐBuild(ctx, field.Selections, res)
+	return ec.marshalNSnapshot2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshotᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_logkeeperBuildMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_mySnapshots(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -43761,39 +46404,34 @@ func (ec *executionContext) fieldContext_Query_logkeeperBuildMetadata(ctx contex
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LogkeeperBuild_id(ctx, field)
-			case "builder":
-				return ec.fieldContext_LogkeeperBuild_builder(ctx, field)
-			case "buildNum":
-				return ec.fieldContext_LogkeeperBuild_buildNum(ctx, field)
-			case "taskId":
-				return ec.fieldContext_LogkeeperBuild_taskId(ctx, field)
-			case "taskExecution":
-				return ec.fieldContext_LogkeeperBuild_taskExecution(ctx, field)
-			case "tests":
-				return ec.fieldContext_LogkeeperBuild_tests(ctx, field)
-			case "task":
-				return ec.fieldContext_LogkeeperBuild_task(ctx, field)
+				return ec.fieldContext_Snapshot_id(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Snapshot_createdBy(ctx, field)
+			case "creationTime":
+				return ec.fieldContext_Snapshot_creationTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Snapshot_displayName(ctx, field)
+			case "expiration":
+				return ec.fieldContext_Snapshot_expiration(ctx, field)
+			case "noExpiration":
+				return ec.fieldContext_Snapshot_noExpiration(ctx, field)
+			case "region":
+				return ec.fieldContext_Snapshot_region(ctx, field)
+			case "size":
+				return ec.fieldContext_Snapshot_size(ctx, field)
+			case "sourceHostID":
+				return ec.fieldContext_Snapshot_sourceHostID(ctx, field)
+			case "sourceVolumeID":
+				return ec.fieldContext_Snapshot_sourceVolumeID(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LogkeeperBuild", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Snapshot", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_logkeeperBuildMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_task(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_task(ctx, field)
+func (ec *executionContext) _Query_logkeeperBuildMetadata(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_logkeeperBuildMetadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43806,21 +46444,24 @@ func (ec *executionContext) _Query_task(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Task(rctx, fc.Args["taskId"].(string), fc.Args["execution"].(*int))
+		return ec.resolvers.Query().LogkeeperBuildMetadata(rctx, fc.Args["buildId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APITask)
+	res := resTmp.(*plank.Build)
 	fc.Result = res
-	return ec.marshalOTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
+	return ec.marshalNLogkeeperBuild2ᚖgithubᚗcomᚋevergreenᚑciᚋplankᚐBuild(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_logkeeperBuildMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -43829,151 +46470,21 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Task_id(ctx, field)
-			case "aborted":
-				return ec.fieldContext_Task_aborted(ctx, field)
-			case "abortInfo":
-				return ec.fieldContext_Task_abortInfo(ctx, field)
-			case "activated":
-				return ec.fieldContext_Task_activated(ctx, field)
-			case "activatedBy":
-				return ec.fieldContext_Task_activatedBy(ctx, field)
-			case "activatedTime":
-				return ec.fieldContext_Task_activatedTime(ctx, field)
-			case "ami":
-				return ec.fieldContext_Task_ami(ctx, field)
-			case "annotation":
-				return ec.fieldContext_Task_annotation(ctx, field)
-			case "baseStatus":
-				return ec.fieldContext_Task_baseStatus(ctx, field)
-			case "baseTask":
-				return ec.fieldContext_Task_baseTask(ctx, field)
-			case "blocked":
-				return ec.fieldContext_Task_blocked(ctx, field)
-			case "buildId":
-				return ec.fieldContext_Task_buildId(ctx, field)
-			case "buildVariant":
-				return ec.fieldContext_Task_buildVariant(ctx, field)
-			case "buildVariantDisplayName":
-				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
-			case "canAbort":
-				return ec.fieldContext_Task_canAbort(ctx, field)
-			case "canDisable":
-				return ec.fieldContext_Task_canDisable(ctx, field)
-			case "canModifyAnnotation":
-				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
-			case "canOverrideDependencies":
-				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
-			case "canRestart":
-				return ec.fieldContext_Task_canRestart(ctx, field)
-			case "canSchedule":
-				return ec.fieldContext_Task_canSchedule(ctx, field)
-			case "canSetPriority":
-				return ec.fieldContext_Task_canSetPriority(ctx, field)
-			case "canSync":
-				return ec.fieldContext_Task_canSync(ctx, field)
-			case "canUnschedule":
-				return ec.fieldContext_Task_canUnschedule(ctx, field)
-			case "containerAllocatedTime":
-				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
-			case "createTime":
-				return ec.fieldContext_Task_createTime(ctx, field)
-			case "dependsOn":
-				return ec.fieldContext_Task_dependsOn(ctx, field)
-			case "details":
-				return ec.fieldContext_Task_details(ctx, field)
-			case "dispatchTime":
-				return ec.fieldContext_Task_dispatchTime(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Task_displayName(ctx, field)
-			case "displayOnly":
-				return ec.fieldContext_Task_displayOnly(ctx, field)
-			case "displayTask":
-				return ec.fieldContext_Task_displayTask(ctx, field)
-			case "distroId":
-				return ec.fieldContext_Task_distroId(ctx, field)
-			case "estimatedStart":
-				return ec.fieldContext_Task_estimatedStart(ctx, field)
-			case "execution":
-				return ec.fieldContext_Task_execution(ctx, field)
-			case "executionTasks":
-				return ec.fieldContext_Task_executionTasks(ctx, field)
-			case "executionTasksFull":
-				return ec.fieldContext_Task_executionTasksFull(ctx, field)
-			case "expectedDuration":
-				return ec.fieldContext_Task_expectedDuration(ctx, field)
-			case "failedTestCount":
-				return ec.fieldContext_Task_failedTestCount(ctx, field)
-			case "finishTime":
-				return ec.fieldContext_Task_finishTime(ctx, field)
-			case "files":
-				return ec.fieldContext_Task_files(ctx, field)
-			case "generatedBy":
-				return ec.fieldContext_Task_generatedBy(ctx, field)
-			case "generatedByName":
-				return ec.fieldContext_Task_generatedByName(ctx, field)
-			case "generateTask":
-				return ec.fieldContext_Task_generateTask(ctx, field)
-			case "hostId":
-				return ec.fieldContext_Task_hostId(ctx, field)
-			case "ingestTime":
-				return ec.fieldContext_Task_ingestTime(ctx, field)
-			case "isPerfPluginEnabled":
-				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
-			case "latestExecution":
-				return ec.fieldContext_Task_latestExecution(ctx, field)
-			case "logs":
-				return ec.fieldContext_Task_logs(ctx, field)
-			case "minQueuePosition":
-				return ec.fieldContext_Task_minQueuePosition(ctx, field)
-			case "order":
-				return ec.fieldContext_Task_order(ctx, field)
-			case "patch":
-				return ec.fieldContext_Task_patch(ctx, field)
-			case "patchNumber":
-				return ec.fieldContext_Task_patchNumber(ctx, field)
-			case "pod":
-				return ec.fieldContext_Task_pod(ctx, field)
-			case "priority":
-				return ec.fieldContext_Task_priority(ctx, field)
-			case "project":
-				return ec.fieldContext_Task_project(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Task_projectId(ctx, field)
-			case "projectIdentifier":
-				return ec.fieldContext_Task_projectIdentifier(ctx, field)
-			case "requester":
-				return ec.fieldContext_Task_requester(ctx, field)
-			case "resetWhenFinished":
-				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
-			case "revision":
-				return ec.fieldContext_Task_revision(ctx, field)
-			case "scheduledTime":
-				return ec.fieldContext_Task_scheduledTime(ctx, field)
-			case "spawnHostLink":
-				return ec.fieldContext_Task_spawnHostLink(ctx, field)
-			case "startTime":
-				return ec.fieldContext_Task_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_Task_status(ctx, field)
-			case "taskFiles":
-				return ec.fieldContext_Task_taskFiles(ctx, field)
-			case "taskGroup":
-				return ec.fieldContext_Task_taskGroup(ctx, field)
-			case "taskGroupMaxHosts":
-				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
-			case "taskLogs":
-				return ec.fieldContext_Task_taskLogs(ctx, field)
+				return ec.fieldContext_LogkeeperBuild_id(ctx, field)
+			case "builder":
+				return ec.fieldContext_LogkeeperBuild_builder(ctx, field)
+			case "buildNum":
+				return ec.fieldContext_LogkeeperBuild_buildNum(ctx, field)
+			case "taskId":
+				return ec.fieldContext_LogkeeperBuild_taskId(ctx, field)
+			case "taskExecution":
+				return ec.fieldContext_LogkeeperBuild_taskExecution(ctx, field)
 			case "tests":
-				return ec.fieldContext_Task_tests(ctx, field)
-			case "timeTaken":
-				return ec.fieldContext_Task_timeTaken(ctx, field)
-			case "totalTestCount":
-				return ec.fieldContext_Task_totalTestCount(ctx, field)
-			case "versionMetadata":
-				return ec.fieldContext_Task_versionMetadata(ctx, field)
+				return ec.fieldContext_LogkeeperBuild_tests(ctx, field)
+			case "task":
+				return ec.fieldContext_LogkeeperBuild_task(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LogkeeperBuild", field.Name)
 		},
 	}
 	defer func() {
@@ -43983,15 +46494,15 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_task_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_logkeeperBuildMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_taskAllExecutions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_taskAllExecutions(ctx, field)
+func (ec *executionContext) _Query_task(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_task(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -44004,24 +46515,21 @@ func (ec *executionContext) _Query_taskAllExecutions(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TaskAllExecutions(rctx, fc.Args["taskId"].(string))
+		return ec.resolvers.Query().Task(rctx, fc.Args["taskId"].(string), fc.Args["execution"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.APITask)
+	res := resTmp.(*model.APITask)
 	fc.Result = res
-	return ec.marshalNTask2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskᚄ(ctx, field.Selections, res)
+	return ec.marshalOTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -44157,6 +46665,211 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
+			case "taskFiles":
+				return ec.fieldContext_Task_taskFiles(ctx, field)
+			case "taskGroup":
+				return ec.fieldContext_Task_taskGroup(ctx, field)
+			case "taskGroupMaxHosts":
+				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
+			case "taskLogs":
+				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "tests":
+				return ec.fieldContext_Task_tests(ctx, field)
+			case "timeTaken":
+				return ec.fieldContext_Task_timeTaken(ctx, field)
+			case "totalTestCount":
+				return ec.fieldContext_Task_totalTestCount(ctx, field)
+			case "versionMetadata":
+				return ec.fieldContext_Task_versionMetadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_task_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_taskAllExecutions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_taskAllExecutions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TaskAllExecutions(rctx, fc.Args["taskId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APITask)
+	fc.Result = res
+	return ec.marshalNTask2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Task_id(ctx, field)
+			case "aborted":
+				return ec.fieldContext_Task_aborted(ctx, field)
+			case "abortInfo":
+				return ec.fieldContext_Task_abortInfo(ctx, field)
+			case "activated":
+				return ec.fieldContext_Task_activated(ctx, field)
+			case "activatedBy":
+				return ec.fieldContext_Task_activatedBy(ctx, field)
+			case "activatedTime":
+				return ec.fieldContext_Task_activatedTime(ctx, field)
+			case "ami":
+				return ec.fieldContext_Task_ami(ctx, field)
+			case "annotation":
+				return ec.fieldContext_Task_annotation(ctx, field)
+			case "baseStatus":
+				return ec.fieldContext_Task_baseStatus(ctx, field)
+			case "baseTask":
+				return ec.fieldContext_Task_baseTask(ctx, field)
+			case "blocked":
+				return ec.fieldContext_Task_blocked(ctx, field)
+			case "buildId":
+				return ec.fieldContext_Task_buildId(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_Task_buildVariant(ctx, field)
+			case "buildVariantDisplayName":
+				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
+			case "canAbort":
+				return ec.fieldContext_Task_canAbort(ctx, field)
+			case "canDisable":
+				return ec.fieldContext_Task_canDisable(ctx, field)
+			case "canModifyAnnotation":
+				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
+			case "canOverrideDependencies":
+				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
+			case "canRestart":
+				return ec.fieldContext_Task_canRestart(ctx, field)
+			case "canSchedule":
+				return ec.fieldContext_Task_canSchedule(ctx, field)
+			case "canSetPriority":
+				return ec.fieldContext_Task_canSetPriority(ctx, field)
+			case "canSync":
+				return ec.fieldContext_Task_canSync(ctx, field)
+			case "canUnschedule":
+				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "containerAllocatedTime":
+				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Task_createTime(ctx, field)
+			case "dependsOn":
+				return ec.fieldContext_Task_dependsOn(ctx, field)
+			case "details":
+				return ec.fieldContext_Task_details(ctx, field)
+			case "dispatchTime":
+				return ec.fieldContext_Task_dispatchTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Task_displayName(ctx, field)
+			case "displayOnly":
+				return ec.fieldContext_Task_displayOnly(ctx, field)
+			case "displayTask":
+				return ec.fieldContext_Task_displayTask(ctx, field)
+			case "distroId":
+				return ec.fieldContext_Task_distroId(ctx, field)
+			case "estimatedStart":
+				return ec.fieldContext_Task_estimatedStart(ctx, field)
+			case "execution":
+				return ec.fieldContext_Task_execution(ctx, field)
+			case "executionTasks":
+				return ec.fieldContext_Task_executionTasks(ctx, field)
+			case "executionTasksFull":
+				return ec.fieldContext_Task_executionTasksFull(ctx, field)
+			case "expectedDuration":
+				return ec.fieldContext_Task_expectedDuration(ctx, field)
+			case "failedTestCount":
+				return ec.fieldContext_Task_failedTestCount(ctx, field)
+			case "finishTime":
+				return ec.fieldContext_Task_finishTime(ctx, field)
+			case "files":
+				return ec.fieldContext_Task_files(ctx, field)
+			case "generatedBy":
+				return ec.fieldContext_Task_generatedBy(ctx, field)
+			case "generatedByName":
+				return ec.fieldContext_Task_generatedByName(ctx, field)
+			case "generateTask":
+				return ec.fieldContext_Task_generateTask(ctx, field)
+			case "hostId":
+				return ec.fieldContext_Task_hostId(ctx, field)
+			case "ingestTime":
+				return ec.fieldContext_Task_ingestTime(ctx, field)
+			case "isPerfPluginEnabled":
+				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
+			case "latestExecution":
+				return ec.fieldContext_Task_latestExecution(ctx, field)
+			case "logs":
+				return ec.fieldContext_Task_logs(ctx, field)
+			case "minQueuePosition":
+				return ec.fieldContext_Task_minQueuePosition(ctx, field)
+			case "order":
+				return ec.fieldContext_Task_order(ctx, field)
+			case "patch":
+				return ec.fieldContext_Task_patch(ctx, field)
+			case "patchNumber":
+				return ec.fieldContext_Task_patchNumber(ctx, field)
+			case "pod":
+				return ec.fieldContext_Task_pod(ctx, field)
+			case "priority":
+				return ec.fieldContext_Task_priority(ctx, field)
+			case "project":
+				return ec.fieldContext_Task_project(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Task_projectId(ctx, field)
+			case "projectIdentifier":
+				return ec.fieldContext_Task_projectIdentifier(ctx, field)
+			case "requester":
+				return ec.fieldContext_Task_requester(ctx, field)
+			case "resetWhenFinished":
+				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "revision":
+				return ec.fieldContext_Task_revision(ctx, field)
+			case "scheduledTime":
+				return ec.fieldContext_Task_scheduledTime(ctx, field)
+			case "spawnHostLink":
+				return ec.fieldContext_Task_spawnHostLink(ctx, field)
+			case "startTime":
+				return ec.fieldContext_Task_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -45080,6 +47793,50 @@ func (ec *executionContext) fieldContext_RepoCommitQueueParams_enabled(ctx conte
 	return fc, nil
 }
 
+func (ec *executionContext) _RepoCommitQueueParams_flakyTestThreshold(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RepoCommitQueueParams_flakyTestThreshold(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FlakyTestThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalNFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RepoCommitQueueParams_flakyTestThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepoCommitQueueParams",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RepoCommitQueueParams_mergeMethod(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_RepoCommitQueueParams_mergeMethod(ctx, field)
 	if err != nil {
@@ -45212,6 +47969,59 @@ func (ec *executionContext) fieldContext_RepoCommitQueueParams_message(ctx conte
 	return fc, nil
 }
 
+func (ec *executionContext) _RepoCommitQueueParams_requiredChecks(ctx context.Context, field graphql.CollectedField, obj *model.APICommitQueueParams) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RepoCommitQueueParams_requiredChecks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequiredChecks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.APIMergeQueueRequiredChecks)
+	fc.Result = res
+	return ec.marshalOMergeQueueRequiredChecks2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecksᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RepoCommitQueueParams_requiredChecks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepoCommitQueueParams",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "branchRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_branchRegex(ctx, field)
+			case "taskRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_taskRegex(ctx, field)
+			case "taskTags":
+				return ec.fieldContext_MergeQueueRequiredChecks_taskTags(ctx, field)
+			case "variantRegex":
+				return ec.fieldContext_MergeQueueRequiredChecks_variantRegex(ctx, field)
+			case "variantTags":
+				return ec.fieldContext_MergeQueueRequiredChecks_variantTags(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MergeQueueRequiredChecks", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RepoRef_id(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_RepoRef_id(ctx, field)
 	if err != nil {
@@ -45445,12 +48255,16 @@ func (ec *executionContext) fieldContext_RepoRef_commitQueue(ctx context.Context
 			switch field.Name {
 			case "enabled":
 				return ec.fieldContext_RepoCommitQueueParams_enabled(ctx, field)
+			case "flakyTestThreshold":
+				return ec.fieldContext_RepoCommitQueueParams_flakyTestThreshold(ctx, field)
 			case "mergeMethod":
 				return ec.fieldContext_RepoCommitQueueParams_mergeMethod(ctx, field)
 			case "mergeQueue":
 				return ec.fieldContext_RepoCommitQueueParams_mergeQueue(ctx, field)
 			case "message":
 				return ec.fieldContext_RepoCommitQueueParams_message(ctx, field)
+			case "requiredChecks":
+				return ec.fieldContext_RepoCommitQueueParams_requiredChecks(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type RepoCommitQueueParams", field.Name)
 		},
@@ -47080,6 +49894,8 @@ func (ec *executionContext) fieldContext_RepoSettings_aliases(ctx context.Contex
 				return ec.fieldContext_ProjectAlias_variantTags(ctx, field)
 			case "parameters":
 				return ec.fieldContext_ProjectAlias_parameters(ctx, field)
+			case "smartSelection":
+				return ec.fieldContext_ProjectAlias_smartSelection(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type ProjectAlias", field.Name)
 		},
@@ -48461,6 +51277,138 @@ func (ec *executionContext) fieldContext_SetLastRevisionPayload_mergeBaseRevisio
 	return fc, nil
 }
 
+func (ec *executionContext) _SkippedTask_reason(ctx context.Context, field graphql.CollectedField, obj *model.APISkippedTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SkippedTask_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SkippedTask_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SkippedTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SkippedTask_taskName(ctx context.Context, field graphql.CollectedField, obj *model.APISkippedTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SkippedTask_taskName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SkippedTask_taskName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SkippedTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SkippedTask_variant(ctx context.Context, field graphql.CollectedField, obj *model.APISkippedTask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SkippedTask_variant(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Variant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SkippedTask_variant(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SkippedTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SlackConfig_name(ctx context.Context, field graphql.CollectedField, obj *model.APISlackConfig) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_SlackConfig_name(ctx, field)
 	if err != nil {
@@ -48475,23 +51423,832 @@ func (ec *executionContext) _SlackConfig_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlackConfig_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlackConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_dailyStartTime(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DailyStartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_dailyStartTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_dailyStopTime(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DailyStopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_dailyStopTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_nextStartTime(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextStartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_nextStartTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_nextStopTime(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextStopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_nextStopTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_temporarilyExemptUntil(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TemporarilyExemptUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_temporarilyExemptUntil(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_timeZone(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_timeZone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeZone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_timeZone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_wholeWeekdaysOff(ctx context.Context, field graphql.CollectedField, obj *model.APISleepSchedule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WholeWeekdaysOff, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalNInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_wholeWeekdaysOff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SmartSelection_maxTasks(ctx context.Context, field graphql.CollectedField, obj *model.APISmartSelection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SmartSelection_maxTasks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxTasks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SmartSelection_maxTasks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SmartSelection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SmartSelection_mandatoryTasks(ctx context.Context, field graphql.CollectedField, obj *model.APISmartSelection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SmartSelection_mandatoryTasks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MandatoryTasks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SmartSelection_mandatoryTasks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SmartSelection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_id(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_createdBy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedBy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_creationTime(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_creationTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_creationTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_displayName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlackConfig_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Snapshot_displayName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlackConfig",
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_expiration(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_expiration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Expiration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_expiration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_noExpiration(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_noExpiration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NoExpiration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_noExpiration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_region(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_region(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Region, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_region(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_size(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_sourceHostID(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_sourceHostID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SourceHostID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_sourceHostID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_sourceVolumeID(ctx context.Context, field graphql.CollectedField, obj *model.APISnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Snapshot_sourceVolumeID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SourceVolumeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Snapshot_sourceVolumeID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -49223,6 +52980,223 @@ func (ec *executionContext) fieldContext_SpruceConfig_ui(ctx context.Context, fi
 	return fc, nil
 }
 
+func (ec *executionContext) _StackInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.APIStackInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StackInfo_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StackInfo_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StackInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StackInfo_commit(ctx context.Context, field graphql.CollectedField, obj *model.APIStackInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StackInfo_commit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StackInfo_commit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StackInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StackInfo_parentPatchId(ctx context.Context, field graphql.CollectedField, obj *model.APIStackInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StackInfo_parentPatchId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentPatchID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StackInfo_parentPatchId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StackInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StackInfo_position(ctx context.Context, field graphql.CollectedField, obj *model.APIStackInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StackInfo_position(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StackInfo_position(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StackInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StackInfo_revision(ctx context.Context, field graphql.CollectedField, obj *model.APIStackInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StackInfo_revision(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revision, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StackInfo_revision(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StackInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _StatusCount_count(ctx context.Context, field graphql.CollectedField, obj *task.StatusCount) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_StatusCount_count(ctx, field)
 	if err != nil {
@@ -49311,6 +53285,179 @@ func (ec *executionContext) fieldContext_StatusCount_status(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _StepbackCulprit_revision(ctx context.Context, field graphql.CollectedField, obj *model.APIStepbackCulprit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StepbackCulprit_revision(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revision, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StepbackCulprit_revision(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StepbackCulprit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StepbackCulprit_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APIStepbackCulprit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StepbackCulprit_taskId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskId, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StepbackCulprit_taskId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StepbackCulprit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StepbackCulprit_testName(ctx context.Context, field graphql.CollectedField, obj *model.APIStepbackCulprit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StepbackCulprit_testName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TestName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StepbackCulprit_testName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StepbackCulprit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StepbackCulprit_versionId(ctx context.Context, field graphql.CollectedField, obj *model.APIStepbackCulprit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StepbackCulprit_versionId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VersionId, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StepbackCulprit_versionId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StepbackCulprit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscriber_emailSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Subscriber_emailSubscriber(ctx, field)
 	if err != nil {
@@ -50316,6 +54463,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(ctx context.Context, fiel
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -51083,6 +55232,8 @@ func (ec *executionContext) fieldContext_Task_details(ctx context.Context, field
 				return ec.fieldContext_TaskEndDetail_description(ctx, field)
 			case "oomTracker":
 				return ec.fieldContext_TaskEndDetail_oomTracker(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_TaskEndDetail_resourceUsage(ctx, field)
 			case "status":
 				return ec.fieldContext_TaskEndDetail_status(ctx, field)
 			case "timedOut":
@@ -51390,6 +55541,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(ctx context.Context, f
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -51747,6 +55900,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(ctx context.Con
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -52467,6 +56622,12 @@ func (ec *executionContext) fieldContext_Task_patch(ctx context.Context, field g
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -53147,6 +57308,57 @@ func (ec *executionContext) fieldContext_Task_status(ctx context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_stepbackCulprit(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Task_stepbackCulprit(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StepbackCulprit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APIStepbackCulprit)
+	fc.Result = res
+	return ec.marshalOStepbackCulprit2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIStepbackCulprit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Task_stepbackCulprit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "revision":
+				return ec.fieldContext_StepbackCulprit_revision(ctx, field)
+			case "taskId":
+				return ec.fieldContext_StepbackCulprit_taskId(ctx, field)
+			case "testName":
+				return ec.fieldContext_StepbackCulprit_testName(ctx, field)
+			case "versionId":
+				return ec.fieldContext_StepbackCulprit_versionId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StepbackCulprit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_taskFiles(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Task_taskFiles(ctx, field)
 	if err != nil {
@@ -53708,6 +57920,182 @@ func (ec *executionContext) fieldContext_TaskAnnotationSettings_jiraCustomFields
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskCgroupSettings_cpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCgroupSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskCgroupSettings_cpuPercent(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CPUPercent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskCgroupSettings_cpuPercent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCgroupSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCgroupSettings_enabled(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCgroupSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskCgroupSettings_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskCgroupSettings_enabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCgroupSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCgroupSettings_memoryLimitMb(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCgroupSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskCgroupSettings_memoryLimitMb(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MemoryLimitMB, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskCgroupSettings_memoryLimitMb(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCgroupSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCgroupSettings_numProcesses(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCgroupSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskCgroupSettings_numProcesses(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumProcesses, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskCgroupSettings_numProcesses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCgroupSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskContainerCreationOpts_image(ctx context.Context, field graphql.CollectedField, obj *model.APIPodTaskContainerCreationOptions) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskContainerCreationOpts_image(ctx, field)
 	if err != nil {
@@ -54063,6 +58451,63 @@ func (ec *executionContext) fieldContext_TaskEndDetail_oomTracker(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskEndDetail_resourceUsage(ctx context.Context, field graphql.CollectedField, obj *model.ApiTaskEndDetail) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskEndDetail_resourceUsage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResourceUsage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APITaskResourceUsage)
+	fc.Result = res
+	return ec.marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskEndDetail_resourceUsage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskEndDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "avgCpuPercent":
+				return ec.fieldContext_TaskResourceUsage_avgCpuPercent(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_TaskResourceUsage_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_TaskResourceUsage_diskWriteBytes(ctx, field)
+			case "durationSecs":
+				return ec.fieldContext_TaskResourceUsage_durationSecs(ctx, field)
+			case "networkBytesReceived":
+				return ec.fieldContext_TaskResourceUsage_networkBytesReceived(ctx, field)
+			case "networkBytesSent":
+				return ec.fieldContext_TaskResourceUsage_networkBytesSent(ctx, field)
+			case "peakMemoryBytes":
+				return ec.fieldContext_TaskResourceUsage_peakMemoryBytes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskResourceUsage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskEndDetail_status(ctx context.Context, field graphql.CollectedField, obj *model.ApiTaskEndDetail) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskEndDetail_status(ctx, field)
 	if err != nil {
@@ -56295,6 +60740,314 @@ func (ec *executionContext) fieldContext_TaskQueueItem_version(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskResourceUsage_avgCpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_avgCpuPercent(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvgCPUPercent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_avgCpuPercent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_diskReadBytes(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_diskReadBytes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DiskReadBytes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_diskReadBytes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_diskWriteBytes(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_diskWriteBytes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DiskWriteBytes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_diskWriteBytes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_durationSecs(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_durationSecs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DurationSecs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_durationSecs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_networkBytesReceived(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_networkBytesReceived(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NetworkBytesReceived, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_networkBytesReceived(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_networkBytesSent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_networkBytesSent(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NetworkBytesSent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_networkBytesSent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_peakMemoryBytes(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_peakMemoryBytes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeakMemoryBytes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_peakMemoryBytes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskSpecifier_patchAlias(ctx context.Context, field graphql.CollectedField, obj *model.APITaskSpecifier) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskSpecifier_patchAlias(ctx, field)
 	if err != nil {
@@ -58816,6 +63569,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(ctx context.Contex
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -59762,6 +64517,10 @@ func (ec *executionContext) fieldContext_UserSettings_notifications(ctx context.
 				return ec.fieldContext_Notifications_spawnHostOutcome(ctx, field)
 			case "spawnHostOutcomeId":
 				return ec.fieldContext_Notifications_spawnHostOutcomeId(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Notifications_stepbackCulprit(ctx, field)
+			case "stepbackCulpritId":
+				return ec.fieldContext_Notifications_stepbackCulpritId(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Notifications", field.Name)
 		},
@@ -61323,6 +66082,12 @@ func (ec *executionContext) fieldContext_Version_patch(ctx context.Context, fiel
 				return ec.fieldContext_Patch_projectIdentifier(ctx, field)
 			case "projectMetadata":
 				return ec.fieldContext_Patch_projectMetadata(ctx, field)
+			case "skippedTasks":
+				return ec.fieldContext_Patch_skippedTasks(ctx, field)
+			case "stack":
+				return ec.fieldContext_Patch_stack(ctx, field)
+			case "stackPatches":
+				return ec.fieldContext_Patch_stackPatches(ctx, field)
 			case "status":
 				return ec.fieldContext_Patch_status(ctx, field)
 			case "taskCount":
@@ -62476,6 +67241,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(ctx context.Context,
 				return ec.fieldContext_Task_startTime(ctx, field)
 			case "status":
 				return ec.fieldContext_Task_status(ctx, field)
+			case "stepbackCulprit":
+				return ec.fieldContext_Task_stepbackCulprit(ctx, field)
 			case "taskFiles":
 				return ec.fieldContext_Task_taskFiles(ctx, field)
 			case "taskGroup":
@@ -62994,6 +67761,8 @@ func (ec *executionContext) fieldContext_Volume_host(ctx context.Context, field
 				return ec.fieldContext_Host_provider(ctx, field)
 			case "runningTask":
 				return ec.fieldContext_Host_runningTask(ctx, field)
+			case "sleepSchedule":
+				return ec.fieldContext_Host_sleepSchedule(ctx, field)
 			case "startedBy":
 				return ec.fieldContext_Host_startedBy(ctx, field)
 			case "status":
@@ -65637,7 +70406,7 @@ func (ec *executionContext) unmarshalInputBootstrapSettingsInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"clientDir", "communication", "env", "jasperBinaryDir", "jasperCredentialsPath", "method", "preconditionScripts", "resourceLimits", "rootDir", "serviceUser", "shellPath"}
+	fieldsInOrder := [...]string{"clientDir", "communication", "env", "jasperBinaryDir", "jasperCredentialsPath", "method", "preconditionScripts", "resourceLimits", "rootDir", "serviceUser", "shellPath", "taskCgroups"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -65747,6 +70516,15 @@ func (ec *executionContext) unmarshalInputBootstrapSettingsInput(ctx context.Con
 				return it, err
 			}
 			it.ShellPath = data
+		case "taskCgroups":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taskCgroups"))
+			data, err := ec.unmarshalNTaskCgroupSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCgroupSettings(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TaskCgroups = data
 		}
 	}
 
@@ -65899,7 +70677,7 @@ func (ec *executionContext) unmarshalInputCommitQueueParamsInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"enabled", "mergeMethod", "mergeQueue", "message"}
+	fieldsInOrder := [...]string{"enabled", "flakyTestThreshold", "mergeMethod", "mergeQueue", "message", "requiredChecks"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -65915,6 +70693,15 @@ func (ec *executionContext) unmarshalInputCommitQueueParamsInput(ctx context.Con
 				return it, err
 			}
 			it.Enabled = data
+		case "flakyTestThreshold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flakyTestThreshold"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlakyTestThreshold = data
 		case "mergeMethod":
 			var err error
 
@@ -65942,6 +70729,15 @@ func (ec *executionContext) unmarshalInputCommitQueueParamsInput(ctx context.Con
 				return it, err
 			}
 			it.Message = data
+		case "requiredChecks":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiredChecks"))
+			data, err := ec.unmarshalOMergeQueueRequiredChecksInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecksᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RequiredChecks = data
 		}
 	}
 
@@ -66174,6 +70970,53 @@ func (ec *executionContext) unmarshalInputCreateProjectInput(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCreateSnapshotInput(ctx context.Context, obj interface{}) (CreateSnapshotInput, error) {
+	var it CreateSnapshotInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"displayName", "hostId", "volumeId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "displayName":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("displayName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DisplayName = data
+		case "hostId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hostId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HostID = data
+		case "volumeId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("volumeId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VolumeID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputDeleteDistroInput(ctx context.Context, obj interface{}) (DeleteDistroInput, error) {
 	var it DeleteDistroInput
 	asMap := map[string]interface{}{}
@@ -66697,7 +71540,7 @@ func (ec *executionContext) unmarshalInputEditSpawnHostInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"addedInstanceTags", "deletedInstanceTags", "displayName", "expiration", "hostId", "instanceType", "noExpiration", "publicKey", "savePublicKey", "servicePassword", "volume"}
+	fieldsInOrder := [...]string{"addedInstanceTags", "deletedInstanceTags", "displayName", "expiration", "hostId", "instanceType", "noExpiration", "publicKey", "savePublicKey", "servicePassword", "sleepSchedule", "volume"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -66794,6 +71637,15 @@ func (ec *executionContext) unmarshalInputEditSpawnHostInput(ctx context.Context
 				return it, err
 			}
 			it.ServicePassword = data
+		case "sleepSchedule":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sleepSchedule"))
+			data, err := ec.unmarshalOSleepScheduleInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISleepSchedule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SleepSchedule = data
 		case "volume":
 			var err error
 
@@ -67403,6 +72255,71 @@ func (ec *executionContext) unmarshalInputMainlineCommitsOptions(ctx context.Con
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputMergeQueueRequiredChecksInput(ctx context.Context, obj interface{}) (model.APIMergeQueueRequiredChecks, error) {
+	var it model.APIMergeQueueRequiredChecks
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"branchRegex", "taskRegex", "taskTags", "variantRegex", "variantTags"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "branchRegex":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("branchRegex"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BranchRegex = data
+		case "taskRegex":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taskRegex"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TaskRegex = data
+		case "taskTags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taskTags"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TaskTags = data
+		case "variantRegex":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantRegex"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VariantRegex = data
+		case "variantTags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantTags"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VariantTags = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputMetadataLinkInput(ctx context.Context, obj interface{}) (model.APIMetadataLink, error) {
 	var it model.APIMetadataLink
 	asMap := map[string]interface{}{}
@@ -67512,7 +72429,7 @@ func (ec *executionContext) unmarshalInputNotificationsInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"buildBreak", "commitQueue", "patchFinish", "patchFirstFailure", "spawnHostExpiration", "spawnHostOutcome"}
+	fieldsInOrder := [...]string{"buildBreak", "commitQueue", "patchFinish", "patchFirstFailure", "spawnHostExpiration", "spawnHostOutcome", "stepbackCulprit"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -67573,6 +72490,15 @@ func (ec *executionContext) unmarshalInputNotificationsInput(ctx context.Context
 				return it, err
 			}
 			it.SpawnHostOutcome = data
+		case "stepbackCulprit":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stepbackCulprit"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StepbackCulprit = data
 		}
 	}
 
@@ -68117,7 +73043,7 @@ func (ec *executionContext) unmarshalInputProjectAliasInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "alias", "description", "gitTag", "remotePath", "task", "taskTags", "variant", "variantTags", "parameters"}
+	fieldsInOrder := [...]string{"id", "alias", "description", "gitTag", "remotePath", "task", "taskTags", "variant", "variantTags", "parameters", "smartSelection"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -68214,6 +73140,15 @@ func (ec *executionContext) unmarshalInputProjectAliasInput(ctx context.Context,
 				return it, err
 			}
 			it.Parameters = data
+		case "smartSelection":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("smartSelection"))
+			data, err := ec.unmarshalOSmartSelectionInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISmartSelection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SmartSelection = data
 		}
 	}
 
@@ -69497,6 +74432,109 @@ func (ec *executionContext) unmarshalInputSetLastRevisionInput(ctx context.Conte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputSleepScheduleInput(ctx context.Context, obj interface{}) (model.APISleepSchedule, error) {
+	var it model.APISleepSchedule
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"dailyStartTime", "dailyStopTime", "temporarilyExemptUntil", "timeZone", "wholeWeekdaysOff"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "dailyStartTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dailyStartTime"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DailyStartTime = data
+		case "dailyStopTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dailyStopTime"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DailyStopTime = data
+		case "temporarilyExemptUntil":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("temporarilyExemptUntil"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TemporarilyExemptUntil = data
+		case "timeZone":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeZone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeZone = data
+		case "wholeWeekdaysOff":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wholeWeekdaysOff"))
+			data, err := ec.unmarshalNInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WholeWeekdaysOff = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSmartSelectionInput(ctx context.Context, obj interface{}) (model.APISmartSelection, error) {
+	var it model.APISmartSelection
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"maxTasks", "mandatoryTasks"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "maxTasks":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxTasks"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxTasks = data
+		case "mandatoryTasks":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mandatoryTasks"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MandatoryTasks = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSortOrder(ctx context.Context, obj interface{}) (SortOrder, error) {
 	var it SortOrder
 	asMap := map[string]interface{}{}
@@ -69542,7 +74580,7 @@ func (ec *executionContext) unmarshalInputSpawnHostInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"distroId", "expiration", "homeVolumeSize", "isVirtualWorkStation", "noExpiration", "publicKey", "region", "savePublicKey", "setUpScript", "spawnHostsStartedByTask", "taskId", "taskSync", "useProjectSetupScript", "userDataScript", "useTaskConfig", "volumeId"}
+	fieldsInOrder := [...]string{"distroId", "expiration", "homeVolumeSize", "homeVolumeSnapshotId", "isVirtualWorkStation", "noExpiration", "publicKey", "region", "savePublicKey", "setUpScript", "spawnHostsStartedByTask", "taskId", "taskSync", "useProjectSetupScript", "userDataScript", "useTaskConfig", "volumeId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -69576,6 +74614,15 @@ func (ec *executionContext) unmarshalInputSpawnHostInput(ctx context.Context, ob
 				return it, err
 			}
 			it.HomeVolumeSize = data
+		case "homeVolumeSnapshotId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("homeVolumeSnapshotId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HomeVolumeSnapshotID = data
 		case "isVirtualWorkStation":
 			var err error
 
@@ -69706,7 +74753,7 @@ func (ec *executionContext) unmarshalInputSpawnVolumeInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"availabilityZone", "expiration", "host", "noExpiration", "size", "type"}
+	fieldsInOrder := [...]string{"availabilityZone", "expiration", "host", "noExpiration", "size", "snapshotId", "type"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -69758,6 +74805,15 @@ func (ec *executionContext) unmarshalInputSpawnVolumeInput(ctx context.Context,
 				return it, err
 			}
 			it.Size = data
+		case "snapshotId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("snapshotId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SnapshotID = data
 		case "type":
 			var err error
 
@@ -69970,6 +75026,62 @@ func (ec *executionContext) unmarshalInputTaskAnnotationSettingsInput(ctx contex
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputTaskCgroupSettingsInput(ctx context.Context, obj interface{}) (model.APITaskCgroupSettings, error) {
+	var it model.APITaskCgroupSettings
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"cpuPercent", "enabled", "memoryLimitMb", "numProcesses"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "cpuPercent":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cpuPercent"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CPUPercent = data
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Enabled = data
+		case "memoryLimitMb":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("memoryLimitMb"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MemoryLimitMB = data
+		case "numProcesses":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("numProcesses"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NumProcesses = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputTaskFilterOptions(ctx context.Context, obj interface{}) (TaskFilterOptions, error) {
 	var it TaskFilterOptions
 	asMap := map[string]interface{}{}
@@ -71358,6 +76470,11 @@ func (ec *executionContext) _BootstrapSettings(ctx context.Context, sel ast.Sele
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "taskCgroups":
+			out.Values[i] = ec._BootstrapSettings_taskCgroups(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -71844,6 +76961,8 @@ func (ec *executionContext) _CommitQueueParams(ctx context.Context, sel ast.Sele
 			out.Values[i] = graphql.MarshalString("CommitQueueParams")
 		case "enabled":
 			out.Values[i] = ec._CommitQueueParams_enabled(ctx, field, obj)
+		case "flakyTestThreshold":
+			out.Values[i] = ec._CommitQueueParams_flakyTestThreshold(ctx, field, obj)
 		case "mergeMethod":
 			out.Values[i] = ec._CommitQueueParams_mergeMethod(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -71859,6 +76978,8 @@ func (ec *executionContext) _CommitQueueParams(ctx context.Context, sel ast.Sele
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "requiredChecks":
+			out.Values[i] = ec._CommitQueueParams_requiredChecks(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -73822,6 +78943,8 @@ func (ec *executionContext) _Host(ctx context.Context, sel ast.SelectionSet, obj
 			}
 		case "runningTask":
 			out.Values[i] = ec._Host_runningTask(ctx, field, obj)
+		case "sleepSchedule":
+			out.Values[i] = ec._Host_sleepSchedule(ctx, field, obj)
 		case "startedBy":
 			out.Values[i] = ec._Host_startedBy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -74824,83 +79947,233 @@ func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var logkeeperBuildImplementors = []string{"LogkeeperBuild"}
+var logkeeperBuildImplementors = []string{"LogkeeperBuild"}
+
+func (ec *executionContext) _LogkeeperBuild(ctx context.Context, sel ast.SelectionSet, obj *plank.Build) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logkeeperBuildImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogkeeperBuild")
+		case "id":
+			out.Values[i] = ec._LogkeeperBuild_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "builder":
+			out.Values[i] = ec._LogkeeperBuild_builder(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "buildNum":
+			out.Values[i] = ec._LogkeeperBuild_buildNum(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "taskId":
+			out.Values[i] = ec._LogkeeperBuild_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "taskExecution":
+			out.Values[i] = ec._LogkeeperBuild_taskExecution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tests":
+			out.Values[i] = ec._LogkeeperBuild_tests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "task":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LogkeeperBuild_task(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var logkeeperTestImplementors = []string{"LogkeeperTest"}
+
+func (ec *executionContext) _LogkeeperTest(ctx context.Context, sel ast.SelectionSet, obj *plank.Test) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logkeeperTestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogkeeperTest")
+		case "id":
+			out.Values[i] = ec._LogkeeperTest_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._LogkeeperTest_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "buildId":
+			out.Values[i] = ec._LogkeeperTest_buildId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._LogkeeperTest_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskExecution":
+			out.Values[i] = ec._LogkeeperTest_taskExecution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "phase":
+			out.Values[i] = ec._LogkeeperTest_phase(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "command":
+			out.Values[i] = ec._LogkeeperTest_command(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mainlineCommitVersionImplementors = []string{"MainlineCommitVersion"}
+
+func (ec *executionContext) _MainlineCommitVersion(ctx context.Context, sel ast.SelectionSet, obj *MainlineCommitVersion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mainlineCommitVersionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MainlineCommitVersion")
+		case "rolledUpVersions":
+			out.Values[i] = ec._MainlineCommitVersion_rolledUpVersions(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._MainlineCommitVersion_version(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mainlineCommitsImplementors = []string{"MainlineCommits"}
 
-func (ec *executionContext) _LogkeeperBuild(ctx context.Context, sel ast.SelectionSet, obj *plank.Build) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, logkeeperBuildImplementors)
+func (ec *executionContext) _MainlineCommits(ctx context.Context, sel ast.SelectionSet, obj *MainlineCommits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mainlineCommitsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LogkeeperBuild")
-		case "id":
-			out.Values[i] = ec._LogkeeperBuild_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "builder":
-			out.Values[i] = ec._LogkeeperBuild_builder(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "buildNum":
-			out.Values[i] = ec._LogkeeperBuild_buildNum(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "taskId":
-			out.Values[i] = ec._LogkeeperBuild_taskId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "taskExecution":
-			out.Values[i] = ec._LogkeeperBuild_taskExecution(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "tests":
-			out.Values[i] = ec._LogkeeperBuild_tests(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("MainlineCommits")
+		case "nextPageOrderNumber":
+			out.Values[i] = ec._MainlineCommits_nextPageOrderNumber(ctx, field, obj)
+		case "prevPageOrderNumber":
+			out.Values[i] = ec._MainlineCommits_prevPageOrderNumber(ctx, field, obj)
+		case "versions":
+			out.Values[i] = ec._MainlineCommits_versions(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "task":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LogkeeperBuild_task(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -74924,130 +80197,43 @@ func (ec *executionContext) _LogkeeperBuild(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var logkeeperTestImplementors = []string{"LogkeeperTest"}
+var manifestImplementors = []string{"Manifest"}
 
-func (ec *executionContext) _LogkeeperTest(ctx context.Context, sel ast.SelectionSet, obj *plank.Test) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, logkeeperTestImplementors)
+func (ec *executionContext) _Manifest(ctx context.Context, sel ast.SelectionSet, obj *Manifest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manifestImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LogkeeperTest")
+			out.Values[i] = graphql.MarshalString("Manifest")
 		case "id":
-			out.Values[i] = ec._LogkeeperTest_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._LogkeeperTest_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "buildId":
-			out.Values[i] = ec._LogkeeperTest_buildId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "taskId":
-			out.Values[i] = ec._LogkeeperTest_taskId(ctx, field, obj)
+			out.Values[i] = ec._Manifest_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "taskExecution":
-			out.Values[i] = ec._LogkeeperTest_taskExecution(ctx, field, obj)
+		case "branch":
+			out.Values[i] = ec._Manifest_branch(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "phase":
-			out.Values[i] = ec._LogkeeperTest_phase(ctx, field, obj)
+		case "isBase":
+			out.Values[i] = ec._Manifest_isBase(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "command":
-			out.Values[i] = ec._LogkeeperTest_command(ctx, field, obj)
+		case "moduleOverrides":
+			out.Values[i] = ec._Manifest_moduleOverrides(ctx, field, obj)
+		case "modules":
+			out.Values[i] = ec._Manifest_modules(ctx, field, obj)
+		case "project":
+			out.Values[i] = ec._Manifest_project(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var mainlineCommitVersionImplementors = []string{"MainlineCommitVersion"}
-
-func (ec *executionContext) _MainlineCommitVersion(ctx context.Context, sel ast.SelectionSet, obj *MainlineCommitVersion) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mainlineCommitVersionImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MainlineCommitVersion")
-		case "rolledUpVersions":
-			out.Values[i] = ec._MainlineCommitVersion_rolledUpVersions(ctx, field, obj)
-		case "version":
-			out.Values[i] = ec._MainlineCommitVersion_version(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var mainlineCommitsImplementors = []string{"MainlineCommits"}
-
-func (ec *executionContext) _MainlineCommits(ctx context.Context, sel ast.SelectionSet, obj *MainlineCommits) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mainlineCommitsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MainlineCommits")
-		case "nextPageOrderNumber":
-			out.Values[i] = ec._MainlineCommits_nextPageOrderNumber(ctx, field, obj)
-		case "prevPageOrderNumber":
-			out.Values[i] = ec._MainlineCommits_prevPageOrderNumber(ctx, field, obj)
-		case "versions":
-			out.Values[i] = ec._MainlineCommits_versions(ctx, field, obj)
+		case "revision":
+			out.Values[i] = ec._Manifest_revision(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -75074,46 +80260,30 @@ func (ec *executionContext) _MainlineCommits(ctx context.Context, sel ast.Select
 	return out
 }
 
-var manifestImplementors = []string{"Manifest"}
+var mergeQueueRequiredChecksImplementors = []string{"MergeQueueRequiredChecks"}
 
-func (ec *executionContext) _Manifest(ctx context.Context, sel ast.SelectionSet, obj *Manifest) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, manifestImplementors)
+func (ec *executionContext) _MergeQueueRequiredChecks(ctx context.Context, sel ast.SelectionSet, obj *model.APIMergeQueueRequiredChecks) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mergeQueueRequiredChecksImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Manifest")
-		case "id":
-			out.Values[i] = ec._Manifest_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "branch":
-			out.Values[i] = ec._Manifest_branch(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isBase":
-			out.Values[i] = ec._Manifest_isBase(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "moduleOverrides":
-			out.Values[i] = ec._Manifest_moduleOverrides(ctx, field, obj)
-		case "modules":
-			out.Values[i] = ec._Manifest_modules(ctx, field, obj)
-		case "project":
-			out.Values[i] = ec._Manifest_project(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "revision":
-			out.Values[i] = ec._Manifest_revision(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("MergeQueueRequiredChecks")
+		case "branchRegex":
+			out.Values[i] = ec._MergeQueueRequiredChecks_branchRegex(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "taskRegex":
+			out.Values[i] = ec._MergeQueueRequiredChecks_taskRegex(ctx, field, obj)
+		case "taskTags":
+			out.Values[i] = ec._MergeQueueRequiredChecks_taskTags(ctx, field, obj)
+		case "variantRegex":
+			out.Values[i] = ec._MergeQueueRequiredChecks_variantRegex(ctx, field, obj)
+		case "variantTags":
+			out.Values[i] = ec._MergeQueueRequiredChecks_variantTags(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -75503,6 +80673,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "rollbackProjectVar":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rollbackProjectVar(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rollbackRepoVar":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rollbackRepoVar(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "saveProjectSettingsForSection":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_saveProjectSettingsForSection(ctx, field)
@@ -75531,6 +80715,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "createSnapshot":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createSnapshot(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "detachVolumeFromHost":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_detachVolumeFromHost(ctx, field)
@@ -75566,6 +80757,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "removeSnapshot":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeSnapshot(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "removeVolume":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_removeVolume(ctx, field)
@@ -75827,6 +81025,10 @@ func (ec *executionContext) _Notifications(ctx context.Context, sel ast.Selectio
 			out.Values[i] = ec._Notifications_spawnHostOutcome(ctx, field, obj)
 		case "spawnHostOutcomeId":
 			out.Values[i] = ec._Notifications_spawnHostOutcomeId(ctx, field, obj)
+		case "stepbackCulprit":
+			out.Values[i] = ec._Notifications_stepbackCulprit(ctx, field, obj)
+		case "stepbackCulpritId":
+			out.Values[i] = ec._Notifications_stepbackCulpritId(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -76369,6 +81571,49 @@ func (ec *executionContext) _Patch(ctx context.Context, sel ast.SelectionSet, ob
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "skippedTasks":
+			out.Values[i] = ec._Patch_skippedTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "stack":
+			out.Values[i] = ec._Patch_stack(ctx, field, obj)
+		case "stackPatches":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patch_stackPatches(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "status":
 			out.Values[i] = ec._Patch_status(ctx, field, obj)
@@ -77891,6 +83136,8 @@ func (ec *executionContext) _ProjectAlias(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "smartSelection":
+			out.Values[i] = ec._ProjectAlias_smartSelection(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -78361,6 +83608,86 @@ func (ec *executionContext) _ProjectSettings(ctx context.Context, sel ast.Select
 	return out
 }
 
+var projectVarVersionImplementors = []string{"ProjectVarVersion"}
+
+func (ec *executionContext) _ProjectVarVersion(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectVarVersion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, projectVarVersionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProjectVarVersion")
+		case "adminOnly":
+			out.Values[i] = ec._ProjectVarVersion_adminOnly(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "canRollback":
+			out.Values[i] = ec._ProjectVarVersion_canRollback(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._ProjectVarVersion_createdAt(ctx, field, obj)
+		case "deleted":
+			out.Values[i] = ec._ProjectVarVersion_deleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "externalSecret":
+			out.Values[i] = ec._ProjectVarVersion_externalSecret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ProjectVarVersion_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "private":
+			out.Values[i] = ec._ProjectVarVersion_private(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "projectId":
+			out.Values[i] = ec._ProjectVarVersion_projectId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "user":
+			out.Values[i] = ec._ProjectVarVersion_user(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._ProjectVarVersion_value(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._ProjectVarVersion_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var projectVarsImplementors = []string{"ProjectVars"}
 
 func (ec *executionContext) _ProjectVars(ctx context.Context, sel ast.SelectionSet, obj *model.APIProjectVars) graphql.Marshaler {
@@ -79000,6 +84327,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "projectVarVersions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_projectVarVersions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "repoEvents":
 			field := field
@@ -79044,6 +84393,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "repoVarVersions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_repoVarVersions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "viewableProjectRefs":
 			field := field
@@ -79110,6 +84481,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "mySnapshots":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_mySnapshots(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "logkeeperBuildMetadata":
 			field := field
@@ -79445,6 +84838,11 @@ func (ec *executionContext) _RepoCommitQueueParams(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "flakyTestThreshold":
+			out.Values[i] = ec._RepoCommitQueueParams_flakyTestThreshold(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "mergeMethod":
 			out.Values[i] = ec._RepoCommitQueueParams_mergeMethod(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -79460,6 +84858,8 @@ func (ec *executionContext) _RepoCommitQueueParams(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "requiredChecks":
+			out.Values[i] = ec._RepoCommitQueueParams_requiredChecks(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -80096,24 +85496,161 @@ func (ec *executionContext) _SSHKey(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var saveDistroPayloadImplementors = []string{"SaveDistroPayload"}
+var saveDistroPayloadImplementors = []string{"SaveDistroPayload"}
+
+func (ec *executionContext) _SaveDistroPayload(ctx context.Context, sel ast.SelectionSet, obj *SaveDistroPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, saveDistroPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SaveDistroPayload")
+		case "distro":
+			out.Values[i] = ec._SaveDistroPayload_distro(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hostCount":
+			out.Values[i] = ec._SaveDistroPayload_hostCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var searchReturnInfoImplementors = []string{"SearchReturnInfo"}
+
+func (ec *executionContext) _SearchReturnInfo(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.SearchReturnInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchReturnInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchReturnInfo")
+		case "featuresURL":
+			out.Values[i] = ec._SearchReturnInfo_featuresURL(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._SearchReturnInfo_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "search":
+			out.Values[i] = ec._SearchReturnInfo_search(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._SearchReturnInfo_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var selectorImplementors = []string{"Selector"}
+
+func (ec *executionContext) _Selector(ctx context.Context, sel ast.SelectionSet, obj *model.APISelector) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, selectorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Selector")
+		case "data":
+			out.Values[i] = ec._Selector_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Selector_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var setLastRevisionPayloadImplementors = []string{"SetLastRevisionPayload"}
 
-func (ec *executionContext) _SaveDistroPayload(ctx context.Context, sel ast.SelectionSet, obj *SaveDistroPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, saveDistroPayloadImplementors)
+func (ec *executionContext) _SetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, obj *SetLastRevisionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setLastRevisionPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SaveDistroPayload")
-		case "distro":
-			out.Values[i] = ec._SaveDistroPayload_distro(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "hostCount":
-			out.Values[i] = ec._SaveDistroPayload_hostCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SetLastRevisionPayload")
+		case "mergeBaseRevision":
+			out.Values[i] = ec._SetLastRevisionPayload_mergeBaseRevision(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -80140,34 +85677,29 @@ func (ec *executionContext) _SaveDistroPayload(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var searchReturnInfoImplementors = []string{"SearchReturnInfo"}
+var skippedTaskImplementors = []string{"SkippedTask"}
 
-func (ec *executionContext) _SearchReturnInfo(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.SearchReturnInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, searchReturnInfoImplementors)
+func (ec *executionContext) _SkippedTask(ctx context.Context, sel ast.SelectionSet, obj *model.APISkippedTask) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, skippedTaskImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SearchReturnInfo")
-		case "featuresURL":
-			out.Values[i] = ec._SearchReturnInfo_featuresURL(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "issues":
-			out.Values[i] = ec._SearchReturnInfo_issues(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SkippedTask")
+		case "reason":
+			out.Values[i] = ec._SkippedTask_reason(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "search":
-			out.Values[i] = ec._SearchReturnInfo_search(ctx, field, obj)
+		case "taskName":
+			out.Values[i] = ec._SkippedTask_taskName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "source":
-			out.Values[i] = ec._SearchReturnInfo_source(ctx, field, obj)
+		case "variant":
+			out.Values[i] = ec._SkippedTask_variant(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -80194,24 +85726,67 @@ func (ec *executionContext) _SearchReturnInfo(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var selectorImplementors = []string{"Selector"}
+var slackConfigImplementors = []string{"SlackConfig"}
 
-func (ec *executionContext) _Selector(ctx context.Context, sel ast.SelectionSet, obj *model.APISelector) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, selectorImplementors)
+func (ec *executionContext) _SlackConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APISlackConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, slackConfigImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Selector")
-		case "data":
-			out.Values[i] = ec._Selector_data(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec._Selector_type(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SlackConfig")
+		case "name":
+			out.Values[i] = ec._SlackConfig_name(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sleepScheduleImplementors = []string{"SleepSchedule"}
+
+func (ec *executionContext) _SleepSchedule(ctx context.Context, sel ast.SelectionSet, obj *model.APISleepSchedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sleepScheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SleepSchedule")
+		case "dailyStartTime":
+			out.Values[i] = ec._SleepSchedule_dailyStartTime(ctx, field, obj)
+		case "dailyStopTime":
+			out.Values[i] = ec._SleepSchedule_dailyStopTime(ctx, field, obj)
+		case "nextStartTime":
+			out.Values[i] = ec._SleepSchedule_nextStartTime(ctx, field, obj)
+		case "nextStopTime":
+			out.Values[i] = ec._SleepSchedule_nextStopTime(ctx, field, obj)
+		case "temporarilyExemptUntil":
+			out.Values[i] = ec._SleepSchedule_temporarilyExemptUntil(ctx, field, obj)
+		case "timeZone":
+			out.Values[i] = ec._SleepSchedule_timeZone(ctx, field, obj)
+		case "wholeWeekdaysOff":
+			out.Values[i] = ec._SleepSchedule_wholeWeekdaysOff(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -80238,22 +85813,24 @@ func (ec *executionContext) _Selector(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var setLastRevisionPayloadImplementors = []string{"SetLastRevisionPayload"}
+var smartSelectionImplementors = []string{"SmartSelection"}
 
-func (ec *executionContext) _SetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, obj *SetLastRevisionPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, setLastRevisionPayloadImplementors)
+func (ec *executionContext) _SmartSelection(ctx context.Context, sel ast.SelectionSet, obj *model.APISmartSelection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, smartSelectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SetLastRevisionPayload")
-		case "mergeBaseRevision":
-			out.Values[i] = ec._SetLastRevisionPayload_mergeBaseRevision(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SmartSelection")
+		case "maxTasks":
+			out.Values[i] = ec._SmartSelection_maxTasks(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "mandatoryTasks":
+			out.Values[i] = ec._SmartSelection_mandatoryTasks(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -80277,19 +85854,61 @@ func (ec *executionContext) _SetLastRevisionPayload(ctx context.Context, sel ast
 	return out
 }
 
-var slackConfigImplementors = []string{"SlackConfig"}
+var snapshotImplementors = []string{"Snapshot"}
 
-func (ec *executionContext) _SlackConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APISlackConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, slackConfigImplementors)
+func (ec *executionContext) _Snapshot(ctx context.Context, sel ast.SelectionSet, obj *model.APISnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, snapshotImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SlackConfig")
-		case "name":
-			out.Values[i] = ec._SlackConfig_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Snapshot")
+		case "id":
+			out.Values[i] = ec._Snapshot_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdBy":
+			out.Values[i] = ec._Snapshot_createdBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "creationTime":
+			out.Values[i] = ec._Snapshot_creationTime(ctx, field, obj)
+		case "displayName":
+			out.Values[i] = ec._Snapshot_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiration":
+			out.Values[i] = ec._Snapshot_expiration(ctx, field, obj)
+		case "noExpiration":
+			out.Values[i] = ec._Snapshot_noExpiration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "region":
+			out.Values[i] = ec._Snapshot_region(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "size":
+			out.Values[i] = ec._Snapshot_size(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sourceHostID":
+			out.Values[i] = ec._Snapshot_sourceHostID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sourceVolumeID":
+			out.Values[i] = ec._Snapshot_sourceVolumeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -80505,6 +86124,62 @@ func (ec *executionContext) _SpruceConfig(ctx context.Context, sel ast.Selection
 	return out
 }
 
+var stackInfoImplementors = []string{"StackInfo"}
+
+func (ec *executionContext) _StackInfo(ctx context.Context, sel ast.SelectionSet, obj *model.APIStackInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stackInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StackInfo")
+		case "id":
+			out.Values[i] = ec._StackInfo_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commit":
+			out.Values[i] = ec._StackInfo_commit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "parentPatchId":
+			out.Values[i] = ec._StackInfo_parentPatchId(ctx, field, obj)
+		case "position":
+			out.Values[i] = ec._StackInfo_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revision":
+			out.Values[i] = ec._StackInfo_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var statusCountImplementors = []string{"StatusCount"}
 
 func (ec *executionContext) _StatusCount(ctx context.Context, sel ast.SelectionSet, obj *task.StatusCount) graphql.Marshaler {
@@ -80549,6 +86224,57 @@ func (ec *executionContext) _StatusCount(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var stepbackCulpritImplementors = []string{"StepbackCulprit"}
+
+func (ec *executionContext) _StepbackCulprit(ctx context.Context, sel ast.SelectionSet, obj *model.APIStepbackCulprit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stepbackCulpritImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StepbackCulprit")
+		case "revision":
+			out.Values[i] = ec._StepbackCulprit_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._StepbackCulprit_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testName":
+			out.Values[i] = ec._StepbackCulprit_testName(ctx, field, obj)
+		case "versionId":
+			out.Values[i] = ec._StepbackCulprit_versionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var subscriberImplementors = []string{"Subscriber"}
 
 func (ec *executionContext) _Subscriber(ctx context.Context, sel ast.SelectionSet, obj *Subscriber) graphql.Marshaler {
@@ -81859,6 +87585,8 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "stepbackCulprit":
+			out.Values[i] = ec._Task_stepbackCulprit(ctx, field, obj)
 		case "taskFiles":
 			field := field
 
@@ -82109,6 +87837,60 @@ func (ec *executionContext) _TaskAnnotationSettings(ctx context.Context, sel ast
 	return out
 }
 
+var taskCgroupSettingsImplementors = []string{"TaskCgroupSettings"}
+
+func (ec *executionContext) _TaskCgroupSettings(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskCgroupSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskCgroupSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskCgroupSettings")
+		case "cpuPercent":
+			out.Values[i] = ec._TaskCgroupSettings_cpuPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "enabled":
+			out.Values[i] = ec._TaskCgroupSettings_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "memoryLimitMb":
+			out.Values[i] = ec._TaskCgroupSettings_memoryLimitMb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numProcesses":
+			out.Values[i] = ec._TaskCgroupSettings_numProcesses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var taskContainerCreationOptsImplementors = []string{"TaskContainerCreationOpts"}
 
 func (ec *executionContext) _TaskContainerCreationOpts(ctx context.Context, sel ast.SelectionSet, obj *model.APIPodTaskContainerCreationOptions) graphql.Marshaler {
@@ -82253,6 +88035,8 @@ func (ec *executionContext) _TaskEndDetail(ctx context.Context, sel ast.Selectio
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "resourceUsage":
+			out.Values[i] = ec._TaskEndDetail_resourceUsage(ctx, field, obj)
 		case "status":
 			out.Values[i] = ec._TaskEndDetail_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -82924,6 +88708,75 @@ func (ec *executionContext) _TaskQueueItem(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var taskResourceUsageImplementors = []string{"TaskResourceUsage"}
+
+func (ec *executionContext) _TaskResourceUsage(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskResourceUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskResourceUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskResourceUsage")
+		case "avgCpuPercent":
+			out.Values[i] = ec._TaskResourceUsage_avgCpuPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "diskReadBytes":
+			out.Values[i] = ec._TaskResourceUsage_diskReadBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "diskWriteBytes":
+			out.Values[i] = ec._TaskResourceUsage_diskWriteBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "durationSecs":
+			out.Values[i] = ec._TaskResourceUsage_durationSecs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "networkBytesReceived":
+			out.Values[i] = ec._TaskResourceUsage_networkBytesReceived(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "networkBytesSent":
+			out.Values[i] = ec._TaskResourceUsage_networkBytesSent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakMemoryBytes":
+			out.Values[i] = ec._TaskResourceUsage_peakMemoryBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var taskSpecifierImplementors = []string{"TaskSpecifier"}
 
 func (ec *executionContext) _TaskSpecifier(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskSpecifier) graphql.Marshaler {
@@ -85763,6 +91616,11 @@ func (ec *executionContext) unmarshalNCreateProjectInput2githubᚗcomᚋevergree
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCreateSnapshotInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCreateSnapshotInput(ctx context.Context, v interface{}) (CreateSnapshotInput, error) {
+	res, err := ec.unmarshalInputCreateSnapshotInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNDeleteDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐDeleteDistroInput(ctx context.Context, v interface{}) (DeleteDistroInput, error) {
 	res, err := ec.unmarshalInputDeleteDistroInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -86318,6 +92176,27 @@ func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.S
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) unmarshalNFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalFloatContext(*v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) marshalNGeneralSubscription2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISubscription(ctx context.Context, sel ast.SelectionSet, v model.APISubscription) graphql.Marshaler {
 	return ec._GeneralSubscription(ctx, sel, &v)
 }
@@ -86758,6 +92637,38 @@ func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNInt2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
@@ -87149,6 +93060,15 @@ func (ec *executionContext) marshalNMergeQueue2githubᚗcomᚋevergreenᚑciᚋe
 	return res
 }
 
+func (ec *executionContext) marshalNMergeQueueRequiredChecks2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecks(ctx context.Context, sel ast.SelectionSet, v model.APIMergeQueueRequiredChecks) graphql.Marshaler {
+	return ec._MergeQueueRequiredChecks(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNMergeQueueRequiredChecksInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecks(ctx context.Context, v interface{}) (model.APIMergeQueueRequiredChecks, error) {
+	res, err := ec.unmarshalInputMergeQueueRequiredChecksInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNMetStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐMetStatus(ctx context.Context, v interface{}) (MetStatus, error) {
 	var res MetStatus
 	err := res.UnmarshalGQL(v)
@@ -87999,6 +93919,60 @@ func (ec *executionContext) marshalNProjectSettingsSection2githubᚗcomᚋevergr
 	return v
 }
 
+func (ec *executionContext) marshalNProjectVarVersion2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVarVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIProjectVarVersion) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProjectVarVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVarVersion(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNProjectVarVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectVarVersion(ctx context.Context, sel ast.SelectionSet, v *model.APIProjectVarVersion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ProjectVarVersion(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNProvider2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐProvider(ctx context.Context, v interface{}) (Provider, error) {
 	var res Provider
 	err := res.UnmarshalGQL(v)
@@ -88033,7 +94007,126 @@ func (ec *executionContext) marshalNPublicKey2ᚕᚖgithubᚗcomᚋevergreenᚑc
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPublicKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPubKey(ctx, sel, v[i])
+			ret[i] = ec.marshalNPublicKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPubKey(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPublicKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPubKey(ctx context.Context, sel ast.SelectionSet, v *model.APIPubKey) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PublicKey(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPublicKeyInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPublicKeyInput(ctx context.Context, v interface{}) (PublicKeyInput, error) {
+	res, err := ec.unmarshalInputPublicKeyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNPublicKeyInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPublicKeyInput(ctx context.Context, v interface{}) (*PublicKeyInput, error) {
+	res, err := ec.unmarshalInputPublicKeyInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRepoCommitQueueParams2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommitQueueParams(ctx context.Context, sel ast.SelectionSet, v model.APICommitQueueParams) graphql.Marshaler {
+	return ec._RepoCommitQueueParams(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRepoSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectSettings(ctx context.Context, sel ast.SelectionSet, v model.APIProjectSettings) graphql.Marshaler {
+	return ec._RepoSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRepoSettings2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectSettings(ctx context.Context, sel ast.SelectionSet, v *model.APIProjectSettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RepoSettings(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRepoTaskSyncOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskSyncOptions(ctx context.Context, sel ast.SelectionSet, v model.APITaskSyncOptions) graphql.Marshaler {
+	return ec._RepoTaskSyncOptions(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRepoWorkstationConfig2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWorkstationConfig(ctx context.Context, sel ast.SelectionSet, v model.APIWorkstationConfig) graphql.Marshaler {
+	return ec._RepoWorkstationConfig(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx context.Context, v interface{}) (RequiredStatus, error) {
+	var res RequiredStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx context.Context, sel ast.SelectionSet, v RequiredStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNResourceLimits2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceLimits(ctx context.Context, sel ast.SelectionSet, v model.APIResourceLimits) graphql.Marshaler {
+	return ec._ResourceLimits(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNResourceLimitsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceLimits(ctx context.Context, v interface{}) (model.APIResourceLimits, error) {
+	res, err := ec.unmarshalInputResourceLimitsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx context.Context, v interface{}) (RoundingRule, error) {
+	var res RoundingRule
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx context.Context, sel ast.SelectionSet, v RoundingRule) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSSHKey2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKeyᚄ(ctx context.Context, sel ast.SelectionSet, v []*SSHKey) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSSHKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKey(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -88053,82 +94146,40 @@ func (ec *executionContext) marshalNPublicKey2ᚕᚖgithubᚗcomᚋevergreenᚑc
 	return ret
 }
 
-func (ec *executionContext) marshalNPublicKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPubKey(ctx context.Context, sel ast.SelectionSet, v *model.APIPubKey) graphql.Marshaler {
+func (ec *executionContext) marshalNSSHKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKey(ctx context.Context, sel ast.SelectionSet, v *SSHKey) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PublicKey(ctx, sel, v)
+	return ec._SSHKey(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPublicKeyInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPublicKeyInput(ctx context.Context, v interface{}) (PublicKeyInput, error) {
-	res, err := ec.unmarshalInputPublicKeyInput(ctx, v)
+func (ec *executionContext) unmarshalNSaveDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroInput(ctx context.Context, v interface{}) (SaveDistroInput, error) {
+	res, err := ec.unmarshalInputSaveDistroInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNPublicKeyInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPublicKeyInput(ctx context.Context, v interface{}) (*PublicKeyInput, error) {
-	res, err := ec.unmarshalInputPublicKeyInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNRepoCommitQueueParams2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICommitQueueParams(ctx context.Context, sel ast.SelectionSet, v model.APICommitQueueParams) graphql.Marshaler {
-	return ec._RepoCommitQueueParams(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNRepoSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectSettings(ctx context.Context, sel ast.SelectionSet, v model.APIProjectSettings) graphql.Marshaler {
-	return ec._RepoSettings(ctx, sel, &v)
+func (ec *executionContext) marshalNSaveDistroPayload2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroPayload(ctx context.Context, sel ast.SelectionSet, v SaveDistroPayload) graphql.Marshaler {
+	return ec._SaveDistroPayload(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNRepoSettings2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectSettings(ctx context.Context, sel ast.SelectionSet, v *model.APIProjectSettings) graphql.Marshaler {
+func (ec *executionContext) marshalNSaveDistroPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroPayload(ctx context.Context, sel ast.SelectionSet, v *SaveDistroPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepoSettings(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNRepoTaskSyncOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskSyncOptions(ctx context.Context, sel ast.SelectionSet, v model.APITaskSyncOptions) graphql.Marshaler {
-	return ec._RepoTaskSyncOptions(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNRepoWorkstationConfig2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWorkstationConfig(ctx context.Context, sel ast.SelectionSet, v model.APIWorkstationConfig) graphql.Marshaler {
-	return ec._RepoWorkstationConfig(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx context.Context, v interface{}) (RequiredStatus, error) {
-	var res RequiredStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNRequiredStatus2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRequiredStatus(ctx context.Context, sel ast.SelectionSet, v RequiredStatus) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNResourceLimits2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceLimits(ctx context.Context, sel ast.SelectionSet, v model.APIResourceLimits) graphql.Marshaler {
-	return ec._ResourceLimits(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalNResourceLimitsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceLimits(ctx context.Context, v interface{}) (model.APIResourceLimits, error) {
-	res, err := ec.unmarshalInputResourceLimitsInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx context.Context, v interface{}) (RoundingRule, error) {
-	var res RoundingRule
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._SaveDistroPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx context.Context, sel ast.SelectionSet, v RoundingRule) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNSelector2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx context.Context, sel ast.SelectionSet, v model.APISelector) graphql.Marshaler {
+	return ec._Selector(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSSHKey2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKeyᚄ(ctx context.Context, sel ast.SelectionSet, v []*SSHKey) graphql.Marshaler {
+func (ec *executionContext) marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APISelector) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -88152,7 +94203,7 @@ func (ec *executionContext) marshalNSSHKey2ᚕᚖgithubᚗcomᚋevergreenᚑci
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSSHKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKey(ctx, sel, v[i])
+			ret[i] = ec.marshalNSelector2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -88172,40 +94223,52 @@ func (ec *executionContext) marshalNSSHKey2ᚕᚖgithubᚗcomᚋevergreenᚑci
 	return ret
 }
 
-func (ec *executionContext) marshalNSSHKey2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSSHKey(ctx context.Context, sel ast.SelectionSet, v *SSHKey) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalNSelectorInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx context.Context, v interface{}) (model.APISelector, error) {
+	res, err := ec.unmarshalInputSelectorInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSelectorInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx context.Context, v interface{}) ([]model.APISelector, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.APISelector, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSelectorInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	return ec._SSHKey(ctx, sel, v)
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalNSaveDistroInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroInput(ctx context.Context, v interface{}) (SaveDistroInput, error) {
-	res, err := ec.unmarshalInputSaveDistroInput(ctx, v)
+func (ec *executionContext) unmarshalNSetLastRevisionInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionInput(ctx context.Context, v interface{}) (SetLastRevisionInput, error) {
+	res, err := ec.unmarshalInputSetLastRevisionInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNSaveDistroPayload2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroPayload(ctx context.Context, sel ast.SelectionSet, v SaveDistroPayload) graphql.Marshaler {
-	return ec._SaveDistroPayload(ctx, sel, &v)
+func (ec *executionContext) marshalNSetLastRevisionPayload2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, v SetLastRevisionPayload) graphql.Marshaler {
+	return ec._SetLastRevisionPayload(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSaveDistroPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSaveDistroPayload(ctx context.Context, sel ast.SelectionSet, v *SaveDistroPayload) graphql.Marshaler {
+func (ec *executionContext) marshalNSetLastRevisionPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, v *SetLastRevisionPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SaveDistroPayload(ctx, sel, v)
+	return ec._SetLastRevisionPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSelector2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx context.Context, sel ast.SelectionSet, v model.APISelector) graphql.Marshaler {
-	return ec._Selector(ctx, sel, &v)
+func (ec *executionContext) marshalNSkippedTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISkippedTask(ctx context.Context, sel ast.SelectionSet, v model.APISkippedTask) graphql.Marshaler {
+	return ec._SkippedTask(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APISelector) graphql.Marshaler {
+func (ec *executionContext) marshalNSkippedTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISkippedTaskᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APISkippedTask) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -88229,7 +94292,7 @@ func (ec *executionContext) marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSelector2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx, sel, v[i])
+			ret[i] = ec.marshalNSkippedTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISkippedTask(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -88249,45 +94312,62 @@ func (ec *executionContext) marshalNSelector2ᚕgithubᚗcomᚋevergreenᚑciᚋ
 	return ret
 }
 
-func (ec *executionContext) unmarshalNSelectorInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx context.Context, v interface{}) (model.APISelector, error) {
-	res, err := ec.unmarshalInputSelectorInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNSnapshot2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshot(ctx context.Context, sel ast.SelectionSet, v model.APISnapshot) graphql.Marshaler {
+	return ec._Snapshot(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNSelectorInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelectorᚄ(ctx context.Context, v interface{}) ([]model.APISelector, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
+func (ec *executionContext) marshalNSnapshot2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APISnapshot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	var err error
-	res := make([]model.APISelector, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNSelectorInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISelector(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSnapshot2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshot(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return res, nil
-}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalNSetLastRevisionInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionInput(ctx context.Context, v interface{}) (SetLastRevisionInput, error) {
-	res, err := ec.unmarshalInputSetLastRevisionInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) marshalNSetLastRevisionPayload2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, v SetLastRevisionPayload) graphql.Marshaler {
-	return ec._SetLastRevisionPayload(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNSetLastRevisionPayload2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSetLastRevisionPayload(ctx context.Context, sel ast.SelectionSet, v *SetLastRevisionPayload) graphql.Marshaler {
+func (ec *executionContext) marshalNSnapshot2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISnapshot(ctx context.Context, sel ast.SelectionSet, v *model.APISnapshot) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SetLastRevisionPayload(ctx, sel, v)
+	return ec._Snapshot(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNSortDirection2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSortDirection(ctx context.Context, v interface{}) (SortDirection, error) {
@@ -88631,6 +94711,15 @@ func (ec *executionContext) marshalNTaskAnnotationSettings2githubᚗcomᚋevergr
 	return ec._TaskAnnotationSettings(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNTaskCgroupSettings2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCgroupSettings(ctx context.Context, sel ast.SelectionSet, v model.APITaskCgroupSettings) graphql.Marshaler {
+	return ec._TaskCgroupSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) unmarshalNTaskCgroupSettingsInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCgroupSettings(ctx context.Context, v interface{}) (model.APITaskCgroupSettings, error) {
+	res, err := ec.unmarshalInputTaskCgroupSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNTaskContainerCreationOpts2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPodTaskContainerCreationOptions(ctx context.Context, sel ast.SelectionSet, v model.APIPodTaskContainerCreationOptions) graphql.Marshaler {
 	return ec._TaskContainerCreationOpts(ctx, sel, &v)
 }
@@ -90842,6 +96931,73 @@ func (ec *executionContext) marshalOMergeQueue2githubᚗcomᚋevergreenᚑciᚋe
 	return res
 }
 
+func (ec *executionContext) marshalOMergeQueueRequiredChecks2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecksᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIMergeQueueRequiredChecks) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMergeQueueRequiredChecks2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecks(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOMergeQueueRequiredChecksInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecksᚄ(ctx context.Context, v interface{}) ([]model.APIMergeQueueRequiredChecks, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.APIMergeQueueRequiredChecks, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNMergeQueueRequiredChecksInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMergeQueueRequiredChecks(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOMetadataLink2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIMetadataLink(ctx context.Context, sel ast.SelectionSet, v model.APIMetadataLink) graphql.Marshaler {
 	return ec._MetadataLink(ctx, sel, &v)
 }
@@ -91518,6 +97674,36 @@ func (ec *executionContext) marshalOSlackConfig2ᚖgithubᚗcomᚋevergreenᚑci
 	return ec._SlackConfig(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSleepSchedule2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISleepSchedule(ctx context.Context, sel ast.SelectionSet, v *model.APISleepSchedule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SleepSchedule(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOSleepScheduleInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISleepSchedule(ctx context.Context, v interface{}) (*model.APISleepSchedule, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputSleepScheduleInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSmartSelection2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISmartSelection(ctx context.Context, sel ast.SelectionSet, v *model.APISmartSelection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SmartSelection(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOSmartSelectionInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPISmartSelection(ctx context.Context, v interface{}) (*model.APISmartSelection, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputSmartSelectionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOSortDirection2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐSortDirection(ctx context.Context, v interface{}) (*SortDirection, error) {
 	if v == nil {
 		return nil, nil
@@ -91576,6 +97762,13 @@ func (ec *executionContext) marshalOSpruceConfig2ᚖgithubᚗcomᚋevergreenᚑc
 	return ec._SpruceConfig(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOStackInfo2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIStackInfo(ctx context.Context, sel ast.SelectionSet, v *model.APIStackInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StackInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOStatusCount2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋtaskᚐStatusCountᚄ(ctx context.Context, sel ast.SelectionSet, v []task.StatusCount) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -91623,6 +97816,13 @@ func (ec *executionContext) marshalOStatusCount2ᚕgithubᚗcomᚋevergreenᚑci
 	return ret
 }
 
+func (ec *executionContext) marshalOStepbackCulprit2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIStepbackCulprit(ctx context.Context, sel ast.SelectionSet, v *model.APIStepbackCulprit) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StepbackCulprit(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -91937,6 +98137,13 @@ func (ec *executionContext) marshalOTaskInfo2githubᚗcomᚋevergreenᚑciᚋeve
 	return ec._TaskInfo(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage(ctx context.Context, sel ast.SelectionSet, v *model.APITaskResourceUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TaskResourceUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTaskSpecifier2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskSpecifierᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APITaskSpecifier) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -29,6 +29,9 @@ func Handler(apiURL string) func(w http.ResponseWriter, r *http.Request) {
 	// Log graphql requests to splunk
 	srv.Use(SplunkTracing{})
 
+	// Record mutations in the audit log
+	srv.Use(AuditLogging{})
+
 	// Disable queries for service degradation
 	srv.Use(DisableQuery{})
 
@@ -46,6 +46,15 @@ type CreateDistroInput struct {
 	NewDistroID string `json:"newDistroId"`
 }
 
+// CreateSnapshotInput is the input to the createSnapshot mutation.
+// Exactly one of hostId or volumeId must be set; for a host, its home volume is
+// snapshotted.
+type CreateSnapshotInput struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	HostID      *string `json:"hostId,omitempty"`
+	VolumeID    *string `json:"volumeId,omitempty"`
+}
+
 // DeleteDistroInput is the input to the deleteDistro mutation.
 type DeleteDistroInput struct {
 	DistroID string `json:"distroId"`
@@ -102,17 +111,18 @@ type DistroPermissionsOptions struct {
 // EditSpawnHostInput is the input to the editSpawnHost mutation.
 // Its fields determine how a given host will be modified.
 type EditSpawnHostInput struct {
-	AddedInstanceTags   []*host.Tag     `json:"addedInstanceTags,omitempty"`
-	DeletedInstanceTags []*host.Tag     `json:"deletedInstanceTags,omitempty"`
-	DisplayName         *string         `json:"displayName,omitempty"`
-	Expiration          *time.Time      `json:"expiration,omitempty"`
-	HostID              string          `json:"hostId"`
-	InstanceType        *string         `json:"instanceType,omitempty"`
-	NoExpiration        *bool           `json:"noExpiration,omitempty"`
-	PublicKey           *PublicKeyInput `json:"publicKey,omitempty"`
-	SavePublicKey       *bool           `json:"savePublicKey,omitempty"`
-	ServicePassword     *string         `json:"servicePassword,omitempty"`
-	Volume              *string         `json:"volume,omitempty"`
+	AddedInstanceTags   []*host.Tag             `json:"addedInstanceTags,omitempty"`
+	DeletedInstanceTags []*host.Tag             `json:"deletedInstanceTags,omitempty"`
+	DisplayName         *string                 `json:"displayName,omitempty"`
+	Expiration          *time.Time              `json:"expiration,omitempty"`
+	HostID              string                  `json:"hostId"`
+	InstanceType        *string                 `json:"instanceType,omitempty"`
+	NoExpiration        *bool                   `json:"noExpiration,omitempty"`
+	PublicKey           *PublicKeyInput         `json:"publicKey,omitempty"`
+	SavePublicKey       *bool                   `json:"savePublicKey,omitempty"`
+	ServicePassword     *string                 `json:"servicePassword,omitempty"`
+	SleepSchedule       *model.APISleepSchedule `json:"sleepSchedule,omitempty"`
+	Volume              *string                 `json:"volume,omitempty"`
 }
 
 type ExternalLinkForMetadata struct {
@@ -333,6 +343,7 @@ type SpawnHostInput struct {
 	DistroID                string          `json:"distroId"`
 	Expiration              *time.Time      `json:"expiration,omitempty"`
 	HomeVolumeSize          *int            `json:"homeVolumeSize,omitempty"`
+	HomeVolumeSnapshotID    *string         `json:"homeVolumeSnapshotId,omitempty"`
 	IsVirtualWorkStation    bool            `json:"isVirtualWorkStation"`
 	NoExpiration            bool            `json:"noExpiration"`
 	PublicKey               *PublicKeyInput `json:"publicKey"`
@@ -356,6 +367,7 @@ type SpawnVolumeInput struct {
 	Host             *string    `json:"host,omitempty"`
 	NoExpiration     *bool      `json:"noExpiration,omitempty"`
 	Size             int        `json:"size"`
+	SnapshotID       *string    `json:"snapshotId,omitempty"`
 	Type             string     `json:"type"`
 }
 
@@ -182,6 +182,10 @@ func (r *mutationResolver) SaveDistro(ctx context.Context, opts SaveDistroInput)
 	if err != nil || oldDistro == nil {
 		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("could not find distro '%s'", d.Id))
 	}
+	// Project distro settings can only be changed through the REST API.
+	d.ProjectDistroSettings = oldDistro.ProjectDistroSettings
+	d.BaseDistroID = oldDistro.BaseDistroID
+	d.ProjectID = oldDistro.ProjectID
 
 	settings, err := evergreen.GetConfig(ctx)
 	validationErrs, err := validator.CheckDistro(ctx, d, settings, false)
@@ -192,7 +196,7 @@ func (r *mutationResolver) SaveDistro(ctx context.Context, opts SaveDistroInput)
 		return nil, InputValidationError.Send(ctx, fmt.Sprintf("validating changes for distro '%s': '%s'", d.Id, validationErrs.String()))
 	}
 
-	if err = data.UpdateDistro(ctx, oldDistro, d); err != nil {
+	if err = data.UpdateDistro(ctx, oldDistro, d, usr.Username()); err != nil {
 		gimletErr, ok := err.(gimlet.ErrorResponse)
 		if ok {
 			return nil, mapHTTPStatusToGqlError(ctx, gimletErr.StatusCode, err)
@@ -694,6 +698,20 @@ func (r *mutationResolver) RemoveFavoriteProject(ctx context.Context, identifier
 	return &apiProjectRef, nil
 }
 
+// RollbackProjectVar is the resolver for the rollbackProjectVar field.
+func (r *mutationResolver) RollbackProjectVar(ctx context.Context, projectID string, varName string, version int) (bool, error) {
+	id, err := model.GetIdForProject(projectID)
+	if err != nil {
+		return false, ResourceNotFound.Send(ctx, fmt.Sprintf("finding project '%s': %s", projectID, err.Error()))
+	}
+	return rollbackProjectVar(ctx, id, varName, version, false)
+}
+
+// RollbackRepoVar is the resolver for the rollbackRepoVar field.
+func (r *mutationResolver) RollbackRepoVar(ctx context.Context, id string, varName string, version int) (bool, error) {
+	return rollbackProjectVar(ctx, id, varName, version, true)
+}
+
 // SaveProjectSettingsForSection is the resolver for the saveProjectSettingsForSection field.
 func (r *mutationResolver) SaveProjectSettingsForSection(ctx context.Context, projectSettings *restModel.APIProjectSettings, section ProjectSettingsSection) (*restModel.APIProjectSettings, error) {
 	projectId := utility.FromStringPtr(projectSettings.ProjectRef.Id)
@@ -758,6 +776,54 @@ func (r *mutationResolver) AttachVolumeToHost(ctx context.Context, volumeAndHost
 	return statusCode == http.StatusOK, nil
 }
 
+// CreateSnapshot is the resolver for the createSnapshot field.
+func (r *mutationResolver) CreateSnapshot(ctx context.Context, createSnapshotInput CreateSnapshotInput) (*restModel.APISnapshot, error) {
+	usr := mustHaveUser(ctx)
+	hostID := utility.FromStringPtr(createSnapshotInput.HostID)
+	volumeID := utility.FromStringPtr(createSnapshotInput.VolumeID)
+	displayName := utility.FromStringPtr(createSnapshotInput.DisplayName)
+	if (hostID == "") == (volumeID == "") {
+		return nil, InputValidationError.Send(ctx, "Must specify exactly one of hostId or volumeId")
+	}
+
+	var snapshot *host.Snapshot
+	var statusCode int
+	if hostID != "" {
+		h, err := host.FindOneByIdOrTag(ctx, hostID)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("Error finding host '%s': %s", hostID, err.Error()))
+		}
+		if h == nil {
+			return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("Host '%s' not found", hostID))
+		}
+		if !host.CanUpdateSpawnHost(h, usr) {
+			return nil, Forbidden.Send(ctx, "You are not authorized to snapshot this host")
+		}
+		snapshot, statusCode, err = cloud.SnapshotHostHomeVolume(ctx, h, displayName, usr.Id)
+		if err != nil {
+			return nil, mapHTTPStatusToGqlError(ctx, statusCode, err)
+		}
+	} else {
+		vol, err := host.FindVolumeByID(volumeID)
+		if err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("Error finding volume '%s': %s", volumeID, err.Error()))
+		}
+		if vol == nil {
+			return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("Volume '%s' not found", volumeID))
+		}
+		if vol.CreatedBy != usr.Id {
+			return nil, Forbidden.Send(ctx, "You are not authorized to snapshot this volume")
+		}
+		snapshot, statusCode, err = cloud.CreateSnapshot(ctx, vol, displayName, usr.Id)
+		if err != nil {
+			return nil, mapHTTPStatusToGqlError(ctx, statusCode, err)
+		}
+	}
+	apiSnapshot := restModel.APISnapshot{}
+	apiSnapshot.BuildFromService(*snapshot)
+	return &apiSnapshot, nil
+}
+
 // DetachVolumeFromHost is the resolver for the detachVolumeFromHost field.
 func (r *mutationResolver) DetachVolumeFromHost(ctx context.Context, volumeID string) (bool, error) {
 	statusCode, err := cloud.DetachVolume(ctx, volumeID)
@@ -847,6 +913,13 @@ func (r *mutationResolver) EditSpawnHost(ctx context.Context, spawnHost *EditSpa
 			}
 		}
 	}
+	if spawnHost.SleepSchedule != nil {
+		schedule := spawnHost.SleepSchedule.ToService()
+		opts.SleepSchedule = &schedule
+	}
+	if err = host.ValidateSleepScheduleModification(h, opts); err != nil {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("Invalid sleep schedule: %s", err.Error()))
+	}
 	if err = cloud.ModifySpawnHost(ctx, evergreen.GetEnvironment(), h, opts); err != nil {
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("Error modifying spawn host: %s", err))
 	}
@@ -903,6 +976,7 @@ func (r *mutationResolver) SpawnVolume(ctx context.Context, spawnVolumeInput Spa
 		Size:             int32(spawnVolumeInput.Size),
 		Type:             spawnVolumeInput.Type,
 		CreatedBy:        mustHaveUser(ctx).Id,
+		SnapshotID:       utility.FromStringPtr(spawnVolumeInput.SnapshotID),
 	}
 	vol, statusCode, err := cloud.RequestNewVolume(ctx, volumeRequest)
 	if err != nil {
@@ -937,6 +1011,25 @@ func (r *mutationResolver) SpawnVolume(ctx context.Context, spawnVolumeInput Spa
 	return true, nil
 }
 
+// RemoveSnapshot is the resolver for the removeSnapshot field.
+func (r *mutationResolver) RemoveSnapshot(ctx context.Context, snapshotID string) (bool, error) {
+	snapshot, err := host.FindSnapshotByID(snapshotID)
+	if err != nil {
+		return false, InternalServerError.Send(ctx, fmt.Sprintf("Error finding snapshot '%s': %s", snapshotID, err.Error()))
+	}
+	if snapshot == nil {
+		return false, ResourceNotFound.Send(ctx, fmt.Sprintf("Snapshot '%s' not found", snapshotID))
+	}
+	if snapshot.CreatedBy != mustHaveUser(ctx).Id {
+		return false, Forbidden.Send(ctx, "You are not authorized to remove this snapshot")
+	}
+	statusCode, err := cloud.DeleteSnapshot(ctx, snapshotID)
+	if err != nil {
+		return false, mapHTTPStatusToGqlError(ctx, statusCode, err)
+	}
+	return statusCode == http.StatusOK, nil
+}
+
 // RemoveVolume is the resolver for the removeVolume field.
 func (r *mutationResolver) RemoveVolume(ctx context.Context, volumeID string) (bool, error) {
 	statusCode, err := cloud.DeleteVolume(ctx, volumeID)
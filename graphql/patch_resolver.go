@@ -158,6 +158,26 @@ func (r *patchResolver) ProjectMetadata(ctx context.Context, obj *restModel.APIP
 	return apiProjectRef, err
 }
 
+// StackPatches is the resolver for the stackPatches field.
+func (r *patchResolver) StackPatches(ctx context.Context, obj *restModel.APIPatch) ([]*restModel.APIPatch, error) {
+	if obj.Stack == nil {
+		return []*restModel.APIPatch{}, nil
+	}
+	stackPatches, err := patch.FindLatestStack(utility.FromStringPtr(obj.ProjectId), utility.FromStringPtr(obj.Author), utility.FromStringPtr(obj.Stack.ID))
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding stack for patch '%s': %s", utility.FromStringPtr(obj.Id), err.Error()))
+	}
+	apiPatches := []*restModel.APIPatch{}
+	for _, p := range stackPatches {
+		apiPatch := restModel.APIPatch{}
+		if err = apiPatch.BuildFromService(p, &restModel.APIPatchArgs{IncludeProjectIdentifier: true}); err != nil {
+			return nil, InternalServerError.Send(ctx, fmt.Sprintf("converting patch '%s' to API model: %s", p.Id.Hex(), err.Error()))
+		}
+		apiPatches = append(apiPatches, &apiPatch)
+	}
+	return apiPatches, nil
+}
+
 // TaskCount is the resolver for the taskCount field.
 func (r *patchResolver) TaskCount(ctx context.Context, obj *restModel.APIPatch) (*int, error) {
 	taskCount, err := task.Count(db.Query(task.DisplayTasksByVersion(*obj.Id, false)))
@@ -488,6 +488,15 @@ func (r *queryResolver) ProjectSettings(ctx context.Context, identifier string)
 	return res, nil
 }
 
+// ProjectVarVersions is the resolver for the projectVarVersions field.
+func (r *queryResolver) ProjectVarVersions(ctx context.Context, projectID string, varName string) ([]*restModel.APIProjectVarVersion, error) {
+	id, err := model.GetIdForProject(projectID)
+	if err != nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("finding project '%s': %s", projectID, err.Error()))
+	}
+	return getProjectVarVersions(ctx, id, varName)
+}
+
 // RepoEvents is the resolver for the repoEvents field.
 func (r *queryResolver) RepoEvents(ctx context.Context, id string, limit *int, before *time.Time) (*ProjectEvents, error) {
 	timestamp := time.Now()
@@ -524,6 +533,11 @@ func (r *queryResolver) RepoSettings(ctx context.Context, id string) (*restModel
 	return res, nil
 }
 
+// RepoVarVersions is the resolver for the repoVarVersions field.
+func (r *queryResolver) RepoVarVersions(ctx context.Context, id string, varName string) ([]*restModel.APIProjectVarVersion, error) {
+	return getProjectVarVersions(ctx, id, varName)
+}
+
 // ViewableProjectRefs is the resolver for the viewableProjectRefs field.
 func (r *queryResolver) ViewableProjectRefs(ctx context.Context) ([]*GroupedProjects, error) {
 	usr := mustHaveUser(ctx)
@@ -580,6 +594,22 @@ func (r *queryResolver) MyVolumes(ctx context.Context) ([]*restModel.APIVolume,
 	return getAPIVolumeList(volumes)
 }
 
+// MySnapshots is the resolver for the mySnapshots field.
+func (r *queryResolver) MySnapshots(ctx context.Context) ([]*restModel.APISnapshot, error) {
+	usr := mustHaveUser(ctx)
+	snapshots, err := host.FindSnapshotsByUser(usr.Username())
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, err.Error())
+	}
+	apiSnapshots := make([]*restModel.APISnapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		apiSnapshot := restModel.APISnapshot{}
+		apiSnapshot.BuildFromService(s)
+		apiSnapshots = append(apiSnapshots, &apiSnapshot)
+	}
+	return apiSnapshots, nil
+}
+
 // LogkeeperBuildMetadata is the resolver for the logkeeperBuildMetadata field.
 func (r *queryResolver) LogkeeperBuildMetadata(ctx context.Context, buildID string) (*plank.Build, error) {
 	client := plank.NewLogkeeperClient(plank.NewLogkeeperClientOptions{
@@ -654,6 +654,7 @@ func getRedactedAPIVarsForProject(ctx context.Context, projectId string) (*restM
 	if vars == nil {
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("vars for '%s' don't exist", projectId))
 	}
+	logVariablesReadAuditEvent(ctx, projectId)
 	vars = vars.RedactPrivateVars()
 	res := &restModel.APIProjectVars{}
 	res.BuildFromService(*vars)
@@ -869,6 +870,9 @@ func getHostRequestOptions(ctx context.Context, usr *user.DBUser, spawnHostInput
 	if spawnHostInput.VolumeID != nil {
 		options.HomeVolumeID = *spawnHostInput.VolumeID
 	}
+	if spawnHostInput.HomeVolumeSnapshotID != nil {
+		options.HomeVolumeSnapshotID = *spawnHostInput.HomeVolumeSnapshotID
+	}
 	if spawnHostInput.Expiration != nil {
 		options.Expiration = spawnHostInput.Expiration
 	}
@@ -1154,3 +1158,34 @@ func interfaceToMap(ctx context.Context, data interface{}) (map[string]interface
 
 	return mapField, nil
 }
+
+// getProjectVarVersions returns the kept versions of the project or repo
+// variable, from newest to oldest.
+func getProjectVarVersions(ctx context.Context, ownerID, varName string) ([]*restModel.APIProjectVarVersion, error) {
+	versions, err := data.GetProjectVarVersions(ownerID, varName)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting versions of variable '%s': %s", varName, err.Error()))
+	}
+	res := make([]*restModel.APIProjectVarVersion, 0, len(versions))
+	for i := range versions {
+		res = append(res, &versions[i])
+	}
+	return res, nil
+}
+
+// rollbackProjectVar sets the project or repo variable back to the given
+// version, which is recorded as a new version.
+func rollbackProjectVar(ctx context.Context, ownerID, varName string, version int, isRepo bool) (bool, error) {
+	if version <= 0 {
+		return false, InputValidationError.Send(ctx, "must provide a positive version to roll back to")
+	}
+	usr := mustHaveUser(ctx)
+	if err := data.RollbackProjectVar(ownerID, varName, version, isRepo, usr.Username()); err != nil {
+		gimletErr, ok := err.(gimlet.ErrorResponse)
+		if ok {
+			return false, mapHTTPStatusToGqlError(ctx, gimletErr.StatusCode, err)
+		}
+		return false, InternalServerError.Send(ctx, fmt.Sprintf("rolling back variable '%s' to version %d: %s", varName, version, err.Error()))
+	}
+	return true, nil
+}
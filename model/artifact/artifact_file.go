@@ -63,6 +63,12 @@ type File struct {
 	FileKey string `json:"filekey,omitempty" bson:"filekey,omitempty"`
 	// ContentType is the content type of the file.
 	ContentType string `json:"content_type" bson:"content_type"`
+	// Size is the size of the file in bytes. It is only set for files uploaded
+	// to the artifact bucket.
+	Size int64 `json:"size,omitempty" bson:"size,omitempty"`
+	// UploadTime is when the file was uploaded to the artifact bucket, which
+	// determines the day its size counts towards the project's artifact quota.
+	UploadTime time.Time `json:"upload_time,omitempty" bson:"upload_time,omitempty"`
 }
 
 // StripHiddenFiles is a helper for only showing users the files they are allowed to see.
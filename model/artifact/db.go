@@ -1,9 +1,13 @@
 package artifact
 
 import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
@@ -18,6 +22,7 @@ var (
 	NameKey        = bsonutil.MustHaveTag(File{}, "Name")
 	LinkKey        = bsonutil.MustHaveTag(File{}, "Link")
 	ContentTypeKey = bsonutil.MustHaveTag(File{}, "ContentType")
+	FileKeyKey     = bsonutil.MustHaveTag(File{}, "FileKey")
 	AwsSecretKey   = bsonutil.MustHaveTag(File{}, "AwsSecret")
 )
 
@@ -116,6 +121,26 @@ func (e Entry) Upsert() error {
 	return err
 }
 
+// RemoveFile removes the files with the given name and bucket key from the
+// artifacts of the task execution. It returns whether any file was removed.
+func RemoveFile(ctx context.Context, taskID string, execution int, name, fileKey string) (bool, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx, bson.M{
+		TaskIdKey:    taskID,
+		ExecutionKey: execution,
+	}, bson.M{
+		"$pull": bson.M{
+			FilesKey: bson.M{
+				NameKey:    name,
+				FileKeyKey: fileKey,
+			},
+		},
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "removing artifact file")
+	}
+	return res.ModifiedCount > 0, nil
+}
+
 func (e Entry) Update() error {
 	update := bson.M{
 		TaskIdKey:   e.TaskId,
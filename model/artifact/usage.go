@@ -0,0 +1,108 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UsageCollection is the collection that tracks how much each project has
+// uploaded to the artifact bucket.
+const UsageCollection = "artifact_usage"
+
+const usageDateFormat = "2006-01-02"
+
+// ProjectUsage is the total size of the artifacts that a project has uploaded
+// to the artifact bucket on a single day (in UTC).
+type ProjectUsage struct {
+	ID        string `bson:"_id" json:"id"`
+	ProjectID string `bson:"project_id" json:"project_id"`
+	Date      string `bson:"date" json:"date"`
+	Bytes     int64  `bson:"bytes" json:"bytes"`
+}
+
+var (
+	ProjectUsageIDKey        = bsonutil.MustHaveTag(ProjectUsage{}, "ID")
+	ProjectUsageProjectIDKey = bsonutil.MustHaveTag(ProjectUsage{}, "ProjectID")
+	ProjectUsageDateKey      = bsonutil.MustHaveTag(ProjectUsage{}, "Date")
+	ProjectUsageBytesKey     = bsonutil.MustHaveTag(ProjectUsage{}, "Bytes")
+)
+
+func projectUsageID(projectID string, ts time.Time) string {
+	return fmt.Sprintf("%s_%s", projectID, ts.UTC().Format(usageDateFormat))
+}
+
+// FindProjectUsage returns the project's artifact usage on the day of the
+// given time. If the project has not uploaded anything that day, it returns
+// nil.
+func FindProjectUsage(ctx context.Context, projectID string, ts time.Time) (*ProjectUsage, error) {
+	res := evergreen.GetEnvironment().DB().Collection(UsageCollection).FindOne(ctx, bson.M{
+		ProjectUsageIDKey: projectUsageID(projectID, ts),
+	})
+	if err := res.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "finding project artifact usage")
+	}
+
+	usage := &ProjectUsage{}
+	if err := res.Decode(usage); err != nil {
+		return nil, errors.Wrap(err, "decoding project artifact usage")
+	}
+	return usage, nil
+}
+
+// ReserveProjectUsage atomically adds the given number of bytes to the
+// project's artifact usage for the day of the given time, as long as doing so
+// would not exceed the quota. It returns whether the bytes were reserved. A
+// quota of zero means the project is unlimited.
+func ReserveProjectUsage(ctx context.Context, projectID string, size, quota int64, ts time.Time) (bool, error) {
+	if size < 0 {
+		return false, errors.New("size cannot be negative")
+	}
+
+	coll := evergreen.GetEnvironment().DB().Collection(UsageCollection)
+	id := projectUsageID(projectID, ts)
+	if _, err := coll.UpdateOne(ctx, bson.M{ProjectUsageIDKey: id}, bson.M{
+		"$setOnInsert": bson.M{
+			ProjectUsageProjectIDKey: projectID,
+			ProjectUsageDateKey:      ts.UTC().Format(usageDateFormat),
+			ProjectUsageBytesKey:     int64(0),
+		},
+	}, options.Update().SetUpsert(true)); err != nil {
+		return false, errors.Wrap(err, "initializing project artifact usage")
+	}
+
+	query := bson.M{ProjectUsageIDKey: id}
+	if quota > 0 {
+		query[ProjectUsageBytesKey] = bson.M{"$lte": quota - size}
+	}
+	res, err := coll.UpdateOne(ctx, query, bson.M{
+		"$inc": bson.M{ProjectUsageBytesKey: size},
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "reserving project artifact usage")
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+// ReleaseProjectUsage removes the given number of bytes from the project's
+// artifact usage for the day of the given time. This is used to release a
+// reservation for an upload that failed.
+func ReleaseProjectUsage(ctx context.Context, projectID string, size int64, ts time.Time) error {
+	_, err := evergreen.GetEnvironment().DB().Collection(UsageCollection).UpdateOne(ctx, bson.M{
+		ProjectUsageIDKey: projectUsageID(projectID, ts),
+	}, bson.M{
+		"$inc": bson.M{ProjectUsageBytesKey: -size},
+	})
+	return errors.Wrap(err, "releasing project artifact usage")
+}
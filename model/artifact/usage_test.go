@@ -0,0 +1,84 @@
+package artifact
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectUsage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"ReservesWithinQuota": func(t *testing.T) {
+			reserved, err := ReserveProjectUsage(ctx, "project", 60, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+			reserved, err = ReserveProjectUsage(ctx, "project", 40, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+
+			usage, err := FindProjectUsage(ctx, "project", now)
+			require.NoError(t, err)
+			require.NotZero(t, usage)
+			assert.Equal(t, "project", usage.ProjectID)
+			assert.EqualValues(t, 100, usage.Bytes)
+		},
+		"DoesNotReserveBeyondQuota": func(t *testing.T) {
+			reserved, err := ReserveProjectUsage(ctx, "project", 60, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+			reserved, err = ReserveProjectUsage(ctx, "project", 41, 100, now)
+			require.NoError(t, err)
+			assert.False(t, reserved)
+
+			usage, err := FindProjectUsage(ctx, "project", now)
+			require.NoError(t, err)
+			require.NotZero(t, usage)
+			assert.EqualValues(t, 60, usage.Bytes)
+		},
+		"ReservesWithoutQuota": func(t *testing.T) {
+			reserved, err := ReserveProjectUsage(ctx, "project", 1000, 0, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+		},
+		"TracksUsagePerProjectAndDay": func(t *testing.T) {
+			reserved, err := ReserveProjectUsage(ctx, "project", 100, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+			reserved, err = ReserveProjectUsage(ctx, "other_project", 100, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+			reserved, err = ReserveProjectUsage(ctx, "project", 100, 100, now.Add(24*time.Hour))
+			require.NoError(t, err)
+			assert.True(t, reserved)
+		},
+		"ReleasesReservation": func(t *testing.T) {
+			reserved, err := ReserveProjectUsage(ctx, "project", 100, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+			require.NoError(t, ReleaseProjectUsage(ctx, "project", 100, now))
+
+			reserved, err = ReserveProjectUsage(ctx, "project", 100, 100, now)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+		},
+		"FindReturnsNilWithoutUsage": func(t *testing.T) {
+			usage, err := FindProjectUsage(ctx, "project", now)
+			assert.NoError(t, err)
+			assert.Nil(t, usage)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.Clear(UsageCollection))
+			tCase(t)
+		})
+	}
+}
@@ -2,17 +2,26 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/reliability"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/google/go-github/v52/github"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func GetModulesFromPR(ctx context.Context, githubToken string, modules []commitqueue.Module, projectConfig *Project) ([]*github.PullRequest, []patch.ModulePatch, error) {
@@ -138,3 +147,408 @@ func preventMergeForItem(item commitqueue.CommitQueueItem, user string) error {
 
 	return nil
 }
+
+// SetCommitQueueBatchTip makes the last of the given commit queue items the
+// tip of their batch, so that its version's tasks test all of the items. The
+// tip's tasks are activated, the other items' tasks are deactivated, and every
+// item's merge task waits on the tip's tasks instead of on its own.
+func SetCommitQueueBatchTip(ctx context.Context, cq *commitqueue.CommitQueue, items []commitqueue.CommitQueueItem, caller string) error {
+	if len(items) == 0 {
+		return nil
+	}
+	tip := items[len(items)-1].Version
+	if tip == "" {
+		return errors.Errorf("batch tip '%s' has not been finalized", items[len(items)-1].Issue)
+	}
+
+	tipTasks, err := task.Find(bson.M{
+		task.VersionKey:          tip,
+		task.CommitQueueMergeKey: bson.M{"$ne": true},
+		task.DisplayOnlyKey:      bson.M{"$ne": true},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "finding tasks for batch tip '%s'", tip)
+	}
+	tipTaskIDs := make(map[string]bool, len(tipTasks))
+	for _, t := range tipTasks {
+		tipTaskIDs[t.Id] = true
+	}
+
+	var toDeactivate []task.Task
+	issues := make([]string, 0, len(items))
+	for _, item := range items {
+		issues = append(issues, item.Issue)
+		mergeTask, err := task.FindMergeTaskForVersion(item.Version)
+		if err != nil {
+			return errors.Wrapf(err, "finding merge task for version '%s'", item.Version)
+		}
+		if mergeTask == nil {
+			return errors.Errorf("merge task not found for version '%s'", item.Version)
+		}
+		if err = dependOnBatchTip(mergeTask, tipTasks, tipTaskIDs); err != nil {
+			return errors.Wrapf(err, "updating dependencies of merge task '%s'", mergeTask.Id)
+		}
+
+		if item.Version == tip {
+			continue
+		}
+		ownTasks, err := task.Find(bson.M{
+			task.VersionKey:          item.Version,
+			task.CommitQueueMergeKey: bson.M{"$ne": true},
+			task.ActivatedKey:        true,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "finding tasks for version '%s'", item.Version)
+		}
+		toDeactivate = append(toDeactivate, ownTasks...)
+	}
+
+	if len(toDeactivate) > 0 {
+		// The tasks are deactivated directly rather than through
+		// SetActiveState, which would dequeue the item.
+		if err = task.DeactivateTasks(toDeactivate, false, caller); err != nil {
+			return errors.Wrap(err, "deactivating tasks for items tested by the batch tip")
+		}
+		updatedBuilds := map[string]bool{}
+		for i, t := range toDeactivate {
+			if updatedBuilds[t.BuildId] {
+				continue
+			}
+			updatedBuilds[t.BuildId] = true
+			if err = UpdateBuildAndVersionStatusForTask(ctx, &toDeactivate[i]); err != nil {
+				return errors.Wrapf(err, "updating build and version status for task '%s'", t.Id)
+			}
+		}
+	}
+
+	var toActivate []task.Task
+	for _, t := range tipTasks {
+		if !t.Activated {
+			toActivate = append(toActivate, t)
+		}
+	}
+	if len(toActivate) > 0 {
+		if err = SetActiveState(ctx, caller, true, toActivate...); err != nil {
+			return errors.Wrapf(err, "activating tasks for batch tip '%s'", tip)
+		}
+	}
+
+	if err = cq.SetBatchTip(issues, tip); err != nil {
+		return errors.Wrapf(err, "setting batch tip '%s'", tip)
+	}
+
+	grip.Info(message.Fields{
+		"message": "set commit queue batch tip",
+		"source":  "commit queue",
+		"project": cq.ProjectID,
+		"tip":     tip,
+		"issues":  issues,
+		"caller":  caller,
+	})
+
+	return nil
+}
+
+// dependOnBatchTip replaces the merge task's dependencies on tasks that test
+// its changes with dependencies on the batch tip's tasks. Dependencies on
+// earlier merge tasks are kept so items still merge in order.
+func dependOnBatchTip(mergeTask *task.Task, tipTasks []task.Task, tipTaskIDs map[string]bool) error {
+	depIDs := make([]string, 0, len(mergeTask.DependsOn))
+	for _, dep := range mergeTask.DependsOn {
+		depIDs = append(depIDs, dep.TaskId)
+	}
+	deps, err := task.FindWithFields(task.ByIds(depIDs), task.IdKey, task.CommitQueueMergeKey)
+	if err != nil {
+		return errors.Wrap(err, "finding dependencies")
+	}
+	for _, dep := range deps {
+		if dep.CommitQueueMerge || tipTaskIDs[dep.Id] {
+			continue
+		}
+		if err = mergeTask.RemoveDependency(dep.Id); err != nil {
+			return errors.Wrapf(err, "removing dependency on task '%s'", dep.Id)
+		}
+	}
+
+	for _, t := range tipTasks {
+		dep := task.Dependency{
+			TaskId:       t.Id,
+			Status:       evergreen.TaskSucceeded,
+			Finished:     t.IsFinished(),
+			Unattainable: t.IsFinished() && t.Status != evergreen.TaskSucceeded,
+		}
+		if err = mergeTask.AddDependency(dep); err != nil {
+			return errors.Wrapf(err, "adding dependency on task '%s'", t.Id)
+		}
+	}
+
+	return nil
+}
+
+// bisectCommitQueueBatch splits the batch tested by the given failed tip in
+// half. The first half is tested by a new tip in the middle of the batch,
+// while the second half stays on the failed tip until the first half
+// resolves. It returns false without changing anything if the tip only tests
+// itself, since then the tip is the culprit.
+func bisectCommitQueueBatch(ctx context.Context, cq *commitqueue.CommitQueue, tip, caller string) (bool, error) {
+	batch := cq.Batch(tip)
+	if len(batch) <= 1 {
+		return false, nil
+	}
+
+	firstHalf := batch[:(len(batch)+1)/2]
+	if err := SetCommitQueueBatchTip(ctx, cq, firstHalf, caller); err != nil {
+		return false, errors.Wrapf(err, "bisecting batch tested by '%s'", tip)
+	}
+
+	grip.Info(message.Fields{
+		"message":      "bisected failed commit queue batch",
+		"source":       "commit queue",
+		"project":      cq.ProjectID,
+		"failed_tip":   tip,
+		"new_tip":      firstHalf[len(firstHalf)-1].Version,
+		"batch_size":   len(batch),
+		"bisect_items": len(firstHalf),
+		"caller":       caller,
+	})
+
+	return true, nil
+}
+
+// minFlakyTestRuns is the minimum number of mainline runs of a task needed to
+// judge whether its tests are flaky.
+const minFlakyTestRuns = 5
+
+// getFlakyTestFailures returns the names of the task's failed tests if the
+// project considers commit queue failures on flaky tests retryable and every
+// test that failed in the task has a historical failure rate above the
+// project's threshold. It returns no tests if the task failed for any other
+// reason.
+func getFlakyTestFailures(ctx context.Context, t *task.Task) ([]string, error) {
+	if t.Details.TimedOut || t.Details.Type == evergreen.CommandTypeSetup || t.Details.Type == evergreen.CommandTypeSystem {
+		return nil, nil
+	}
+
+	pRef, err := FindMergedProjectRef(t.Project, t.Version, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project ref '%s'", t.Project)
+	}
+	if pRef == nil {
+		return nil, errors.Errorf("project ref '%s' not found", t.Project)
+	}
+	threshold := pRef.CommitQueue.FlakyTestThreshold
+	if threshold <= 0 {
+		return nil, nil
+	}
+
+	taskOpts, err := t.CreateTestResultsTaskOptions()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating test results task options")
+	}
+	if len(taskOpts) == 0 {
+		return nil, nil
+	}
+	env := evergreen.GetEnvironment()
+	samples, err := testresult.GetFailedTestSamples(ctx, env, taskOpts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting failed tests")
+	}
+	failedTests := allFailedTestNames(samples)
+	if len(failedTests) == 0 {
+		return nil, nil
+	}
+
+	rates, err := reliability.GetTestFailureRates(ctx, env, t.Project, t.BuildVariant, t.DisplayName, failedTests, reliability.DefaultTestFailureRateRuns)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting historical test failure rates")
+	}
+	for _, testName := range failedTests {
+		rate := rates[testName]
+		if rate.TotalRuns < minFlakyTestRuns || rate.Rate() <= threshold {
+			return nil, nil
+		}
+	}
+
+	return failedTests, nil
+}
+
+// allFailedTestNames returns the names of all the failed tests in the samples.
+// It returns no tests if any sample is missing the names of some of its failed
+// tests, since the unnamed failures can't be shown to be flaky.
+func allFailedTestNames(samples []testresult.TaskTestResultsFailedSample) []string {
+	var failedTests []string
+	for _, sample := range samples {
+		if len(sample.MatchingFailedTestNames) != sample.TotalFailedNames {
+			return nil
+		}
+		failedTests = append(failedTests, sample.MatchingFailedTestNames...)
+	}
+	return failedTests
+}
+
+// retryCommitQueueTaskForFlakyTests restarts the failed task if its item has
+// not already been retried for flaky tests. It returns whether the task was
+// restarted.
+func retryCommitQueueTaskForFlakyTests(ctx context.Context, cq *commitqueue.CommitQueue, t *task.Task, flakyTests []string, caller string) (bool, error) {
+	i := cq.FindItem(t.Version)
+	if i < 0 {
+		return false, nil
+	}
+	item := cq.Queue[i]
+	if item.RetriedFlakyTests {
+		return false, nil
+	}
+
+	if err := cq.SetRetriedFlakyTests(item.Issue); err != nil {
+		return false, errors.Wrapf(err, "marking item '%s' as retried", item.Issue)
+	}
+	if err := ResetTaskOrDisplayTask(ctx, evergreen.GetEnvironment().Settings(), t, evergreen.User, caller, true, &t.Details); err != nil {
+		return false, errors.Wrapf(err, "restarting task '%s'", t.Id)
+	}
+
+	grip.Info(message.Fields{
+		"message":     "retrying commit queue task that only failed on flaky tests",
+		"project":     cq.ProjectID,
+		"item":        item.Issue,
+		"task":        t.Id,
+		"flaky_tests": flakyTests,
+		"caller":      caller,
+	})
+	return true, nil
+}
+
+// flakyTestsDequeueReason explains that the item is being dequeued because
+// the task failed on the given flaky tests again after being retried.
+func flakyTestsDequeueReason(t *task.Task, flakyTests []string) string {
+	const maxListedTests = 3
+	listed := flakyTests
+	if len(listed) > maxListedTests {
+		listed = listed[:maxListedTests]
+	}
+	reason := fmt.Sprintf("task '%s' failed again on known flaky tests after being retried: %s", t.DisplayName, strings.Join(listed, ", "))
+	if len(flakyTests) > maxListedTests {
+		reason += fmt.Sprintf(" and %d more", len(flakyTests)-maxListedTests)
+	}
+	return reason
+}
+
+// AddMergeQueueRequiredTasks adds the variants and tasks required for the
+// GitHub merge queue patch's target branch to the patch, along with their
+// dependencies, and records them as the patch's required tasks. If the commit
+// queue has no required checks for the target branch, the patch is unchanged.
+func (p *Project) AddMergeQueueRequiredTasks(patchDoc *patch.Patch, cqParams CommitQueueParams) error {
+	branch := patchDoc.GithubMergeData.BaseBranch
+	aliases, err := cqParams.RequiredChecksAliases(branch)
+	if err != nil {
+		return errors.Wrapf(err, "getting required checks for branch '%s'", branch)
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	requester := patchDoc.GetRequester()
+	pairs := TaskVariantPairs{}
+	pairs.ExecTasks, pairs.DisplayTasks, err = p.BuildProjectTVPairsWithAlias(aliases, requester)
+	if err != nil {
+		return errors.Wrap(err, "getting pairs matching required checks")
+	}
+	pairs = p.extractDisplayTasks(pairs)
+	if len(pairs.ExecTasks) == 0 && len(pairs.DisplayTasks) == 0 {
+		return errors.Errorf("required checks for branch '%s' do not match any tasks", branch)
+	}
+	required := pairs.TVPairsToVariantTasks()
+
+	pairs.ExecTasks, err = IncludeDependencies(p, pairs.ExecTasks, requester, nil)
+	if err != nil {
+		return errors.Wrap(err, "including dependencies of required tasks")
+	}
+
+	patchDoc.VariantsTasks = patch.MergeVariantsTasks(patchDoc.VariantsTasks, pairs.TVPairsToVariantTasks())
+	patchDoc.BuildVariants, patchDoc.Tasks = patch.ResolveVariantTasks(patchDoc.VariantsTasks)
+	patchDoc.RequiredVariantsTasks = required
+	return nil
+}
+
+// UpdateMergeQueueRequiredStatusForTask sends the merge check of the task's
+// GitHub merge queue patch as soon as its required tasks determine it, rather
+// than waiting for the rest of the patch to finish.
+func UpdateMergeQueueRequiredStatusForTask(t *task.Task) error {
+	if t.Requester != evergreen.GithubMergeRequester || !t.IsFinished() {
+		return nil
+	}
+	p, err := patch.FindOneId(t.Version)
+	if err != nil {
+		return errors.Wrapf(err, "finding patch '%s'", t.Version)
+	}
+	// Without required tasks, the merge check is the patch's status.
+	if p == nil || len(p.RequiredVariantsTasks) == 0 {
+		return nil
+	}
+
+	status, err := GetMergeQueueRequiredStatus(p, p.Status)
+	if err != nil {
+		return errors.Wrapf(err, "getting required tasks status for merge queue patch '%s'", p.Id.Hex())
+	}
+	if !evergreen.IsFinishedVersionStatus(status) {
+		return nil
+	}
+	changed, err := p.SetMergeQueueRequiredStatus(status)
+	if err != nil {
+		return errors.Wrapf(err, "setting required tasks status for merge queue patch '%s'", p.Id.Hex())
+	}
+	if changed {
+		event.LogPatchMergeQueueRequiredTasksFinishedEvent(p.Id.Hex(), status)
+	}
+	return nil
+}
+
+// GetMergeQueueRequiredStatus returns the status of the GitHub merge queue
+// patch's merge check, which only depends on the patch's required tasks. The
+// check succeeds once all of the required tasks succeed and fails if any of
+// them fail or do not run. If the patch has no required tasks, the merge
+// check has the given patch status.
+func GetMergeQueueRequiredStatus(p *patch.Patch, patchStatus string) (string, error) {
+	if len(p.RequiredVariantsTasks) == 0 {
+		return patchStatus, nil
+	}
+
+	required := map[TVPair]bool{}
+	for _, vt := range p.RequiredVariantsTasks {
+		for _, t := range vt.Tasks {
+			required[TVPair{Variant: vt.Variant, TaskName: t}] = true
+		}
+		for _, dt := range vt.DisplayTasks {
+			required[TVPair{Variant: vt.Variant, TaskName: dt.Name}] = true
+		}
+	}
+
+	tasks, err := task.FindAll(db.Query(task.ByVersion(p.Id.Hex())).WithFields(
+		task.BuildVariantKey, task.DisplayNameKey, task.StatusKey, task.ActivatedKey))
+	if err != nil {
+		return "", errors.Wrapf(err, "finding tasks for patch '%s'", p.Id.Hex())
+	}
+
+	succeeded := map[TVPair]bool{}
+	isRunning := false
+	for _, t := range tasks {
+		pair := TVPair{Variant: t.BuildVariant, TaskName: t.DisplayName}
+		if !required[pair] {
+			continue
+		}
+		switch {
+		case t.Status == evergreen.TaskSucceeded:
+			succeeded[pair] = true
+		case evergreen.IsFailedTaskStatus(t.Status):
+			return evergreen.VersionFailed, nil
+		case t.Activated && !t.IsFinished():
+			isRunning = true
+		}
+	}
+	if isRunning {
+		return evergreen.VersionStarted, nil
+	}
+	if len(succeeded) != len(required) {
+		return evergreen.VersionFailed, nil
+	}
+	return evergreen.VersionSucceeded, nil
+}
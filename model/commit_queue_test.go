@@ -2,16 +2,25 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type CommitQueueSuite struct {
@@ -76,3 +85,429 @@ func (s *CommitQueueSuite) TestPreventMergeForItem() {
 	s.Equal(evergreen.DisabledTaskPriority, mergeTask.Priority)
 	s.False(mergeTask.Activated)
 }
+
+// setUpCommitQueueBatch inserts a commit queue with the given number of
+// finalized items. Each item's version has a test task and a merge task that
+// depends on the test task and on the previous item's merge task.
+func setUpCommitQueueBatch(s *CommitQueueSuite, numItems int) *commitqueue.CommitQueue {
+	s.Require().NoError(db.ClearCollections(commitqueue.Collection, task.Collection, build.Collection, VersionCollection, patch.Collection))
+
+	cq := &commitqueue.CommitQueue{ProjectID: "mci"}
+	var prevMergeTask string
+	for i := 0; i < numItems; i++ {
+		id := mgobson.NewObjectId()
+		version := id.Hex()
+		s.Require().NoError((&patch.Patch{Id: id, Version: version, Alias: evergreen.CommitQueueAlias}).Insert())
+		s.Require().NoError((&Version{Id: version, Requester: evergreen.MergeTestRequester}).Insert())
+		s.Require().NoError((&build.Build{Id: "test-" + version, Version: version, Activated: true}).Insert())
+		s.Require().NoError((&build.Build{Id: "merge-" + version, Version: version, Activated: true}).Insert())
+
+		testTask := task.Task{
+			Id:           "test-" + version,
+			DisplayName:  "test",
+			BuildVariant: "bv",
+			Version:      version,
+			BuildId:      "test-" + version,
+			Project:      "mci",
+			Status:       evergreen.TaskUndispatched,
+			Activated:    true,
+			Requester:    evergreen.MergeTestRequester,
+		}
+		s.Require().NoError(testTask.Insert())
+		mergeTask := task.Task{
+			Id:               "merge-" + version,
+			DisplayName:      evergreen.MergeTaskName,
+			BuildVariant:     evergreen.MergeTaskVariant,
+			Version:          version,
+			BuildId:          "merge-" + version,
+			Project:          "mci",
+			Status:           evergreen.TaskUndispatched,
+			Activated:        true,
+			Requester:        evergreen.MergeTestRequester,
+			CommitQueueMerge: true,
+			DependsOn:        []task.Dependency{{TaskId: testTask.Id, Status: evergreen.TaskSucceeded}},
+		}
+		if prevMergeTask != "" {
+			mergeTask.DependsOn = append(mergeTask.DependsOn, task.Dependency{TaskId: prevMergeTask, Status: task.AllStatuses})
+		}
+		s.Require().NoError(mergeTask.Insert())
+		prevMergeTask = mergeTask.Id
+
+		cq.Queue = append(cq.Queue, commitqueue.CommitQueueItem{Issue: version, PatchId: version, Version: version, Source: commitqueue.SourceDiff})
+	}
+	s.Require().NoError(commitqueue.InsertQueue(cq))
+
+	return cq
+}
+
+func (s *CommitQueueSuite) dependencyIDs(taskID string) []string {
+	t, err := task.FindOneId(taskID)
+	s.Require().NoError(err)
+	s.Require().NotNil(t)
+	var ids []string
+	for _, dep := range t.DependsOn {
+		ids = append(ids, dep.TaskId)
+	}
+	return ids
+}
+
+func (s *CommitQueueSuite) isActivated(taskID string) bool {
+	t, err := task.FindOneId(taskID)
+	s.Require().NoError(err)
+	s.Require().NotNil(t)
+	return t.Activated
+}
+
+func (s *CommitQueueSuite) TestSetCommitQueueBatchTip() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cq := setUpCommitQueueBatch(s, 3)
+	v1, v2, v3 := cq.Queue[0].Version, cq.Queue[1].Version, cq.Queue[2].Version
+
+	s.Require().NoError(SetCommitQueueBatchTip(ctx, cq, cq.Queue, evergreen.CommitQueueBatchActivator))
+
+	s.False(s.isActivated("test-" + v1))
+	s.False(s.isActivated("test-" + v2))
+	s.True(s.isActivated("test-" + v3))
+	for _, version := range []string{v1, v2, v3} {
+		s.True(s.isActivated("merge-" + version))
+	}
+
+	s.ElementsMatch([]string{"test-" + v3}, s.dependencyIDs("merge-"+v1))
+	s.ElementsMatch([]string{"merge-" + v1, "test-" + v3}, s.dependencyIDs("merge-"+v2))
+	s.ElementsMatch([]string{"merge-" + v2, "test-" + v3}, s.dependencyIDs("merge-"+v3))
+
+	dbCq, err := commitqueue.FindOneId(cq.ProjectID)
+	s.Require().NoError(err)
+	s.Require().Len(dbCq.Queue, 3)
+	for _, item := range dbCq.Queue {
+		s.Equal(v3, item.BatchTip)
+	}
+	s.False(dbCq.Queue[0].IsBatchTip())
+	s.False(dbCq.Queue[1].IsBatchTip())
+	s.True(dbCq.Queue[2].IsBatchTip())
+	s.Len(dbCq.Batch(v3), 3)
+}
+
+func (s *CommitQueueSuite) TestBisectCommitQueueBatch() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cq := setUpCommitQueueBatch(s, 3)
+	v1, v2, v3 := cq.Queue[0].Version, cq.Queue[1].Version, cq.Queue[2].Version
+	s.Require().NoError(SetCommitQueueBatchTip(ctx, cq, cq.Queue, evergreen.CommitQueueBatchActivator))
+
+	// The tip failing bisects the batch rather than dequeueing the tip.
+	failedTip, err := task.FindOneId("test-" + v3)
+	s.Require().NoError(err)
+	s.Require().NoError(failedTip.MarkFailed())
+	s.Require().NoError(dequeueAndRestartWithStepback(ctx, cq, failedTip, evergreen.MergeTestRequester, "failed"))
+
+	dbCq, err := commitqueue.FindOneId(cq.ProjectID)
+	s.Require().NoError(err)
+	s.Require().Len(dbCq.Queue, 3)
+	s.Equal(v2, dbCq.Queue[0].BatchTip)
+	s.Equal(v2, dbCq.Queue[1].BatchTip)
+	s.Equal(v3, dbCq.Queue[2].BatchTip)
+
+	s.False(s.isActivated("test-" + v1))
+	s.True(s.isActivated("test-" + v2))
+	s.ElementsMatch([]string{"test-" + v2}, s.dependencyIDs("merge-"+v1))
+	s.ElementsMatch([]string{"merge-" + v1, "test-" + v2}, s.dependencyIDs("merge-"+v2))
+	s.ElementsMatch([]string{"merge-" + v2, "test-" + v3}, s.dependencyIDs("merge-"+v3))
+
+	// Bisecting again leaves the first item testing only itself.
+	failedTip, err = task.FindOneId("test-" + v2)
+	s.Require().NoError(err)
+	s.Require().NoError(failedTip.MarkFailed())
+	s.Require().NoError(dequeueAndRestartWithStepback(ctx, dbCq, failedTip, evergreen.MergeTestRequester, "failed"))
+
+	dbCq, err = commitqueue.FindOneId(cq.ProjectID)
+	s.Require().NoError(err)
+	s.Require().Len(dbCq.Queue, 3)
+	s.Equal(v1, dbCq.Queue[0].BatchTip)
+	s.Equal(v2, dbCq.Queue[1].BatchTip)
+	s.True(s.isActivated("test-" + v1))
+	s.ElementsMatch([]string{"test-" + v1}, s.dependencyIDs("merge-"+v1))
+
+	bisected, err := bisectCommitQueueBatch(ctx, dbCq, v1, evergreen.MergeTestRequester)
+	s.NoError(err)
+	s.False(bisected, "a batch with a single item cannot be bisected")
+}
+
+func (s *CommitQueueSuite) TestRetryCommitQueueTaskForFlakyTests() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	cq := setUpCommitQueueBatch(s, 1)
+	v1 := cq.Queue[0].Version
+	s.Require().NoError(db.ClearCollections(ProjectRefCollection))
+	s.Require().NoError(testresult.ClearLocal(ctx, env))
+	defer func() {
+		s.NoError(testresult.ClearLocal(ctx, env))
+	}()
+	pRef := &ProjectRef{Id: "mci", CommitQueue: CommitQueueParams{Enabled: utility.TruePtr(), FlakyTestThreshold: 0.2}}
+	s.Require().NoError(pRef.Insert())
+
+	// "flaky" failed in half of the recent mainline runs and "broken" in
+	// only one of them.
+	for i := 0; i < 6; i++ {
+		mainlineTask := task.Task{
+			Id:                  fmt.Sprintf("mainline-%d", i),
+			DisplayName:         "test",
+			BuildVariant:        "bv",
+			Project:             "mci",
+			Requester:           evergreen.RepotrackerVersionRequester,
+			RevisionOrderNumber: i,
+			Status:              evergreen.TaskSucceeded,
+			ResultsService:      testresult.TestResultsServiceLocal,
+		}
+		flakyStatus := evergreen.TestSucceededStatus
+		if i%2 == 0 {
+			mainlineTask.Status = evergreen.TaskFailed
+			flakyStatus = evergreen.TestFailedStatus
+		}
+		brokenStatus := evergreen.TestSucceededStatus
+		if i == 0 {
+			brokenStatus = evergreen.TestFailedStatus
+		}
+		s.Require().NoError(mainlineTask.Insert())
+		s.Require().NoError(testresult.InsertLocal(ctx, env,
+			testresult.TestResult{TaskID: mainlineTask.Id, TestName: "flaky", Status: flakyStatus},
+			testresult.TestResult{TaskID: mainlineTask.Id, TestName: "broken", Status: brokenStatus},
+		))
+	}
+
+	s.Require().NoError(task.UpdateOne(bson.M{task.IdKey: "test-" + v1}, bson.M{"$set": bson.M{
+		task.StatusKey:         evergreen.TaskFailed,
+		task.ResultsServiceKey: testresult.TestResultsServiceLocal,
+	}}))
+	failed, err := task.FindOneId("test-" + v1)
+	s.Require().NoError(err)
+	s.Require().NoError(testresult.InsertLocal(ctx, env, testresult.TestResult{TaskID: failed.Id, TestName: "flaky", Status: evergreen.TestFailedStatus}))
+
+	flakyTests, err := getFlakyTestFailures(ctx, failed)
+	s.Require().NoError(err)
+	s.Equal([]string{"flaky"}, flakyTests)
+
+	s.Require().NoError(dequeueAndRestartWithStepback(ctx, cq, failed, evergreen.MergeTestRequester, "failed"))
+	dbCq, err := commitqueue.FindOneId(cq.ProjectID)
+	s.Require().NoError(err)
+	s.Require().Len(dbCq.Queue, 1, "item should be retried rather than dequeued")
+	s.True(dbCq.Queue[0].RetriedFlakyTests)
+	restarted, err := task.FindOneId(failed.Id)
+	s.Require().NoError(err)
+	s.Equal(1, restarted.Execution)
+	s.Equal(evergreen.TaskUndispatched, restarted.Status)
+
+	retried, err := retryCommitQueueTaskForFlakyTests(ctx, dbCq, restarted, flakyTests, evergreen.MergeTestRequester)
+	s.NoError(err)
+	s.False(retried, "item should only be retried once")
+
+	s.Require().NoError(testresult.InsertLocal(ctx, env, testresult.TestResult{TaskID: failed.Id, Execution: 1, TestName: "broken", Status: evergreen.TestFailedStatus}))
+	restarted.Details.Type = evergreen.CommandTypeTest
+	flakyTests, err = getFlakyTestFailures(ctx, restarted)
+	s.NoError(err)
+	s.Empty(flakyTests, "tests that rarely fail are not flaky")
+}
+
+func TestAllFailedTestNames(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, allFailedTestNames([]testresult.TaskTestResultsFailedSample{
+		{MatchingFailedTestNames: []string{"a", "b"}, TotalFailedNames: 2},
+		{MatchingFailedTestNames: []string{"c"}, TotalFailedNames: 1},
+	}))
+	assert.Empty(t, allFailedTestNames([]testresult.TaskTestResultsFailedSample{
+		{MatchingFailedTestNames: []string{"a", "b"}, TotalFailedNames: 2},
+		{MatchingFailedTestNames: []string{"c"}, TotalFailedNames: 3},
+	}), "failures missing from the sample should not be treated as flaky")
+	assert.Empty(t, allFailedTestNames(nil))
+}
+
+func TestFlakyTestsDequeueReason(t *testing.T) {
+	tsk := &task.Task{DisplayName: "unit_tests"}
+	assert.Equal(t, "task 'unit_tests' failed again on known flaky tests after being retried: a, b",
+		flakyTestsDequeueReason(tsk, []string{"a", "b"}))
+	assert.Equal(t, "task 'unit_tests' failed again on known flaky tests after being retried: a, b, c and 2 more",
+		flakyTestsDequeueReason(tsk, []string{"a", "b", "c", "d", "e"}))
+}
+
+func TestAddMergeQueueRequiredTasks(t *testing.T) {
+	project := &Project{
+		Tasks: []ProjectTask{
+			{Name: "compile"},
+			{Name: "unit", DependsOn: []TaskUnitDependency{{Name: "compile"}}},
+			{Name: "integration", DependsOn: []TaskUnitDependency{{Name: "compile"}}},
+		},
+		BuildVariants: []BuildVariant{
+			{
+				Name: "ubuntu",
+				Tasks: []BuildVariantTaskUnit{
+					{Name: "compile", Variant: "ubuntu"},
+					{Name: "unit", Variant: "ubuntu", DependsOn: []TaskUnitDependency{{Name: "compile"}}},
+					{Name: "integration", Variant: "ubuntu", DependsOn: []TaskUnitDependency{{Name: "compile"}}},
+				},
+			},
+		},
+	}
+	cq := CommitQueueParams{RequiredChecks: []MergeQueueRequiredChecks{
+		{BranchRegex: "^release/", VariantRegex: ".*", TaskRegex: "^integration$"},
+	}}
+	makePatch := func(branch string) *patch.Patch {
+		return &patch.Patch{
+			GithubMergeData: thirdparty.GithubMergeGroup{BaseBranch: branch},
+			VariantsTasks:   []patch.VariantTasks{{Variant: "ubuntu", Tasks: []string{"compile", "unit"}}},
+		}
+	}
+
+	t.Run("AddsRequiredTasksForMatchingBranch", func(t *testing.T) {
+		p := makePatch("release/1.0")
+		require.NoError(t, project.AddMergeQueueRequiredTasks(p, cq))
+		require.Len(t, p.VariantsTasks, 1)
+		assert.ElementsMatch(t, []string{"compile", "unit", "integration"}, p.VariantsTasks[0].Tasks)
+		assert.ElementsMatch(t, []string{"compile", "unit", "integration"}, p.Tasks)
+		require.Len(t, p.RequiredVariantsTasks, 1)
+		assert.Equal(t, []string{"integration"}, p.RequiredVariantsTasks[0].Tasks, "dependencies should not be required")
+	})
+	t.Run("NoopsForOtherBranches", func(t *testing.T) {
+		p := makePatch("main")
+		require.NoError(t, project.AddMergeQueueRequiredTasks(p, cq))
+		assert.Equal(t, makePatch("main").VariantsTasks, p.VariantsTasks)
+		assert.Empty(t, p.RequiredVariantsTasks)
+	})
+	t.Run("ErrorsWhenNoTasksMatch", func(t *testing.T) {
+		p := makePatch("release/1.0")
+		noMatches := CommitQueueParams{RequiredChecks: []MergeQueueRequiredChecks{
+			{BranchRegex: ".*", VariantRegex: ".*", TaskRegex: "^nonexistent$"},
+		}}
+		assert.Error(t, project.AddMergeQueueRequiredTasks(p, noMatches))
+	})
+}
+
+func TestGetMergeQueueRequiredStatus(t *testing.T) {
+	p := &patch.Patch{
+		Id: mgobson.NewObjectId(),
+		RequiredVariantsTasks: []patch.VariantTasks{
+			{Variant: "ubuntu", Tasks: []string{"unit"}, DisplayTasks: []patch.DisplayTask{{Name: "display"}}},
+		},
+	}
+	makeTasks := func(unitStatus, displayStatus, optionalStatus string) []task.Task {
+		return []task.Task{
+			{Id: "unit", Version: p.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "unit", Status: unitStatus, Activated: true},
+			{Id: "display", Version: p.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "display", Status: displayStatus, Activated: true, DisplayOnly: true},
+			{Id: "optional", Version: p.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "optional", Status: optionalStatus, Activated: true},
+		}
+	}
+
+	for tName, tCase := range map[string]struct {
+		tasks    []task.Task
+		expected string
+	}{
+		"SucceedsDespiteOptionalFailures": {
+			tasks:    makeTasks(evergreen.TaskSucceeded, evergreen.TaskSucceeded, evergreen.TaskFailed),
+			expected: evergreen.VersionSucceeded,
+		},
+		"FailsWithRequiredFailure": {
+			tasks:    makeTasks(evergreen.TaskSucceeded, evergreen.TaskFailed, evergreen.TaskSucceeded),
+			expected: evergreen.VersionFailed,
+		},
+		"PendingWhileRequiredTasksRun": {
+			tasks:    makeTasks(evergreen.TaskStarted, evergreen.TaskSucceeded, evergreen.TaskSucceeded),
+			expected: evergreen.VersionStarted,
+		},
+		"FailsWhenRequiredTaskDidNotRun": {
+			tasks:    makeTasks(evergreen.TaskSucceeded, evergreen.TaskSucceeded, evergreen.TaskSucceeded)[1:],
+			expected: evergreen.VersionFailed,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection))
+			for _, tsk := range tCase.tasks {
+				require.NoError(t, tsk.Insert())
+			}
+			status, err := GetMergeQueueRequiredStatus(p, evergreen.VersionFailed)
+			require.NoError(t, err)
+			assert.Equal(t, tCase.expected, status)
+		})
+	}
+
+	t.Run("UsesPatchStatusWithoutRequiredTasks", func(t *testing.T) {
+		status, err := GetMergeQueueRequiredStatus(&patch.Patch{}, evergreen.VersionFailed)
+		require.NoError(t, err)
+		assert.Equal(t, evergreen.VersionFailed, status)
+	})
+}
+
+func TestUpdateMergeQueueRequiredStatusForTask(t *testing.T) {
+	p := patch.Patch{
+		Id:     mgobson.NewObjectId(),
+		Status: evergreen.VersionStarted,
+		RequiredVariantsTasks: []patch.VariantTasks{
+			{Variant: "ubuntu", Tasks: []string{"unit"}},
+		},
+	}
+	unit := task.Task{Id: "unit", Version: p.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "unit", Status: evergreen.TaskSucceeded, Activated: true, Requester: evergreen.GithubMergeRequester}
+	optional := task.Task{Id: "optional", Version: p.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "optional", Status: evergreen.TaskStarted, Activated: true, Requester: evergreen.GithubMergeRequester}
+	mergeCheckEvents := func(t *testing.T) []event.EventLogEntry {
+		events, err := event.Find(db.Query(bson.M{
+			event.ResourceIdKey: p.Id.Hex(),
+			event.TypeKey:       event.PatchMergeQueueRequiredTasksFinished,
+		}))
+		require.NoError(t, err)
+		return events
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"SendsStatusOnceRequiredTasksFinish": func(t *testing.T) {
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&unit))
+			events := mergeCheckEvents(t)
+			require.Len(t, events, 1)
+			data, ok := events[0].Data.(*event.PatchEventData)
+			require.True(t, ok)
+			assert.Equal(t, evergreen.VersionSucceeded, data.Status)
+
+			dbPatch, err := patch.FindOneId(p.Id.Hex())
+			require.NoError(t, err)
+			require.NotNil(t, dbPatch)
+			assert.Equal(t, evergreen.VersionSucceeded, dbPatch.MergeQueueRequiredStatus)
+
+			// The same status is not sent again.
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&unit))
+			assert.Len(t, mergeCheckEvents(t), 1)
+		},
+		"SendsChangedStatusAfterRestart": func(t *testing.T) {
+			require.NoError(t, task.UpdateOne(bson.M{task.IdKey: unit.Id}, bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskFailed}}))
+			failed := unit
+			failed.Status = evergreen.TaskFailed
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&failed))
+			require.NoError(t, task.UpdateOne(bson.M{task.IdKey: unit.Id}, bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskSucceeded}}))
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&unit))
+
+			events := mergeCheckEvents(t)
+			assert.Len(t, events, 2)
+		},
+		"NoopWhileRequiredTasksRun": func(t *testing.T) {
+			require.NoError(t, task.UpdateOne(bson.M{task.IdKey: unit.Id}, bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskStarted}}))
+			require.NoError(t, task.UpdateOne(bson.M{task.IdKey: optional.Id}, bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskFailed}}))
+			failedOptional := optional
+			failedOptional.Status = evergreen.TaskFailed
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&failedOptional))
+			assert.Empty(t, mergeCheckEvents(t))
+		},
+		"NoopForOtherRequesters": func(t *testing.T) {
+			prTask := unit
+			prTask.Requester = evergreen.GithubPRRequester
+			require.NoError(t, UpdateMergeQueueRequiredStatusForTask(&prTask))
+			assert.Empty(t, mergeCheckEvents(t))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(patch.Collection, task.Collection, event.EventCollection))
+			require.NoError(t, p.Insert())
+			require.NoError(t, unit.Insert())
+			require.NoError(t, optional.Insert())
+			tCase(t)
+		})
+	}
+}
@@ -36,6 +36,20 @@ type CommitQueueItem struct {
 	// QueueLengthAtEnqueue is the length of the queue when the item was enqueued. Used for tracking the speed of the
 	// commit queue as this value is logged when a commit queue item is processed.
 	QueueLengthAtEnqueue int `bson:"queue_length_at_enqueue"`
+	// BatchTip is the version whose tasks test this item when the item is
+	// tested as part of a batch. The tip is the last item in the batch, so its
+	// version includes the changes from every item in the batch. Items that
+	// are not the tip only run their merge task.
+	BatchTip string `bson:"batch_tip,omitempty"`
+	// RetriedFlakyTests indicates that the item's tasks were already retried
+	// once because they only failed on known flaky tests.
+	RetriedFlakyTests bool `bson:"retried_flaky_tests,omitempty"`
+}
+
+// IsBatchTip returns whether the item's own version tests it, either because
+// it's the tip of its batch or because it's not part of a batch.
+func (i *CommitQueueItem) IsBatchTip() bool {
+	return i.BatchTip == "" || i.BatchTip == i.Version
 }
 
 func (i *CommitQueueItem) MarshalBSON() ([]byte, error)  { return mgobson.Marshal(i) }
@@ -178,6 +192,50 @@ func (q *CommitQueue) UpdateVersion(item *CommitQueueItem) error {
 	return errors.Wrap(addVersionAndTime(q.ProjectID, *item), "updating version")
 }
 
+// Batch returns the items in the queue that are tested by the given batch tip
+// version, in queue order.
+func (q *CommitQueue) Batch(tip string) []CommitQueueItem {
+	if tip == "" {
+		return nil
+	}
+	var items []CommitQueueItem
+	for _, item := range q.Queue {
+		if item.BatchTip == tip {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// SetBatchTip sets the version that tests the given items.
+func (q *CommitQueue) SetBatchTip(issues []string, tip string) error {
+	if err := setBatchTip(q.ProjectID, issues, tip); err != nil {
+		return errors.Wrap(err, "updating batch tip")
+	}
+	for i, item := range q.Queue {
+		for _, issue := range issues {
+			if item.Issue == issue {
+				q.Queue[i].BatchTip = tip
+			}
+		}
+	}
+	return nil
+}
+
+// SetRetriedFlakyTests records that the item's tasks were retried because they
+// only failed on known flaky tests.
+func (q *CommitQueue) SetRetriedFlakyTests(issue string) error {
+	if err := setRetriedFlakyTests(q.ProjectID, issue); err != nil {
+		return errors.Wrap(err, "marking item as retried for flaky tests")
+	}
+	for i, item := range q.Queue {
+		if item.Issue == issue {
+			q.Queue[i].RetriedFlakyTests = true
+		}
+	}
+	return nil
+}
+
 func (q *CommitQueue) FindItem(issue string) int {
 	for i, queued := range q.Queue {
 		if queued.Issue == issue || queued.Version == issue || queued.PatchId == issue {
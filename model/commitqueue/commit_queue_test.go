@@ -162,6 +162,55 @@ func (s *CommitQueueSuite) TestUpdateVersion() {
 	s.InDelta(now.Unix(), dbq.Queue[0].ProcessingStartTime.Unix(), float64(1*time.Millisecond))
 }
 
+func (s *CommitQueueSuite) TestSetBatchTip() {
+	for _, issue := range []string{"1", "2", "3"} {
+		item := CommitQueueItem{Issue: issue}
+		_, err := s.q.Enqueue(item)
+		s.Require().NoError(err)
+		item.Version = "v" + issue
+		s.Require().NoError(s.q.UpdateVersion(&item))
+	}
+	s.Empty(s.q.Batch("v3"))
+	s.True(s.q.Queue[0].IsBatchTip())
+
+	s.NoError(s.q.SetBatchTip([]string{"1", "2", "3"}, "v3"))
+	s.Len(s.q.Batch("v3"), 3)
+	s.NoError(s.q.SetBatchTip([]string{"1", "2"}, "v2"))
+
+	dbq, err := FindOneId("mci")
+	s.Require().NoError(err)
+	s.Require().Len(dbq.Queue, 3)
+	s.Equal("v2", dbq.Queue[0].BatchTip)
+	s.Equal("v2", dbq.Queue[1].BatchTip)
+	s.Equal("v3", dbq.Queue[2].BatchTip)
+	s.False(dbq.Queue[0].IsBatchTip())
+	s.True(dbq.Queue[1].IsBatchTip())
+	s.True(dbq.Queue[2].IsBatchTip())
+
+	batch := dbq.Batch("v2")
+	s.Require().Len(batch, 2)
+	s.Equal("1", batch[0].Issue)
+	s.Equal("2", batch[1].Issue)
+	s.Empty(dbq.Batch(""))
+}
+
+func (s *CommitQueueSuite) TestSetRetriedFlakyTests() {
+	for _, issue := range []string{"1", "2"} {
+		_, err := s.q.Enqueue(CommitQueueItem{Issue: issue})
+		s.Require().NoError(err)
+	}
+
+	s.NoError(s.q.SetRetriedFlakyTests("2"))
+	s.False(s.q.Queue[0].RetriedFlakyTests)
+	s.True(s.q.Queue[1].RetriedFlakyTests)
+
+	dbq, err := FindOneId("mci")
+	s.Require().NoError(err)
+	s.Require().Len(dbq.Queue, 2)
+	s.False(dbq.Queue[0].RetriedFlakyTests)
+	s.True(dbq.Queue[1].RetriedFlakyTests)
+}
+
 func (s *CommitQueueSuite) TestNext() {
 	// nothing is enqueued
 	next, valid := s.q.Next()
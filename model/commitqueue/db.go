@@ -3,11 +3,13 @@ package commitqueue
 import (
 	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const Collection = "commit_queue"
@@ -21,6 +23,8 @@ var (
 	EnqueueTimeKey          = bsonutil.MustHaveTag(CommitQueueItem{}, "EnqueueTime")
 	ProcessingStartTimeKey  = bsonutil.MustHaveTag(CommitQueueItem{}, "ProcessingStartTime")
 	QueueLengthAtEnqueueKey = bsonutil.MustHaveTag(CommitQueueItem{}, "QueueLengthAtEnqueue")
+	BatchTipKey             = bsonutil.MustHaveTag(CommitQueueItem{}, "BatchTip")
+	RetriedFlakyTestsKey    = bsonutil.MustHaveTag(CommitQueueItem{}, "RetriedFlakyTests")
 )
 
 func updateOne(query interface{}, update interface{}) error {
@@ -106,6 +110,37 @@ func addVersionAndTime(id string, item CommitQueueItem) error {
 		})
 }
 
+func setBatchTip(id string, issues []string, tip string) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	env := evergreen.GetEnvironment()
+	ctx, cancel := env.Context()
+	defer cancel()
+
+	_, err := env.DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{IdKey: id},
+		bson.M{
+			"$set": bson.M{bsonutil.GetDottedKeyName(QueueKey, "$[item]", BatchTipKey): tip},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.M{bsonutil.GetDottedKeyName("item", IssueKey): bson.M{"$in": issues}},
+		}}),
+	)
+	return err
+}
+
+func setRetriedFlakyTests(id, issue string) error {
+	return updateOne(
+		bson.M{
+			IdKey: id,
+			bsonutil.GetDottedKeyName(QueueKey, IssueKey): issue,
+		},
+		bson.M{
+			"$set": bson.M{bsonutil.GetDottedKeyName(QueueKey, "$", RetriedFlakyTestsKey): true},
+		})
+}
+
 // remove removes a given item from a project's commit queue. Make sure to pass the actual
 // issue identifier and not the patch or version
 func remove(project, issue string) error {
@@ -44,6 +44,8 @@ var (
 	IsVirtualWorkstationKey  = bsonutil.MustHaveTag(Distro{}, "IsVirtualWorkstation")
 	IsClusterKey             = bsonutil.MustHaveTag(Distro{}, "IsCluster")
 	IceCreamSettingsKey      = bsonutil.MustHaveTag(Distro{}, "IceCreamSettings")
+	BaseDistroIDKey          = bsonutil.MustHaveTag(Distro{}, "BaseDistroID")
+	ProjectIDKey             = bsonutil.MustHaveTag(Distro{}, "ProjectID")
 )
 
 var (
@@ -66,6 +68,7 @@ var (
 	BootstrapSettingsRootDirKey               = bsonutil.MustHaveTag(BootstrapSettings{}, "RootDir")
 	BootstrapSettingsEnvKey                   = bsonutil.MustHaveTag(BootstrapSettings{}, "Env")
 	BootstrapSettingsResourceLimitsKey        = bsonutil.MustHaveTag(BootstrapSettings{}, "ResourceLimits")
+	BootstrapSettingsTaskCgroupsKey           = bsonutil.MustHaveTag(BootstrapSettings{}, "TaskCgroups")
 
 	ResourceLimitsNumFilesKey        = bsonutil.MustHaveTag(ResourceLimits{}, "NumFiles")
 	ResourceLimitsNumProcessesKey    = bsonutil.MustHaveTag(ResourceLimits{}, "NumProcesses")
@@ -149,6 +152,12 @@ func ById(id string) bson.M {
 	return bson.M{IdKey: id}
 }
 
+// ByProjectID returns a query that finds the project distros owned by the
+// project.
+func ByProjectID(projectID string) bson.M {
+	return bson.M{ProjectIDKey: projectID}
+}
+
 // ByProvider returns a query that contains a Provider selector on the string, p.
 func ByProvider(p string) bson.M {
 	return bson.M{ProviderKey: p}
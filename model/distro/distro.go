@@ -54,6 +54,71 @@ type Distro struct {
 	IsCluster             bool                  `bson:"is_cluster" json:"is_cluster" mapstructure:"is_cluster"`
 	HomeVolumeSettings    HomeVolumeSettings    `bson:"home_volume_settings" json:"home_volume_settings" mapstructure:"home_volume_settings"`
 	IceCreamSettings      IceCreamSettings      `bson:"icecream_settings,omitempty" json:"icecream_settings,omitempty" mapstructure:"icecream_settings,omitempty"`
+	ProjectDistroSettings ProjectDistroSettings `bson:"project_distro_settings,omitempty" json:"project_distro_settings,omitempty" mapstructure:"project_distro_settings,omitempty"`
+	// BaseDistroID is the distro that this project distro was derived from.
+	// It's only set for project distros.
+	BaseDistroID string `bson:"base_distro_id,omitempty" json:"base_distro_id,omitempty" mapstructure:"base_distro_id,omitempty"`
+	// ProjectID is the project that owns this project distro. It's only set
+	// for project distros.
+	ProjectID string `bson:"project_id,omitempty" json:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+}
+
+// ProjectDistroSettings are the settings for deriving project distros from a
+// distro.
+type ProjectDistroSettings struct {
+	// Allowed is whether project admins can derive project distros from this
+	// distro.
+	Allowed bool `bson:"allowed,omitempty" json:"allowed,omitempty" mapstructure:"allowed,omitempty"`
+	// AllowedInstanceTypes are the instance types that project distros can use
+	// in place of this distro's instance type.
+	AllowedInstanceTypes []string `bson:"allowed_instance_types,omitempty" json:"allowed_instance_types,omitempty" mapstructure:"allowed_instance_types,omitempty"`
+	// MaxHosts is the maximum number of hosts that each project distro derived
+	// from this distro can run at once. If it's not set, it defaults to
+	// DefaultProjectDistroMaxHosts.
+	MaxHosts int `bson:"max_hosts,omitempty" json:"max_hosts,omitempty" mapstructure:"max_hosts,omitempty"`
+	// MaxDistrosPerProject is the maximum number of project distros that each
+	// project can derive from this distro. If it's not set, it defaults to
+	// DefaultMaxProjectDistrosPerProject.
+	MaxDistrosPerProject int `bson:"max_distros_per_project,omitempty" json:"max_distros_per_project,omitempty" mapstructure:"max_distros_per_project,omitempty"`
+}
+
+const (
+	// DefaultProjectDistroMaxHosts is the default maximum number of hosts that
+	// each project distro can run at once.
+	DefaultProjectDistroMaxHosts = 10
+	// DefaultMaxProjectDistrosPerProject is the default maximum number of
+	// project distros that a project can derive from a base distro.
+	DefaultMaxProjectDistrosPerProject = 3
+)
+
+// GetMaxHosts returns the maximum number of hosts that each project distro can
+// run at once.
+func (s ProjectDistroSettings) GetMaxHosts() int {
+	if s.MaxHosts > 0 {
+		return s.MaxHosts
+	}
+	return DefaultProjectDistroMaxHosts
+}
+
+// GetMaxDistrosPerProject returns the maximum number of project distros that
+// each project can derive from the distro.
+func (s ProjectDistroSettings) GetMaxDistrosPerProject() int {
+	if s.MaxDistrosPerProject > 0 {
+		return s.MaxDistrosPerProject
+	}
+	return DefaultMaxProjectDistrosPerProject
+}
+
+// ProjectDistroOptions are the fields that a project distro can override from
+// its base distro.
+type ProjectDistroOptions struct {
+	// Setup replaces the base distro's setup script if it's set.
+	Setup string
+	// Expansions are added to the base distro's expansions, replacing any
+	// base distro expansions with the same key.
+	Expansions []Expansion
+	// InstanceType replaces the base distro's instance type if it's set.
+	InstanceType string
 }
 
 // DistroData is the same as a distro, with the only difference being that all
@@ -97,6 +162,7 @@ type BootstrapSettings struct {
 	// Optional
 	Env                 []EnvVar             `bson:"env,omitempty" json:"env,omitempty" mapstructure:"env,omitempty"`
 	ResourceLimits      ResourceLimits       `bson:"resource_limits,omitempty" json:"resource_limits,omitempty" mapstructure:"resource_limits,omitempty"`
+	TaskCgroups         TaskCgroupSettings   `bson:"task_cgroups,omitempty" json:"task_cgroups,omitempty" mapstructure:"task_cgroups,omitempty"`
 	PreconditionScripts []PreconditionScript `bson:"precondition_scripts,omitempty" json:"precondition_scripts,omitempty" mapstructure:"precondition_scripts,omitempty"`
 
 	// Required for new provisioning
@@ -131,6 +197,21 @@ type ResourceLimits struct {
 	VirtualMemoryKB int `bson:"virtual_memory,omitempty" json:"virtual_memory,omitempty" mapstructure:"virtual_memory,omitempty"`
 }
 
+// TaskCgroupSettings represents settings for running each task's processes
+// in a separate cgroup on Linux.
+type TaskCgroupSettings struct {
+	// Enabled opts the distro into running each task in its own cgroup.
+	Enabled bool `bson:"enabled,omitempty" json:"enabled,omitempty" mapstructure:"enabled,omitempty"`
+	// MemoryLimitMB is the default memory limit for a task's cgroup.
+	MemoryLimitMB int `bson:"memory_limit_mb,omitempty" json:"memory_limit_mb,omitempty" mapstructure:"memory_limit_mb,omitempty"`
+	// CPUPercent is the default CPU limit for a task's cgroup, as a
+	// percentage of a single CPU.
+	CPUPercent int `bson:"cpu_percent,omitempty" json:"cpu_percent,omitempty" mapstructure:"cpu_percent,omitempty"`
+	// NumProcesses is the default limit on the number of processes in a
+	// task's cgroup.
+	NumProcesses int `bson:"num_processes,omitempty" json:"num_processes,omitempty" mapstructure:"num_processes,omitempty"`
+}
+
 type HomeVolumeSettings struct {
 	FormatCommand string `bson:"format_command" json:"format_command" mapstructure:"format_command"`
 }
@@ -200,6 +281,10 @@ func (d *Distro) ValidateBootstrapSettings() error {
 	}
 
 	catcher.NewWhen(d.IsWindows() && d.BootstrapSettings.RootDir == "", "root directory cannot be empty for Windows")
+	catcher.NewWhen(d.BootstrapSettings.TaskCgroups.Enabled && !d.IsLinux(), "task cgroups can only be enabled for Linux distros")
+	catcher.NewWhen(d.BootstrapSettings.TaskCgroups.MemoryLimitMB < 0, "task cgroup memory limit cannot be negative")
+	catcher.NewWhen(d.BootstrapSettings.TaskCgroups.CPUPercent < 0, "task cgroup CPU limit cannot be negative")
+	catcher.NewWhen(d.BootstrapSettings.TaskCgroups.NumProcesses < 0, "task cgroup process limit cannot be negative")
 
 	if d.BootstrapSettings.Method == BootstrapMethodLegacySSH || d.BootstrapSettings.Communication == CommunicationMethodLegacySSH {
 		return catcher.Resolve()
@@ -777,14 +862,16 @@ func (d *Distro) AddPermissions(creator *user.DBUser) error {
 		return errors.Wrapf(err, "adding scope for distro '%s'", d.Id)
 	}
 	newRole := gimlet.Role{
-		ID:     fmt.Sprintf("admin_distro_%s", d.Id),
-		Owners: []string{creator.Id},
-		Scope:  newScope.ID,
+		ID:    fmt.Sprintf("admin_distro_%s", d.Id),
+		Scope: newScope.ID,
 		Permissions: map[string]int{
 			evergreen.PermissionDistroSettings: evergreen.DistroSettingsAdmin.Value,
 			evergreen.PermissionHosts:          evergreen.HostsEdit.Value,
 		},
 	}
+	if creator != nil {
+		newRole.Owners = []string{creator.Id}
+	}
 	if err := rm.UpdateRole(newRole); err != nil {
 		return errors.Wrapf(err, "adding admin role for distro '%s'", d.Id)
 	}
@@ -796,6 +883,135 @@ func (d *Distro) AddPermissions(creator *user.DBUser) error {
 	return nil
 }
 
+// IsProjectDistro returns whether the distro is a project distro, which a
+// project admin derived from a base distro.
+func (d *Distro) IsProjectDistro() bool {
+	return d.BaseDistroID != ""
+}
+
+// GetInstanceTypes returns the distinct instance types in the distro's
+// provider settings.
+func (d *Distro) GetInstanceTypes() []string {
+	instanceTypes := []string{}
+	for _, doc := range d.ProviderSettingsList {
+		instanceType, ok := doc.Lookup("instance_type").StringValueOK()
+		if ok && !utility.StringSliceContains(instanceTypes, instanceType) {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	return instanceTypes
+}
+
+// NewProjectDistro returns a new project distro derived from the base distro.
+// The project distro has all the base distro's settings except for the given
+// overrides and its host limit, and it can only be used by the given project.
+func NewProjectDistro(base *Distro, id, projectID string, opts ProjectDistroOptions) (*Distro, error) {
+	if base.IsProjectDistro() {
+		return nil, errors.Errorf("distro '%s' is a project distro, so it cannot be used as a base distro", base.Id)
+	}
+	if !base.ProjectDistroSettings.Allowed {
+		return nil, errors.Errorf("distro '%s' does not allow project distros to be derived from it", base.Id)
+	}
+	if opts.InstanceType != "" && !evergreen.IsEc2Provider(base.Provider) {
+		return nil, errors.Errorf("cannot set the instance type for a distro with provider '%s'", base.Provider)
+	}
+
+	return deriveProjectDistro(base, id, projectID, opts), nil
+}
+
+func deriveProjectDistro(base *Distro, id, projectID string, opts ProjectDistroOptions) *Distro {
+	d := *base
+	d.Id = id
+	d.BaseDistroID = base.Id
+	d.ProjectID = projectID
+	d.ValidProjects = []string{projectID}
+	d.Aliases = nil
+	d.SpawnAllowed = false
+	d.IsVirtualWorkstation = false
+	d.ProjectDistroSettings = ProjectDistroSettings{}
+	d.HostAllocatorSettings.MaximumHosts = ProjectDistroMaxHosts(base)
+	d.Note = fmt.Sprintf("Project distro for project '%s' derived from distro '%s'.", projectID, base.Id)
+	if opts.Setup != "" {
+		d.Setup = opts.Setup
+	}
+
+	d.Expansions = []Expansion{}
+	for _, e := range base.Expansions {
+		if !expansionsContainKey(opts.Expansions, e.Key) {
+			d.Expansions = append(d.Expansions, e)
+		}
+	}
+	d.Expansions = append(d.Expansions, opts.Expansions...)
+
+	d.ProviderSettingsList = make([]*birch.Document, 0, len(base.ProviderSettingsList))
+	for _, doc := range base.ProviderSettingsList {
+		doc = doc.Copy()
+		if opts.InstanceType != "" {
+			doc.Set(birch.EC.String("instance_type", opts.InstanceType))
+		}
+		d.ProviderSettingsList = append(d.ProviderSettingsList, doc)
+	}
+
+	return &d
+}
+
+// ProjectDistroMaxHosts returns the maximum number of hosts that a project
+// distro derived from the base distro can run at once, which is never more
+// than the base distro itself can run.
+func ProjectDistroMaxHosts(base *Distro) int {
+	maxHosts := base.ProjectDistroSettings.GetMaxHosts()
+	if base.HostAllocatorSettings.MaximumHosts > 0 && base.HostAllocatorSettings.MaximumHosts < maxHosts {
+		return base.HostAllocatorSettings.MaximumHosts
+	}
+	return maxHosts
+}
+
+// ProjectDistroOverrides returns the settings that the project distro
+// overrides from the given base distro.
+func (d *Distro) ProjectDistroOverrides(base *Distro) ProjectDistroOptions {
+	var opts ProjectDistroOptions
+	if d.Setup != base.Setup {
+		opts.Setup = d.Setup
+	}
+
+	baseExpansions := map[string]string{}
+	for _, e := range base.Expansions {
+		baseExpansions[e.Key] = e.Value
+	}
+	for _, e := range d.Expansions {
+		if value, ok := baseExpansions[e.Key]; !ok || value != e.Value {
+			opts.Expansions = append(opts.Expansions, e)
+		}
+	}
+
+	if len(d.ProviderSettingsList) > 0 && len(base.ProviderSettingsList) > 0 {
+		instanceType, _ := d.ProviderSettingsList[0].Lookup("instance_type").StringValueOK()
+		baseInstanceType, _ := base.ProviderSettingsList[0].Lookup("instance_type").StringValueOK()
+		if instanceType != baseInstanceType {
+			opts.InstanceType = instanceType
+		}
+	}
+
+	return opts
+}
+
+// RederiveFromBase returns the project distro derived again from its base
+// distro after the base distro changed from oldBase to newBase. The project
+// distro keeps the settings it overrode from the old base distro and takes
+// every other setting from the new base distro.
+func (d *Distro) RederiveFromBase(oldBase, newBase *Distro) *Distro {
+	return deriveProjectDistro(newBase, d.Id, d.ProjectID, d.ProjectDistroOverrides(oldBase))
+}
+
+func expansionsContainKey(expansions []Expansion, key string) bool {
+	for _, e := range expansions {
+		if e.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
 // LegacyBootstrap returns whether hosts of this distro are bootstrapped using
 // the legacy method.
 func (d *Distro) LegacyBootstrap() bool {
@@ -716,3 +716,149 @@ func TestGetAuthorizedKeysFile(t *testing.T) {
 		assert.Equal(t, expected, d.GetAuthorizedKeysFile())
 	})
 }
+
+func TestNewProjectDistro(t *testing.T) {
+	base := &Distro{
+		Id:           "base",
+		Aliases:      []string{"alias"},
+		Provider:     evergreen.ProviderNameEc2Fleet,
+		Setup:        "base setup",
+		SpawnAllowed: true,
+		Expansions: []Expansion{
+			{Key: "a", Value: "base"},
+			{Key: "b", Value: "base"},
+		},
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", "ami"),
+			birch.EC.String("instance_type", "m5.large"),
+		)},
+		ProjectDistroSettings: ProjectDistroSettings{Allowed: true},
+	}
+
+	t.Run("OverridesOnlyGivenFields", func(t *testing.T) {
+		d, err := NewProjectDistro(base, "project_distro", "project", ProjectDistroOptions{
+			Expansions:   []Expansion{{Key: "b", Value: "project"}, {Key: "c", Value: "project"}},
+			InstanceType: "m5.xlarge",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "project_distro", d.Id)
+		assert.Equal(t, "base", d.BaseDistroID)
+		assert.Equal(t, "project", d.ProjectID)
+		assert.True(t, d.IsProjectDistro())
+		assert.Equal(t, []string{"project"}, d.ValidProjects)
+		assert.Empty(t, d.Aliases)
+		assert.False(t, d.SpawnAllowed)
+		assert.False(t, d.ProjectDistroSettings.Allowed)
+		assert.Equal(t, "base setup", d.Setup)
+		assert.Equal(t, []Expansion{
+			{Key: "a", Value: "base"},
+			{Key: "b", Value: "project"},
+			{Key: "c", Value: "project"},
+		}, d.Expansions)
+		assert.Equal(t, []string{"m5.xlarge"}, d.GetInstanceTypes())
+		assert.Equal(t, "ami", d.GetDefaultAMI())
+
+		assert.Equal(t, []string{"m5.large"}, base.GetInstanceTypes(), "base distro should not be modified")
+		assert.Len(t, base.Expansions, 2, "base distro should not be modified")
+	})
+	t.Run("OverridesSetup", func(t *testing.T) {
+		d, err := NewProjectDistro(base, "project_distro", "project", ProjectDistroOptions{Setup: "project setup"})
+		require.NoError(t, err)
+		assert.Equal(t, "project setup", d.Setup)
+		assert.Equal(t, []string{"m5.large"}, d.GetInstanceTypes())
+	})
+	t.Run("FailsForDisallowedBaseDistro", func(t *testing.T) {
+		_, err := NewProjectDistro(&Distro{Id: "base"}, "project_distro", "project", ProjectDistroOptions{})
+		assert.Error(t, err)
+	})
+	t.Run("FailsForProjectDistroBase", func(t *testing.T) {
+		d, err := NewProjectDistro(base, "project_distro", "project", ProjectDistroOptions{})
+		require.NoError(t, err)
+		d.ProjectDistroSettings.Allowed = true
+		_, err = NewProjectDistro(d, "other_project_distro", "project", ProjectDistroOptions{})
+		assert.Error(t, err)
+	})
+	t.Run("FailsForInstanceTypeWithNonEC2Provider", func(t *testing.T) {
+		static := &Distro{Id: "static", Provider: evergreen.ProviderNameStatic, ProjectDistroSettings: ProjectDistroSettings{Allowed: true}}
+		_, err := NewProjectDistro(static, "project_distro", "project", ProjectDistroOptions{InstanceType: "m5.xlarge"})
+		assert.Error(t, err)
+	})
+}
+
+func TestRederiveFromBase(t *testing.T) {
+	makeSettings := func(ami, instanceType string) *birch.Document {
+		return birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", ami),
+			birch.EC.String("instance_type", instanceType),
+		)
+	}
+	oldBase := &Distro{
+		Id:                   "base",
+		Provider:             evergreen.ProviderNameEc2Fleet,
+		Setup:                "echo base",
+		Expansions:           []Expansion{{Key: "k1", Value: "v1"}},
+		ProviderSettingsList: []*birch.Document{makeSettings("old_ami", "m5.large")},
+		ProjectDistroSettings: ProjectDistroSettings{
+			Allowed:              true,
+			AllowedInstanceTypes: []string{"m5.xlarge"},
+		},
+	}
+
+	t.Run("KeepsOverridesAndTakesOtherSettingsFromNewBase", func(t *testing.T) {
+		d, err := NewProjectDistro(oldBase, "project_distro", "project", ProjectDistroOptions{
+			Setup:        "echo project",
+			Expansions:   []Expansion{{Key: "k2", Value: "v2"}},
+			InstanceType: "m5.xlarge",
+		})
+		require.NoError(t, err)
+
+		newBase := *oldBase
+		newBase.Disabled = true
+		newBase.BootstrapSettings.Method = BootstrapMethodSSH
+		newBase.SSHOptions = []string{"StrictHostKeyChecking=no"}
+		newBase.ProviderSettingsList = []*birch.Document{makeSettings("new_ami", "m5.large")}
+
+		updated := d.RederiveFromBase(oldBase, &newBase)
+		assert.Equal(t, "project_distro", updated.Id)
+		assert.Equal(t, "base", updated.BaseDistroID)
+		assert.Equal(t, []string{"project"}, updated.ValidProjects)
+		assert.True(t, updated.Disabled)
+		assert.Equal(t, BootstrapMethodSSH, updated.BootstrapSettings.Method)
+		assert.Equal(t, []string{"StrictHostKeyChecking=no"}, updated.SSHOptions)
+		assert.Equal(t, "new_ami", updated.GetDefaultAMI())
+		assert.Equal(t, "echo project", updated.Setup)
+		assert.Equal(t, []string{"m5.xlarge"}, updated.GetInstanceTypes())
+		assert.Equal(t, "v1", updated.Expansions[0].Value)
+		assert.True(t, expansionsContainKey(updated.Expansions, "k2"))
+		assert.Empty(t, updated.ProjectDistroSettings.AllowedInstanceTypes)
+	})
+	t.Run("TakesSettingsThatWereNotOverriddenFromNewBase", func(t *testing.T) {
+		d, err := NewProjectDistro(oldBase, "project_distro", "project", ProjectDistroOptions{})
+		require.NoError(t, err)
+
+		newBase := *oldBase
+		newBase.Setup = "echo new base"
+		newBase.ProviderSettingsList = []*birch.Document{makeSettings("old_ami", "m5.2xlarge")}
+
+		updated := d.RederiveFromBase(oldBase, &newBase)
+		assert.Equal(t, "echo new base", updated.Setup)
+		assert.Equal(t, []string{"m5.2xlarge"}, updated.GetInstanceTypes())
+	})
+}
+
+func TestProjectDistroMaxHosts(t *testing.T) {
+	base := &Distro{Id: "base", ProjectDistroSettings: ProjectDistroSettings{Allowed: true}}
+	assert.Equal(t, DefaultProjectDistroMaxHosts, ProjectDistroMaxHosts(base))
+
+	base.ProjectDistroSettings.MaxHosts = 20
+	assert.Equal(t, 20, ProjectDistroMaxHosts(base))
+
+	base.HostAllocatorSettings.MaximumHosts = 5
+	assert.Equal(t, 5, ProjectDistroMaxHosts(base), "project distro should not run more hosts than its base distro")
+
+	d, err := NewProjectDistro(base, "project_distro", "project", ProjectDistroOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 5, d.HostAllocatorSettings.MaximumHosts)
+}
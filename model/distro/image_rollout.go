@@ -0,0 +1,332 @@
+package distro
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ImageRolloutCollection is the collection of distro image rollouts.
+const ImageRolloutCollection = "distro_image_rollouts"
+
+const (
+	// ImageRolloutStatusInProgress means new hosts are being created with
+	// the candidate image.
+	ImageRolloutStatusInProgress = "in-progress"
+	// ImageRolloutStatusPromoted means the candidate image became the
+	// distro's image.
+	ImageRolloutStatusPromoted = "promoted"
+	// ImageRolloutStatusRolledBack means the candidate image was abandoned
+	// and the distro kept its previous image.
+	ImageRolloutStatusRolledBack = "rolled-back"
+)
+
+const (
+	defaultImageRolloutHostPercentage                   = 10
+	defaultImageRolloutMinHosts                         = 5
+	defaultImageRolloutMinCanaryTasks                   = 20
+	defaultImageRolloutMaxProvisioningFailurePercentage = 10
+	defaultImageRolloutMaxSystemFailurePercentage       = 5
+	defaultImageRolloutTimeout                          = 24 * time.Hour
+)
+
+var (
+	ImageRolloutIDKey            = bsonutil.MustHaveTag(ImageRollout{}, "ID")
+	ImageRolloutDistroIDKey      = bsonutil.MustHaveTag(ImageRollout{}, "DistroID")
+	ImageRolloutStatusKey        = bsonutil.MustHaveTag(ImageRollout{}, "Status")
+	ImageRolloutStartedAtKey     = bsonutil.MustHaveTag(ImageRollout{}, "StartedAt")
+	ImageRolloutFinishedAtKey    = bsonutil.MustHaveTag(ImageRollout{}, "FinishedAt")
+	ImageRolloutReasonKey        = bsonutil.MustHaveTag(ImageRollout{}, "Reason")
+	ImageRolloutCanaryTaskIDsKey = bsonutil.MustHaveTag(ImageRollout{}, "CanaryTaskIDs")
+)
+
+// ImageRolloutCanaryTask selects tasks from the latest mainline version of a
+// project to run on an image rollout's candidate hosts. It selects the tasks
+// in the given build variant, the tasks with the given name, or the task with
+// the given name in the given build variant.
+type ImageRolloutCanaryTask struct {
+	Project string `bson:"project" json:"project"`
+	Variant string `bson:"variant,omitempty" json:"variant,omitempty"`
+	Task    string `bson:"task,omitempty" json:"task,omitempty"`
+}
+
+// ImageRollout is a staged rollout of a new image for a distro. While it's in
+// progress, a percentage of the distro's new hosts use the candidate image and
+// the tasks that run on them act as canaries. The candidate image is promoted
+// or rolled back based on how those hosts and tasks fare.
+type ImageRollout struct {
+	ID       string `bson:"_id" json:"id"`
+	DistroID string `bson:"distro_id" json:"distro_id"`
+	// Region is the region whose image is being replaced.
+	Region       string `bson:"region" json:"region"`
+	CandidateAMI string `bson:"candidate_ami" json:"candidate_ami"`
+	// PreviousAMI is the distro's image when the rollout started.
+	PreviousAMI string `bson:"previous_ami" json:"previous_ami"`
+	// HostPercentage is the percentage of new hosts that use the candidate
+	// image.
+	HostPercentage int `bson:"host_percentage" json:"host_percentage"`
+	// MinHosts is the number of candidate hosts that must finish
+	// provisioning, successfully or not, before the rollout can finish.
+	MinHosts int `bson:"min_hosts" json:"min_hosts"`
+	// MinCanaryTasks is the number of tasks that must finish on candidate
+	// hosts before the rollout can finish. It only applies if the rollout
+	// doesn't have configured canary tasks.
+	MinCanaryTasks int `bson:"min_canary_tasks" json:"min_canary_tasks"`
+	// CanaryTasks selects the tasks that are scheduled to run on candidate
+	// hosts when the rollout starts. If it's set, the rollout can only be
+	// promoted once all of those tasks have succeeded on candidate hosts.
+	CanaryTasks []ImageRolloutCanaryTask `bson:"canary_tasks,omitempty" json:"canary_tasks,omitempty"`
+	// CanaryTaskIDs are the IDs of the tasks that were scheduled to run on
+	// candidate hosts for CanaryTasks.
+	CanaryTaskIDs []string `bson:"canary_task_ids,omitempty" json:"canary_task_ids,omitempty"`
+	// MaxProvisioningFailurePercentage is the percentage of candidate hosts
+	// that can fail to provision before the rollout is rolled back.
+	MaxProvisioningFailurePercentage int `bson:"max_provisioning_failure_percentage" json:"max_provisioning_failure_percentage"`
+	// MaxSystemFailurePercentage is the percentage of canary tasks that can
+	// system fail before the rollout is rolled back.
+	MaxSystemFailurePercentage int `bson:"max_system_failure_percentage" json:"max_system_failure_percentage"`
+	// Timeout is how long the rollout can take to gather enough hosts and
+	// canary tasks before it's rolled back.
+	Timeout    time.Duration `bson:"timeout" json:"timeout"`
+	Status     string        `bson:"status" json:"status"`
+	StartedBy  string        `bson:"started_by" json:"started_by"`
+	StartedAt  time.Time     `bson:"started_at" json:"started_at"`
+	FinishedAt time.Time     `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+	// Reason explains why the rollout was promoted or rolled back.
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// NewImageRollout returns a new in-progress image rollout for the distro. The
+// rollout's thresholds are defaulted if they're not set.
+func NewImageRollout(d *Distro, user string, r ImageRollout) (*ImageRollout, error) {
+	if !evergreen.IsEc2Provider(d.Provider) {
+		return nil, errors.Errorf("cannot roll out an image for distro '%s' with provider '%s'", d.Id, d.Provider)
+	}
+	if r.CandidateAMI == "" {
+		return nil, errors.New("must specify a candidate image")
+	}
+	if r.Region == "" {
+		r.Region = evergreen.DefaultEC2Region
+	}
+	settings, err := d.GetProviderSettingByRegion(r.Region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting provider settings for region '%s'", r.Region)
+	}
+	previousAMI, _ := settings.Lookup("ami").StringValueOK()
+	if previousAMI == r.CandidateAMI {
+		return nil, errors.Errorf("distro '%s' already uses image '%s' in region '%s'", d.Id, r.CandidateAMI, r.Region)
+	}
+
+	for _, c := range r.CanaryTasks {
+		if c.Project == "" {
+			return nil, errors.New("canary tasks must specify a project")
+		}
+		if c.Variant == "" && c.Task == "" {
+			return nil, errors.New("canary tasks must specify a build variant, a task, or both")
+		}
+	}
+
+	if r.HostPercentage == 0 {
+		r.HostPercentage = defaultImageRolloutHostPercentage
+	}
+	if r.MinHosts == 0 {
+		r.MinHosts = defaultImageRolloutMinHosts
+		if len(r.CanaryTasks) > 0 {
+			// The canary tasks are what gate the rollout, so it shouldn't
+			// also have to wait for many candidate hosts on a quiet distro.
+			r.MinHosts = 1
+		}
+	}
+	if r.MinCanaryTasks == 0 {
+		r.MinCanaryTasks = defaultImageRolloutMinCanaryTasks
+	}
+	if r.MaxProvisioningFailurePercentage == 0 {
+		r.MaxProvisioningFailurePercentage = defaultImageRolloutMaxProvisioningFailurePercentage
+	}
+	if r.MaxSystemFailurePercentage == 0 {
+		r.MaxSystemFailurePercentage = defaultImageRolloutMaxSystemFailurePercentage
+	}
+	if r.Timeout == 0 {
+		r.Timeout = defaultImageRolloutTimeout
+	}
+	if r.HostPercentage < 0 || r.HostPercentage > 100 {
+		return nil, errors.New("host percentage must be between 0 and 100")
+	}
+	if r.MaxProvisioningFailurePercentage < 0 || r.MaxProvisioningFailurePercentage > 100 ||
+		r.MaxSystemFailurePercentage < 0 || r.MaxSystemFailurePercentage > 100 {
+		return nil, errors.New("failure percentages must be between 0 and 100")
+	}
+	if r.MinHosts < 0 || r.MinCanaryTasks < 0 || r.Timeout < 0 {
+		return nil, errors.New("minimum hosts, minimum canary tasks, and timeout cannot be negative")
+	}
+
+	r.ID = mgobson.NewObjectId().Hex()
+	r.DistroID = d.Id
+	r.PreviousAMI = previousAMI
+	r.Status = ImageRolloutStatusInProgress
+	r.StartedBy = user
+	r.StartedAt = time.Now()
+	r.FinishedAt = time.Time{}
+	r.Reason = ""
+	r.CanaryTaskIDs = nil
+	return &r, nil
+}
+
+// FindImageRollouts returns the distro's image rollouts, from newest to
+// oldest.
+func FindImageRollouts(ctx context.Context, distroID string) ([]ImageRollout, error) {
+	return findImageRollouts(ctx, bson.M{ImageRolloutDistroIDKey: distroID}, options.Find().SetSort(bson.M{ImageRolloutStartedAtKey: -1}))
+}
+
+// FindActiveImageRollouts returns all in-progress image rollouts.
+func FindActiveImageRollouts(ctx context.Context) ([]ImageRollout, error) {
+	return findImageRollouts(ctx, bson.M{ImageRolloutStatusKey: ImageRolloutStatusInProgress})
+}
+
+// FindActiveImageRollout returns the distro's in-progress image rollout, if
+// it has one.
+func FindActiveImageRollout(ctx context.Context, distroID string) (*ImageRollout, error) {
+	return findOneImageRollout(ctx, bson.M{
+		ImageRolloutDistroIDKey: distroID,
+		ImageRolloutStatusKey:   ImageRolloutStatusInProgress,
+	})
+}
+
+// FindImageRolloutByID returns the image rollout with the given ID.
+func FindImageRolloutByID(ctx context.Context, id string) (*ImageRollout, error) {
+	return findOneImageRollout(ctx, bson.M{ImageRolloutIDKey: id})
+}
+
+func findOneImageRollout(ctx context.Context, query bson.M) (*ImageRollout, error) {
+	res := evergreen.GetEnvironment().DB().Collection(ImageRolloutCollection).FindOne(ctx, query)
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "finding image rollout")
+	}
+	r := &ImageRollout{}
+	if err := res.Decode(r); err != nil {
+		return nil, errors.Wrap(err, "decoding image rollout")
+	}
+	return r, nil
+}
+
+func findImageRollouts(ctx context.Context, query bson.M, opts ...*options.FindOptions) ([]ImageRollout, error) {
+	cur, err := evergreen.GetEnvironment().DB().Collection(ImageRolloutCollection).Find(ctx, query, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding image rollouts")
+	}
+	rollouts := []ImageRollout{}
+	if err := cur.All(ctx, &rollouts); err != nil {
+		return nil, errors.Wrap(err, "decoding image rollouts")
+	}
+	return rollouts, nil
+}
+
+// Insert writes the image rollout to the database.
+func (r *ImageRollout) Insert(ctx context.Context) error {
+	_, err := evergreen.GetEnvironment().DB().Collection(ImageRolloutCollection).InsertOne(ctx, r)
+	return errors.Wrap(err, "inserting image rollout")
+}
+
+// Finish marks the in-progress image rollout as promoted or rolled back. It
+// returns an error if the rollout already finished.
+func (r *ImageRollout) Finish(ctx context.Context, status, reason string) error {
+	if status != ImageRolloutStatusPromoted && status != ImageRolloutStatusRolledBack {
+		return errors.Errorf("invalid final image rollout status '%s'", status)
+	}
+	now := time.Now()
+	res, err := evergreen.GetEnvironment().DB().Collection(ImageRolloutCollection).UpdateOne(ctx,
+		bson.M{
+			ImageRolloutIDKey:     r.ID,
+			ImageRolloutStatusKey: ImageRolloutStatusInProgress,
+		},
+		bson.M{"$set": bson.M{
+			ImageRolloutStatusKey:     status,
+			ImageRolloutFinishedAtKey: now,
+			ImageRolloutReasonKey:     reason,
+		}},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "finishing image rollout '%s'", r.ID)
+	}
+	if res.ModifiedCount == 0 {
+		return errors.Errorf("image rollout '%s' is not in progress", r.ID)
+	}
+	r.Status = status
+	r.FinishedAt = now
+	r.Reason = reason
+	return nil
+}
+
+// SetCanaryTaskIDs sets the IDs of the tasks that were scheduled to run on the
+// image rollout's candidate hosts.
+func (r *ImageRollout) SetCanaryTaskIDs(ctx context.Context, taskIDs []string) error {
+	_, err := evergreen.GetEnvironment().DB().Collection(ImageRolloutCollection).UpdateOne(ctx,
+		bson.M{ImageRolloutIDKey: r.ID},
+		bson.M{"$set": bson.M{ImageRolloutCanaryTaskIDsKey: taskIDs}},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "setting canary tasks for image rollout '%s'", r.ID)
+	}
+	r.CanaryTaskIDs = taskIDs
+	return nil
+}
+
+// SetDistroImage sets the distro's image in the rollout's region to toAMI if
+// it's still fromAMI. Only the image is updated, so other changes made to the
+// distro while the rollout was in progress are kept. It returns whether the
+// image was updated.
+func (r *ImageRollout) SetDistroImage(ctx context.Context, fromAMI, toAMI string) (bool, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{
+			IdKey: r.DistroID,
+			ProviderSettingsListKey: bson.M{"$elemMatch": bson.M{
+				"region": r.Region,
+				"ami":    fromAMI,
+			}},
+		},
+		bson.M{"$set": bson.M{bsonutil.GetDottedKeyName(ProviderSettingsListKey, "$", "ami"): toAMI}},
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "setting image for distro '%s' in region '%s'", r.DistroID, r.Region)
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+// ShouldUseCandidate randomly decides whether a new host should use the
+// candidate image, so that the rollout's host percentage of new hosts use it.
+func (r *ImageRollout) ShouldUseCandidate() bool {
+	return rand.Intn(100) < r.HostPercentage
+}
+
+// ApplyCandidate returns a copy of the distro that uses the candidate image in
+// the rollout's region.
+func (r *ImageRollout) ApplyCandidate(d Distro) (Distro, error) {
+	settingsList := make([]*birch.Document, 0, len(d.ProviderSettingsList))
+	found := false
+	for _, doc := range d.ProviderSettingsList {
+		doc = doc.Copy()
+		region, _ := doc.Lookup("region").StringValueOK()
+		if region == r.Region {
+			doc.Set(birch.EC.String("ami", r.CandidateAMI))
+			found = true
+		}
+		settingsList = append(settingsList, doc)
+	}
+	if !found {
+		return d, errors.Errorf("distro '%s' has no settings for region '%s'", d.Id, r.Region)
+	}
+	d.ProviderSettingsList = settingsList
+	return d, nil
+}
@@ -0,0 +1,129 @@
+package distro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImageRollout(t *testing.T) {
+	d := &Distro{
+		Id:       "distro",
+		Provider: evergreen.ProviderNameEc2Fleet,
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", "old_ami"),
+		)},
+	}
+
+	t.Run("DefaultsUnsetFields", func(t *testing.T) {
+		r, err := NewImageRollout(d, "user", ImageRollout{CandidateAMI: "new_ami"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, r.ID)
+		assert.Equal(t, "distro", r.DistroID)
+		assert.Equal(t, evergreen.DefaultEC2Region, r.Region)
+		assert.Equal(t, "old_ami", r.PreviousAMI)
+		assert.Equal(t, "new_ami", r.CandidateAMI)
+		assert.Equal(t, defaultImageRolloutHostPercentage, r.HostPercentage)
+		assert.Equal(t, defaultImageRolloutMinHosts, r.MinHosts)
+		assert.Equal(t, defaultImageRolloutMinCanaryTasks, r.MinCanaryTasks)
+		assert.Equal(t, defaultImageRolloutMaxProvisioningFailurePercentage, r.MaxProvisioningFailurePercentage)
+		assert.Equal(t, defaultImageRolloutMaxSystemFailurePercentage, r.MaxSystemFailurePercentage)
+		assert.Equal(t, defaultImageRolloutTimeout, r.Timeout)
+		assert.Equal(t, ImageRolloutStatusInProgress, r.Status)
+		assert.Equal(t, "user", r.StartedBy)
+		assert.False(t, r.StartedAt.IsZero())
+	})
+	t.Run("KeepsSetFields", func(t *testing.T) {
+		r, err := NewImageRollout(d, "user", ImageRollout{
+			CandidateAMI:   "new_ami",
+			HostPercentage: 50,
+			MinHosts:       2,
+			Timeout:        time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 50, r.HostPercentage)
+		assert.Equal(t, 2, r.MinHosts)
+		assert.Equal(t, time.Hour, r.Timeout)
+	})
+	t.Run("FailsWithoutCandidateAMI", func(t *testing.T) {
+		_, err := NewImageRollout(d, "user", ImageRollout{})
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithCurrentAMI", func(t *testing.T) {
+		_, err := NewImageRollout(d, "user", ImageRollout{CandidateAMI: "old_ami"})
+		assert.Error(t, err)
+	})
+	t.Run("FailsForMissingRegion", func(t *testing.T) {
+		_, err := NewImageRollout(d, "user", ImageRollout{CandidateAMI: "new_ami", Region: "us-west-2"})
+		assert.Error(t, err)
+	})
+	t.Run("FailsForNonEC2Distro", func(t *testing.T) {
+		static := &Distro{Id: "static", Provider: evergreen.ProviderNameStatic}
+		_, err := NewImageRollout(static, "user", ImageRollout{CandidateAMI: "new_ami"})
+		assert.Error(t, err)
+	})
+	t.Run("DefaultsMinHostsForCanaryTasks", func(t *testing.T) {
+		r, err := NewImageRollout(d, "user", ImageRollout{
+			CandidateAMI: "new_ami",
+			CanaryTasks:  []ImageRolloutCanaryTask{{Project: "project", Task: "compile"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, r.MinHosts)
+	})
+	t.Run("FailsWithInvalidCanaryTasks", func(t *testing.T) {
+		_, err := NewImageRollout(d, "user", ImageRollout{
+			CandidateAMI: "new_ami",
+			CanaryTasks:  []ImageRolloutCanaryTask{{Task: "compile"}},
+		})
+		assert.Error(t, err, "canary tasks should require a project")
+		_, err = NewImageRollout(d, "user", ImageRollout{
+			CandidateAMI: "new_ami",
+			CanaryTasks:  []ImageRolloutCanaryTask{{Project: "project"}},
+		})
+		assert.Error(t, err, "canary tasks should require a variant or task")
+	})
+	t.Run("FailsWithInvalidPercentages", func(t *testing.T) {
+		_, err := NewImageRollout(d, "user", ImageRollout{CandidateAMI: "new_ami", HostPercentage: 101})
+		assert.Error(t, err)
+		_, err = NewImageRollout(d, "user", ImageRollout{CandidateAMI: "new_ami", MaxSystemFailurePercentage: -1})
+		assert.Error(t, err)
+	})
+}
+
+func TestImageRolloutApplyCandidate(t *testing.T) {
+	d := Distro{
+		Id:       "distro",
+		Provider: evergreen.ProviderNameEc2Fleet,
+		ProviderSettingsList: []*birch.Document{
+			birch.NewDocument(
+				birch.EC.String("region", evergreen.DefaultEC2Region),
+				birch.EC.String("ami", "old_ami"),
+			),
+			birch.NewDocument(
+				birch.EC.String("region", "us-west-2"),
+				birch.EC.String("ami", "west_ami"),
+			),
+		},
+	}
+
+	t.Run("ReplacesImageInRegion", func(t *testing.T) {
+		r := ImageRollout{Region: evergreen.DefaultEC2Region, CandidateAMI: "new_ami"}
+		candidate, err := r.ApplyCandidate(d)
+		require.NoError(t, err)
+		assert.Equal(t, "new_ami", candidate.GetDefaultAMI())
+		west, err := candidate.GetProviderSettingByRegion("us-west-2")
+		require.NoError(t, err)
+		assert.Equal(t, "west_ami", west.Lookup("ami").StringValue())
+		assert.Equal(t, "old_ami", d.GetDefaultAMI(), "original distro should not be modified")
+	})
+	t.Run("FailsForMissingRegion", func(t *testing.T) {
+		r := ImageRollout{Region: "eu-west-1", CandidateAMI: "new_ami"}
+		_, err := r.ApplyCandidate(d)
+		assert.Error(t, err)
+	})
+}
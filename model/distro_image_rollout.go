@@ -0,0 +1,325 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ImageRolloutStats summarizes how the candidate hosts and canary tasks of a
+// distro image rollout have fared.
+type ImageRolloutStats struct {
+	// ProvisionedHosts is the number of candidate hosts that provisioned
+	// successfully.
+	ProvisionedHosts int `json:"provisioned_hosts"`
+	// FailedHosts is the number of candidate hosts that failed to be created
+	// or provisioned.
+	FailedHosts int `json:"failed_hosts"`
+	// CanaryTasks is the number of tasks that finished on candidate hosts.
+	CanaryTasks int `json:"canary_tasks"`
+	// SystemFailedTasks is the number of canary tasks that system failed.
+	SystemFailedTasks int `json:"system_failed_tasks"`
+	// ConfiguredCanaryTasks is the number of tasks that were scheduled to run
+	// on candidate hosts for the rollout's configured canary tasks.
+	ConfiguredCanaryTasks int `json:"configured_canary_tasks"`
+	// SucceededConfiguredCanaryTasks is the number of configured canary tasks
+	// that succeeded on candidate hosts.
+	SucceededConfiguredCanaryTasks int `json:"succeeded_configured_canary_tasks"`
+	// FailedConfiguredCanaryTasks is the number of configured canary tasks
+	// that failed on candidate hosts.
+	FailedConfiguredCanaryTasks int `json:"failed_configured_canary_tasks"`
+}
+
+// ProvisioningFailurePercentage returns the percentage of candidate hosts
+// that finished provisioning that failed to provision.
+func (s ImageRolloutStats) ProvisioningFailurePercentage() float64 {
+	if s.ProvisionedHosts+s.FailedHosts == 0 {
+		return 0
+	}
+	return 100 * float64(s.FailedHosts) / float64(s.ProvisionedHosts+s.FailedHosts)
+}
+
+// SystemFailurePercentage returns the percentage of canary tasks that system
+// failed.
+func (s ImageRolloutStats) SystemFailurePercentage() float64 {
+	if s.CanaryTasks == 0 {
+		return 0
+	}
+	return 100 * float64(s.SystemFailedTasks) / float64(s.CanaryTasks)
+}
+
+// GetImageRolloutStats returns the stats for the image rollout's candidate
+// hosts and canary tasks.
+func GetImageRolloutStats(ctx context.Context, r *distro.ImageRollout) (*ImageRolloutStats, error) {
+	hosts, err := host.Find(ctx, host.ByImageRolloutID(r.ID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding candidate hosts for image rollout '%s'", r.ID)
+	}
+
+	stats := &ImageRolloutStats{ConfiguredCanaryTasks: len(r.CanaryTaskIDs)}
+	hostIDs := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		hostIDs = append(hostIDs, h.Id)
+		switch {
+		case utility.StringSliceContains([]string{evergreen.HostBuildingFailed, evergreen.HostProvisionFailed}, h.Status):
+			stats.FailedHosts++
+		case h.Provisioned:
+			stats.ProvisionedHosts++
+		case h.Status == evergreen.HostTerminated:
+			// Hosts that fail to provision are often terminated before the
+			// stats are checked, so a terminated host that never provisioned
+			// is a failure too.
+			stats.FailedHosts++
+		}
+	}
+	if len(hostIDs) == 0 {
+		return stats, nil
+	}
+
+	tasks, err := task.FindWithFields(bson.M{
+		task.HostIdKey: bson.M{"$in": hostIDs},
+		task.StatusKey: bson.M{"$in": evergreen.TaskCompletedStatuses},
+	}, task.StatusKey, task.DetailsKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding canary tasks for image rollout '%s'", r.ID)
+	}
+	for _, t := range tasks {
+		stats.CanaryTasks++
+		if t.Status == evergreen.TaskFailed && t.Details.Type == evergreen.CommandTypeSystem {
+			stats.SystemFailedTasks++
+		}
+	}
+
+	if len(r.CanaryTaskIDs) == 0 {
+		return stats, nil
+	}
+	configuredTasks, err := task.FindWithFields(bson.M{
+		task.IdKey:     bson.M{"$in": r.CanaryTaskIDs},
+		task.HostIdKey: bson.M{"$in": hostIDs},
+		task.StatusKey: bson.M{"$in": evergreen.TaskCompletedStatuses},
+	}, task.StatusKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding configured canary tasks for image rollout '%s'", r.ID)
+	}
+	for _, t := range configuredTasks {
+		if t.Status == evergreen.TaskSucceeded {
+			stats.SucceededConfiguredCanaryTasks++
+		} else {
+			stats.FailedConfiguredCanaryTasks++
+		}
+	}
+
+	return stats, nil
+}
+
+// ScheduleImageRolloutCanaryTasks schedules the image rollout's configured
+// canary tasks to run on its candidate hosts. The selected tasks in each
+// project's latest mainline version that run on the rollout's distro are
+// activated, or restarted if they already finished, and they can only run on
+// the rollout's candidate hosts until the rollout finishes.
+func ScheduleImageRolloutCanaryTasks(ctx context.Context, r *distro.ImageRollout, user, origin string) error {
+	if len(r.CanaryTasks) == 0 {
+		return nil
+	}
+
+	canaries := map[string]task.Task{}
+	for _, c := range r.CanaryTasks {
+		projectID, err := GetIdForProject(c.Project)
+		if err != nil {
+			return errors.Wrapf(err, "finding project '%s'", c.Project)
+		}
+		v, err := VersionFindOne(VersionByMostRecentSystemRequester(projectID).WithFields(VersionIdKey))
+		if err != nil {
+			return errors.Wrapf(err, "finding latest mainline version for project '%s'", c.Project)
+		}
+		if v == nil {
+			return errors.Errorf("project '%s' has no mainline versions", c.Project)
+		}
+
+		query := bson.M{
+			task.VersionKey:     v.Id,
+			task.DistroIdKey:    r.DistroID,
+			task.DisplayOnlyKey: bson.M{"$ne": true},
+		}
+		if c.Variant != "" {
+			query[task.BuildVariantKey] = c.Variant
+		}
+		if c.Task != "" {
+			query[task.DisplayNameKey] = c.Task
+		}
+		tasks, err := task.Find(query)
+		if err != nil {
+			return errors.Wrapf(err, "finding canary tasks in version '%s'", v.Id)
+		}
+		for _, t := range tasks {
+			canaries[t.Id] = t
+		}
+	}
+	if len(canaries) == 0 {
+		return errors.Errorf("no tasks that run on distro '%s' match the canary tasks", r.DistroID)
+	}
+
+	taskIDs := make([]string, 0, len(canaries))
+	for id := range canaries {
+		taskIDs = append(taskIDs, id)
+	}
+	sort.Strings(taskIDs)
+	// Mark the tasks before scheduling them so that they can't be dispatched
+	// to hosts that use the distro's current image.
+	if err := task.SetImageRolloutCanaries(taskIDs, r.ID); err != nil {
+		return err
+	}
+	if err := r.SetCanaryTaskIDs(ctx, taskIDs); err != nil {
+		return err
+	}
+
+	settings, err := evergreen.GetConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting admin settings")
+	}
+	catcher := grip.NewBasicCatcher()
+	for _, id := range taskIDs {
+		t := canaries[id]
+		switch {
+		case t.IsFinished():
+			catcher.Wrapf(ResetTaskOrDisplayTask(ctx, settings, &t, user, origin, false, nil), "restarting canary task '%s'", t.Id)
+		case !t.Activated:
+			catcher.Wrapf(SetActiveState(ctx, user, true, t), "activating canary task '%s'", t.Id)
+		}
+	}
+	return catcher.Resolve()
+}
+
+// CheckImageRollout promotes or rolls back the in-progress image rollout once
+// enough of its candidate hosts and canary tasks have finished. If the rollout
+// has configured canary tasks, it's promoted once all of them have succeeded
+// instead of waiting for a minimum number of canary tasks. Rollouts that
+// exceed a failure threshold or whose configured canary tasks fail are rolled
+// back right away, and rollouts that don't gather enough hosts and tasks
+// before their timeout are rolled back. It returns the rollout's new status,
+// or an empty string if it's still in progress.
+func CheckImageRollout(ctx context.Context, r *distro.ImageRollout) (string, error) {
+	stats, err := GetImageRolloutStats(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	enoughHosts := stats.ProvisionedHosts+stats.FailedHosts >= r.MinHosts
+	enoughTasks := stats.CanaryTasks >= r.MinCanaryTasks
+	if stats.ConfiguredCanaryTasks > 0 {
+		enoughTasks = stats.SucceededConfiguredCanaryTasks == stats.ConfiguredCanaryTasks
+	}
+	var reason string
+	switch {
+	case enoughHosts && stats.ProvisioningFailurePercentage() > float64(r.MaxProvisioningFailurePercentage):
+		reason = fmt.Sprintf("%.1f%% of candidate hosts failed to provision, which exceeds the limit of %d%%", stats.ProvisioningFailurePercentage(), r.MaxProvisioningFailurePercentage)
+	case stats.FailedConfiguredCanaryTasks > 0:
+		reason = fmt.Sprintf("%d of %d configured canary tasks failed on candidate hosts", stats.FailedConfiguredCanaryTasks, stats.ConfiguredCanaryTasks)
+	case enoughTasks && stats.SystemFailurePercentage() > float64(r.MaxSystemFailurePercentage):
+		reason = fmt.Sprintf("%.1f%% of canary tasks system failed, which exceeds the limit of %d%%", stats.SystemFailurePercentage(), r.MaxSystemFailurePercentage)
+	case enoughHosts && enoughTasks:
+		reason = fmt.Sprintf("%d candidate hosts and %d canary tasks finished within the failure limits", stats.ProvisionedHosts+stats.FailedHosts, stats.CanaryTasks)
+		if err = PromoteImageRollout(ctx, r, evergreen.User, reason); err != nil {
+			return "", err
+		}
+		// The rollout is rolled back instead if the distro's image changed
+		// while it was in progress.
+		return r.Status, nil
+	case time.Since(r.StartedAt) > r.Timeout:
+		reason = fmt.Sprintf("rollout timed out after %s with %d candidate hosts and %d canary tasks finished", r.Timeout, stats.ProvisionedHosts+stats.FailedHosts, stats.CanaryTasks)
+	default:
+		return "", nil
+	}
+
+	if err = RollBackImageRollout(ctx, r, evergreen.User, reason); err != nil {
+		return "", err
+	}
+	return distro.ImageRolloutStatusRolledBack, nil
+}
+
+// PromoteImageRollout finishes the in-progress image rollout by making the
+// candidate image the distro's image. If the distro's image changed while the
+// rollout was in progress, the rollout is rolled back instead so that the
+// change isn't overwritten.
+func PromoteImageRollout(ctx context.Context, r *distro.ImageRollout, user, reason string) error {
+	d, err := distro.FindOneId(ctx, r.DistroID)
+	if err != nil {
+		return errors.Wrapf(err, "finding distro '%s'", r.DistroID)
+	}
+	if d == nil {
+		return errors.Errorf("distro '%s' not found", r.DistroID)
+	}
+
+	updated, err := r.SetDistroImage(ctx, r.PreviousAMI, r.CandidateAMI)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return RollBackImageRollout(ctx, r, user, fmt.Sprintf("distro's image in region '%s' is no longer '%s'", r.Region, r.PreviousAMI))
+	}
+	if err = r.Finish(ctx, distro.ImageRolloutStatusPromoted, reason); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		_, revertErr := r.SetDistroImage(ctx, r.CandidateAMI, r.PreviousAMI)
+		catcher.Wrapf(revertErr, "reverting image for distro '%s'", d.Id)
+		return catcher.Resolve()
+	}
+
+	promoted, err := distro.FindOneId(ctx, r.DistroID)
+	if err != nil {
+		return errors.Wrapf(err, "finding promoted distro '%s'", r.DistroID)
+	}
+	if promoted == nil {
+		return errors.Errorf("promoted distro '%s' not found", r.DistroID)
+	}
+	event.LogDistroModified(d.Id, user, d.DistroData(), promoted.DistroData())
+	event.LogDistroAMIModified(d.Id, user)
+	event.LogDistroImageRolloutPromoted(d.Id, user, r)
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(releaseImageRolloutCanaryTasks(r))
+	catcher.Wrapf(UpdateProjectDistros(ctx, d, promoted, user), "updating project distros derived from distro '%s'", d.Id)
+	return catcher.Resolve()
+}
+
+// RollBackImageRollout finishes the in-progress image rollout without
+// changing the distro's image. Candidate hosts are decommissioned so that no
+// more tasks run on the candidate image.
+func RollBackImageRollout(ctx context.Context, r *distro.ImageRollout, user, reason string) error {
+	if err := r.Finish(ctx, distro.ImageRolloutStatusRolledBack, reason); err != nil {
+		return err
+	}
+	event.LogDistroImageRolloutRolledBack(r.DistroID, user, r)
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(releaseImageRolloutCanaryTasks(r))
+	hosts, err := host.Find(ctx, host.UpByImageRolloutID(r.ID))
+	if err != nil {
+		catcher.Wrapf(err, "finding candidate hosts for image rollout '%s'", r.ID)
+		return catcher.Resolve()
+	}
+	for _, h := range hosts {
+		catcher.Wrapf(h.SetDecommissioned(ctx, user, false, "distro image rollout was rolled back"), "decommissioning candidate host '%s'", h.Id)
+	}
+	return catcher.Resolve()
+}
+
+// releaseImageRolloutCanaryTasks lets the rollout's configured canary tasks
+// run on any host again once the rollout has finished.
+func releaseImageRolloutCanaryTasks(r *distro.ImageRollout) error {
+	if len(r.CanaryTaskIDs) == 0 {
+		return nil
+	}
+	return task.UnsetImageRolloutCanaries(r.CanaryTaskIDs, r.ID)
+}
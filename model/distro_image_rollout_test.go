@@ -0,0 +1,331 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestCheckImageRollout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	makeDistro := func() distro.Distro {
+		return distro.Distro{
+			Id:       "distro",
+			Provider: evergreen.ProviderNameEc2Fleet,
+			ProviderSettingsList: []*birch.Document{birch.NewDocument(
+				birch.EC.String("region", evergreen.DefaultEC2Region),
+				birch.EC.String("ami", "old_ami"),
+			)},
+		}
+	}
+	insertHosts := func(t *testing.T, rolloutID string, provisioned, failed int) []string {
+		var ids []string
+		for i := 0; i < provisioned+failed; i++ {
+			h := host.Host{
+				Id:             fmt.Sprintf("h%d", i),
+				ImageRolloutID: rolloutID,
+				Status:         evergreen.HostRunning,
+				Provisioned:    true,
+			}
+			if i >= provisioned {
+				h.Status = evergreen.HostProvisionFailed
+				h.Provisioned = false
+			}
+			require.NoError(t, h.Insert(ctx))
+			ids = append(ids, h.Id)
+		}
+		return ids
+	}
+	insertTasks := func(t *testing.T, hostID string, succeeded, systemFailed int) {
+		for i := 0; i < succeeded+systemFailed; i++ {
+			tsk := task.Task{
+				Id:     fmt.Sprintf("%s_t%d", hostID, i),
+				HostId: hostID,
+				Status: evergreen.TaskSucceeded,
+			}
+			if i >= succeeded {
+				tsk.Status = evergreen.TaskFailed
+				tsk.Details = apimodels.TaskEndDetail{Type: evergreen.CommandTypeSystem}
+			}
+			require.NoError(t, tsk.Insert())
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, d distro.Distro, r *distro.ImageRollout){
+		"StaysInProgressWithoutEnoughHostsOrTasks": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			hostIDs := insertHosts(t, r.ID, 1, 0)
+			insertTasks(t, hostIDs[0], 1, 0)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Empty(t, status)
+
+			dbRollout, err := distro.FindImageRolloutByID(ctx, r.ID)
+			require.NoError(t, err)
+			require.NotZero(t, dbRollout)
+			assert.Equal(t, distro.ImageRolloutStatusInProgress, dbRollout.Status)
+		},
+		"PromotesWithinFailureLimits": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			hostIDs := insertHosts(t, r.ID, 2, 0)
+			insertTasks(t, hostIDs[0], 4, 0)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusPromoted, status)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Equal(t, "new_ami", dbDistro.GetDefaultAMI())
+
+			dbRollout, err := distro.FindImageRolloutByID(ctx, r.ID)
+			require.NoError(t, err)
+			require.NotZero(t, dbRollout)
+			assert.Equal(t, distro.ImageRolloutStatusPromoted, dbRollout.Status)
+			assert.NotEmpty(t, dbRollout.Reason)
+		},
+		"RollsBackForProvisioningFailures": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			insertHosts(t, r.ID, 1, 1)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Equal(t, "old_ami", dbDistro.GetDefaultAMI())
+
+			dbHost, err := host.FindOneId(ctx, "h0")
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, evergreen.HostDecommissioned, dbHost.Status)
+		},
+		"RollsBackForTerminatedHostsThatNeverProvisioned": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			insertHosts(t, r.ID, 1, 0)
+			terminated := host.Host{
+				Id:             "terminated",
+				ImageRolloutID: r.ID,
+				Status:         evergreen.HostTerminated,
+				Provisioned:    false,
+			}
+			require.NoError(t, terminated.Insert(ctx))
+
+			stats, err := GetImageRolloutStats(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, 1, stats.ProvisionedHosts)
+			assert.Equal(t, 1, stats.FailedHosts)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+		},
+		"KeepsOtherDistroChangesWhenPromoting": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			d.Setup = "echo edited during rollout"
+			require.NoError(t, d.ReplaceOne(ctx))
+			hostIDs := insertHosts(t, r.ID, 2, 0)
+			insertTasks(t, hostIDs[0], 4, 0)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusPromoted, status)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Equal(t, "new_ami", dbDistro.GetDefaultAMI())
+			assert.Equal(t, "echo edited during rollout", dbDistro.Setup)
+		},
+		"RollsBackInsteadOfPromotingIfDistroImageChanged": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			d.ProviderSettingsList[0].Set(birch.EC.String("ami", "edited_ami"))
+			require.NoError(t, d.ReplaceOne(ctx))
+			hostIDs := insertHosts(t, r.ID, 2, 0)
+			insertTasks(t, hostIDs[0], 4, 0)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Equal(t, "edited_ami", dbDistro.GetDefaultAMI())
+		},
+		"DoesNotPromoteIfRolloutAlreadyFinished": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			finished := *r
+			require.NoError(t, finished.Finish(ctx, distro.ImageRolloutStatusRolledBack, "rolled back by an admin"))
+
+			assert.Error(t, PromoteImageRollout(ctx, r, "user", "promoted"))
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Equal(t, "old_ami", dbDistro.GetDefaultAMI(), "distro image should be reverted")
+		},
+		"WaitsForConfiguredCanaryTasks": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			hostIDs := insertHosts(t, r.ID, 2, 0)
+			insertTasks(t, hostIDs[0], 4, 0)
+			canary := task.Task{
+				Id:             "canary",
+				ImageRolloutID: r.ID,
+				Status:         evergreen.TaskUndispatched,
+				Activated:      true,
+			}
+			require.NoError(t, canary.Insert())
+			require.NoError(t, r.SetCanaryTaskIDs(ctx, []string{canary.Id}))
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Empty(t, status, "rollout should wait for its configured canary tasks")
+
+			require.NoError(t, task.UpdateOne(bson.M{task.IdKey: canary.Id}, bson.M{"$set": bson.M{
+				task.StatusKey: evergreen.TaskSucceeded,
+				task.HostIdKey: hostIDs[1],
+			}}))
+			status, err = CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusPromoted, status)
+
+			dbCanary, err := task.FindOneId(canary.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbCanary)
+			assert.Empty(t, dbCanary.ImageRolloutID, "canary task should be released after the rollout finishes")
+		},
+		"RollsBackForFailedConfiguredCanaryTasks": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			hostIDs := insertHosts(t, r.ID, 1, 0)
+			canary := task.Task{
+				Id:             "canary",
+				ImageRolloutID: r.ID,
+				HostId:         hostIDs[0],
+				Status:         evergreen.TaskFailed,
+			}
+			require.NoError(t, canary.Insert())
+			require.NoError(t, r.SetCanaryTaskIDs(ctx, []string{canary.Id}))
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+		},
+		"RollsBackForSystemFailures": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			hostIDs := insertHosts(t, r.ID, 1, 0)
+			insertTasks(t, hostIDs[0], 3, 1)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+
+			dbRollout, err := distro.FindImageRolloutByID(ctx, r.ID)
+			require.NoError(t, err)
+			require.NotZero(t, dbRollout)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, dbRollout.Status)
+		},
+		"RollsBackAfterTimeout": func(t *testing.T, d distro.Distro, r *distro.ImageRollout) {
+			r.StartedAt = time.Now().Add(-2 * r.Timeout)
+
+			status, err := CheckImageRollout(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, distro.ImageRolloutStatusRolledBack, status)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(distro.Collection, distro.ImageRolloutCollection, host.Collection, task.Collection, event.EventCollection))
+
+			d := makeDistro()
+			require.NoError(t, d.Insert(ctx))
+			r, err := distro.NewImageRollout(&d, "user", distro.ImageRollout{
+				CandidateAMI:                     "new_ami",
+				MinHosts:                         2,
+				MinCanaryTasks:                   4,
+				MaxProvisioningFailurePercentage: 10,
+				MaxSystemFailurePercentage:       10,
+				Timeout:                          time.Hour,
+			})
+			require.NoError(t, err)
+			require.NoError(t, r.Insert(ctx))
+
+			tCase(t, d, r)
+		})
+	}
+}
+
+func TestScheduleImageRolloutCanaryTasks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(ProjectRefCollection, VersionCollection, build.Collection, task.Collection, distro.ImageRolloutCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(ProjectRefCollection, VersionCollection, build.Collection, task.Collection, distro.ImageRolloutCollection))
+	}()
+
+	pRef := ProjectRef{Id: "project", Identifier: "project_identifier"}
+	require.NoError(t, pRef.Insert())
+	for i, id := range []string{"old_version", "latest_version"} {
+		v := Version{
+			Id:                  id,
+			Identifier:          pRef.Id,
+			Requester:           evergreen.RepotrackerVersionRequester,
+			RevisionOrderNumber: i,
+		}
+		require.NoError(t, v.Insert())
+		b := build.Build{Id: id + "_build", Version: id}
+		require.NoError(t, b.Insert())
+		for _, tsk := range []task.Task{
+			{Id: id + "_compile", DisplayName: "compile", BuildVariant: "bv", DistroId: "distro"},
+			{Id: id + "_test", DisplayName: "test", BuildVariant: "bv", DistroId: "distro"},
+			{Id: id + "_other_distro", DisplayName: "compile", BuildVariant: "other_bv", DistroId: "other_distro"},
+		} {
+			tsk.Version = id
+			tsk.BuildId = b.Id
+			tsk.Project = pRef.Id
+			tsk.Status = evergreen.TaskUndispatched
+			require.NoError(t, tsk.Insert())
+		}
+	}
+
+	r := &distro.ImageRollout{
+		ID:          "rollout",
+		DistroID:    "distro",
+		Status:      distro.ImageRolloutStatusInProgress,
+		CanaryTasks: []distro.ImageRolloutCanaryTask{{Project: pRef.Identifier, Task: "compile"}},
+	}
+	require.NoError(t, r.Insert(ctx))
+	require.NoError(t, ScheduleImageRolloutCanaryTasks(ctx, r, "user", evergreen.RESTV2Package))
+	assert.Equal(t, []string{"latest_version_compile"}, r.CanaryTaskIDs)
+
+	dbRollout, err := distro.FindImageRolloutByID(ctx, r.ID)
+	require.NoError(t, err)
+	require.NotZero(t, dbRollout)
+	assert.Equal(t, r.CanaryTaskIDs, dbRollout.CanaryTaskIDs)
+
+	canary, err := task.FindOneId("latest_version_compile")
+	require.NoError(t, err)
+	require.NotZero(t, canary)
+	assert.Equal(t, r.ID, canary.ImageRolloutID)
+	assert.True(t, canary.Activated)
+	for _, id := range []string{"old_version_compile", "latest_version_test", "latest_version_other_distro"} {
+		tsk, err := task.FindOneId(id)
+		require.NoError(t, err)
+		require.NotZero(t, tsk)
+		assert.Empty(t, tsk.ImageRolloutID, "task '%s' should not be a canary", id)
+		assert.False(t, tsk.Activated, "task '%s' should not be activated", id)
+	}
+
+	r.CanaryTasks = []distro.ImageRolloutCanaryTask{{Project: pRef.Identifier, Task: "nonexistent"}}
+	assert.Error(t, ScheduleImageRolloutCanaryTasks(ctx, r, "user", evergreen.RESTV2Package), "should error if no tasks match")
+}
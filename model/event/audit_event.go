@@ -0,0 +1,171 @@
+package event
+
+import (
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	registry.AddType(ResourceTypeAudit, func() interface{} { return &AuditEventData{} })
+	registry.setUnexpirable(ResourceTypeAudit, EventTypeAuditRESTRequest)
+	registry.setUnexpirable(ResourceTypeAudit, EventTypeAuditGraphQLMutation)
+	registry.setUnexpirable(ResourceTypeAudit, EventTypeAuditVariablesRead)
+}
+
+const (
+	ResourceTypeAudit = "AUDIT"
+
+	// EventTypeAuditRESTRequest is a mutating request to the REST API.
+	EventTypeAuditRESTRequest = "REST_REQUEST"
+	// EventTypeAuditGraphQLMutation is a GraphQL mutation.
+	EventTypeAuditGraphQLMutation = "GRAPHQL_MUTATION"
+	// EventTypeAuditVariablesRead is a read of a project's or repo's
+	// variables through the REST API or GraphQL.
+	EventTypeAuditVariablesRead = "VARIABLES_READ"
+
+	// AuditRedactedValue replaces the values of sensitive audit parameters.
+	AuditRedactedValue = "{REDACTED}"
+
+	// DefaultAuditEventsLimit is the default number of audit events returned
+	// by a query.
+	DefaultAuditEventsLimit = 100
+)
+
+// auditSensitiveParamSubstrings are the substrings of parameter names whose
+// values are redacted from audit events.
+var auditSensitiveParamSubstrings = []string{"secret", "password", "passwd", "token", "key", "credential", "private", "vars", "cert"}
+
+// AuditEventData describes an action that a user took.
+type AuditEventData struct {
+	// Actor is the user who took the action.
+	Actor string `bson:"actor" json:"actor"`
+	// AccessTokenID is the ID of the personal access token used to
+	// authenticate, if any.
+	AccessTokenID string `bson:"access_token_id,omitempty" json:"access_token_id,omitempty"`
+	// Method is the HTTP method of a REST request.
+	Method string `bson:"method,omitempty" json:"method,omitempty"`
+	// Operation is the path of a REST request or the name of a GraphQL
+	// mutation or field.
+	Operation string `bson:"operation" json:"operation"`
+	// Params are the request parameters, with sensitive values redacted.
+	Params map[string]interface{} `bson:"params,omitempty" json:"params,omitempty"`
+	// StatusCode is the HTTP status code of a REST response.
+	StatusCode int `bson:"status_code,omitempty" json:"status_code,omitempty"`
+	// Error is the error returned by a GraphQL mutation, if any.
+	Error string `bson:"error,omitempty" json:"error,omitempty"`
+}
+
+var (
+	auditActorKey = bsonutil.MustHaveTag(AuditEventData{}, "Actor")
+)
+
+// LogAuditEvent logs a user action on the given resource to the event log.
+// The resource ID may be empty if the action has no particular target.
+func LogAuditEvent(eventType, resourceID string, data AuditEventData) error {
+	if eventType != EventTypeAuditRESTRequest && eventType != EventTypeAuditGraphQLMutation && eventType != EventTypeAuditVariablesRead {
+		return errors.Errorf("invalid audit event type '%s'", eventType)
+	}
+	data.Params = RedactAuditParams(data.Params)
+	e := EventLogEntry{
+		Timestamp:    time.Now(),
+		EventType:    eventType,
+		ResourceId:   resourceID,
+		ResourceType: ResourceTypeAudit,
+		Data:         data,
+	}
+	if err := e.Log(); err != nil {
+		return errors.Wrapf(err, "logging audit event for user '%s'", data.Actor)
+	}
+	return nil
+}
+
+// RedactAuditParams returns a copy of the parameters where the values of any
+// sensitive parameters, including nested ones, are redacted.
+func RedactAuditParams(params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		if isSensitiveAuditParam(k) {
+			redacted[k] = AuditRedactedValue
+			continue
+		}
+		redacted[k] = redactAuditValue(v)
+	}
+	return redacted
+}
+
+func redactAuditValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return RedactAuditParams(val)
+	case []interface{}:
+		out := make([]interface{}, 0, len(val))
+		for _, elem := range val {
+			out = append(out, redactAuditValue(elem))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func isSensitiveAuditParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range auditSensitiveParamSubstrings {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditEventsQueryOptions filter the audit events to find.
+type AuditEventsQueryOptions struct {
+	Actor      string
+	ResourceID string
+	// StartTime (inclusive) and EndTime (exclusive) bound when the events
+	// happened. Either can be zero to leave that end of the range open.
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// FindAuditEvents returns the audit events matching the options, sorted from
+// newest to oldest.
+func FindAuditEvents(opts AuditEventsQueryOptions) ([]EventLogEntry, error) {
+	if !opts.StartTime.IsZero() && !opts.EndTime.IsZero() && !opts.EndTime.After(opts.StartTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	filter := ResourceTypeKeyIs(ResourceTypeAudit)
+	if opts.Actor != "" {
+		filter[bsonutil.GetDottedKeyName(DataKey, auditActorKey)] = opts.Actor
+	}
+	if opts.ResourceID != "" {
+		filter[ResourceIdKey] = opts.ResourceID
+	}
+	timeFilter := bson.M{}
+	if !opts.StartTime.IsZero() {
+		timeFilter["$gte"] = opts.StartTime
+	}
+	if !opts.EndTime.IsZero() {
+		timeFilter["$lt"] = opts.EndTime
+	}
+	if len(timeFilter) > 0 {
+		filter[TimestampKey] = timeFilter
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultAuditEventsLimit
+	}
+
+	return Find(db.Query(filter).Sort([]string{"-" + TimestampKey}).Limit(limit))
+}
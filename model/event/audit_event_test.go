@@ -0,0 +1,97 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactAuditParams(t *testing.T) {
+	params := map[string]interface{}{
+		"task_id":  "t1",
+		"priority": 100,
+		"body": map[string]interface{}{
+			"password": "hunter2",
+			"vars":     map[string]interface{}{"foo": "bar"},
+			"nested": []interface{}{
+				map[string]interface{}{"apiKey": "abc", "name": "n"},
+			},
+		},
+		"github_token": "secret",
+	}
+	redacted := RedactAuditParams(params)
+
+	assert.Equal(t, "t1", redacted["task_id"])
+	assert.Equal(t, 100, redacted["priority"])
+	assert.Equal(t, AuditRedactedValue, redacted["github_token"])
+	body, ok := redacted["body"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, AuditRedactedValue, body["password"])
+	assert.Equal(t, AuditRedactedValue, body["vars"])
+	nested, ok := body["nested"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, nested, 1)
+	assert.Equal(t, map[string]interface{}{"apiKey": AuditRedactedValue, "name": "n"}, nested[0])
+
+	assert.Equal(t, "hunter2", params["body"].(map[string]interface{})["password"], "original parameters should not be modified")
+	assert.Nil(t, RedactAuditParams(nil))
+}
+
+func TestFindAuditEvents(t *testing.T) {
+	require.NoError(t, db.Clear(EventCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(EventCollection))
+	}()
+
+	require.NoError(t, LogAuditEvent(EventTypeAuditRESTRequest, "t1", AuditEventData{
+		Actor:      "alice",
+		Method:     "PATCH",
+		Operation:  "/rest/v2/tasks/t1",
+		Params:     map[string]interface{}{"task_id": "t1", "secret": "s"},
+		StatusCode: 200,
+	}))
+	require.NoError(t, LogAuditEvent(EventTypeAuditGraphQLMutation, "v1", AuditEventData{
+		Actor:     "bob",
+		Operation: "restartVersions",
+	}))
+	assert.Error(t, LogAuditEvent("invalid", "", AuditEventData{Actor: "alice"}))
+
+	t.Run("ByActor", func(t *testing.T) {
+		events, err := FindAuditEvents(AuditEventsQueryOptions{Actor: "alice"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "t1", events[0].ResourceId)
+		data, ok := events[0].Data.(*AuditEventData)
+		require.True(t, ok)
+		assert.Equal(t, "/rest/v2/tasks/t1", data.Operation)
+		assert.Equal(t, AuditRedactedValue, data.Params["secret"])
+		assert.False(t, events[0].Expirable)
+	})
+	t.Run("ByResource", func(t *testing.T) {
+		events, err := FindAuditEvents(AuditEventsQueryOptions{ResourceID: "v1"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTypeAuditGraphQLMutation, events[0].EventType)
+	})
+	t.Run("ByTime", func(t *testing.T) {
+		events, err := FindAuditEvents(AuditEventsQueryOptions{StartTime: time.Now().Add(-time.Hour)})
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		events, err = FindAuditEvents(AuditEventsQueryOptions{EndTime: time.Now().Add(-time.Hour)})
+		require.NoError(t, err)
+		assert.Empty(t, events)
+
+		_, err = FindAuditEvents(AuditEventsQueryOptions{StartTime: time.Now(), EndTime: time.Now().Add(-time.Hour)})
+		assert.Error(t, err)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		events, err := FindAuditEvents(AuditEventsQueryOptions{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "v1", events[0].ResourceId)
+	})
+}
@@ -14,6 +14,9 @@ func init() {
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroModified)
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroAMIModfied)
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroRemoved)
+	registry.setUnexpirable(ResourceTypeDistro, EventDistroImageRolloutStarted)
+	registry.setUnexpirable(ResourceTypeDistro, EventDistroImageRolloutPromoted)
+	registry.setUnexpirable(ResourceTypeDistro, EventDistroImageRolloutRolledBack)
 }
 
 const (
@@ -25,6 +28,10 @@ const (
 	EventDistroModified   = "DISTRO_MODIFIED"
 	EventDistroAMIModfied = "DISTRO_AMI_MODIFIED"
 	EventDistroRemoved    = "DISTRO_REMOVED"
+
+	EventDistroImageRolloutStarted    = "DISTRO_IMAGE_ROLLOUT_STARTED"
+	EventDistroImageRolloutPromoted   = "DISTRO_IMAGE_ROLLOUT_PROMOTED"
+	EventDistroImageRolloutRolledBack = "DISTRO_IMAGE_ROLLOUT_ROLLED_BACK"
 )
 
 // DistroEventData implements EventData.
@@ -95,3 +102,21 @@ func LogDistroRemoved(distroId, userId string, data interface{}) {
 func LogDistroAMIModified(distroId, userId string) {
 	LogDistroEvent(distroId, EventDistroAMIModfied, DistroEventData{UserId: userId})
 }
+
+// LogDistroImageRolloutStarted logs when a staged rollout of a candidate image
+// starts for the distro.
+func LogDistroImageRolloutStarted(distroId, userId string, rollout interface{}) {
+	LogDistroEvent(distroId, EventDistroImageRolloutStarted, DistroEventData{UserId: userId, User: userId, Data: rollout})
+}
+
+// LogDistroImageRolloutPromoted logs when a distro's candidate image replaces
+// its previous image.
+func LogDistroImageRolloutPromoted(distroId, userId string, rollout interface{}) {
+	LogDistroEvent(distroId, EventDistroImageRolloutPromoted, DistroEventData{UserId: userId, User: userId, Data: rollout})
+}
+
+// LogDistroImageRolloutRolledBack logs when a distro's candidate image is
+// abandoned.
+func LogDistroImageRolloutRolledBack(distroId, userId string, rollout interface{}) {
+	LogDistroEvent(distroId, EventDistroImageRolloutRolledBack, DistroEventData{UserId: userId, User: userId, Data: rollout})
+}
@@ -521,6 +521,7 @@ func TestEventExpiration(t *testing.T) {
 		{ResourceType: EventResourceTypeProject, EventType: EventTypeProjectModified, Data: ""}: false,
 		{ResourceType: ResourceTypeAdmin, EventType: EventTypeValueChanged, Data: ""}:           false,
 		{ResourceType: ResourceTypeDistro, EventType: EventDistroAdded, Data: ""}:               false,
+		{ResourceType: ResourceTypeAudit, EventType: EventTypeAuditRESTRequest, Data: ""}:       false,
 		{ResourceType: ResourceTypeCommitQueue, EventType: CommitQueueConcludeTest, Data: ""}:   true,
 		{ResourceType: ResourceTypeTask, EventType: EventHostTaskFinished, Data: ""}:            true,
 		{ResourceType: ResourceTypeHost, EventType: EventHostCreated, Data: ""}:                 true,
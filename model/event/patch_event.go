@@ -11,6 +11,7 @@ func init() {
 	registry.AddType(ResourceTypePatch, patchEventDataFactory)
 	registry.AllowSubscription(ResourceTypePatch, PatchStateChange)
 	registry.AllowSubscription(ResourceTypePatch, PatchChildrenCompletion)
+	registry.AllowSubscription(ResourceTypePatch, PatchMergeQueueRequiredTasksFinished)
 }
 
 func patchEventDataFactory() interface{} {
@@ -22,6 +23,10 @@ const (
 
 	PatchStateChange        = "STATE_CHANGE"
 	PatchChildrenCompletion = "CHILDREN_FINISHED"
+	// PatchMergeQueueRequiredTasksFinished indicates that the required tasks
+	// of a GitHub merge queue patch have determined its merge check, which can
+	// happen before the rest of the patch finishes.
+	PatchMergeQueueRequiredTasksFinished = "MERGE_QUEUE_REQUIRED_TASKS_FINISHED"
 )
 
 type PatchEventData struct {
@@ -49,6 +54,26 @@ func LogPatchStateChangeEvent(id, newStatus string) {
 	}
 }
 
+func LogPatchMergeQueueRequiredTasksFinishedEvent(id, status string) {
+	event := EventLogEntry{
+		Timestamp:    time.Now().Truncate(0).Round(time.Millisecond),
+		ResourceId:   id,
+		ResourceType: ResourceTypePatch,
+		EventType:    PatchMergeQueueRequiredTasksFinished,
+		Data: &PatchEventData{
+			Status: status,
+		},
+	}
+
+	if err := event.Log(); err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"resource_type": ResourceTypePatch,
+			"message":       "error logging event",
+			"source":        "event-log-fail",
+		}))
+	}
+}
+
 func LogPatchChildrenCompletionEvent(id, status, author string) {
 	event := EventLogEntry{
 		Timestamp:    time.Now().Truncate(0).Round(time.Millisecond),
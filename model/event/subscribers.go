@@ -11,17 +11,18 @@ import (
 )
 
 const (
-	GithubPullRequestSubscriberType = "github_pull_request"
-	GithubCheckSubscriberType       = "github_check"
-	GithubMergeSubscriberType       = "github_merge"
-	JIRAIssueSubscriberType         = "jira-issue"
-	JIRACommentSubscriberType       = "jira-comment"
-	EvergreenWebhookSubscriberType  = "evergreen-webhook"
-	EmailSubscriberType             = "email"
-	SlackSubscriberType             = "slack"
-	EnqueuePatchSubscriberType      = "enqueue-patch"
-	SubscriberTypeNone              = "none"
-	RunChildPatchSubscriberType     = "run-child-patch"
+	GithubPullRequestSubscriberType  = "github_pull_request"
+	GithubCheckSubscriberType        = "github_check"
+	GithubMergeSubscriberType        = "github_merge"
+	GitlabCommitStatusSubscriberType = "gitlab_commit_status"
+	JIRAIssueSubscriberType          = "jira-issue"
+	JIRACommentSubscriberType        = "jira-comment"
+	EvergreenWebhookSubscriberType   = "evergreen-webhook"
+	EmailSubscriberType              = "email"
+	SlackSubscriberType              = "slack"
+	EnqueuePatchSubscriberType       = "enqueue-patch"
+	SubscriberTypeNone               = "none"
+	RunChildPatchSubscriberType      = "run-child-patch"
 
 	webhookRetryLimit    = 10
 	webhookMinDelayLimit = 10000
@@ -32,6 +33,7 @@ var SubscriberTypes = []string{
 	GithubPullRequestSubscriberType,
 	GithubCheckSubscriberType,
 	GithubMergeSubscriberType,
+	GitlabCommitStatusSubscriberType,
 	JIRAIssueSubscriberType,
 	JIRACommentSubscriberType,
 	EvergreenWebhookSubscriberType,
@@ -78,6 +80,8 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &GithubCheckSubscriber{}
 	case GithubMergeSubscriberType:
 		s.Target = &GithubMergeSubscriber{}
+	case GitlabCommitStatusSubscriberType:
+		s.Target = &GitlabCommitStatusSubscriber{}
 	case EvergreenWebhookSubscriberType:
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
@@ -210,6 +214,19 @@ type GithubMergeSubscriber struct {
 	Ref   string `bson:"ref"`
 }
 
+// GitlabCommitStatusSubscriber sets commit statuses on a commit in a GitLab
+// project.
+type GitlabCommitStatusSubscriber struct {
+	// ProjectPath is the full path of the GitLab project, e.g.
+	// "group/subgroup/project".
+	ProjectPath string `bson:"project_path"`
+	Ref         string `bson:"ref"`
+}
+
+func (s *GitlabCommitStatusSubscriber) String() string {
+	return fmt.Sprintf("%s-%s", s.ProjectPath, s.Ref)
+}
+
 type ChildPatchSubscriber struct {
 	ParentStatus string `bson:"parent_status"`
 	ChildPatchId string `bson:"child_patch_id"`
@@ -255,6 +272,13 @@ func NewGithubMergeAPISubscriber(s GithubMergeSubscriber) Subscriber {
 	}
 }
 
+func NewGitlabCommitStatusSubscriber(s GitlabCommitStatusSubscriber) Subscriber {
+	return Subscriber{
+		Type:   GitlabCommitStatusSubscriberType,
+		Target: s,
+	}
+}
+
 func NewEmailSubscriber(t string) Subscriber {
 	return Subscriber{
 		Type:   EmailSubscriberType,
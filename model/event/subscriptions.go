@@ -67,6 +67,7 @@ const (
 	GeneralSubscriptionSpawnhostExpiration           = "spawnhost-expiration"
 	GeneralSubscriptionSpawnHostOutcome              = "spawnhost-outcome"
 	GeneralSubscriptionCommitQueue                   = "commit-queue"
+	GeneralSubscriptionStepbackCulprit               = "stepback-culprit"
 
 	ObjectTask    = "task"
 	ObjectVersion = "version"
@@ -90,6 +91,9 @@ const (
 	TriggerPatchStarted              = "started"
 	TriggerTaskFirstFailureInVersion = "first-failure-in-version"
 	TriggerTaskStarted               = "task-started"
+	// TriggerCulpritIdentified indicates that bisect stepback identified the
+	// commit that caused a task to start failing.
+	TriggerCulpritIdentified = "culprit-identified"
 )
 
 type Subscription struct {
@@ -728,6 +732,8 @@ func CreateOrUpdateGeneralSubscription(resourceType string, id string,
 				temp = NewSpawnHostOutcomeByOwner(user, subscriber)
 			case GeneralSubscriptionCommitQueue:
 				temp = NewCommitQueueSubscriptionByOwner(user, subscriber)
+			case GeneralSubscriptionStepbackCulprit:
+				temp = NewStepbackCulpritSubscriptionByOwner(user, subscriber)
 			default:
 				return nil, errors.Errorf("unknown subscription resource type: %s", resourceType)
 			}
@@ -850,6 +856,32 @@ func NewFirstTaskFailureInVersionSubscriptionByOwner(owner string, sub Subscribe
 	}
 }
 
+// NewStepbackCulpritSubscriptionByOwner returns a subscription that notifies
+// the owner when bisect stepback identifies one of their commits as the one
+// that caused a task to start failing.
+func NewStepbackCulpritSubscriptionByOwner(owner string, sub Subscriber) Subscription {
+	return Subscription{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerCulpritIdentified,
+		Selectors: []Selector{
+			{
+				Type: SelectorOwner,
+				Data: owner,
+			},
+			{
+				Type: SelectorObject,
+				Data: ObjectTask,
+			},
+		},
+		Filter: Filter{
+			Owner:  owner,
+			Object: ObjectTask,
+		},
+		Subscriber: sub,
+	}
+}
+
 func NewBuildBreakSubscriptionByOwner(owner string, sub Subscriber) Subscription {
 	return Subscription{
 		ID:           mgobson.NewObjectId().Hex(),
@@ -14,6 +14,7 @@ func init() {
 	registry.AllowSubscription(ResourceTypeTask, TaskStarted)
 	registry.AllowSubscription(ResourceTypeTask, TaskFinished)
 	registry.AllowSubscription(ResourceTypeTask, TaskBlocked)
+	registry.AllowSubscription(ResourceTypeTask, TaskStepbackCulpritIdentified)
 }
 
 const (
@@ -36,6 +37,8 @@ const (
 	TaskJiraAlertCreated       = "TASK_JIRA_ALERT_CREATED"
 	TaskDependenciesOverridden = "TASK_DEPENDENCIES_OVERRIDDEN"
 	MergeTaskUnscheduled       = "MERGE_TASK_UNSCHEDULED"
+
+	TaskStepbackCulpritIdentified = "TASK_STEPBACK_CULPRIT_IDENTIFIED"
 )
 
 // implements Data
@@ -217,3 +220,9 @@ func LogMergeTaskUnscheduled(taskId string, execution int, userID string) {
 	logTaskEvent(taskId, MergeTaskUnscheduled,
 		TaskEventData{Execution: execution, UserId: userID})
 }
+
+// LogTaskStepbackCulpritIdentified logs an event indicating that bisect
+// stepback identified the task as the first failing run.
+func LogTaskStepbackCulpritIdentified(taskId string, execution int) {
+	logTaskEvent(taskId, TaskStepbackCulpritIdentified, TaskEventData{Execution: execution})
+}
@@ -26,6 +26,9 @@ const (
 	// Collection is the name of the MongoDB collection that stores hosts.
 	Collection        = "hosts"
 	VolumesCollection = "volumes"
+	// SnapshotsCollection is the name of the MongoDB collection that stores
+	// volume snapshots.
+	SnapshotsCollection = "snapshots"
 )
 
 var (
@@ -54,6 +57,7 @@ var (
 	CreateTimeKey                      = bsonutil.MustHaveTag(Host{}, "CreationTime")
 	ExpirationTimeKey                  = bsonutil.MustHaveTag(Host{}, "ExpirationTime")
 	NoExpirationKey                    = bsonutil.MustHaveTag(Host{}, "NoExpiration")
+	SleepScheduleKey                   = bsonutil.MustHaveTag(Host{}, "SleepSchedule")
 	TerminationTimeKey                 = bsonutil.MustHaveTag(Host{}, "TerminationTime")
 	LTCTimeKey                         = bsonutil.MustHaveTag(Host{}, "LastTaskCompletedTime")
 	LTCTaskKey                         = bsonutil.MustHaveTag(Host{}, "LastTask")
@@ -94,6 +98,7 @@ var (
 	HomeVolumeIDKey                    = bsonutil.MustHaveTag(Host{}, "HomeVolumeID")
 	PortBindingsKey                    = bsonutil.MustHaveTag(Host{}, "PortBindings")
 	IsVirtualWorkstationKey            = bsonutil.MustHaveTag(Host{}, "IsVirtualWorkstation")
+	ImageRolloutIDKey                  = bsonutil.MustHaveTag(Host{}, "ImageRolloutID")
 	SpawnOptionsTaskIDKey              = bsonutil.MustHaveTag(SpawnOptions{}, "TaskID")
 	SpawnOptionsTaskExecutionNumberKey = bsonutil.MustHaveTag(SpawnOptions{}, "TaskExecutionNumber")
 	SpawnOptionsBuildIDKey             = bsonutil.MustHaveTag(SpawnOptions{}, "BuildID")
@@ -110,6 +115,12 @@ var (
 	VolumeMigratingKey                 = bsonutil.MustHaveTag(Volume{}, "Migrating")
 	VolumeAttachmentIDKey              = bsonutil.MustHaveTag(VolumeAttachment{}, "VolumeID")
 	VolumeDeviceNameKey                = bsonutil.MustHaveTag(VolumeAttachment{}, "DeviceName")
+	SnapshotIDKey                      = bsonutil.MustHaveTag(Snapshot{}, "ID")
+	SnapshotDisplayNameKey             = bsonutil.MustHaveTag(Snapshot{}, "DisplayName")
+	SnapshotCreatedByKey               = bsonutil.MustHaveTag(Snapshot{}, "CreatedBy")
+	SnapshotExpirationKey              = bsonutil.MustHaveTag(Snapshot{}, "Expiration")
+	SnapshotNoExpirationKey            = bsonutil.MustHaveTag(Snapshot{}, "NoExpiration")
+	SnapshotCreationDateKey            = bsonutil.MustHaveTag(Snapshot{}, "CreationDate")
 	DockerOptionsStdinDataKey          = bsonutil.MustHaveTag(DockerOptions{}, "StdinData")
 )
 
@@ -534,6 +545,21 @@ func ByDistroIDs(distroIDs ...string) bson.M {
 	}
 }
 
+// ByImageRolloutID produces a query that returns all hosts that were created
+// with the candidate image of the given distro image rollout.
+func ByImageRolloutID(rolloutID string) bson.M {
+	return bson.M{ImageRolloutIDKey: rolloutID}
+}
+
+// UpByImageRolloutID produces a query that returns all hosts that are up and
+// were created with the candidate image of the given distro image rollout.
+func UpByImageRolloutID(rolloutID string) bson.M {
+	return bson.M{
+		ImageRolloutIDKey: rolloutID,
+		StatusKey:         bson.M{"$in": evergreen.UpHostStatus},
+	}
+}
+
 // ById produces a query that returns a host with the given id.
 func ById(id string) bson.M {
 	return bson.M{IdKey: id}
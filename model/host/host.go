@@ -41,6 +41,9 @@ type Host struct {
 	Tag             string        `bson:"tag" json:"tag"`
 	Distro          distro.Distro `bson:"distro" json:"distro"`
 	Provider        string        `bson:"host_type" json:"host_type"`
+	// ImageRolloutID is the distro image rollout whose candidate image the
+	// host was created with, if any.
+	ImageRolloutID string `bson:"image_rollout_id,omitempty" json:"image_rollout_id,omitempty"`
 	// IP holds the ipv6 address when applicable
 	IP   string `bson:"ip_address" json:"ip_address"`
 	IPv4 string `bson:"ipv4_address" json:"ipv4_address"`
@@ -81,6 +84,9 @@ type Host struct {
 
 	ExpirationTime time.Time `bson:"expiration_time,omitempty" json:"expiration_time"`
 	NoExpiration   bool      `bson:"no_expiration" json:"no_expiration"`
+	// SleepSchedule is the schedule on which an unexpirable spawn host is
+	// automatically stopped and started.
+	SleepSchedule SleepScheduleInfo `bson:"sleep_schedule,omitempty" json:"sleep_schedule,omitempty"`
 
 	// creation is when the host document was inserted to the DB, start is when it was started on the cloud provider
 	CreationTime time.Time `bson:"creation_time" json:"creation_time"`
@@ -155,6 +161,9 @@ type Host struct {
 	// HomeVolumeSize is the size of the home volume in GB
 	HomeVolumeSize int    `bson:"home_volume_size" json:"home_volume_size"`
 	HomeVolumeID   string `bson:"home_volume_id" json:"home_volume_id"`
+	// HomeVolumeSnapshotID is the ID of the snapshot that the home volume
+	// should be restored from when it is created.
+	HomeVolumeSnapshotID string `bson:"home_volume_snapshot_id,omitempty" json:"home_volume_snapshot_id,omitempty"`
 }
 
 type Tag struct {
@@ -338,6 +347,12 @@ type HostModifyOptions struct {
 	SubscriptionType   string
 	NewName            string
 	AddKey             string
+	// SleepSchedule replaces the host's sleep schedule. An empty schedule
+	// removes it.
+	SleepSchedule *SleepScheduleInfo
+	// KeepAwakeUntil temporarily exempts the host from its sleep schedule
+	// until the given time.
+	KeepAwakeUntil time.Time
 }
 
 type SpawnHostUsage struct {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/evergreen-ci/certdepot"
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/cloud/userdata"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/user"
@@ -35,6 +36,15 @@ import (
 
 const OutputBufferSize = 1000
 
+const (
+	// AgentStatusPort is the default port of the agent's status server.
+	AgentStatusPort = 2285
+	// agentDebugInfoBufferSize is the maximum number of lines of agent
+	// debugging information to return, which must be large enough to fit a
+	// goroutine dump.
+	agentDebugInfoBufferSize = 100000
+)
+
 // SetupCommand returns the command to run the host setup script.
 func (h *Host) SetupCommand() string {
 	cmd := fmt.Sprintf("cd %s && ./%s host setup", h.Distro.HomeDir(), h.Distro.BinaryName())
@@ -799,6 +809,64 @@ func (h *Host) GetJasperProcess(ctx context.Context, env evergreen.Environment,
 	return true, strings.Join(logStream.Logs, "\n"), nil
 }
 
+// AgentDebugInfoCommand returns the command to fetch the given kind of
+// debugging information from the agent's status server on the host.
+func (h *Host) AgentDebugInfoCommand(kind string) []string {
+	return []string{
+		"curl",
+		"--silent",
+		"--show-error",
+		"--fail",
+		"--max-time", "30",
+		fmt.Sprintf("http://127.0.0.1:%d/debug/%s", AgentStatusPort, kind),
+	}
+}
+
+// GetAgentDebugInfo makes a request to the host's Jasper service to fetch the
+// given kind of debugging information from the agent's status server.
+func (h *Host) GetAgentDebugInfo(ctx context.Context, env evergreen.Environment, kind string) (string, error) {
+	if !utility.StringSliceContains(apimodels.AgentDebugInfoKinds, kind) {
+		return "", errors.Errorf("unrecognized agent debug info kind '%s'", kind)
+	}
+
+	client, err := h.JasperClient(ctx, env)
+	if err != nil {
+		return "", errors.Wrap(err, "getting Jasper client")
+	}
+	defer func() {
+		grip.Warning(message.WrapError(client.CloseConnection(), message.Fields{
+			"message": "could not close connection to Jasper",
+			"host_id": h.Id,
+			"distro":  h.Distro.Id,
+		}))
+	}()
+
+	logger, err := jasper.NewInMemoryLogger(agentDebugInfoBufferSize)
+	if err != nil {
+		return "", errors.Wrap(err, "creating new in-memory logger")
+	}
+	opts := &options.Create{
+		Args:   h.AgentDebugInfoCommand(kind),
+		Output: options.Output{Loggers: []*options.LoggerConfig{logger}},
+	}
+	proc, err := client.CreateProcess(ctx, opts)
+	if err != nil {
+		return "", errors.Wrap(err, "creating Jasper process")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	if _, err = proc.Wait(ctx); err != nil {
+		catcher.Wrap(err, "fetching agent debug info")
+	}
+
+	logStream, err := client.GetLogStream(ctx, proc.ID(), agentDebugInfoBufferSize)
+	if err != nil {
+		catcher.Wrap(err, "getting output of Jasper process")
+	}
+
+	return strings.Join(logStream.Logs, "\n"), catcher.Resolve()
+}
+
 const jasperDialTimeout = 15 * time.Second
 
 // JasperClient returns a remote client that communicates with this host's
@@ -14,6 +14,7 @@ import (
 
 	"github.com/evergreen-ci/certdepot"
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/cloud/userdata"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/mock"
@@ -904,6 +905,33 @@ func TestJasperProcess(t *testing.T) {
 				assert.Error(t, err)
 			}))
 		},
+		"GetAgentDebugInfoRequestsAgentStatusServer": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
+			var args []string
+			manager.Create = func(opts *options.Create) jmock.Process {
+				args = opts.Args
+				return jmock.Process{}
+			}
+			assert.NoError(t, withJasperServiceSetupAndTeardown(ctx, env, manager, h, func() {
+				_, err := h.GetAgentDebugInfo(ctx, env, apimodels.AgentDebugInfoTimeouts)
+				assert.NoError(t, err)
+			}))
+			require.NotEmpty(t, args)
+			assert.Equal(t, "curl", args[0])
+			assert.Equal(t, fmt.Sprintf("http://127.0.0.1:%d/debug/timeouts", AgentStatusPort), args[len(args)-1])
+		},
+		"GetAgentDebugInfoFailsIfRequestFails": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
+			manager.Create = func(*options.Create) jmock.Process {
+				return jmock.Process{FailWait: true}
+			}
+			assert.NoError(t, withJasperServiceSetupAndTeardown(ctx, env, manager, h, func() {
+				_, err := h.GetAgentDebugInfo(ctx, env, apimodels.AgentDebugInfoTask)
+				assert.Error(t, err)
+			}))
+		},
+		"GetAgentDebugInfoFailsForInvalidKind": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
+			_, err := h.GetAgentDebugInfo(ctx, env, "nonexistent")
+			assert.Error(t, err)
+		},
 	} {
 		t.Run(testName, func(t *testing.T) {
 			tctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -0,0 +1,294 @@
+package host
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// sleepScheduleClockFormat is the format of the daily start and stop
+	// times in a sleep schedule.
+	sleepScheduleClockFormat = "15:04"
+
+	// MaxSleepScheduleExemptionDuration is the longest that a host can be
+	// temporarily kept awake in spite of its sleep schedule.
+	MaxSleepScheduleExemptionDuration = 30 * 24 * time.Hour
+)
+
+// SleepScheduleInfo is a recurring schedule for when an unexpirable spawn host
+// should be stopped and started so that it doesn't run while it's not in use.
+// A host is asleep during its daily window between the stop and start times,
+// as well as for the whole of each weekday that is off.
+type SleepScheduleInfo struct {
+	// TimeZone is the IANA time zone that the schedule is in. If unset, the
+	// schedule is in UTC.
+	TimeZone string `bson:"time_zone,omitempty" json:"time_zone,omitempty"`
+	// DailyStartTime is the time of day in the form HH:MM when the host
+	// should be started.
+	DailyStartTime string `bson:"daily_start_time,omitempty" json:"daily_start_time,omitempty"`
+	// DailyStopTime is the time of day in the form HH:MM when the host should
+	// be stopped.
+	DailyStopTime string `bson:"daily_stop_time,omitempty" json:"daily_stop_time,omitempty"`
+	// WholeWeekdaysOff are the days of the week when the host should be
+	// stopped for the entire day.
+	WholeWeekdaysOff []time.Weekday `bson:"whole_weekdays_off,omitempty" json:"whole_weekdays_off,omitempty"`
+	// TemporarilyExemptUntil keeps the host awake until the given time, even
+	// if the schedule would otherwise stop it.
+	TemporarilyExemptUntil time.Time `bson:"temporarily_exempt_until,omitempty" json:"temporarily_exempt_until,omitempty"`
+
+	// NextStopTime is the next time the host should be stopped.
+	NextStopTime time.Time `bson:"next_stop_time,omitempty" json:"next_stop_time,omitempty"`
+	// NextStartTime is the next time the host should be started.
+	NextStartTime time.Time `bson:"next_start_time,omitempty" json:"next_start_time,omitempty"`
+}
+
+var (
+	SleepScheduleNextStopTimeKey           = bsonutil.MustHaveTag(SleepScheduleInfo{}, "NextStopTime")
+	SleepScheduleNextStartTimeKey          = bsonutil.MustHaveTag(SleepScheduleInfo{}, "NextStartTime")
+	SleepScheduleTemporarilyExemptUntilKey = bsonutil.MustHaveTag(SleepScheduleInfo{}, "TemporarilyExemptUntil")
+)
+
+// IsZero returns whether the sleep schedule has no times at which the host
+// should be asleep.
+func (s SleepScheduleInfo) IsZero() bool {
+	return s.DailyStartTime == "" && s.DailyStopTime == "" && len(s.WholeWeekdaysOff) == 0
+}
+
+// Validate checks that the sleep schedule is well-formed. An empty sleep
+// schedule is valid.
+func (s *SleepScheduleInfo) Validate() error {
+	if s.IsZero() {
+		return nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	_, err := time.LoadLocation(s.TimeZone)
+	catcher.Wrapf(err, "invalid time zone '%s'", s.TimeZone)
+
+	catcher.NewWhen((s.DailyStartTime == "") != (s.DailyStopTime == ""), "daily start and stop times must be set together")
+	if s.DailyStartTime != "" && s.DailyStopTime != "" {
+		start, startErr := parseSleepScheduleClock(s.DailyStartTime)
+		catcher.Wrap(startErr, "invalid daily start time")
+		stop, stopErr := parseSleepScheduleClock(s.DailyStopTime)
+		catcher.Wrap(stopErr, "invalid daily stop time")
+		catcher.NewWhen(startErr == nil && stopErr == nil && start == stop, "daily start and stop times cannot be the same")
+	}
+
+	seen := map[time.Weekday]bool{}
+	for _, day := range s.WholeWeekdaysOff {
+		catcher.ErrorfWhen(day < time.Sunday || day > time.Saturday, "invalid weekday %d", day)
+		catcher.ErrorfWhen(seen[day], "weekday '%s' cannot be listed more than once", day)
+		seen[day] = true
+	}
+	catcher.NewWhen(len(seen) >= 7, "host cannot be asleep for the whole week")
+
+	return catcher.Resolve()
+}
+
+// IsExempt returns whether the host is temporarily kept awake at the given
+// time.
+func (s *SleepScheduleInfo) IsExempt(t time.Time) bool {
+	return t.Before(s.TemporarilyExemptUntil)
+}
+
+// ShouldBeAsleep returns whether the schedule has the host asleep at the given
+// time, ignoring any temporary exemption.
+func (s *SleepScheduleInfo) ShouldBeAsleep(t time.Time) bool {
+	if s.IsZero() {
+		return false
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+
+	for _, day := range s.WholeWeekdaysOff {
+		if t.Weekday() == day {
+			return true
+		}
+	}
+	if s.DailyStartTime == "" || s.DailyStopTime == "" {
+		return false
+	}
+
+	start, err := parseSleepScheduleClock(s.DailyStartTime)
+	if err != nil {
+		return false
+	}
+	stop, err := parseSleepScheduleClock(s.DailyStopTime)
+	if err != nil {
+		return false
+	}
+	now := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if stop < start {
+		return now >= stop && now < start
+	}
+	return now >= stop || now < start
+}
+
+// nextTransition returns the first time after the given time when the host
+// goes to sleep if toAsleep is true, or wakes up otherwise. It returns the
+// zero time if there is no such transition within the next week.
+func (s *SleepScheduleInfo) nextTransition(after time.Time, toAsleep bool) time.Time {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.Time{}
+	}
+	start, _ := parseSleepScheduleClock(s.DailyStartTime)
+	stop, _ := parseSleepScheduleClock(s.DailyStopTime)
+
+	// The schedule can only change at midnight and at the daily start and
+	// stop times.
+	local := after.In(loc)
+	var candidates []time.Time
+	for i := 0; i <= 8; i++ {
+		midnight := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		candidates = append(candidates, midnight)
+		if s.DailyStartTime != "" && s.DailyStopTime != "" {
+			candidates = append(candidates,
+				time.Date(midnight.Year(), midnight.Month(), midnight.Day(), int(start.Hours()), int(start.Minutes())%60, 0, 0, loc),
+				time.Date(midnight.Year(), midnight.Month(), midnight.Day(), int(stop.Hours()), int(stop.Minutes())%60, 0, 0, loc),
+			)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+	asleep := s.ShouldBeAsleep(after)
+	for _, candidate := range candidates {
+		if !candidate.After(after) {
+			continue
+		}
+		candidateAsleep := s.ShouldBeAsleep(candidate)
+		if candidateAsleep != asleep && candidateAsleep == toAsleep {
+			return candidate
+		}
+		asleep = candidateAsleep
+	}
+
+	return time.Time{}
+}
+
+// NextStop returns the next time after the given time when the host should be
+// stopped, accounting for any temporary exemption.
+func (s *SleepScheduleInfo) NextStop(after time.Time) time.Time {
+	if s.IsExempt(after) {
+		if s.ShouldBeAsleep(s.TemporarilyExemptUntil) {
+			return s.TemporarilyExemptUntil
+		}
+		return s.nextTransition(s.TemporarilyExemptUntil, true)
+	}
+	return s.nextTransition(after, true)
+}
+
+// NextStart returns the next time after the given time when the host should
+// be started.
+func (s *SleepScheduleInfo) NextStart(after time.Time) time.Time {
+	return s.nextTransition(after, false)
+}
+
+func parseSleepScheduleClock(clock string) (time.Duration, error) {
+	t, err := time.Parse(sleepScheduleClockFormat, clock)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parsing time of day '%s' in the form HH:MM", clock)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ValidateSleepScheduleModification checks that the sleep schedule changes in
+// the modify options can be applied to the host.
+func ValidateSleepScheduleModification(h *Host, opts HostModifyOptions) error {
+	noExpiration := h.NoExpiration
+	if opts.NoExpiration != nil {
+		noExpiration = *opts.NoExpiration
+	}
+
+	catcher := grip.NewBasicCatcher()
+	if opts.SleepSchedule != nil && !opts.SleepSchedule.IsZero() {
+		catcher.Add(opts.SleepSchedule.Validate())
+		catcher.NewWhen(!noExpiration, "only unexpirable hosts can have a sleep schedule")
+		catcher.NewWhen(time.Until(opts.SleepSchedule.TemporarilyExemptUntil) > MaxSleepScheduleExemptionDuration, "cannot keep host awake for longer than the maximum exemption duration")
+	}
+	if !opts.KeepAwakeUntil.IsZero() {
+		schedule := h.SleepSchedule
+		if opts.SleepSchedule != nil {
+			schedule = *opts.SleepSchedule
+		}
+		catcher.NewWhen(schedule.IsZero(), "cannot keep host awake because it does not have a sleep schedule")
+		catcher.NewWhen(!opts.KeepAwakeUntil.After(time.Now()), "time to keep host awake until must be in the future")
+		catcher.NewWhen(time.Until(opts.KeepAwakeUntil) > MaxSleepScheduleExemptionDuration, "cannot keep host awake for longer than the maximum exemption duration")
+	}
+
+	return catcher.Resolve()
+}
+
+// SetSleepSchedule replaces the host's sleep schedule and schedules its next
+// stop and start. An empty schedule removes the host's sleep schedule.
+func (h *Host) SetSleepSchedule(ctx context.Context, schedule SleepScheduleInfo, now time.Time) error {
+	if schedule.IsZero() {
+		if err := UpdateOne(ctx, bson.M{IdKey: h.Id}, bson.M{"$unset": bson.M{SleepScheduleKey: 1}}); err != nil {
+			return err
+		}
+		h.SleepSchedule = SleepScheduleInfo{}
+		return nil
+	}
+	if err := schedule.Validate(); err != nil {
+		return errors.Wrap(err, "invalid sleep schedule")
+	}
+
+	schedule.NextStopTime = schedule.NextStop(now)
+	schedule.NextStartTime = schedule.NextStart(now)
+	if err := UpdateOne(ctx, bson.M{IdKey: h.Id}, bson.M{"$set": bson.M{SleepScheduleKey: schedule}}); err != nil {
+		return err
+	}
+	h.SleepSchedule = schedule
+	return nil
+}
+
+// SetSleepScheduleExemption keeps the host awake until the given time in spite
+// of its sleep schedule.
+func (h *Host) SetSleepScheduleExemption(ctx context.Context, until, now time.Time) error {
+	if h.SleepSchedule.IsZero() {
+		return errors.New("host does not have a sleep schedule")
+	}
+	schedule := h.SleepSchedule
+	schedule.TemporarilyExemptUntil = until
+	return h.SetSleepSchedule(ctx, schedule, now)
+}
+
+// UpdateSleepScheduleTransitions schedules the host's next stop and start
+// after the given time.
+func (h *Host) UpdateSleepScheduleTransitions(ctx context.Context, now time.Time) error {
+	nextStop := h.SleepSchedule.NextStop(now)
+	nextStart := h.SleepSchedule.NextStart(now)
+	if err := UpdateOne(ctx, bson.M{IdKey: h.Id}, bson.M{"$set": bson.M{
+		bsonutil.GetDottedKeyName(SleepScheduleKey, SleepScheduleNextStopTimeKey):  nextStop,
+		bsonutil.GetDottedKeyName(SleepScheduleKey, SleepScheduleNextStartTimeKey): nextStart,
+	}}); err != nil {
+		return err
+	}
+	h.SleepSchedule.NextStopTime = nextStop
+	h.SleepSchedule.NextStartTime = nextStart
+	return nil
+}
+
+// FindHostsWithSleepScheduleDue finds unexpirable spawn hosts whose sleep
+// schedule has a stop or start that is due at the given time.
+func FindHostsWithSleepScheduleDue(ctx context.Context, now time.Time) ([]Host, error) {
+	return Find(ctx, bson.M{
+		UserHostKey:     true,
+		NoExpirationKey: true,
+		StatusKey:       bson.M{"$in": []string{evergreen.HostRunning, evergreen.HostStopped}},
+		"$or": []bson.M{
+			{bsonutil.GetDottedKeyName(SleepScheduleKey, SleepScheduleNextStopTimeKey): bson.M{"$lte": now}},
+			{bsonutil.GetDottedKeyName(SleepScheduleKey, SleepScheduleNextStartTimeKey): bson.M{"$lte": now}},
+		},
+	})
+}
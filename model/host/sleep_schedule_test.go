@@ -0,0 +1,166 @@
+package host
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSleepScheduleValidate(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		schedule SleepScheduleInfo
+		isValid  bool
+	}{
+		"EmptyScheduleIsValid": {
+			isValid: true,
+		},
+		"DailyWindowIsValid": {
+			schedule: SleepScheduleInfo{TimeZone: "America/New_York", DailyStartTime: "08:00", DailyStopTime: "20:00"},
+			isValid:  true,
+		},
+		"WeekdaysOffOnlyIsValid": {
+			schedule: SleepScheduleInfo{WholeWeekdaysOff: []time.Weekday{time.Saturday, time.Sunday}},
+			isValid:  true,
+		},
+		"InvalidTimeZone": {
+			schedule: SleepScheduleInfo{TimeZone: "Not/AZone", DailyStartTime: "08:00", DailyStopTime: "20:00"},
+		},
+		"OnlyStartTime": {
+			schedule: SleepScheduleInfo{DailyStartTime: "08:00"},
+		},
+		"MalformedTime": {
+			schedule: SleepScheduleInfo{DailyStartTime: "8am", DailyStopTime: "20:00"},
+		},
+		"SameStartAndStopTime": {
+			schedule: SleepScheduleInfo{DailyStartTime: "08:00", DailyStopTime: "08:00"},
+		},
+		"DuplicateWeekday": {
+			schedule: SleepScheduleInfo{WholeWeekdaysOff: []time.Weekday{time.Sunday, time.Sunday}},
+		},
+		"InvalidWeekday": {
+			schedule: SleepScheduleInfo{WholeWeekdaysOff: []time.Weekday{7}},
+		},
+		"WholeWeekOff": {
+			schedule: SleepScheduleInfo{WholeWeekdaysOff: []time.Weekday{
+				time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+			}},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			err := tCase.schedule.Validate()
+			if tCase.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSleepScheduleShouldBeAsleep(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	schedule := SleepScheduleInfo{
+		TimeZone:         loc.String(),
+		DailyStartTime:   "08:00",
+		DailyStopTime:    "20:00",
+		WholeWeekdaysOff: []time.Weekday{time.Saturday, time.Sunday},
+	}
+
+	// January 3, 2023 is a Tuesday.
+	assert.False(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 3, 12, 0, 0, 0, loc)))
+	assert.False(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 3, 8, 0, 0, 0, loc)))
+	assert.True(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 3, 20, 0, 0, 0, loc)))
+	assert.True(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 3, 7, 59, 0, 0, loc)))
+	assert.True(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 7, 12, 0, 0, 0, loc)), "should be asleep all day on a weekday off")
+	assert.True(t, schedule.ShouldBeAsleep(time.Date(2023, time.January, 3, 12, 0, 0, 0, time.UTC)), "should use the schedule's time zone")
+
+	overnight := SleepScheduleInfo{DailyStartTime: "22:00", DailyStopTime: "06:00"}
+	assert.True(t, overnight.ShouldBeAsleep(time.Date(2023, time.January, 3, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, overnight.ShouldBeAsleep(time.Date(2023, time.January, 3, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, overnight.ShouldBeAsleep(time.Date(2023, time.January, 3, 2, 0, 0, 0, time.UTC)))
+
+	assert.False(t, (&SleepScheduleInfo{}).ShouldBeAsleep(time.Now()))
+}
+
+func TestSleepScheduleNextTransitions(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	schedule := SleepScheduleInfo{
+		TimeZone:         loc.String(),
+		DailyStartTime:   "08:00",
+		DailyStopTime:    "20:00",
+		WholeWeekdaysOff: []time.Weekday{time.Saturday, time.Sunday},
+	}
+
+	t.Run("DuringTheDay", func(t *testing.T) {
+		now := time.Date(2023, time.January, 3, 12, 0, 0, 0, loc)
+		assert.True(t, time.Date(2023, time.January, 3, 20, 0, 0, 0, loc).Equal(schedule.NextStop(now)))
+		assert.True(t, time.Date(2023, time.January, 4, 8, 0, 0, 0, loc).Equal(schedule.NextStart(now)))
+	})
+	t.Run("SkipsWeekend", func(t *testing.T) {
+		now := time.Date(2023, time.January, 6, 21, 0, 0, 0, loc)
+		assert.True(t, time.Date(2023, time.January, 9, 8, 0, 0, 0, loc).Equal(schedule.NextStart(now)))
+		assert.True(t, time.Date(2023, time.January, 9, 20, 0, 0, 0, loc).Equal(schedule.NextStop(now)))
+	})
+	t.Run("WeekdaysOffOnly", func(t *testing.T) {
+		weekends := SleepScheduleInfo{WholeWeekdaysOff: []time.Weekday{time.Saturday, time.Sunday}}
+		now := time.Date(2023, time.January, 3, 12, 0, 0, 0, time.UTC)
+		assert.True(t, time.Date(2023, time.January, 7, 0, 0, 0, 0, time.UTC).Equal(weekends.NextStop(now)))
+		assert.True(t, time.Date(2023, time.January, 9, 0, 0, 0, 0, time.UTC).Equal(weekends.NextStart(now)))
+	})
+	t.Run("ExemptionEndingWhileAsleepStopsAtEndOfExemption", func(t *testing.T) {
+		exempt := schedule
+		exempt.TemporarilyExemptUntil = time.Date(2023, time.January, 3, 23, 0, 0, 0, loc)
+		now := time.Date(2023, time.January, 3, 12, 0, 0, 0, loc)
+		assert.True(t, exempt.TemporarilyExemptUntil.Equal(exempt.NextStop(now)))
+	})
+	t.Run("ExemptionEndingWhileAwakeStopsAtNextStop", func(t *testing.T) {
+		exempt := schedule
+		exempt.TemporarilyExemptUntil = time.Date(2023, time.January, 4, 12, 0, 0, 0, loc)
+		now := time.Date(2023, time.January, 3, 12, 0, 0, 0, loc)
+		assert.True(t, time.Date(2023, time.January, 4, 20, 0, 0, 0, loc).Equal(exempt.NextStop(now)))
+	})
+	t.Run("AcrossDaylightSavingTime", func(t *testing.T) {
+		// Daylight saving time starts on March 12, 2023 in New York.
+		now := time.Date(2023, time.March, 10, 21, 0, 0, 0, loc)
+		assert.True(t, time.Date(2023, time.March, 13, 8, 0, 0, 0, loc).Equal(schedule.NextStart(now)))
+	})
+}
+
+func TestValidateSleepScheduleModification(t *testing.T) {
+	schedule := SleepScheduleInfo{DailyStartTime: "08:00", DailyStopTime: "20:00"}
+
+	t.Run("SucceedsForUnexpirableHost", func(t *testing.T) {
+		h := &Host{NoExpiration: true}
+		assert.NoError(t, ValidateSleepScheduleModification(h, HostModifyOptions{SleepSchedule: &schedule}))
+	})
+	t.Run("SucceedsForHostBecomingUnexpirable", func(t *testing.T) {
+		h := &Host{}
+		assert.NoError(t, ValidateSleepScheduleModification(h, HostModifyOptions{SleepSchedule: &schedule, NoExpiration: utility.TruePtr()}))
+	})
+	t.Run("FailsForExpirableHost", func(t *testing.T) {
+		h := &Host{}
+		assert.Error(t, ValidateSleepScheduleModification(h, HostModifyOptions{SleepSchedule: &schedule}))
+	})
+	t.Run("SucceedsRemovingScheduleFromExpirableHost", func(t *testing.T) {
+		h := &Host{}
+		assert.NoError(t, ValidateSleepScheduleModification(h, HostModifyOptions{SleepSchedule: &SleepScheduleInfo{}}))
+	})
+	t.Run("KeepAwakeRequiresSchedule", func(t *testing.T) {
+		h := &Host{NoExpiration: true}
+		opts := HostModifyOptions{KeepAwakeUntil: time.Now().Add(time.Hour)}
+		assert.Error(t, ValidateSleepScheduleModification(h, opts))
+
+		h.SleepSchedule = schedule
+		assert.NoError(t, ValidateSleepScheduleModification(h, opts))
+	})
+	t.Run("KeepAwakeMustBeInFutureAndWithinLimit", func(t *testing.T) {
+		h := &Host{NoExpiration: true, SleepSchedule: schedule}
+		assert.Error(t, ValidateSleepScheduleModification(h, HostModifyOptions{KeepAwakeUntil: time.Now().Add(-time.Hour)}))
+		assert.Error(t, ValidateSleepScheduleModification(h, HostModifyOptions{KeepAwakeUntil: time.Now().Add(2 * MaxSleepScheduleExemptionDuration)}))
+	})
+}
@@ -0,0 +1,111 @@
+package host
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Snapshot is a point-in-time copy of a volume that can be used to create new
+// volumes or restore the home volume of a new spawn host.
+type Snapshot struct {
+	ID          string `bson:"_id" json:"id"`
+	DisplayName string `bson:"display_name" json:"display_name"`
+	CreatedBy   string `bson:"created_by" json:"created_by"`
+	// SourceVolumeID is the ID of the volume that the snapshot was taken of.
+	SourceVolumeID string `bson:"source_volume_id" json:"source_volume_id"`
+	// SourceHostID is the ID of the host that the source volume was attached
+	// to when the snapshot was taken, if any.
+	SourceHostID string `bson:"source_host_id,omitempty" json:"source_host_id,omitempty"`
+	// Region is the region that the snapshot is stored in. Volumes can only be
+	// restored from the snapshot within the same region.
+	Region string `bson:"region" json:"region"`
+	// Size is the size of the source volume in GB, which is the minimum size
+	// of any volume restored from the snapshot.
+	Size         int32     `bson:"size" json:"size"`
+	Expiration   time.Time `bson:"expiration" json:"expiration"`
+	NoExpiration bool      `bson:"no_expiration" json:"no_expiration"`
+	CreationDate time.Time `bson:"created_at" json:"created_at"`
+}
+
+// Insert a snapshot into the snapshots collection.
+func (s *Snapshot) Insert() error {
+	s.CreationDate = time.Now()
+	if s.Expiration.IsZero() && !s.NoExpiration {
+		s.Expiration = s.CreationDate.Add(evergreen.DefaultSnapshotExpiration)
+	}
+	return db.Insert(SnapshotsCollection, s)
+}
+
+// Remove a snapshot from the snapshots collection. Note that this does not
+// delete the snapshot from the cloud provider.
+func (s *Snapshot) Remove() error {
+	return db.Remove(SnapshotsCollection, bson.M{SnapshotIDKey: s.ID})
+}
+
+// SetDisplayName sets the snapshot's display name.
+func (s *Snapshot) SetDisplayName(displayName string) error {
+	if err := db.UpdateId(SnapshotsCollection, s.ID, bson.M{"$set": bson.M{SnapshotDisplayNameKey: displayName}}); err != nil {
+		return errors.WithStack(err)
+	}
+	s.DisplayName = displayName
+	return nil
+}
+
+// SetExpiration sets the time at which the snapshot will be deleted.
+func (s *Snapshot) SetExpiration(expiration time.Time) error {
+	if err := db.UpdateId(SnapshotsCollection, s.ID, bson.M{"$set": bson.M{SnapshotExpirationKey: expiration}}); err != nil {
+		return errors.WithStack(err)
+	}
+	s.Expiration = expiration
+	return nil
+}
+
+// SetNoExpiration sets whether or not the snapshot is exempt from expiring.
+func (s *Snapshot) SetNoExpiration(noExpiration bool) error {
+	if err := db.UpdateId(SnapshotsCollection, s.ID, bson.M{"$set": bson.M{SnapshotNoExpirationKey: noExpiration}}); err != nil {
+		return errors.WithStack(err)
+	}
+	s.NoExpiration = noExpiration
+	return nil
+}
+
+// FindSnapshotByID finds a snapshot by its ID.
+func FindSnapshotByID(id string) (*Snapshot, error) {
+	s := &Snapshot{}
+	err := db.FindOneQ(SnapshotsCollection, db.Query(bson.M{SnapshotIDKey: id}), s)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// FindSnapshotsByUser finds all the snapshots created by the given user,
+// sorted from newest to oldest.
+func FindSnapshotsByUser(user string) ([]Snapshot, error) {
+	return findSnapshots(db.Query(bson.M{SnapshotCreatedByKey: user}).Sort([]string{"-" + SnapshotCreationDateKey}))
+}
+
+// CountSnapshotsForUser returns the number of snapshots that the given user
+// currently has.
+func CountSnapshotsForUser(user string) (int, error) {
+	return db.Count(SnapshotsCollection, bson.M{SnapshotCreatedByKey: user})
+}
+
+// FindSnapshotsToDelete finds all expirable snapshots that expired at or before
+// the given time.
+func FindSnapshotsToDelete(expirationTime time.Time) ([]Snapshot, error) {
+	return findSnapshots(db.Query(bson.M{
+		SnapshotNoExpirationKey: bson.M{"$ne": true},
+		SnapshotExpirationKey:   bson.M{"$lte": expirationTime},
+	}))
+}
+
+func findSnapshots(q db.Q) ([]Snapshot, error) {
+	snapshots := []Snapshot{}
+	return snapshots, db.FindAllQ(SnapshotsCollection, q, &snapshots)
+}
@@ -0,0 +1,83 @@
+package host
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotInsertDefaultsExpiration(t *testing.T) {
+	require.NoError(t, db.Clear(SnapshotsCollection))
+
+	expirable := Snapshot{ID: "s0"}
+	require.NoError(t, expirable.Insert())
+	assert.False(t, expirable.CreationDate.IsZero())
+	assert.True(t, expirable.Expiration.After(expirable.CreationDate))
+
+	unexpirable := Snapshot{ID: "s1", NoExpiration: true}
+	require.NoError(t, unexpirable.Insert())
+	assert.True(t, unexpirable.Expiration.IsZero())
+}
+
+func TestFindSnapshotsToDelete(t *testing.T) {
+	require.NoError(t, db.Clear(SnapshotsCollection))
+
+	snapshots := []Snapshot{
+		{ID: "s0", Expiration: time.Date(2010, time.December, 10, 23, 0, 0, 0, time.UTC)},
+		{ID: "s1", Expiration: time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC), NoExpiration: true},
+		{ID: "s2", Expiration: time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)},
+	}
+	for _, s := range snapshots {
+		require.NoError(t, s.Insert())
+	}
+
+	toDelete, err := FindSnapshotsToDelete(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	require.Len(t, toDelete, 1)
+	assert.Equal(t, "s2", toDelete[0].ID)
+}
+
+func TestFindSnapshotsByUser(t *testing.T) {
+	require.NoError(t, db.Clear(SnapshotsCollection))
+
+	snapshots := []Snapshot{
+		{ID: "s0", CreatedBy: "me"},
+		{ID: "s1", CreatedBy: "someone-else"},
+		{ID: "s2", CreatedBy: "me"},
+	}
+	for _, s := range snapshots {
+		require.NoError(t, s.Insert())
+	}
+
+	found, err := FindSnapshotsByUser("me")
+	assert.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "s2", found[0].ID)
+	assert.Equal(t, "s0", found[1].ID)
+
+	count, err := CountSnapshotsForUser("me")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSnapshotSetters(t *testing.T) {
+	require.NoError(t, db.Clear(SnapshotsCollection))
+
+	s := Snapshot{ID: "s0"}
+	require.NoError(t, s.Insert())
+
+	require.NoError(t, s.SetDisplayName("my-snapshot"))
+	require.NoError(t, s.SetNoExpiration(true))
+	expiration := time.Now().Add(time.Hour).Round(time.Second)
+	require.NoError(t, s.SetExpiration(expiration))
+
+	dbSnapshot, err := FindSnapshotByID(s.ID)
+	require.NoError(t, err)
+	require.NotNil(t, dbSnapshot)
+	assert.Equal(t, "my-snapshot", dbSnapshot.DisplayName)
+	assert.True(t, dbSnapshot.NoExpiration)
+	assert.True(t, expiration.Equal(dbSnapshot.Expiration))
+}
@@ -67,7 +67,8 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	case event.SlackSubscriberType:
 		n.Payload = &SlackPayload{}
 
-	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
+	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType,
+		event.GitlabCommitStatusSubscriberType:
 		n.Payload = &message.GithubStatus{}
 
 	case event.EnqueuePatchSubscriberType:
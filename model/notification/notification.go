@@ -83,6 +83,9 @@ func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		return evergreen.SenderGithubStatus, nil
 
+	case event.GitlabCommitStatusSubscriberType:
+		return evergreen.SenderGitlabStatus, nil
+
 	case event.EnqueuePatchSubscriberType:
 		return evergreen.SenderGeneric, nil
 	default:
@@ -214,6 +217,23 @@ func (n *Notification) Composer(env evergreen.Environment) (message.Composer, er
 		payload.Ref = sub.Ref
 		return message.NewGithubStatusMessageWithRepo(level.Notice, *payload), nil
 
+	case event.GitlabCommitStatusSubscriberType:
+		sub := n.Subscriber.Target.(*event.GitlabCommitStatusSubscriber)
+		// GitLab statuses are built from the same payload as GitHub
+		// statuses, since they carry the same information.
+		payload, ok := n.Payload.(*message.GithubStatus)
+		if !ok || payload == nil {
+			return nil, errors.New("gitlab-commit-status payload is invalid")
+		}
+		return util.NewGitlabStatusMessage(util.GitlabStatus{
+			ProjectPath: sub.ProjectPath,
+			Ref:         sub.Ref,
+			State:       gitlabStateForGithubState(payload.State),
+			Name:        payload.Context,
+			Description: payload.Description,
+			URL:         payload.URL,
+		}), nil
+
 	case event.EnqueuePatchSubscriberType:
 		payload, ok := n.Payload.(*model.EnqueuePatch)
 		if !ok || payload == nil {
@@ -227,6 +247,21 @@ func (n *Notification) Composer(env evergreen.Environment) (message.Composer, er
 	}
 }
 
+// gitlabStateForGithubState returns the GitLab commit status state that
+// corresponds to the GitHub status state.
+func gitlabStateForGithubState(state message.GithubState) string {
+	switch state {
+	case message.GithubStatePending:
+		return util.GitlabStatePending
+	case message.GithubStateSuccess:
+		return util.GitlabStateSuccess
+	case message.GithubStateFailure, message.GithubStateError:
+		return util.GitlabStateFailed
+	default:
+		return string(state)
+	}
+}
+
 func (n *Notification) MarkSent() error {
 	if len(n.ID) == 0 {
 		return errors.New("notification has no ID")
@@ -309,6 +344,7 @@ type NotificationStats struct {
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	GithubMerge       int `json:"github_merge" bson:"github_merge" yaml:"github_merge"`
+	GitlabStatus      int `json:"gitlab_status" bson:"gitlab_status" yaml:"gitlab_status"`
 	EnqueuePatch      int `json:"enqueue_patch" bson:"enqueue_patch" yaml:"enqueue_patch"`
 }
 
@@ -354,6 +390,9 @@ func CollectUnsentNotificationStats() (*NotificationStats, error) {
 		case event.GithubMergeSubscriberType:
 			nStats.GithubMerge = data.Count
 
+		case event.GitlabCommitStatusSubscriberType:
+			nStats.GitlabStatus = data.Count
+
 		case event.JIRAIssueSubscriberType:
 			nStats.JIRAIssue = data.Count
 
@@ -1,7 +1,6 @@
 package patch
 
 import (
-	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -20,9 +19,14 @@ type cliIntent struct {
 	// ID is created by the driver and has no special meaning to the application.
 	DocumentID string `bson:"_id"`
 
-	// PatchFileID is the object id of the patch file created in gridfs.
+	// PatchFileID is the object id of the patch file created in gridfs. It
+	// is only set for intents created before patch diffs could be stored in
+	// the patch diff bucket.
 	PatchFileID mgobson.ObjectId `bson:"patch_file_id,omitempty"`
 
+	// PatchDiffID is the patch file ID of the stored patch diff.
+	PatchDiffID string `bson:"patch_diff_id,omitempty"`
+
 	// PatchContent is the patch as supplied by the client. It is saved
 	// separately from the patch intent.
 	PatchContent string
@@ -91,6 +95,9 @@ type cliIntent struct {
 	// GitInfo contains information about the author's git environment.
 	GitInfo *GitMetadata `bson:"git_info,omitempty"`
 
+	// Stack is the patch's place in a stack of patches, if it's part of one.
+	Stack StackInfo `bson:"stack,omitempty"`
+
 	// RepeatDefinition reuses the latest patch's task/variants (if no patch ID is provided)
 	RepeatDefinition bool `bson:"reuse_definition"`
 	// RepeatFailed reuses the latest patch's failed tasks (if no patch ID is provided)
@@ -105,6 +112,7 @@ type cliIntent struct {
 var (
 	cliDocumentIDKey    = bsonutil.MustHaveTag(cliIntent{}, "DocumentID")
 	cliPatchFileIDKey   = bsonutil.MustHaveTag(cliIntent{}, "PatchFileID")
+	cliPatchDiffIDKey   = bsonutil.MustHaveTag(cliIntent{}, "PatchDiffID")
 	cliDescriptionKey   = bsonutil.MustHaveTag(cliIntent{}, "Description")
 	cliBuildVariantsKey = bsonutil.MustHaveTag(cliIntent{}, "BuildVariants")
 	cliTasksKey         = bsonutil.MustHaveTag(cliIntent{}, "Tasks")
@@ -122,13 +130,13 @@ var (
 
 func (c *cliIntent) Insert() error {
 	if len(c.PatchContent) > 0 {
-		patchFileID := mgobson.NewObjectId()
-		if err := db.WriteGridFile(GridFSPrefix, patchFileID.Hex(), strings.NewReader(c.PatchContent)); err != nil {
+		patchDiffID, err := StorePatchDiff(c.PatchContent)
+		if err != nil {
 			return err
 		}
 
 		c.PatchContent = ""
-		c.PatchFileID = patchFileID
+		c.PatchDiffID = patchDiffID
 	}
 
 	c.CreatedAt = time.Now().UTC().Round(time.Millisecond)
@@ -206,14 +214,19 @@ func (c *cliIntent) NewPatch() *Patch {
 		BackportOf:         c.BackportOf,
 		Patches:            []ModulePatch{},
 		GitInfo:            c.GitInfo,
+		Stack:              c.Stack,
+	}
+	patchFileID := c.PatchDiffID
+	if patchFileID == "" && len(c.PatchFileID) > 0 {
+		patchFileID = c.PatchFileID.Hex()
 	}
-	if len(c.PatchFileID) > 0 {
+	if patchFileID != "" {
 		p.Patches = append(p.Patches,
 			ModulePatch{
 				ModuleName: c.Module,
 				Githash:    c.BaseHash,
 				PatchSet: PatchSet{
-					PatchFileId: c.PatchFileID.Hex(),
+					PatchFileId: patchFileID,
 				},
 			})
 	}
@@ -231,6 +244,7 @@ type CLIIntentParams struct {
 	Finalize         bool
 	BackportOf       BackportInfo
 	GitInfo          *GitMetadata
+	Stack            StackInfo
 	Parameters       []Parameter
 	Variants         []string
 	Tasks            []string
@@ -302,6 +316,7 @@ func NewCliIntent(params CLIIntentParams) (Intent, error) {
 		TriggerAliases:     params.TriggerAliases,
 		BackportOf:         params.BackportOf,
 		GitInfo:            params.GitInfo,
+		Stack:              params.Stack,
 		RepeatDefinition:   params.RepeatDefinition,
 		RepeatFailed:       params.RepeatFailed,
 		RepeatPatchId:      params.RepeatPatchId,
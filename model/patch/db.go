@@ -51,6 +51,9 @@ var (
 	MergePatchKey           = bsonutil.MustHaveTag(Patch{}, "MergePatch")
 	TriggersKey             = bsonutil.MustHaveTag(Patch{}, "Triggers")
 	HiddenKey               = bsonutil.MustHaveTag(Patch{}, "Hidden")
+	DiffMigrationFailedKey  = bsonutil.MustHaveTag(Patch{}, "DiffMigrationFailed")
+
+	MergeQueueRequiredStatusKey = bsonutil.MustHaveTag(Patch{}, "MergeQueueRequiredStatus")
 
 	// BSON fields for sync at end struct
 	SyncAtEndOptionsBuildVariantsKey = bsonutil.MustHaveTag(SyncAtEndOptions{}, "BuildVariants")
@@ -65,8 +68,12 @@ var (
 	ModulePatchSetKey     = bsonutil.MustHaveTag(ModulePatch{}, "PatchSet")
 
 	// BSON fields for the patch set struct
-	PatchSetPatchKey   = bsonutil.MustHaveTag(PatchSet{}, "Patch")
-	PatchSetSummaryKey = bsonutil.MustHaveTag(PatchSet{}, "Summary")
+	PatchSetPatchKey       = bsonutil.MustHaveTag(PatchSet{}, "Patch")
+	PatchSetPatchFileIdKey = bsonutil.MustHaveTag(PatchSet{}, "PatchFileId")
+	PatchSetSummaryKey     = bsonutil.MustHaveTag(PatchSet{}, "Summary")
+
+	// BSON fields for the summary struct
+	SummaryNameKey = bsonutil.MustHaveTag(thirdparty.Summary{}, "Name")
 
 	// BSON fields for the patch trigger struct
 	TriggerInfoAliasesKey              = bsonutil.MustHaveTag(TriggerInfo{}, "Aliases")
@@ -289,6 +296,33 @@ func FindOneId(id string) (*Patch, error) {
 	return FindOne(ByStringId(id))
 }
 
+// FindWithDatabasePatchDiffs returns up to the given number of patches that
+// have diffs stored inline in the patch or in GridFS instead of in the patch
+// diff bucket. Patches whose diffs previously failed to migrate are skipped.
+func FindWithDatabasePatchDiffs(limit int) ([]Patch, error) {
+	// Patch file IDs that don't start with the patch diff key prefix are
+	// matched as the string ranges before and after that prefix rather than
+	// with a regex, so that every branch can use the patch file ID index.
+	patchFileIDKey := bsonutil.GetDottedKeyName(ModulePatchSetKey, PatchSetPatchFileIdKey)
+	lastPrefixChar := len(patchDiffKeyPrefix) - 1
+	afterPrefix := patchDiffKeyPrefix[:lastPrefixChar] + string(patchDiffKeyPrefix[lastPrefixChar]+1)
+	return Find(db.Query(bson.M{
+		DiffMigrationFailedKey: bson.M{"$ne": true},
+		"$or": []bson.M{
+			{PatchesKey: bson.M{"$elemMatch": bson.M{
+				patchFileIDKey: bson.M{"$gt": "", "$lt": patchDiffKeyPrefix},
+			}}},
+			{PatchesKey: bson.M{"$elemMatch": bson.M{
+				patchFileIDKey: bson.M{"$gte": afterPrefix},
+			}}},
+			{PatchesKey: bson.M{"$elemMatch": bson.M{
+				patchFileIDKey: nil,
+				bsonutil.GetDottedKeyName(ModulePatchSetKey, PatchSetPatchKey): bson.M{"$nin": []interface{}{nil, ""}},
+			}}},
+		},
+	}).Sort([]string{IdKey}).Limit(limit))
+}
+
 // Find runs a patch query, returning all patches that satisfy the query.
 func Find(query db.Q) ([]Patch, error) {
 	patches := []Patch{}
@@ -0,0 +1,171 @@
+package patch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// GitlabIntentType is an intent to create a patch for a GitLab merge
+	// request.
+	GitlabIntentType = "gitlab"
+)
+
+// gitlabIntent represents an intent to create a patch build as a result of a
+// GitLab merge request webhook. These intents are processed asynchronously by
+// an amboy queue.
+type gitlabIntent struct {
+	// DocumentID is created by the driver and has no special meaning to the application
+	DocumentID string `bson:"_id"`
+
+	// MsgID identifies the webhook event that created the intent.
+	MsgID string `bson:"msg_id"`
+
+	// CreatedAt is the time that this intent was stored in the database
+	CreatedAt time.Time `bson:"created_at"`
+
+	// Processed indicates whether a patch intent has been processed by the amboy queue.
+	Processed bool `bson:"processed"`
+
+	// ProcessedAt is the time that this intent was processed
+	ProcessedAt time.Time `bson:"processed_at"`
+
+	// IntentType indicates the type of the patch intent, i.e., GitlabIntentType
+	IntentType string `bson:"intent_type"`
+
+	// CalledBy indicates whether the intent was created automatically by Evergreen or by a user.
+	CalledBy string `bson:"called_by"`
+
+	// MergeRequest is the merge request to test.
+	MergeRequest thirdparty.GitlabMergeRequest `bson:"merge_request"`
+}
+
+// NewGitlabIntent creates an Intent for a GitLab merge request, or returns an
+// error if the merge request is missing required information.
+func NewGitlabIntent(msgID, calledBy string, mr thirdparty.GitlabMergeRequest) (Intent, error) {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(msgID == "", "message ID cannot be empty")
+	catcher.NewWhen(calledBy == "", "caller cannot be empty")
+	catcher.NewWhen(mr.ProjectPath == "", "project path cannot be empty")
+	catcher.NewWhen(mr.IID <= 0, "merge request IID must be positive")
+	catcher.NewWhen(mr.TargetBranch == "", "target branch cannot be empty")
+	catcher.NewWhen(mr.HeadSHA == "", "head SHA cannot be empty")
+	catcher.NewWhen(mr.AuthorID <= 0, "author ID must be positive")
+	if catcher.HasErrors() {
+		return nil, errors.Wrap(catcher.Resolve(), "invalid GitLab merge request")
+	}
+
+	return &gitlabIntent{
+		DocumentID:   msgID,
+		MsgID:        msgID,
+		IntentType:   GitlabIntentType,
+		CalledBy:     calledBy,
+		MergeRequest: mr,
+	}, nil
+}
+
+// SetProcessed should be called by an amboy queue after creating a patch from an intent.
+func (g *gitlabIntent) SetProcessed() error {
+	g.Processed = true
+	g.ProcessedAt = time.Now().UTC().Round(time.Millisecond)
+	return updateOneIntent(
+		bson.M{documentIDKey: g.DocumentID},
+		bson.M{"$set": bson.M{
+			processedKey:   g.Processed,
+			processedAtKey: g.ProcessedAt,
+		}},
+	)
+}
+
+// IsProcessed returns whether the intent has been processed.
+func (g *gitlabIntent) IsProcessed() bool {
+	return g.Processed
+}
+
+// GetType returns the patch intent, i.e., GitlabIntentType
+func (g *gitlabIntent) GetType() string {
+	return g.IntentType
+}
+
+// Insert inserts a patch intent in the database.
+func (g *gitlabIntent) Insert() error {
+	g.CreatedAt = time.Now().UTC().Round(time.Millisecond)
+	err := db.Insert(IntentCollection, g)
+	if err != nil {
+		g.CreatedAt = time.Time{}
+		return err
+	}
+
+	return nil
+}
+
+// ID returns the message ID, which is also the document ID.
+func (g *gitlabIntent) ID() string {
+	return g.MsgID
+}
+
+// ShouldFinalizePatch returns true, since merge request patches should always
+// be scheduled.
+func (g *gitlabIntent) ShouldFinalizePatch() bool {
+	return true
+}
+
+// RepeatPreviousPatchDefinition does not apply to GitLab merge requests.
+func (g *gitlabIntent) RepeatPreviousPatchDefinition() (string, bool) {
+	return "", false
+}
+
+// RepeatFailedTasksAndVariants does not apply to GitLab merge requests.
+func (g *gitlabIntent) RepeatFailedTasksAndVariants() (string, bool) {
+	return "", false
+}
+
+// RequesterIdentity returns the requester, i.e., GitlabMRRequester.
+func (g *gitlabIntent) RequesterIdentity() string {
+	return evergreen.GitlabMRRequester
+}
+
+// GetCalledBy returns the caller of the merge request, e.g., patch.AutomatedCaller
+func (g *gitlabIntent) GetCalledBy() string {
+	return g.CalledBy
+}
+
+// NewPatch creates a patch document from a GitLab intent. The base revision is
+// filled in once the merge request has been fetched.
+func (g *gitlabIntent) NewPatch() *Patch {
+	mr := g.MergeRequest
+	return &Patch{
+		Id:              mgobson.NewObjectId(),
+		Alias:           g.GetAlias(),
+		Description:     GitlabMergeRequestDescription(mr),
+		Author:          evergreen.GitlabPatchUser,
+		Status:          evergreen.VersionCreated,
+		CreateTime:      g.CreatedAt,
+		GitlabPatchData: mr,
+	}
+}
+
+// GitlabMergeRequestDescription returns the description of a patch for the
+// GitLab merge request. Until the author's username is known, the author is
+// identified by their GitLab user ID.
+func GitlabMergeRequestDescription(mr thirdparty.GitlabMergeRequest) string {
+	author := mr.Author
+	if author == "" {
+		author = fmt.Sprintf("user %d", mr.AuthorID)
+	}
+	return fmt.Sprintf("'%s' merge request !%d by %s: %s (%s)", mr.ProjectPath, mr.IID, author, mr.Title, mr.URL)
+}
+
+// GetAlias returns the alias for pull request patches, which merge request
+// patches share.
+func (g *gitlabIntent) GetAlias() string {
+	return evergreen.GithubPRAlias
+}
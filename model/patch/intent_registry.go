@@ -35,6 +35,7 @@ func init() {
 			CliIntentType:         func() Intent { return &cliIntent{} },
 			TriggerIntentType:     func() Intent { return &TriggerIntent{} },
 			GithubMergeIntentType: func() Intent { return &githubMergeIntent{} },
+			GitlabIntentType:      func() Intent { return &gitlabIntent{} },
 		},
 	}
 }
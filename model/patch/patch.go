@@ -5,7 +5,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"strings"
 	"text/template"
@@ -176,6 +175,7 @@ type Patch struct {
 	MergePatch           string                               `bson:"merge_patch"`
 	GithubPatchData      thirdparty.GithubPatch               `bson:"github_patch_data,omitempty"`
 	GithubMergeData      thirdparty.GithubMergeGroup          `bson:"github_merge_data,omitempty"`
+	GitlabPatchData      thirdparty.GitlabMergeRequest        `bson:"gitlab_patch_data,omitempty"`
 	GitInfo              *GitMetadata                         `bson:"git_info,omitempty"`
 	// DisplayNewUI is only used when roundtripping the patch via the CLI
 	DisplayNewUI bool `bson:"display_new_ui,omitempty"`
@@ -185,6 +185,31 @@ type Patch struct {
 	// MergedFrom is populated with the patch id of the existing patch
 	// the merged patch is based off of, if applicable.
 	MergedFrom string `bson:"merged_from,omitempty"`
+	// SkippedTasks are the tasks selected by the patch's alias that were not
+	// scheduled because of the alias's smart selection.
+	SkippedTasks []SkippedTask `bson:"skipped_tasks,omitempty"`
+	// RequiredVariantsTasks are the tasks that must succeed for a GitHub
+	// merge queue patch to pass its merge check. If empty, all of the
+	// patch's tasks are required.
+	RequiredVariantsTasks []VariantTasks `bson:"required_variants_tasks,omitempty"`
+	// MergeQueueRequiredStatus is the last finished status of the GitHub
+	// merge queue patch's required tasks that was sent to GitHub.
+	MergeQueueRequiredStatus string `bson:"merge_queue_required_status,omitempty"`
+	// Stack is the patch's place in a stack of patches, if it was submitted
+	// as part of one.
+	Stack StackInfo `bson:"stack,omitempty"`
+	// DiffMigrationFailed indicates that moving the patch's diffs into the
+	// patch diff bucket failed, so the migration should not retry it.
+	DiffMigrationFailed bool `bson:"diff_migration_failed,omitempty"`
+}
+
+// SkippedTask is a task that was selected by a patch's alias but was not
+// scheduled.
+type SkippedTask struct {
+	Variant  string `bson:"variant"`
+	TaskName string `bson:"task_name"`
+	// Reason explains why the task was skipped.
+	Reason string `bson:"reason"`
 }
 
 func (p *Patch) MarshalBSON() ([]byte, error)  { return mgobson.Marshal(p) }
@@ -235,6 +260,31 @@ func (p *Patch) IsFinished() bool {
 }
 
 // SetDescription sets a patch's description in the database
+// SetMergeQueueRequiredStatus records the finished status of the GitHub merge
+// queue patch's required tasks. It returns whether the status changed, so that
+// each status is only sent to GitHub once.
+func (p *Patch) SetMergeQueueRequiredStatus(status string) (bool, error) {
+	err := UpdateOne(
+		bson.M{
+			IdKey:                       p.Id,
+			MergeQueueRequiredStatusKey: bson.M{"$ne": status},
+		},
+		bson.M{
+			"$set": bson.M{
+				MergeQueueRequiredStatusKey: status,
+			},
+		},
+	)
+	if adb.ResultsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	p.MergeQueueRequiredStatus = status
+	return true, nil
+}
+
 func (p *Patch) SetDescription(desc string) error {
 	if p.Description == desc {
 		return nil
@@ -285,7 +335,7 @@ func (p *Patch) GetURL(uiHost string) string {
 }
 
 // ClearPatchData removes any inline patch data stored in this patch object for patches that have
-// an associated id in gridfs, so that it can be stored properly.
+// an associated patch file, so that it can be stored properly.
 func (p *Patch) ClearPatchData() {
 	for i, patchPart := range p.Patches {
 		// If the patch isn't stored externally, no need to do anything.
@@ -295,16 +345,16 @@ func (p *Patch) ClearPatchData() {
 	}
 }
 
-// FetchPatchFiles dereferences externally-stored patch diffs by fetching them from gridfs
-// and placing their contents into the patch object.
-func (p *Patch) FetchPatchFiles(useRaw bool) error {
+// FetchPatchFiles dereferences externally-stored patch diffs by fetching them from the patch
+// diff bucket or gridfs and placing their contents into the patch object.
+func (p *Patch) FetchPatchFiles(ctx context.Context, useRaw bool) error {
 	for i, patchPart := range p.Patches {
 		// If the patch isn't stored externally, no need to do anything.
 		if patchPart.PatchSet.PatchFileId == "" {
 			continue
 		}
 
-		rawStr, err := FetchPatchContents(patchPart.PatchSet.PatchFileId)
+		rawStr, err := FetchPatchContents(ctx, patchPart.PatchSet.PatchFileId)
 		if err != nil {
 			return errors.Wrapf(err, "getting patch contents for patchfile '%s'", patchPart.PatchSet.PatchFileId)
 		}
@@ -322,20 +372,6 @@ func (p *Patch) FetchPatchFiles(useRaw bool) error {
 	return nil
 }
 
-func FetchPatchContents(patchfileID string) (string, error) {
-	fileReader, err := db.GetGridFile(GridFSPrefix, patchfileID)
-	if err != nil {
-		return "", errors.Wrap(err, "getting grid file")
-	}
-	defer fileReader.Close()
-	patchContents, err := io.ReadAll(fileReader)
-	if err != nil {
-		return "", errors.Wrap(err, "reading patch contents")
-	}
-
-	return string(patchContents), nil
-}
-
 // UpdateVariantsTasks updates the patch's Tasks and BuildVariants fields to match with the set
 // in the given list of VariantTasks. This is to ensure schema backwards compatibility for T shaped
 // patches. This mutates the patch in memory but does not update it in the database; for that, use
@@ -802,6 +838,11 @@ func (p *Patch) IsGithubMergePatch() bool {
 	return p.GithubMergeData.HeadSHA != ""
 }
 
+// IsGitlabMRPatch returns true if the patch is for a GitLab merge request.
+func (p *Patch) IsGitlabMRPatch() bool {
+	return p.GitlabPatchData.HeadSHA != ""
+}
+
 func (p *Patch) IsBackport() bool {
 	return len(p.BackportOf.PatchID) != 0 || len(p.BackportOf.SHA) != 0
 }
@@ -847,7 +888,7 @@ func (p *Patch) IsParent() bool {
 // ShouldPatchFileWithDiff returns true if the patch should read with diff
 // (i.e. is not a PR or CQ patch) and the config has changed.
 func (p *Patch) ShouldPatchFileWithDiff(path string) bool {
-	return !(p.IsGithubPRPatch() || p.IsPRMergePatch()) && p.ConfigChanged(path)
+	return !(p.IsGithubPRPatch() || p.IsPRMergePatch() || p.IsGitlabMRPatch()) && p.ConfigChanged(path)
 }
 
 func (p *Patch) GetPatchIndex(parentPatch *Patch) (int, error) {
@@ -985,6 +1026,9 @@ func (p *Patch) GetRequester() string {
 	if p.IsGithubMergePatch() {
 		return evergreen.GithubMergeRequester
 	}
+	if p.IsGitlabMRPatch() {
+		return evergreen.GitlabMRRequester
+	}
 	if p.IsCommitQueuePatch() {
 		return evergreen.MergeTestRequester
 	}
@@ -1064,9 +1108,9 @@ func CreatePatchSetForSHA(ctx context.Context, settings *evergreen.Settings, own
 		return patchSet, errors.Wrap(err, "converting diff to mbox format")
 	}
 
-	patchFileID := mgobson.NewObjectId()
-	if err = db.WriteGridFile(GridFSPrefix, patchFileID.Hex(), strings.NewReader(mboxPatch)); err != nil {
-		return patchSet, errors.Wrap(err, "write patch to DB")
+	patchFileID, err := StorePatchDiff(mboxPatch)
+	if err != nil {
+		return patchSet, errors.Wrap(err, "storing patch diff")
 	}
 
 	summaries := []thirdparty.Summary{}
@@ -1083,7 +1127,7 @@ func CreatePatchSetForSHA(ctx context.Context, settings *evergreen.Settings, own
 
 	patchSet.Summary = summaries
 	patchSet.CommitMessages = []string{message}
-	patchSet.PatchFileId = patchFileID.Hex()
+	patchSet.PatchFileId = patchFileID
 	return patchSet, nil
 }
 
@@ -1098,14 +1142,14 @@ func IsPatchEmpty(id string) (bool, error) {
 	return len(patchDoc.Patches) == 0, nil
 }
 
-func MakeMergePatchPatches(existingPatch *Patch, commitMessage string) ([]ModulePatch, error) {
+func MakeMergePatchPatches(ctx context.Context, existingPatch *Patch, commitMessage string) ([]ModulePatch, error) {
 	if !existingPatch.HasValidGitInfo() {
 		return nil, errors.New("can't make merge patches without git info")
 	}
 
 	newModulePatches := make([]ModulePatch, 0, len(existingPatch.Patches))
 	for _, modulePatch := range existingPatch.Patches {
-		diff, err := FetchPatchContents(modulePatch.PatchSet.PatchFileId)
+		diff, err := FetchPatchContents(ctx, modulePatch.PatchSet.PatchFileId)
 		if err != nil {
 			return nil, errors.Wrap(err, "fetching patch contents")
 		}
@@ -1117,15 +1161,15 @@ func MakeMergePatchPatches(existingPatch *Patch, commitMessage string) ([]Module
 		if err != nil {
 			return nil, errors.Wrap(err, "converting diff to mbox format")
 		}
-		patchFileID := mgobson.NewObjectId()
-		if err := db.WriteGridFile(GridFSPrefix, patchFileID.Hex(), strings.NewReader(mboxPatch)); err != nil {
-			return nil, errors.Wrap(err, "writing new patch to DB")
+		patchFileID, err := StorePatchDiff(mboxPatch)
+		if err != nil {
+			return nil, errors.Wrap(err, "storing new patch diff")
 		}
 		newModulePatches = append(newModulePatches, ModulePatch{
 			ModuleName: modulePatch.ModuleName,
 			IsMbox:     true,
 			PatchSet: PatchSet{
-				PatchFileId:    patchFileID.Hex(),
+				PatchFileId:    patchFileID,
 				CommitMessages: []string{commitMessage},
 				Summary:        modulePatch.PatchSet.Summary,
 			},
@@ -0,0 +1,265 @@
+package patch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// patchDiffKeyPrefix is the prefix of the patch file IDs of patch diffs that
+// are stored in the patch diff bucket. Patch file IDs without this prefix
+// refer to patch diffs stored in GridFS.
+const patchDiffKeyPrefix = "sha256_"
+
+// patchDiffKey returns the key for a patch diff in the patch diff bucket.
+// Patch diffs are keyed by the hash of their contents, so identical diffs are
+// only stored once.
+func patchDiffKey(contents string) string {
+	hash := sha256.Sum256([]byte(contents))
+	return patchDiffKeyPrefix + hex.EncodeToString(hash[:])
+}
+
+// IsBucketPatchFile returns whether the patch file ID refers to a patch diff
+// stored in the patch diff bucket rather than GridFS.
+func IsBucketPatchFile(patchFileID string) bool {
+	return strings.HasPrefix(patchFileID, patchDiffKeyPrefix)
+}
+
+// NewPatchDiffBucket returns the bucket that patch diffs are stored in. If no
+// patch diff bucket is configured, it returns nil.
+func NewPatchDiffBucket(ctx context.Context, settings *evergreen.Settings) (pail.Bucket, error) {
+	if settings.Buckets.PatchDiffBucket.Name == "" {
+		return nil, nil
+	}
+	b, err := taskoutput.NewBucket(ctx, settings.Buckets.PatchDiffBucket)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting patch diff bucket")
+	}
+	return b, nil
+}
+
+// StorePatchDiff stores the contents of a patch diff and returns the patch
+// file ID to retrieve it with. If the patch diff bucket is configured, the diff
+// is stored in the bucket; otherwise, it is stored in GridFS.
+func StorePatchDiff(contents string) (string, error) {
+	env := evergreen.GetEnvironment()
+	ctx, cancel := env.Context()
+	defer cancel()
+
+	bucket, err := NewPatchDiffBucket(ctx, env.Settings())
+	if err != nil {
+		return "", err
+	}
+	if bucket == nil {
+		patchFileID := mgobson.NewObjectId().Hex()
+		if err := db.WriteGridFile(GridFSPrefix, patchFileID, strings.NewReader(contents)); err != nil {
+			return "", errors.Wrap(err, "writing patch file to GridFS")
+		}
+		return patchFileID, nil
+	}
+
+	return storePatchDiffInBucket(ctx, bucket, contents)
+}
+
+func storePatchDiffInBucket(ctx context.Context, bucket pail.Bucket, contents string) (string, error) {
+	key := patchDiffKey(contents)
+	if err := bucket.Put(ctx, key, strings.NewReader(contents)); err != nil {
+		return "", errors.Wrapf(err, "writing patch diff '%s' to bucket", key)
+	}
+	return key, nil
+}
+
+// FetchPatchContents returns the contents of the patch diff with the given
+// patch file ID, regardless of whether it is stored in the patch diff bucket or
+// GridFS.
+func FetchPatchContents(ctx context.Context, patchfileID string) (string, error) {
+	var fileReader io.ReadCloser
+	if IsBucketPatchFile(patchfileID) {
+		bucket, err := NewPatchDiffBucket(ctx, evergreen.GetEnvironment().Settings())
+		if err != nil {
+			return "", err
+		}
+		if bucket == nil {
+			return "", errors.Errorf("patch diff '%s' is stored in a bucket but no patch diff bucket is configured", patchfileID)
+		}
+		fileReader, err = bucket.Get(ctx, patchfileID)
+		if err != nil {
+			return "", errors.Wrap(err, "getting patch diff from bucket")
+		}
+	} else {
+		var err error
+		fileReader, err = db.GetGridFile(ctx, GridFSPrefix, patchfileID)
+		if err != nil {
+			return "", errors.Wrap(err, "getting grid file")
+		}
+	}
+	defer fileReader.Close()
+
+	patchContents, err := io.ReadAll(fileReader)
+	if err != nil {
+		return "", errors.Wrap(err, "reading patch contents")
+	}
+
+	return string(patchContents), nil
+}
+
+// HasDatabasePatchDiffs returns whether any of the patch's diffs are stored
+// inline in the patch or in GridFS.
+func (p *Patch) HasDatabasePatchDiffs() bool {
+	for _, mp := range p.Patches {
+		if mp.PatchSet.PatchFileId == "" && mp.PatchSet.Patch != "" {
+			return true
+		}
+		if mp.PatchSet.PatchFileId != "" && !IsBucketPatchFile(mp.PatchSet.PatchFileId) {
+			return true
+		}
+	}
+	return false
+}
+
+// MigratePatchDiffs moves the patch's diffs that are stored inline in the
+// patch or in GridFS into the given patch diff bucket. GridFS files are deleted
+// once no patch refers to them anymore. If a diff can never be migrated because
+// its GridFS file is missing, the patch is marked so that later migrations skip
+// it; any other error is assumed to be transient so the patch is retried.
+func (p *Patch) MigratePatchDiffs(ctx context.Context, bucket pail.Bucket) error {
+	catcher := grip.NewBasicCatcher()
+	missingPatchFile := false
+	for i, mp := range p.Patches {
+		patchFileID := mp.PatchSet.PatchFileId
+		var contents string
+		switch {
+		case patchFileID == "" && mp.PatchSet.Patch != "":
+			contents = mp.PatchSet.Patch
+		case patchFileID != "" && !IsBucketPatchFile(patchFileID):
+			var err error
+			contents, err = FetchPatchContents(ctx, patchFileID)
+			if err != nil {
+				missingPatchFile = missingPatchFile || pail.IsKeyNotFoundError(err)
+				catcher.Wrapf(err, "fetching patch file '%s' for module '%s'", patchFileID, mp.ModuleName)
+				continue
+			}
+		default:
+			continue
+		}
+
+		key, err := storePatchDiffInBucket(ctx, bucket, contents)
+		if err != nil {
+			catcher.Wrapf(err, "storing patch diff for module '%s'", mp.ModuleName)
+			continue
+		}
+
+		if err := p.setModulePatchFileID(i, key); err != nil {
+			catcher.Wrapf(err, "updating patch file ID for module '%s'", mp.ModuleName)
+			continue
+		}
+
+		if patchFileID != "" {
+			catcher.Wrapf(deleteUnreferencedGridFile(patchFileID), "deleting patch file '%s'", patchFileID)
+		}
+	}
+
+	if missingPatchFile {
+		catcher.Wrap(p.SetDiffMigrationFailed(), "marking diff migration as failed")
+	}
+
+	return catcher.Resolve()
+}
+
+// SetDiffMigrationFailed marks that the patch's diffs could not be moved into
+// the patch diff bucket, so that the migration skips it from now on.
+func (p *Patch) SetDiffMigrationFailed() error {
+	if err := UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{"$set": bson.M{DiffMigrationFailedKey: true}},
+	); err != nil {
+		return err
+	}
+	p.DiffMigrationFailed = true
+	return nil
+}
+
+// setModulePatchFileID sets the patch file ID of the module patch at the given
+// index and removes any diff stored inline in it.
+func (p *Patch) setModulePatchFileID(i int, patchFileID string) error {
+	mp := p.Patches[i]
+	modulePatchKey := bsonutil.GetDottedKeyName(PatchesKey, strconv.Itoa(i))
+	patchSetKey := bsonutil.GetDottedKeyName(modulePatchKey, ModulePatchSetKey)
+	query := bson.M{
+		IdKey: p.Id,
+		bsonutil.GetDottedKeyName(modulePatchKey, ModulePatchNameKey): mp.ModuleName,
+	}
+	if mp.PatchSet.PatchFileId == "" {
+		query[bsonutil.GetDottedKeyName(patchSetKey, PatchSetPatchFileIdKey)] = bson.M{"$exists": false}
+	} else {
+		query[bsonutil.GetDottedKeyName(patchSetKey, PatchSetPatchFileIdKey)] = mp.PatchSet.PatchFileId
+	}
+
+	if err := UpdateOne(query, bson.M{
+		"$set":   bson.M{bsonutil.GetDottedKeyName(patchSetKey, PatchSetPatchFileIdKey): patchFileID},
+		"$unset": bson.M{bsonutil.GetDottedKeyName(patchSetKey, PatchSetPatchKey): 1},
+	}); err != nil {
+		return err
+	}
+
+	p.Patches[i].PatchSet.PatchFileId = patchFileID
+	p.Patches[i].PatchSet.Patch = ""
+	return nil
+}
+
+// deleteUnreferencedGridFile deletes the GridFS patch file if no patch or
+// unprocessed patch intent refers to it. Patch files can be shared between
+// patches, such as when a merge patch reuses a patch's diff, and a CLI patch
+// intent still needs its patch file until a patch is created from it.
+func deleteUnreferencedGridFile(patchFileID string) error {
+	count, err := Count(db.Query(bson.M{
+		bsonutil.GetDottedKeyName(PatchesKey, ModulePatchSetKey, PatchSetPatchFileIdKey): patchFileID,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "counting patches that refer to patch file")
+	}
+	if count > 0 {
+		grip.Info(message.Fields{
+			"message":       "not deleting patch file that is still referenced by other patches",
+			"patch_file_id": patchFileID,
+			"num_patches":   count,
+		})
+		return nil
+	}
+
+	intentRefs := []bson.M{{cliPatchDiffIDKey: patchFileID}}
+	if mgobson.IsObjectIdHex(patchFileID) {
+		intentRefs = append(intentRefs, bson.M{cliPatchFileIDKey: mgobson.ObjectIdHex(patchFileID)})
+	}
+	numIntents, err := db.Count(IntentCollection, bson.M{
+		cliProcessedKey: false,
+		"$or":           intentRefs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "counting unprocessed patch intents that refer to patch file")
+	}
+	if numIntents > 0 {
+		grip.Info(message.Fields{
+			"message":       "not deleting patch file that is still referenced by unprocessed patch intents",
+			"patch_file_id": patchFileID,
+			"num_intents":   numIntents,
+		})
+		return nil
+	}
+
+	return db.DeleteGridFile(GridFSPrefix, patchFileID)
+}
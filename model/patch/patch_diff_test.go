@@ -0,0 +1,226 @@
+package patch
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/pail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchDiffKey(t *testing.T) {
+	key := patchDiffKey("diff")
+	assert.True(t, IsBucketPatchFile(key))
+	assert.Equal(t, key, patchDiffKey("diff"), "identical diffs should have the same key")
+	assert.NotEqual(t, key, patchDiffKey("other diff"))
+	assert.False(t, IsBucketPatchFile(mgobson.NewObjectId().Hex()))
+}
+
+func TestHasDatabasePatchDiffs(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		patchSet PatchSet
+		expected bool
+	}{
+		"InlineDiff": {
+			patchSet: PatchSet{Patch: "diff"},
+			expected: true,
+		},
+		"GridFSDiff": {
+			patchSet: PatchSet{PatchFileId: mgobson.NewObjectId().Hex()},
+			expected: true,
+		},
+		"BucketDiff": {
+			patchSet: PatchSet{PatchFileId: patchDiffKey("diff")},
+		},
+		"EmptyDiff": {},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			p := Patch{Patches: []ModulePatch{{PatchSet: tCase.patchSet}}}
+			assert.Equal(t, tCase.expected, p.HasDatabasePatchDiffs())
+		})
+	}
+}
+
+func TestPatchDiffBucketStorage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := evergreen.GetEnvironment()
+	originalBucket := env.Settings().Buckets.PatchDiffBucket
+	defer func() {
+		env.Settings().Buckets.PatchDiffBucket = originalBucket
+	}()
+
+	for tName, tCase := range map[string]func(t *testing.T, bucket pail.Bucket){
+		"StoresDiffInBucket": func(t *testing.T, bucket pail.Bucket) {
+			patchFileID, err := StorePatchDiff("diff")
+			require.NoError(t, err)
+			assert.True(t, IsBucketPatchFile(patchFileID))
+
+			contents, err := FetchPatchContents(ctx, patchFileID)
+			require.NoError(t, err)
+			assert.Equal(t, "diff", contents)
+
+			_, err = db.GetGridFile(ctx, GridFSPrefix, patchFileID)
+			assert.Error(t, err, "diff should not be stored in GridFS")
+		},
+		"DeduplicatesIdenticalDiffs": func(t *testing.T, bucket pail.Bucket) {
+			firstID, err := StorePatchDiff("diff")
+			require.NoError(t, err)
+			secondID, err := StorePatchDiff("diff")
+			require.NoError(t, err)
+			assert.Equal(t, firstID, secondID)
+		},
+		"StoresDiffInGridFSWithoutBucket": func(t *testing.T, bucket pail.Bucket) {
+			env.Settings().Buckets.PatchDiffBucket = evergreen.BucketConfig{}
+
+			patchFileID, err := StorePatchDiff("diff")
+			require.NoError(t, err)
+			assert.False(t, IsBucketPatchFile(patchFileID))
+
+			contents, err := FetchPatchContents(ctx, patchFileID)
+			require.NoError(t, err)
+			assert.Equal(t, "diff", contents)
+		},
+		"FetchPatchFilesReadsBucketDiffs": func(t *testing.T, bucket pail.Bucket) {
+			patchFileID, err := StorePatchDiff("diff")
+			require.NoError(t, err)
+			p := Patch{Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: patchFileID}}}}
+			require.NoError(t, p.FetchPatchFiles(ctx, true))
+			assert.Equal(t, "diff", p.Patches[0].PatchSet.Patch)
+		},
+		"MigratesDatabaseDiffs": func(t *testing.T, bucket pail.Bucket) {
+			gridFSID := mgobson.NewObjectId().Hex()
+			require.NoError(t, db.WriteGridFile(GridFSPrefix, gridFSID, strings.NewReader("gridfs diff")))
+			p := Patch{
+				Id: mgobson.NewObjectId(),
+				Patches: []ModulePatch{
+					{PatchSet: PatchSet{PatchFileId: gridFSID}},
+					{ModuleName: "module", PatchSet: PatchSet{Patch: "inline diff"}},
+				},
+			}
+			require.NoError(t, p.Insert())
+
+			patches, err := FindWithDatabasePatchDiffs(10)
+			require.NoError(t, err)
+			require.Len(t, patches, 1)
+			require.NoError(t, patches[0].MigratePatchDiffs(ctx, bucket))
+
+			dbPatch, err := FindOneId(p.Id.Hex())
+			require.NoError(t, err)
+			require.NotZero(t, dbPatch)
+			assert.False(t, dbPatch.HasDatabasePatchDiffs())
+			require.Len(t, dbPatch.Patches, 2)
+			assert.Equal(t, patchDiffKey("gridfs diff"), dbPatch.Patches[0].PatchSet.PatchFileId)
+			assert.Equal(t, patchDiffKey("inline diff"), dbPatch.Patches[1].PatchSet.PatchFileId)
+			assert.Empty(t, dbPatch.Patches[1].PatchSet.Patch)
+
+			require.NoError(t, dbPatch.FetchPatchFiles(ctx, true))
+			assert.Equal(t, "gridfs diff", dbPatch.Patches[0].PatchSet.Patch)
+			assert.Equal(t, "inline diff", dbPatch.Patches[1].PatchSet.Patch)
+
+			_, err = db.GetGridFile(ctx, GridFSPrefix, gridFSID)
+			assert.Error(t, err, "GridFS file should be deleted after migration")
+
+			patches, err = FindWithDatabasePatchDiffs(10)
+			require.NoError(t, err)
+			assert.Empty(t, patches)
+		},
+		"KeepsGridFSFilesReferencedByOtherPatches": func(t *testing.T, bucket pail.Bucket) {
+			gridFSID := mgobson.NewObjectId().Hex()
+			require.NoError(t, db.WriteGridFile(GridFSPrefix, gridFSID, strings.NewReader("shared diff")))
+			p := Patch{
+				Id:      mgobson.NewObjectId(),
+				Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: gridFSID}}},
+			}
+			require.NoError(t, p.Insert())
+			mergePatch := Patch{
+				Id:      mgobson.NewObjectId(),
+				Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: gridFSID}}},
+			}
+			require.NoError(t, mergePatch.Insert())
+
+			require.NoError(t, p.MigratePatchDiffs(ctx, bucket))
+
+			contents, err := FetchPatchContents(ctx, gridFSID)
+			require.NoError(t, err)
+			assert.Equal(t, "shared diff", contents)
+		},
+		"KeepsGridFSFilesReferencedByUnprocessedIntents": func(t *testing.T, bucket pail.Bucket) {
+			gridFSID := mgobson.NewObjectId().Hex()
+			require.NoError(t, db.WriteGridFile(GridFSPrefix, gridFSID, strings.NewReader("intent diff")))
+			p := Patch{
+				Id:      mgobson.NewObjectId(),
+				Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: gridFSID}}},
+			}
+			require.NoError(t, p.Insert())
+			intent := &cliIntent{
+				DocumentID:  mgobson.NewObjectId().Hex(),
+				PatchFileID: mgobson.ObjectIdHex(gridFSID),
+				IntentType:  CliIntentType,
+			}
+			require.NoError(t, intent.Insert())
+
+			require.NoError(t, p.MigratePatchDiffs(ctx, bucket))
+
+			contents, err := FetchPatchContents(ctx, gridFSID)
+			require.NoError(t, err)
+			assert.Equal(t, "intent diff", contents)
+		},
+		"SkipsPatchesWithFailedMigrations": func(t *testing.T, bucket pail.Bucket) {
+			p := Patch{
+				Id:      mgobson.NewObjectId(),
+				Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: mgobson.NewObjectId().Hex()}}},
+			}
+			require.NoError(t, p.Insert())
+
+			patches, err := FindWithDatabasePatchDiffs(10)
+			require.NoError(t, err)
+			require.Len(t, patches, 1)
+			assert.Error(t, patches[0].MigratePatchDiffs(ctx, bucket), "migration should fail when the GridFS file is missing")
+			assert.True(t, patches[0].DiffMigrationFailed)
+
+			patches, err = FindWithDatabasePatchDiffs(10)
+			require.NoError(t, err)
+			assert.Empty(t, patches)
+		},
+		"RetriesPatchesWithTransientFailures": func(t *testing.T, bucket pail.Bucket) {
+			gridFSID := mgobson.NewObjectId().Hex()
+			require.NoError(t, db.WriteGridFile(GridFSPrefix, gridFSID, strings.NewReader("gridfs diff")))
+			p := Patch{
+				Id:      mgobson.NewObjectId(),
+				Patches: []ModulePatch{{PatchSet: PatchSet{PatchFileId: gridFSID}}},
+			}
+			require.NoError(t, p.Insert())
+
+			canceledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			assert.Error(t, p.MigratePatchDiffs(canceledCtx, bucket))
+			assert.False(t, p.DiffMigrationFailed)
+
+			patches, err := FindWithDatabasePatchDiffs(10)
+			require.NoError(t, err)
+			assert.Len(t, patches, 1)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(Collection, IntentCollection))
+			require.NoError(t, db.ClearGridCollections(GridFSPrefix))
+
+			env.Settings().Buckets.PatchDiffBucket = evergreen.BucketConfig{
+				Name: t.TempDir(),
+				Type: evergreen.BucketTypeLocal,
+			}
+			bucket, err := NewPatchDiffBucket(ctx, env.Settings())
+			require.NoError(t, err)
+			require.NotNil(t, bucket)
+
+			tCase(t, bucket)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package patch
 
 import (
+	"context"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -614,6 +615,9 @@ func TestAddSyncVariantsTasks(t *testing.T) {
 }
 
 func TestMakeMergePatchPatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	require.NoError(t, db.ClearGridCollections(GridFSPrefix))
 	patchDiff := "Lorem Ipsum"
 	patchFileID := bson.NewObjectId()
@@ -633,12 +637,12 @@ func TestMakeMergePatchPatches(t *testing.T) {
 			Username: "octocat",
 		},
 	}
-	newPatches, err := MakeMergePatchPatches(existingPatch, "new message")
+	newPatches, err := MakeMergePatchPatches(ctx, existingPatch, "new message")
 	assert.NoError(t, err)
 	assert.Len(t, newPatches, 1)
 	assert.NotEqual(t, patchFileID.Hex(), newPatches[0].PatchSet.PatchFileId)
 
-	patchContents, err := FetchPatchContents(newPatches[0].PatchSet.PatchFileId)
+	patchContents, err := FetchPatchContents(ctx, newPatches[0].PatchSet.PatchFileId)
 	require.NoError(t, err)
 	assert.Contains(t, patchContents, "From: octocat <octocat@github.com>")
 	assert.Contains(t, patchContents, patchDiff)
@@ -0,0 +1,110 @@
+package patch
+
+import (
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// StackInfo describes a patch's place in a stack of patches that was
+// submitted from a chain of local commits. Each patch in the stack tests the
+// changes of its own commit on top of the changes of its parent patch.
+type StackInfo struct {
+	// ID identifies the stack and is shared by all of its patches.
+	ID string `bson:"id,omitempty" json:"id,omitempty"`
+	// ParentPatchID is the ID of the patch that this patch builds on. It is
+	// empty for the patch at the bottom of the stack.
+	ParentPatchID string `bson:"parent_patch_id,omitempty" json:"parent_patch_id,omitempty"`
+	// Position is the 0-indexed position of the patch in the stack, counting
+	// from the bottom.
+	Position int `bson:"position" json:"position"`
+	// Revision is the number of times the stack had been refreshed when this
+	// patch was submitted.
+	Revision int `bson:"revision" json:"revision"`
+	// Commit is the local commit that the patch tests the changes up to.
+	Commit string `bson:"commit,omitempty" json:"commit,omitempty"`
+}
+
+//nolint:megacheck,unused
+var (
+	StackKey = bsonutil.MustHaveTag(Patch{}, "Stack")
+
+	StackInfoIDKey            = bsonutil.MustHaveTag(StackInfo{}, "ID")
+	StackInfoParentPatchIDKey = bsonutil.MustHaveTag(StackInfo{}, "ParentPatchID")
+	StackInfoPositionKey      = bsonutil.MustHaveTag(StackInfo{}, "Position")
+	StackInfoRevisionKey      = bsonutil.MustHaveTag(StackInfo{}, "Revision")
+)
+
+// IsStackPatch returns whether the patch was submitted as part of a stack.
+func (p *Patch) IsStackPatch() bool {
+	return p.Stack.ID != ""
+}
+
+// ValidateStack checks that the patch's stack information is consistent with
+// its parent patch, which must be the previous patch in the same revision of
+// the stack.
+func (p *Patch) ValidateStack() error {
+	if !p.IsStackPatch() {
+		return nil
+	}
+	if p.Stack.Position < 0 || p.Stack.Revision < 0 {
+		return errors.New("stack position and revision cannot be negative")
+	}
+	if p.Stack.ParentPatchID == "" {
+		if p.Stack.Position != 0 {
+			return errors.New("only the patch at the bottom of a stack can have no parent patch")
+		}
+		return nil
+	}
+	if !IsValidId(p.Stack.ParentPatchID) {
+		return errors.Errorf("parent patch ID '%s' is not a valid patch ID", p.Stack.ParentPatchID)
+	}
+
+	parent, err := FindOneId(p.Stack.ParentPatchID)
+	if err != nil {
+		return errors.Wrapf(err, "finding parent patch '%s'", p.Stack.ParentPatchID)
+	}
+	if parent == nil {
+		return errors.Errorf("parent patch '%s' not found", p.Stack.ParentPatchID)
+	}
+	if parent.Stack.ID != p.Stack.ID || parent.Stack.Revision != p.Stack.Revision {
+		return errors.Errorf("parent patch '%s' is not in the same revision of stack '%s'", parent.Id.Hex(), p.Stack.ID)
+	}
+	if parent.Stack.Position != p.Stack.Position-1 {
+		return errors.Errorf("parent patch '%s' is at position %d, which is not directly below position %d", parent.Id.Hex(), parent.Stack.Position, p.Stack.Position)
+	}
+	if parent.Project != p.Project || parent.Author != p.Author {
+		return errors.Errorf("parent patch '%s' must have the same project and author", parent.Id.Hex())
+	}
+	return nil
+}
+
+// FindLatestStack returns the patches in the latest revision of the stack
+// with the given ID, ordered from the bottom of the stack to the top. Stack
+// IDs are chosen by the client, so only patches in the given project by the
+// given author are considered part of the stack.
+func FindLatestStack(projectID, author, stackID string) ([]Patch, error) {
+	stackIDKey := bsonutil.GetDottedKeyName(StackKey, StackInfoIDKey)
+	revisionKey := bsonutil.GetDottedKeyName(StackKey, StackInfoRevisionKey)
+	latest, err := FindOne(db.Query(bson.M{
+		ProjectKey: projectID,
+		AuthorKey:  author,
+		stackIDKey: stackID,
+	}).
+		WithFields(StackKey).
+		Sort([]string{"-" + revisionKey}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding latest revision of stack '%s'", stackID)
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	return Find(db.Query(bson.M{
+		ProjectKey:  projectID,
+		AuthorKey:   author,
+		stackIDKey:  stackID,
+		revisionKey: latest.Stack.Revision,
+	}).Sort([]string{bsonutil.GetDottedKeyName(StackKey, StackInfoPositionKey)}))
+}
@@ -0,0 +1,110 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStack(t *testing.T) {
+	parent := Patch{
+		Id:      mgobson.NewObjectId(),
+		Project: "project",
+		Author:  "me",
+		Stack:   StackInfo{ID: "stack", Position: 0, Revision: 1},
+	}
+	makeChild := func() Patch {
+		return Patch{
+			Project: "project",
+			Author:  "me",
+			Stack: StackInfo{
+				ID:            "stack",
+				ParentPatchID: parent.Id.Hex(),
+				Position:      1,
+				Revision:      1,
+			},
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"PassesWithoutStack": func(t *testing.T) {
+			p := Patch{}
+			assert.NoError(t, p.ValidateStack())
+		},
+		"PassesForBottomOfStack": func(t *testing.T) {
+			p := Patch{Stack: StackInfo{ID: "stack"}}
+			assert.NoError(t, p.ValidateStack())
+		},
+		"FailsForNonBottomPatchWithoutParent": func(t *testing.T) {
+			p := Patch{Stack: StackInfo{ID: "stack", Position: 1}}
+			assert.Error(t, p.ValidateStack())
+		},
+		"PassesWithParentDirectlyBelow": func(t *testing.T) {
+			p := makeChild()
+			assert.NoError(t, p.ValidateStack())
+		},
+		"FailsWithNonexistentParent": func(t *testing.T) {
+			p := makeChild()
+			p.Stack.ParentPatchID = mgobson.NewObjectId().Hex()
+			assert.Error(t, p.ValidateStack())
+		},
+		"FailsWithParentInDifferentRevision": func(t *testing.T) {
+			p := makeChild()
+			p.Stack.Revision = 2
+			assert.Error(t, p.ValidateStack())
+		},
+		"FailsWithParentNotDirectlyBelow": func(t *testing.T) {
+			p := makeChild()
+			p.Stack.Position = 2
+			assert.Error(t, p.ValidateStack())
+		},
+		"FailsWithParentFromAnotherAuthor": func(t *testing.T) {
+			p := makeChild()
+			p.Author = "someone_else"
+			assert.Error(t, p.ValidateStack())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(Collection))
+			require.NoError(t, parent.Insert())
+			tCase(t)
+		})
+	}
+}
+
+func TestFindLatestStack(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+
+	patches := []Patch{
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "me", Stack: StackInfo{ID: "stack", Position: 0, Revision: 0}},
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "me", Stack: StackInfo{ID: "stack", Position: 1, Revision: 0}},
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "me", Stack: StackInfo{ID: "stack", Position: 1, Revision: 1}},
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "me", Stack: StackInfo{ID: "stack", Position: 0, Revision: 1}},
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "me", Stack: StackInfo{ID: "other", Position: 0, Revision: 2}},
+		// Patches that reuse the stack ID in another project or as another
+		// author are not part of the stack.
+		{Id: mgobson.NewObjectId(), Project: "proj", Author: "someone_else", Stack: StackInfo{ID: "stack", Position: 0, Revision: 5}},
+		{Id: mgobson.NewObjectId(), Project: "other_proj", Author: "me", Stack: StackInfo{ID: "stack", Position: 0, Revision: 5}},
+	}
+	for _, p := range patches {
+		require.NoError(t, p.Insert())
+	}
+
+	stack, err := FindLatestStack("proj", "me", "stack")
+	require.NoError(t, err)
+	require.Len(t, stack, 2)
+	assert.Equal(t, patches[3].Id, stack[0].Id)
+	assert.Equal(t, patches[2].Id, stack[1].Id)
+
+	stack, err = FindLatestStack("proj", "someone_else", "stack")
+	require.NoError(t, err)
+	require.Len(t, stack, 1)
+	assert.Equal(t, patches[5].Id, stack[0].Id)
+
+	stack, err = FindLatestStack("proj", "me", "nonexistent")
+	require.NoError(t, err)
+	assert.Empty(t, stack)
+}
@@ -222,10 +222,13 @@ func getPatchedProjectYAML(ctx context.Context, projectRef *ProjectRef, opts *Ge
 	if p.IsGithubMergePatch() {
 		hash = p.GithubMergeData.HeadSHA
 	}
+	if p.IsGitlabMRPatch() {
+		hash = p.GitlabPatchData.HeadSHA
+	}
 	opts.Revision = hash
 
 	path := projectRef.RemotePath
-	if p.Path != "" && !p.IsGithubPRPatch() && !p.IsCommitQueuePatch() {
+	if p.Path != "" && !p.IsGithubPRPatch() && !p.IsGitlabMRPatch() && !p.IsCommitQueuePatch() {
 		path = p.Path
 	}
 	opts.RemotePath = path
@@ -388,7 +391,7 @@ func MakePatchedConfig(ctx context.Context, env evergreen.Environment, p *patch.
 		var err error
 		if patchPart.PatchSet.Patch == "" {
 			var patchContents string
-			patchContents, err = patch.FetchPatchContents(patchPart.PatchSet.PatchFileId)
+			patchContents, err = patch.FetchPatchContents(ctx, patchPart.PatchSet.PatchFileId)
 			if err != nil {
 				return nil, errors.Wrap(err, "fetching patch contents")
 			}
@@ -793,6 +796,9 @@ func getLoadProjectOptsForPatch(p *patch.Patch, githubOauthToken string) (*Proje
 	if p.IsGithubMergePatch() {
 		hash = p.GithubMergeData.HeadSHA
 	}
+	if p.IsGitlabMRPatch() {
+		hash = p.GitlabPatchData.HeadSHA
+	}
 
 	opts := GetProjectOpts{
 		Ref:          projectRef,
@@ -803,6 +809,9 @@ func getLoadProjectOptsForPatch(p *patch.Patch, githubOauthToken string) (*Proje
 			patch: p,
 		},
 	}
+	if p.IsGitlabMRPatch() {
+		opts.GitDir = thirdparty.GitMirrorDir(projectRef.Id)
+	}
 	return projectRef, &opts, nil
 }
 
@@ -1052,7 +1061,7 @@ func MakeMergePatchFromExisting(ctx context.Context, settings *evergreen.Setting
 		MergedFrom:           existingPatch.Id.Hex(),
 	}
 
-	if patchDoc.Patches, err = patch.MakeMergePatchPatches(existingPatch, commitMessage); err != nil {
+	if patchDoc.Patches, err = patch.MakeMergePatchPatches(ctx, existingPatch, commitMessage); err != nil {
 		return nil, errors.Wrap(err, "making merge patches from existing patch")
 	}
 	patchDoc.Description = MakeCommitQueueDescription(patchDoc.Patches, projectRef, project,
@@ -47,6 +47,7 @@ type Project struct {
 	PreErrorFailsTask  bool                       `yaml:"pre_error_fails_task,omitempty" bson:"pre_error_fails_task,omitempty"`
 	PostErrorFailsTask bool                       `yaml:"post_error_fails_task,omitempty" bson:"post_error_fails_task,omitempty"`
 	OomTracker         bool                       `yaml:"oom_tracker,omitempty" bson:"oom_tracker"`
+	CgroupLimits       *CgroupLimits              `yaml:"cgroup_limits,omitempty" bson:"cgroup_limits,omitempty"`
 	BatchTime          int                        `yaml:"batchtime,omitempty" bson:"batch_time"`
 	Identifier         string                     `yaml:"identifier,omitempty" bson:"identifier"`
 	DisplayName        string                     `yaml:"display_name,omitempty" bson:"display_name"`
@@ -70,6 +71,20 @@ type Project struct {
 	Private bool `yaml:"private,omitempty" bson:"private"`
 }
 
+// CgroupLimits are resource limits for the cgroup that each task runs in on
+// distros that have task cgroups enabled. Limits set here take precedence over
+// the distro's defaults.
+type CgroupLimits struct {
+	// MemoryLimitMB is the maximum memory that the task's processes may use.
+	MemoryLimitMB int `yaml:"memory_limit_mb,omitempty" bson:"memory_limit_mb,omitempty"`
+	// CPUPercent is the maximum CPU time that the task's processes may use,
+	// as a percentage of a single CPU.
+	CPUPercent int `yaml:"cpu_percent,omitempty" bson:"cpu_percent,omitempty"`
+	// NumProcesses is the maximum number of processes the task may run at
+	// once.
+	NumProcesses int `yaml:"num_processes,omitempty" bson:"num_processes,omitempty"`
+}
+
 type ProjectInfo struct {
 	Ref                 *ProjectRef
 	Project             *Project
@@ -147,6 +162,14 @@ type BuildVariantTaskUnit struct {
 
 	// CreateCheckRun will create a check run on GitHub if set.
 	CreateCheckRun *CheckRun `yaml:"create_check_run,omitempty" bson:"create_check_run,omitempty"`
+
+	// Paths are gitignore-style globs for the files that the task depends on.
+	// If set, the task only runs when at least one matching file changes.
+	Paths []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	// IgnorePaths are gitignore-style globs for the files that the task does
+	// not depend on. If set, the task does not run when only matching files
+	// change.
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 }
 
 func (b BuildVariant) Get(name string) (BuildVariantTaskUnit, error) {
@@ -248,6 +271,10 @@ func (bvt *BuildVariantTaskUnit) Populate(pt ProjectTask, bv BuildVariant) {
 	if bvt.Disable == nil {
 		bvt.Disable = bv.Disable
 	}
+	if !bvt.HasPathFilters() {
+		bvt.Paths = bv.Paths
+		bvt.IgnorePaths = bv.IgnorePaths
+	}
 }
 
 // BuildVariantsByName represents a slice of project config build variants that
@@ -413,6 +440,12 @@ type BuildVariant struct {
 	// provided for the task
 	RunOn []string `yaml:"run_on,omitempty" bson:"run_on"`
 
+	// Paths and IgnorePaths are the default path filters for the build
+	// variant's tasks. They are gitignore-style globs that limit the tasks to
+	// running only when matching files change.
+	Paths       []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
+
 	// all of the tasks/groups to be run on the build variant, compile through tests.
 	Tasks        []BuildVariantTaskUnit `yaml:"tasks,omitempty" bson:"tasks"`
 	DisplayTasks []patch.DisplayTask    `yaml:"display_tasks,omitempty" bson:"display_tasks,omitempty"`
@@ -1231,6 +1264,13 @@ func PopulateExpansions(t *task.Task, h *host.Host, oauthToken, appToken string)
 			// this looks like "gh-readonly-queue/main/pr-515-9cd8a2532bcddf58369aa82eb66ba88e2323c056"
 			expansions.Put("github_head_branch", p.GithubMergeData.HeadBranch)
 		}
+		if p.IsGitlabMRPatch() {
+			expansions.Put("gitlab_mr_iid", strconv.Itoa(p.GitlabPatchData.IID))
+			expansions.Put("gitlab_project", p.GitlabPatchData.ProjectPath)
+			expansions.Put("gitlab_author", p.GitlabPatchData.Author)
+			expansions.Put("gitlab_commit", p.GitlabPatchData.HeadSHA)
+			expansions.Put("gitlab_source_branch", p.GitlabPatchData.SourceBranch)
+		}
 	} else {
 		expansions.Put("is_patch", "")
 		expansions.Put("revision_order_id", strconv.Itoa(v.RevisionOrderNumber))
@@ -1697,12 +1737,97 @@ func (p *Project) IgnoresAllFiles(files []string) bool {
 	return true
 }
 
+// HasPathFilters returns whether any task in the project only runs when
+// certain files change.
+func (p *Project) HasPathFilters() bool {
+	for _, bv := range p.BuildVariants {
+		if len(bv.Paths) > 0 || len(bv.IgnorePaths) > 0 {
+			return true
+		}
+		for _, bvt := range bv.Tasks {
+			if bvt.HasPathFilters() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasPathFilters returns whether the build variant task unit only runs when
+// certain files change.
+func (bvt *BuildVariantTaskUnit) HasPathFilters() bool {
+	return len(bvt.Paths) > 0 || len(bvt.IgnorePaths) > 0
+}
+
+// MatchesChangedFiles returns whether any of the changed files is relevant to
+// the build variant task unit according to its paths and ignore_paths. If the
+// changed files are unknown, the build variant task unit always matches.
+func (bvt *BuildVariantTaskUnit) MatchesChangedFiles(files []string) bool {
+	if !bvt.HasPathFilters() || len(files) == 0 {
+		return true
+	}
+
+	var include, exclude *ignore.GitIgnore
+	if len(bvt.Paths) > 0 {
+		include = ignore.CompileIgnoreLines(bvt.Paths...)
+	}
+	if len(bvt.IgnorePaths) > 0 {
+		exclude = ignore.CompileIgnoreLines(bvt.IgnorePaths...)
+	}
+	for _, f := range files {
+		if include != nil && !include.MatchesPath(f) {
+			continue
+		}
+		if exclude != nil && exclude.MatchesPath(f) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// filterPairsByChangedFiles removes the tasks whose path filters do not match
+// any of the changed files. Display tasks are removed if none of their
+// execution tasks match.
+func (p *Project) filterPairsByChangedFiles(pairs TaskVariantPairs, files []string) TaskVariantPairs {
+	if len(files) == 0 || !p.HasPathFilters() {
+		return pairs
+	}
+
+	matches := func(variant, taskName string) bool {
+		bvt := p.FindTaskForVariant(taskName, variant)
+		return bvt == nil || bvt.MatchesChangedFiles(files)
+	}
+
+	var filtered TaskVariantPairs
+	for _, et := range pairs.ExecTasks {
+		if matches(et.Variant, et.TaskName) {
+			filtered.ExecTasks = append(filtered.ExecTasks, et)
+		}
+	}
+	for _, dt := range pairs.DisplayTasks {
+		displayTask := p.GetDisplayTask(dt.Variant, dt.TaskName)
+		if displayTask == nil {
+			continue
+		}
+		for _, et := range displayTask.ExecTasks {
+			if matches(dt.Variant, et) {
+				filtered.DisplayTasks = append(filtered.DisplayTasks, dt)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 // BuildProjectTVPairs resolves the build variants and tasks into which build
 // variants will run and which tasks will run on each build variant. This
 // filters out tasks that cannot run due to being disabled or having an
 // unmatched requester (e.g. a patch-only task for a mainline commit).
+// If the alias uses smart selection, the tasks it skips are recorded in the
+// patch.
 func (p *Project) BuildProjectTVPairs(patchDoc *patch.Patch, alias string) {
-	patchDoc.BuildVariants, patchDoc.Tasks, patchDoc.VariantsTasks = p.ResolvePatchVTs(patchDoc, patchDoc.GetRequester(), alias, true)
+	patchDoc.BuildVariants, patchDoc.Tasks, patchDoc.VariantsTasks, patchDoc.SkippedTasks = p.resolvePatchVTs(patchDoc, patchDoc.GetRequester(), alias, true)
 }
 
 // ResolvePatchVTs resolves a list of build variants and tasks into a list of
@@ -1712,6 +1837,13 @@ func (p *Project) BuildProjectTVPairs(patchDoc *patch.Patch, alias string) {
 // filters out tasks that cannot run due to being disabled or having an
 // unmatched requester (e.g. a patch-only task for a mainline commit).
 func (p *Project) ResolvePatchVTs(patchDoc *patch.Patch, requester, alias string, includeDeps bool) (resolvedBVs []string, resolvedTasks []string, vts []patch.VariantTasks) {
+	resolvedBVs, resolvedTasks, vts, _ = p.resolvePatchVTs(patchDoc, requester, alias, includeDeps)
+	return resolvedBVs, resolvedTasks, vts
+}
+
+// resolvePatchVTs is the same as ResolvePatchVTs, but also returns the tasks
+// selected by the alias that were skipped by smart selection.
+func (p *Project) resolvePatchVTs(patchDoc *patch.Patch, requester, alias string, includeDeps bool) (resolvedBVs []string, resolvedTasks []string, vts []patch.VariantTasks, skipped []patch.SkippedTask) {
 	var bvs, bvTags, tasks, taskTags []string
 	for _, bv := range patchDoc.BuildVariants {
 		// Tags should start with "."
@@ -1807,11 +1939,21 @@ func (p *Project) ResolvePatchVTs(patchDoc *patch.Patch, requester, alias string
 		}))
 
 		if !catcher.HasErrors() {
-			pairs.ExecTasks = append(pairs.ExecTasks, aliasPairs...)
-			pairs.DisplayTasks = append(pairs.DisplayTasks, displayTaskPairs...)
+			aliasTVPairs := TaskVariantPairs{ExecTasks: aliasPairs, DisplayTasks: displayTaskPairs}
+			// Commit queue and merge queue patches must run all of their
+			// alias's tasks, so only patch aliases use smart selection.
+			smartSelection := ProjectAliases(aliases).SmartSelection()
+			if smartSelection != nil && IsPatchAlias(alias) && !patchDoc.IsCommitQueuePatch() {
+				aliasTVPairs, skipped = p.smartSelectAliasPairs(patchDoc, alias, smartSelection, aliasTVPairs)
+			}
+			pairs.ExecTasks = append(pairs.ExecTasks, aliasTVPairs.ExecTasks...)
+			pairs.DisplayTasks = append(pairs.DisplayTasks, aliasTVPairs.DisplayTasks...)
 		}
 	}
 
+	// Only run the tasks whose inputs changed. Dependencies of those tasks
+	// still run regardless of their own path filters.
+	pairs = p.filterPairsByChangedFiles(pairs, patchDoc.FilesChanged())
 	pairs = p.extractDisplayTasks(pairs)
 	if includeDeps {
 		var err error
@@ -1824,7 +1966,39 @@ func (p *Project) ResolvePatchVTs(patchDoc *patch.Patch, requester, alias string
 
 	vts = pairs.TVPairsToVariantTasks()
 	bvs, tasks = patch.ResolveVariantTasks(vts)
-	return bvs, tasks, vts
+	return bvs, tasks, vts, skipped
+}
+
+// smartSelectAliasPairs limits the task/variant pairs selected by an alias to
+// the ones most likely to fail given the patch's changed files. If the tasks
+// cannot be ranked, all of the alias's tasks are kept.
+func (p *Project) smartSelectAliasPairs(patchDoc *patch.Patch, alias string, opts *SmartSelection, pairs TaskVariantPairs) (TaskVariantPairs, []patch.SkippedTask) {
+	env := evergreen.GetEnvironment()
+	ctx, cancel := env.Context()
+	defer cancel()
+
+	selected, skipped, err := smartSelectPairs(ctx, env, patchDoc, opts, pairs)
+	if err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"message": "could not use smart selection for alias, scheduling all of its tasks",
+			"alias":   alias,
+			"project": p.Identifier,
+			"patch":   patchDoc.Id.Hex(),
+		}))
+		return pairs, nil
+	}
+
+	grip.Info(message.Fields{
+		"message":       "smart selection skipped alias tasks",
+		"alias":         alias,
+		"project":       p.Identifier,
+		"patch":         patchDoc.Id.Hex(),
+		"num_selected":  len(selected.ExecTasks) + len(selected.DisplayTasks),
+		"num_skipped":   len(skipped),
+		"files_changed": len(patchDoc.FilesChanged()),
+	})
+
+	return selected, skipped
 }
 
 // GetVariantTasks returns all the build variants and all tasks specified for
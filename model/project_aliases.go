@@ -29,6 +29,8 @@ var (
 	parametersKey  = bsonutil.MustHaveTag(ProjectAlias{}, "Parameters")
 	variantTagsKey = bsonutil.MustHaveTag(ProjectAlias{}, "VariantTags")
 	taskTagsKey    = bsonutil.MustHaveTag(ProjectAlias{}, "TaskTags")
+
+	smartSelectionKey = bsonutil.MustHaveTag(ProjectAlias{}, "SmartSelection")
 )
 
 const (
@@ -77,11 +79,28 @@ type ProjectAlias struct {
 	Task        string            `bson:"task,omitempty" json:"task" yaml:"task"`
 	TaskTags    []string          `bson:"tags,omitempty" json:"tags" yaml:"task_tags"`
 	Parameters  []patch.Parameter `bson:"parameters,omitempty" json:"parameters" yaml:"parameters"`
+	// SmartSelection, if set, limits the tasks that this alias schedules to
+	// the ones most likely to fail given the files changed in the patch.
+	SmartSelection *SmartSelection `bson:"smart_selection,omitempty" json:"smart_selection,omitempty" yaml:"smart_selection,omitempty"`
 
 	// Source is not stored; indicates where the alias is stored for the project.
 	Source string `bson:"-" json:"-" yaml:"-"`
 }
 
+// SmartSelection configures an alias to only schedule the tasks that
+// historically failed most often in patches that changed the same files.
+type SmartSelection struct {
+	// MaxTasks is the maximum number of ranked tasks to schedule, not
+	// including mandatory tasks.
+	MaxTasks int `bson:"max_tasks" json:"max_tasks" yaml:"max_tasks"`
+	// MandatoryTasks are regexes matching the names of tasks that are always
+	// scheduled.
+	MandatoryTasks []string `bson:"mandatory_tasks,omitempty" json:"mandatory_tasks,omitempty" yaml:"mandatory_tasks,omitempty"`
+
+	// mandatoryTaskRegexes caches the compiled MandatoryTasks regexes.
+	mandatoryTaskRegexes []*regexp.Regexp
+}
+
 const (
 	AliasSourceProject = "project"
 	AliasSourceConfig  = "config"
@@ -436,10 +455,16 @@ func (p *ProjectAlias) Upsert() error {
 		taskKey:        p.Task,
 		parametersKey:  p.Parameters,
 	}
+	change := bson.M{"$set": update}
+	if p.SmartSelection != nil {
+		update[smartSelectionKey] = p.SmartSelection
+	} else {
+		change["$unset"] = bson.M{smartSelectionKey: 1}
+	}
 
 	_, err := db.Upsert(ProjectAliasCollection, bson.M{
 		idKey: p.ID,
-	}, bson.M{"$set": update})
+	}, change)
 	if err != nil {
 		return errors.Wrapf(err, "inserting project alias '%s'", p.ID)
 	}
@@ -637,6 +662,65 @@ func isValidRegexOrTag(curItem string, curTags, aliasTags []string, aliasRegex *
 	return false
 }
 
+// SmartSelection returns the combined smart selection options of the aliases.
+// It returns nil if none of the aliases use smart selection.
+func (a ProjectAliases) SmartSelection() *SmartSelection {
+	var opts *SmartSelection
+	for _, alias := range a {
+		if alias.SmartSelection == nil {
+			continue
+		}
+		if opts == nil {
+			opts = &SmartSelection{mandatoryTaskRegexes: []*regexp.Regexp{}}
+		}
+		if alias.SmartSelection.MaxTasks > opts.MaxTasks {
+			opts.MaxTasks = alias.SmartSelection.MaxTasks
+		}
+		opts.MandatoryTasks = append(opts.MandatoryTasks, alias.SmartSelection.MandatoryTasks...)
+		opts.mandatoryTaskRegexes = append(opts.mandatoryTaskRegexes, alias.SmartSelection.getMandatoryTaskRegexes()...)
+	}
+	return opts
+}
+
+// getMandatoryTaskRegexes returns the compiled mandatory task regexes,
+// compiling them the first time they are needed. Invalid regexes are skipped
+// since they are rejected when the alias is saved.
+func (s *SmartSelection) getMandatoryTaskRegexes() []*regexp.Regexp {
+	if s.mandatoryTaskRegexes != nil {
+		return s.mandatoryTaskRegexes
+	}
+	s.mandatoryTaskRegexes = make([]*regexp.Regexp, 0, len(s.MandatoryTasks))
+	for _, mandatory := range s.MandatoryTasks {
+		re, err := regexp.Compile(mandatory)
+		if err != nil {
+			continue
+		}
+		s.mandatoryTaskRegexes = append(s.mandatoryTaskRegexes, re)
+	}
+	return s.mandatoryTaskRegexes
+}
+
+// isMandatoryTask returns whether the task must be scheduled regardless of its
+// ranking.
+func (s *SmartSelection) isMandatoryTask(taskName string) bool {
+	for _, re := range s.getMandatoryTaskRegexes() {
+		if re.MatchString(taskName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SmartSelection) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.MaxTasks <= 0, "smart selection max tasks must be positive")
+	for _, mandatory := range s.MandatoryTasks {
+		_, err := regexp.Compile(mandatory)
+		catcher.Wrapf(err, "invalid mandatory task regex '%s'", mandatory)
+	}
+	return catcher.Resolve()
+}
+
 func ValidateProjectAliases(aliases []ProjectAlias, aliasType string) []string {
 	errs := []string{}
 	for i, pd := range aliases {
@@ -671,6 +755,14 @@ func validateAliasPatchDefinition(pd ProjectAlias, aliasType string, lineNum int
 	if _, err := pd.getTaskRegex(); err != nil {
 		errs = append(errs, fmt.Sprintf("%s: task regex #%d is invalid", aliasType, lineNum))
 	}
+	if pd.SmartSelection != nil {
+		if !IsPatchAlias(pd.Alias) {
+			errs = append(errs, fmt.Sprintf("%s: smart selection can only be used by patch aliases on line #%d", aliasType, lineNum))
+		}
+		if err := pd.SmartSelection.validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: invalid smart selection on line #%d: %s", aliasType, lineNum, err.Error()))
+		}
+	}
 	return errs
 }
 
@@ -769,3 +769,57 @@ func TestValidateGitTagAlias(t *testing.T) {
 	errs = validateGitTagAlias(a, "gitTag", 1)
 	assert.Empty(t, errs)
 }
+
+func TestValidateSmartSelectionAlias(t *testing.T) {
+	a := ProjectAlias{
+		Alias:          "smart",
+		Variant:        ".*",
+		Task:           ".*",
+		SmartSelection: &SmartSelection{MaxTasks: 5, MandatoryTasks: []string{"^lint$"}},
+	}
+	assert.Empty(t, ValidateProjectAliases([]ProjectAlias{a}, "patch"))
+
+	a.SmartSelection.MaxTasks = 0
+	assert.NotEmpty(t, ValidateProjectAliases([]ProjectAlias{a}, "patch"))
+
+	a.SmartSelection.MaxTasks = 5
+	a.SmartSelection.MandatoryTasks = []string{"("}
+	assert.NotEmpty(t, ValidateProjectAliases([]ProjectAlias{a}, "patch"))
+
+	a.SmartSelection.MandatoryTasks = []string{"^lint$"}
+	for _, alias := range evergreen.InternalAliases {
+		if alias == evergreen.GitTagAlias {
+			continue
+		}
+		a.Alias = alias
+		errs := ValidateProjectAliases([]ProjectAlias{a}, "internal")
+		require.Len(t, errs, 1, alias)
+		assert.Contains(t, errs[0], "smart selection can only be used by patch aliases")
+	}
+}
+
+func TestProjectAliasesSmartSelection(t *testing.T) {
+	aliases := ProjectAliases{
+		{Alias: "smart", Variant: "bv1", Task: ".*"},
+	}
+	assert.Nil(t, aliases.SmartSelection())
+
+	aliases = append(aliases,
+		ProjectAlias{Alias: "smart", Variant: "bv2", Task: ".*", SmartSelection: &SmartSelection{MaxTasks: 3, MandatoryTasks: []string{"lint"}}},
+		ProjectAlias{Alias: "smart", Variant: "bv3", Task: ".*", SmartSelection: &SmartSelection{MaxTasks: 10, MandatoryTasks: []string{"compile"}}},
+	)
+	opts := aliases.SmartSelection()
+	require.NotNil(t, opts)
+	assert.Equal(t, 10, opts.MaxTasks)
+	assert.ElementsMatch(t, []string{"lint", "compile"}, opts.MandatoryTasks)
+	assert.True(t, opts.isMandatoryTask("compile_and_package"))
+	assert.False(t, opts.isMandatoryTask("unit_tests"))
+
+	// Each alias's regexes are compiled once and reused.
+	require.Len(t, aliases[1].SmartSelection.mandatoryTaskRegexes, 1)
+	compiled := aliases[1].SmartSelection.mandatoryTaskRegexes[0]
+	opts = aliases.SmartSelection()
+	require.NotNil(t, opts)
+	assert.Same(t, compiled, aliases[1].SmartSelection.mandatoryTaskRegexes[0])
+	assert.Contains(t, opts.mandatoryTaskRegexes, compiled)
+}
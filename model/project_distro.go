@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// UpdateProjectDistros derives the project distros of the base distro again
+// after the base distro changed from oldBase to newBase. Project distros are
+// copies of their base distro, so this must be called whenever the base
+// distro changes for its project distros to pick up the change.
+func UpdateProjectDistros(ctx context.Context, oldBase, newBase *distro.Distro, userID string) error {
+	if newBase.IsProjectDistro() {
+		return nil
+	}
+	projectDistros, err := distro.Find(ctx, bson.M{distro.BaseDistroIDKey: newBase.Id})
+	if err != nil {
+		return errors.Wrapf(err, "finding project distros derived from distro '%s'", newBase.Id)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for i := range projectDistros {
+		old := &projectDistros[i]
+		updated := old.RederiveFromBase(oldBase, newBase)
+		oldData := old.DistroData()
+		updatedData := updated.DistroData()
+		if reflect.DeepEqual(oldData, updatedData) {
+			continue
+		}
+		if err := updated.ReplaceOne(ctx); err != nil {
+			catcher.Wrapf(err, "updating project distro '%s'", updated.Id)
+			continue
+		}
+		event.LogDistroModified(updated.Id, userID, oldData, updatedData)
+		if updated.GetDefaultAMI() != old.GetDefaultAMI() {
+			event.LogDistroAMIModified(updated.Id, userID)
+		}
+	}
+	return catcher.Resolve()
+}
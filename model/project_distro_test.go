@@ -0,0 +1,58 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProjectDistros(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(distro.Collection, event.EventCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(distro.Collection, event.EventCollection))
+	}()
+
+	base := &distro.Distro{
+		Id:       "base",
+		Provider: evergreen.ProviderNameEc2Fleet,
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", "old_ami"),
+			birch.EC.String("instance_type", "m5.large"),
+		)},
+		ProjectDistroSettings: distro.ProjectDistroSettings{Allowed: true},
+	}
+	require.NoError(t, base.Insert(ctx))
+	projectDistro, err := distro.NewProjectDistro(base, "project_distro", "project", distro.ProjectDistroOptions{InstanceType: "m5.xlarge"})
+	require.NoError(t, err)
+	require.NoError(t, projectDistro.Insert(ctx))
+
+	promoted, err := (&distro.ImageRollout{Region: evergreen.DefaultEC2Region, CandidateAMI: "new_ami"}).ApplyCandidate(*base)
+	require.NoError(t, err)
+	promoted.Disabled = true
+	require.NoError(t, promoted.ReplaceOne(ctx))
+	require.NoError(t, UpdateProjectDistros(ctx, base, &promoted, "user"))
+
+	dbProjectDistro, err := distro.FindOneId(ctx, projectDistro.Id)
+	require.NoError(t, err)
+	require.NotZero(t, dbProjectDistro)
+	assert.Equal(t, "new_ami", dbProjectDistro.GetDefaultAMI())
+	assert.Equal(t, []string{"m5.xlarge"}, dbProjectDistro.GetInstanceTypes())
+	assert.True(t, dbProjectDistro.Disabled)
+	assert.Equal(t, distro.DefaultProjectDistroMaxHosts, dbProjectDistro.HostAllocatorSettings.MaximumHosts)
+
+	dbBase, err := distro.FindOneId(ctx, base.Id)
+	require.NoError(t, err)
+	require.NotZero(t, dbBase)
+	assert.Equal(t, "new_ami", dbBase.GetDefaultAMI())
+}
@@ -264,13 +264,17 @@ func (p *ProjectSettings) resolveDefaults() *ProjectSettingsEvent {
 	return projectSettingsEvent
 }
 
-// LogProjectModified logs an event for a modification of a project's settings.
+// LogProjectModified logs an event for a modification of a project's settings
+// and records a new version of each project variable that changed.
 func LogProjectModified(projectId, username string, before, after *ProjectSettings) error {
 	eventData := constructProjectChangeEvent(username, before, after)
 	if eventData == nil {
 		return nil
 	}
-	return LogProjectEvent(event.EventTypeProjectModified, projectId, *eventData)
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(LogProjectEvent(event.EventTypeProjectModified, projectId, *eventData))
+	catcher.Wrap(RecordProjectVarVersions(projectId, username, before.Vars, after.Vars), "recording project variable versions")
+	return catcher.Resolve()
 }
 
 // LogProjectRepoAttachment logs an event for either the attachment of a project to a repo,
@@ -84,6 +84,7 @@ type ParserProject struct {
 	PreErrorFailsTask  *bool                      `yaml:"pre_error_fails_task,omitempty" bson:"pre_error_fails_task,omitempty"`
 	PostErrorFailsTask *bool                      `yaml:"post_error_fails_task,omitempty" bson:"post_error_fails_task,omitempty"`
 	OomTracker         *bool                      `yaml:"oom_tracker,omitempty" bson:"oom_tracker,omitempty"`
+	CgroupLimits       *CgroupLimits              `yaml:"cgroup_limits,omitempty" bson:"cgroup_limits,omitempty"`
 	BatchTime          *int                       `yaml:"batchtime,omitempty" bson:"batchtime,omitempty"`
 	Owner              *string                    `yaml:"owner,omitempty" bson:"owner,omitempty"`
 	Repo               *string                    `yaml:"repo,omitempty" bson:"repo,omitempty"`
@@ -334,6 +335,10 @@ type parserBV struct {
 	AllowForGitTag    *bool                     `yaml:"allow_for_git_tag,omitempty" bson:"allow_for_git_tag,omitempty"`
 	GitTagOnly        *bool                     `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
+	// Paths and IgnorePaths are gitignore-style globs that limit the tasks in
+	// the build variant to running only when matching files change.
+	Paths       parserStringSlice `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths parserStringSlice `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 
 	// internal matrix stuff
 	MatrixId  string      `yaml:"matrix_id,omitempty" bson:"matrix_id,omitempty"`
@@ -402,6 +407,8 @@ func (pbv *parserBV) canMerge() bool {
 		pbv.AllowForGitTag == nil &&
 		pbv.GitTagOnly == nil &&
 		len(pbv.AllowedRequesters) == 0 &&
+		pbv.Paths == nil &&
+		pbv.IgnorePaths == nil &&
 		pbv.MatrixId == "" &&
 		pbv.MatrixVal == nil &&
 		pbv.Matrix == nil &&
@@ -437,6 +444,11 @@ type parserBVTaskUnit struct {
 	TaskGroup *parserTaskGroup `yaml:"task_group,omitempty" bson:"task_group,omitempty"`
 	// CreateCheckRun will create a check run on GitHub if set.
 	CreateCheckRun *CheckRun `yaml:"create_check_run,omitempty" bson:"create_check_run,omitempty"`
+	// Paths and IgnorePaths are gitignore-style globs that limit the task to
+	// running only when matching files change. If set, they override the
+	// build variant's paths.
+	Paths       parserStringSlice `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths parserStringSlice `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 }
 
 // UnmarshalYAML allows the YAML parser to read both a single selector string or
@@ -629,6 +641,7 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 		ReadFileFrom:    projectOpts.ReadFileFrom,
 		Identifier:      identifier,
 		UnmarshalStrict: projectOpts.UnmarshalStrict,
+		GitDir:          projectOpts.GitDir,
 	}
 	localOpts.UpdateReadFileFrom(include.FileName)
 
@@ -753,6 +766,8 @@ const (
 	ReadFromLocal     = "local"
 	ReadFromPatch     = "patch"
 	ReadFromPatchDiff = "patch_diff"
+	// ReadFromGit reads files from the local git repository in GitDir.
+	ReadFromGit = "git"
 )
 
 type GetProjectOpts struct {
@@ -765,6 +780,9 @@ type GetProjectOpts struct {
 	ReadFileFrom    string
 	Identifier      string
 	UnmarshalStrict bool
+	// GitDir is the local git repository to read files from when
+	// ReadFileFrom is ReadFromGit.
+	GitDir string
 }
 
 type PatchOpts struct {
@@ -811,6 +829,12 @@ func retrieveFile(ctx context.Context, opts GetProjectOpts) ([]byte, error) {
 			return nil, errors.Wrap(err, "patching remote configuration file")
 		}
 		return fileContents, nil
+	case ReadFromGit:
+		fileContents, err := thirdparty.GitShowFile(ctx, opts.GitDir, opts.Revision, opts.RemotePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading project file for project '%s' at revision '%s' from git", opts.Identifier, opts.Revision)
+		}
+		return fileContents, nil
 	default:
 		if opts.Token == "" {
 			conf, err := evergreen.GetConfig(ctx)
@@ -877,6 +901,9 @@ func getFileForPatchDiff(ctx context.Context, opts GetProjectOpts) ([]byte, erro
 	if opts.Ref == nil {
 		return nil, errors.New("project not passed in")
 	}
+	if opts.PatchOpts.patch.IsGitlabMRPatch() {
+		return getGitlabFileForPatch(ctx, opts)
+	}
 	var projectFileBytes []byte
 	githubFile, err := thirdparty.GetGithubFile(ctx, opts.Token, opts.Ref.Owner,
 		opts.Ref.Repo, opts.RemotePath, opts.Revision)
@@ -899,6 +926,31 @@ func getFileForPatchDiff(ctx context.Context, opts GetProjectOpts) ([]byte, erro
 	return projectFileBytes, nil
 }
 
+// getGitlabFileForPatch reads the file for a GitLab merge request patch from
+// the project's local mirror, fetching the merge request into it first if
+// this app server has not fetched it yet.
+func getGitlabFileForPatch(ctx context.Context, opts GetProjectOpts) ([]byte, error) {
+	mr := opts.PatchOpts.patch.GitlabPatchData
+	if !thirdparty.GitHasCommit(ctx, opts.GitDir, opts.Revision) {
+		settings, err := evergreen.GetConfig(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting evergreen configuration")
+		}
+		if err = thirdparty.FetchGitlabMergeRequest(ctx, opts.GitDir, settings.Gitlab.CloneURL(mr.ProjectPath), mr); err != nil {
+			return nil, errors.Wrap(err, "fetching merge request")
+		}
+	}
+	projectFileBytes, err := thirdparty.GitShowFile(ctx, opts.GitDir, opts.Revision, opts.RemotePath)
+	if err != nil {
+		// As with GitHub, a missing project file is fine if the patch adds it.
+		if opts.PatchOpts.patch.ConfigChanged(opts.RemotePath) && thirdparty.IsFileNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "getting GitLab file at '%s/%s'@%s", mr.ProjectPath, opts.RemotePath, opts.Revision)
+	}
+	return projectFileBytes, nil
+}
+
 // fetchProjectFilesTimeout is the maximum timeout to fetch project
 // configuration files from its source.
 const fetchProjectFilesTimeout = time.Minute
@@ -975,6 +1027,7 @@ func TranslateProject(pp *ParserProject) (*Project, error) {
 		PreErrorFailsTask:  utility.FromBoolPtr(pp.PreErrorFailsTask),
 		PostErrorFailsTask: utility.FromBoolPtr(pp.PostErrorFailsTask),
 		OomTracker:         utility.FromBoolPtr(pp.OomTracker),
+		CgroupLimits:       pp.CgroupLimits,
 		BatchTime:          utility.FromIntPtr(pp.BatchTime),
 		Identifier:         utility.FromStringPtr(pp.Identifier),
 		DisplayName:        utility.FromStringPtr(pp.DisplayName),
@@ -1152,6 +1205,8 @@ func evaluateBuildVariants(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluato
 			Stepback:       pbv.Stepback,
 			RunOn:          pbv.RunOn,
 			Tags:           pbv.Tags,
+			Paths:          pbv.Paths,
+			IgnorePaths:    pbv.IgnorePaths,
 		}
 		bv.AllowedRequesters = pbv.AllowedRequesters
 		bv.Tasks, errs = evaluateBVTasks(tse, tgse, vse, pbv, tasks)
@@ -1376,6 +1431,8 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 		BatchTime:        bvt.BatchTime,
 		Activate:         bvt.Activate,
 		CreateCheckRun:   bvt.CreateCheckRun,
+		Paths:            bvt.Paths,
+		IgnorePaths:      bvt.IgnorePaths,
 	}
 	res.AllowedRequesters = bvt.AllowedRequesters
 	if bvt.TaskGroup != nil {
@@ -1460,6 +1517,10 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 	if res.Disable == nil {
 		res.Disable = bv.Disable
 	}
+	if !res.HasPathFilters() {
+		res.Paths = bv.Paths
+		res.IgnorePaths = bv.IgnorePaths
+	}
 
 	return res
 }
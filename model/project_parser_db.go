@@ -19,6 +19,7 @@ var (
 	ParserProjectStepbackKey          = bsonutil.MustHaveTag(ParserProject{}, "Stepback")
 	ParserProjectPreErrorFailsTaskKey = bsonutil.MustHaveTag(ParserProject{}, "PreErrorFailsTask")
 	ParserProjectOomTracker           = bsonutil.MustHaveTag(ParserProject{}, "OomTracker")
+	ParserProjectCgroupLimitsKey      = bsonutil.MustHaveTag(ParserProject{}, "CgroupLimits")
 	ParserProjectBatchTimeKey         = bsonutil.MustHaveTag(ParserProject{}, "BatchTime")
 	ParserProjectOwnerKey             = bsonutil.MustHaveTag(ParserProject{}, "Owner")
 	ParserProjectRepoKey              = bsonutil.MustHaveTag(ParserProject{}, "Repo")
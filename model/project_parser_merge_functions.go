@@ -176,6 +176,12 @@ func (pp *ParserProject) mergeUnique(toMerge *ParserProject) error {
 		pp.OomTracker = toMerge.OomTracker
 	}
 
+	if pp.CgroupLimits != nil && toMerge.CgroupLimits != nil {
+		catcher.New("cgroup limits can only be defined in one YAML")
+	} else if toMerge.CgroupLimits != nil {
+		pp.CgroupLimits = toMerge.CgroupLimits
+	}
+
 	if pp.DisplayName != nil && toMerge.DisplayName != nil {
 		catcher.New("display name can only be defined in one YAML")
 	} else if toMerge.DisplayName != nil {
@@ -646,6 +646,34 @@ func TestTranslateBuildVariants(t *testing.T) {
 			So(bvts[0].CommitQueueMerge, ShouldBeTrue)
 			So(bvts[1].DependsOn[0].Name, ShouldEqual, "t3")
 		})
+		Convey("variant tasks should inherit the variant's path filters unless they define their own", func() {
+			pp.Tasks = []parserTask{
+				{Name: "t1"},
+				{Name: "t2"},
+			}
+			pp.BuildVariants = []parserBV{{
+				Name:        "v1",
+				Paths:       parserStringSlice{"src/"},
+				IgnorePaths: parserStringSlice{"*.md"},
+				Tasks: parserBVTaskUnits{
+					{Name: "t1"},
+					{Name: "t2", Paths: parserStringSlice{"docs/"}},
+				},
+			}}
+
+			out, err := TranslateProject(pp)
+			So(err, ShouldBeNil)
+			So(out, ShouldNotBeNil)
+			So(len(out.BuildVariants), ShouldEqual, 1)
+			So(out.BuildVariants[0].Paths, ShouldResemble, []string{"src/"})
+			So(out.BuildVariants[0].IgnorePaths, ShouldResemble, []string{"*.md"})
+			bvts := out.BuildVariants[0].Tasks
+			So(len(bvts), ShouldEqual, 2)
+			So(bvts[0].Paths, ShouldResemble, []string{"src/"})
+			So(bvts[0].IgnorePaths, ShouldResemble, []string{"*.md"})
+			So(bvts[1].Paths, ShouldResemble, []string{"docs/"})
+			So(bvts[1].IgnorePaths, ShouldBeEmpty)
+		})
 	})
 }
 
@@ -88,6 +88,17 @@ type ProjectRef struct {
 	// If a repo is enabled and this is what creates the hook, then TracksPushEvents will be set at the repo level.
 	TracksPushEvents *bool `bson:"tracks_push_events" json:"tracks_push_events" yaml:"tracks_push_events"`
 
+	// GitRemoteURL, if set, is a git remote that the repotracker polls for new
+	// commits using plain git instead of the GitHub API, so that projects
+	// hosted on other git servers can create mainline versions.
+	GitRemoteURL string `bson:"git_remote_url,omitempty" json:"git_remote_url,omitempty" yaml:"git_remote_url,omitempty"`
+
+	// CodeHost is the service hosting the project's repository. If unset, the
+	// repository is hosted on GitHub. For GitLab projects, Owner is the path
+	// of the GitLab group (including any subgroups) and Repo is the name of
+	// the GitLab project.
+	CodeHost string `bson:"code_host,omitempty" json:"code_host,omitempty" yaml:"code_host,omitempty"`
+
 	// TaskSync holds settings for synchronizing task directories to S3.
 	TaskSync TaskSyncOptions `bson:"task_sync" json:"task_sync" yaml:"task_sync"`
 
@@ -165,11 +176,89 @@ const (
 	MergeQueueGitHub    MergeQueue = "GITHUB"
 )
 
+const (
+	// CodeHostGithub indicates that a project's repository is hosted on
+	// GitHub. It is the same as leaving the code host unset.
+	CodeHostGithub = "github"
+	// CodeHostGitlab indicates that a project's repository is hosted on the
+	// GitLab instance in the admin settings.
+	CodeHostGitlab = "gitlab"
+)
+
+// ValidCodeHosts are the values that a project's code host can have.
+var ValidCodeHosts = []string{"", CodeHostGithub, CodeHostGitlab}
+
 type CommitQueueParams struct {
 	Enabled     *bool      `bson:"enabled" json:"enabled" yaml:"enabled"`
 	MergeMethod string     `bson:"merge_method" json:"merge_method" yaml:"merge_method"`
 	MergeQueue  MergeQueue `bson:"merge_queue" json:"merge_queue" yaml:"merge_queue"`
 	Message     string     `bson:"message,omitempty" json:"message,omitempty" yaml:"message"`
+	// FlakyTestThreshold is the historical failure rate above which a test is
+	// considered flaky. If set, an item whose only failures are flaky tests
+	// is retried once instead of being dequeued.
+	FlakyTestThreshold float64 `bson:"flaky_test_threshold,omitempty" json:"flaky_test_threshold,omitempty" yaml:"flaky_test_threshold,omitempty"`
+	// RequiredChecks are the variants and tasks that must succeed for GitHub
+	// merge queue versions, by target branch. If none match a version's
+	// target branch, all of the version's tasks are required.
+	RequiredChecks []MergeQueueRequiredChecks `bson:"required_checks,omitempty" json:"required_checks,omitempty" yaml:"required_checks,omitempty"`
+}
+
+// MergeQueueRequiredChecks are the variants and tasks that must succeed for
+// GitHub merge queue versions that merge into matching branches. They are
+// scheduled in addition to the tasks selected by the commit queue alias.
+type MergeQueueRequiredChecks struct {
+	// BranchRegex matches the names of the target branches that the checks
+	// are required for.
+	BranchRegex  string   `bson:"branch_regex" json:"branch_regex" yaml:"branch_regex"`
+	VariantRegex string   `bson:"variant_regex,omitempty" json:"variant_regex,omitempty" yaml:"variant_regex,omitempty"`
+	VariantTags  []string `bson:"variant_tags,omitempty" json:"variant_tags,omitempty" yaml:"variant_tags,omitempty"`
+	TaskRegex    string   `bson:"task_regex,omitempty" json:"task_regex,omitempty" yaml:"task_regex,omitempty"`
+	TaskTags     []string `bson:"task_tags,omitempty" json:"task_tags,omitempty" yaml:"task_tags,omitempty"`
+}
+
+// toAlias returns an alias that selects the required variants and tasks.
+func (c MergeQueueRequiredChecks) toAlias() ProjectAlias {
+	return ProjectAlias{
+		Alias:       evergreen.CommitQueueAlias,
+		Variant:     c.VariantRegex,
+		VariantTags: c.VariantTags,
+		Task:        c.TaskRegex,
+		TaskTags:    c.TaskTags,
+	}
+}
+
+// Validate checks that the commit queue settings are valid.
+func (cq CommitQueueParams) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(cq.FlakyTestThreshold < 0 || cq.FlakyTestThreshold >= 1, "flaky test threshold %v must be at least 0 and less than 1", cq.FlakyTestThreshold)
+	for i, checks := range cq.RequiredChecks {
+		if strings.TrimSpace(checks.BranchRegex) == "" {
+			catcher.Errorf("required checks #%d must specify a branch regex", i+1)
+		} else if _, err := regexp.Compile(checks.BranchRegex); err != nil {
+			catcher.Errorf("required checks #%d has invalid branch regex '%s'", i+1, checks.BranchRegex)
+		}
+		for _, errMsg := range validateAliasPatchDefinition(checks.toAlias(), "required checks", i+1) {
+			catcher.New(errMsg)
+		}
+	}
+	return catcher.Resolve()
+}
+
+// RequiredChecksAliases returns aliases selecting the variants and tasks that
+// are required for GitHub merge queue versions merging into the given branch.
+// If no required checks match the branch, it returns nil.
+func (cq CommitQueueParams) RequiredChecksAliases(branch string) ([]ProjectAlias, error) {
+	var aliases []ProjectAlias
+	for _, checks := range cq.RequiredChecks {
+		branchRegex, err := regexp.Compile(checks.BranchRegex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compiling branch regex '%s'", checks.BranchRegex)
+		}
+		if branchRegex.MatchString(branch) {
+			aliases = append(aliases, checks.toAlias())
+		}
+	}
+	return aliases, nil
 }
 
 // TaskSyncOptions contains information about which features are allowed for
@@ -325,6 +414,8 @@ var (
 	ProjectRefGitTagAuthorizedUsersKey    = bsonutil.MustHaveTag(ProjectRef{}, "GitTagAuthorizedUsers")
 	ProjectRefGitTagAuthorizedTeamsKey    = bsonutil.MustHaveTag(ProjectRef{}, "GitTagAuthorizedTeams")
 	ProjectRefTracksPushEventsKey         = bsonutil.MustHaveTag(ProjectRef{}, "TracksPushEvents")
+	projectRefGitRemoteURLKey             = bsonutil.MustHaveTag(ProjectRef{}, "GitRemoteURL")
+	ProjectRefCodeHostKey                 = bsonutil.MustHaveTag(ProjectRef{}, "CodeHost")
 	projectRefPRTestingEnabledKey         = bsonutil.MustHaveTag(ProjectRef{}, "PRTestingEnabled")
 	projectRefManualPRTestingEnabledKey   = bsonutil.MustHaveTag(ProjectRef{}, "ManualPRTestingEnabled")
 	projectRefGithubChecksEnabledKey      = bsonutil.MustHaveTag(ProjectRef{}, "GithubChecksEnabled")
@@ -440,6 +531,16 @@ func (p *ProjectRef) UseRepoSettings() bool {
 	return p.RepoRefId != ""
 }
 
+// SecretPathPrefixes returns the external secret path prefixes that the
+// project's variables can reference. This includes the prefixes of its repo,
+// since the project inherits the repo's variables.
+func (p *ProjectRef) SecretPathPrefixes(conf evergreen.SecretProvidersConfig) []string {
+	if p.UseRepoSettings() {
+		return conf.PathPrefixesForProjects(p.Id, p.RepoRefId)
+	}
+	return conf.PathPrefixesForProjects(p.Id)
+}
+
 func (p *ProjectRef) DoesTrackPushEvents() bool {
 	return utility.FromBoolPtr(p.TracksPushEvents)
 }
@@ -1200,6 +1301,7 @@ func getCommonProjectVariables(projectIds []string) (*ProjectVars, error) {
 	commonProjectVariables := map[string]string{}
 	commonPrivate := map[string]bool{}
 	commonAdminOnly := map[string]bool{}
+	commonExternal := map[string]bool{}
 	for i, id := range projectIds {
 		vars, err := FindOneProjectVars(id)
 		if err != nil {
@@ -1218,6 +1320,9 @@ func getCommonProjectVariables(projectIds []string) (*ProjectVars, error) {
 			if vars.AdminOnlyVars != nil {
 				commonAdminOnly = vars.AdminOnlyVars
 			}
+			if vars.ExternalSecretVars != nil {
+				commonExternal = vars.ExternalSecretVars
+			}
 			continue
 		}
 		for key, val := range commonProjectVariables {
@@ -1229,6 +1334,9 @@ func getCommonProjectVariables(projectIds []string) (*ProjectVars, error) {
 				if vars.AdminOnlyVars[key] {
 					commonAdminOnly[key] = true
 				}
+				if vars.ExternalSecretVars[key] {
+					commonExternal[key] = true
+				}
 			} else {
 				// remove any variables from the common set that aren't in all the project refs
 				delete(commonProjectVariables, key)
@@ -1236,9 +1344,10 @@ func getCommonProjectVariables(projectIds []string) (*ProjectVars, error) {
 		}
 	}
 	return &ProjectVars{
-		Vars:          commonProjectVariables,
-		PrivateVars:   commonPrivate,
-		AdminOnlyVars: commonAdminOnly,
+		Vars:               commonProjectVariables,
+		PrivateVars:        commonPrivate,
+		AdminOnlyVars:      commonAdminOnly,
+		ExternalSecretVars: commonExternal,
 	}, nil
 }
 
@@ -1544,6 +1653,54 @@ func FindDownstreamProjects(project string) ([]ProjectRef, error) {
 	return projectRefs, err
 }
 
+// GitlabOwnerAndRepo splits the full path of a GitLab project into the owner
+// and repo that project refs hosted on GitLab store. The owner is the path of
+// the group, which may include subgroups.
+func GitlabOwnerAndRepo(projectPath string) (string, string, error) {
+	i := strings.LastIndex(projectPath, "/")
+	if i <= 0 || i == len(projectPath)-1 {
+		return "", "", errors.Errorf("invalid GitLab project path '%s'", projectPath)
+	}
+	return projectPath[:i], projectPath[i+1:], nil
+}
+
+// FindMergedEnabledGitlabProjectRefsByPathAndBranch returns the enabled
+// projects hosted on GitLab that track the branch of the GitLab project at the
+// given path.
+func FindMergedEnabledGitlabProjectRefsByPathAndBranch(projectPath, branch string) ([]ProjectRef, error) {
+	owner, repo, err := GitlabOwnerAndRepo(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	projectRefs, err := FindMergedEnabledProjectRefsByRepoAndBranch(owner, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	gitlabProjectRefs := []ProjectRef{}
+	for _, p := range projectRefs {
+		if p.IsGitlabProject() {
+			gitlabProjectRefs = append(gitlabProjectRefs, p)
+		}
+	}
+	return gitlabProjectRefs, nil
+}
+
+// FindOneGitlabProjectRefWithPRTesting returns the enabled project hosted on
+// GitLab that tracks the branch of the GitLab project at the given path and
+// tests merge requests for the caller, if there is one.
+func FindOneGitlabProjectRefWithPRTesting(projectPath, branch, calledBy string) (*ProjectRef, error) {
+	projectRefs, err := FindMergedEnabledGitlabProjectRefsByPathAndBranch(projectPath, branch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding project refs for GitLab project '%s' with branch '%s'", projectPath, branch)
+	}
+	for _, p := range projectRefs {
+		if p.IsPRTestingEnabledByCaller(calledBy) {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
 // FindOneProjectRefByRepoAndBranchWithPRTesting finds a single ProjectRef with matching
 // repo/branch that is enabled and setup for PR testing.
 func FindOneProjectRefByRepoAndBranchWithPRTesting(owner, repo, branch, calledBy string) (*ProjectRef, error) {
@@ -1932,6 +2089,61 @@ func (p *ProjectRef) ValidateEnabledRepotracker() error {
 	return nil
 }
 
+// validGitRemoteURLSchemes are the URL schemes allowed for a project's git
+// remote URL.
+var validGitRemoteURLSchemes = []string{"https", "ssh", "git"}
+
+// scpLikeGitRemoteURL matches the scp-like syntax for SSH git remotes, such
+// as git@example.com:owner/repo.git.
+var scpLikeGitRemoteURL = regexp.MustCompile(`^[A-Za-z0-9._~-]+@[A-Za-z0-9.][A-Za-z0-9.-]*:[^-\s]\S*$`)
+
+// ValidateGitRemoteURL checks that the project's git remote URL, if set, is
+// an https, ssh or git URL. The URL is passed to git commands run on the app
+// server, so anything else (such as a value git would parse as an option) is
+// rejected. A file:// URL or an absolute path is only allowed if
+// allowLocalRemotes is set, since it reads from the app server's filesystem.
+func (p *ProjectRef) ValidateGitRemoteURL(allowLocalRemotes bool) error {
+	remote := p.GitRemoteURL
+	if remote == "" {
+		return nil
+	}
+	if strings.HasPrefix(remote, "-") {
+		return errors.New("git remote URL cannot begin with '-'")
+	}
+	if strings.ContainsAny(remote, " \t\r\n") {
+		return errors.New("git remote URL cannot contain whitespace")
+	}
+	if filepath.IsAbs(remote) {
+		if !allowLocalRemotes {
+			return errors.New("git remote URL cannot be a local path")
+		}
+		return nil
+	}
+	if scpLikeGitRemoteURL.MatchString(remote) {
+		return nil
+	}
+	u, err := url.Parse(remote)
+	if err != nil {
+		return errors.Wrap(err, "parsing git remote URL")
+	}
+	if u.Scheme == "file" {
+		if !allowLocalRemotes {
+			return errors.New("git remote URL cannot be a file URL")
+		}
+		if (u.Host != "" && u.Host != "localhost") || !filepath.IsAbs(u.Path) {
+			return errors.New("git remote file URL must have an absolute path on the local host")
+		}
+		return nil
+	}
+	if !utility.StringSliceContains(validGitRemoteURLSchemes, u.Scheme) {
+		return errors.Errorf("git remote URL scheme must be one of: %s", strings.Join(validGitRemoteURLSchemes, ", "))
+	}
+	if u.Host == "" || strings.HasPrefix(u.Host, "-") {
+		return errors.New("git remote URL must have a valid host")
+	}
+	return nil
+}
+
 func (p *ProjectRef) CanEnableCommitQueue() (bool, error) {
 	conflicts, err := p.GetGithubProjectConflicts()
 	if err != nil {
@@ -2013,6 +2225,8 @@ func SaveProjectPageForSection(projectId string, p *ProjectRef, section ProjectP
 			projectRefPatchingDisabledKey:      p.PatchingDisabled,
 			projectRefTaskSyncKey:              p.TaskSync,
 			ProjectRefDisabledStatsCacheKey:    p.DisabledStatsCache,
+			projectRefGitRemoteURLKey:          p.GitRemoteURL,
+			ProjectRefCodeHostKey:              p.CodeHost,
 		}
 		// Unlike other fields, this will only be set if we're actually modifying it since it's used by the backend.
 		if p.TracksPushEvents != nil {
@@ -2491,10 +2705,29 @@ func (p *ProjectRef) ValidateOwnerAndRepo(validOrgs []string) error {
 	if p.Owner == "" || p.Repo == "" {
 		return errors.New("no owner/repo specified")
 	}
+	if !utility.StringSliceContains(ValidCodeHosts, p.CodeHost) {
+		return errors.Errorf("invalid code host '%s'", p.CodeHost)
+	}
+	// The allowed organizations only apply to GitHub.
+	if p.IsGitlabProject() {
+		return nil
+	}
 
 	return validateOwner(p.Owner, validOrgs)
 }
 
+// IsGitlabProject returns whether the project's repository is hosted on
+// GitLab.
+func (p *ProjectRef) IsGitlabProject() bool {
+	return p.CodeHost == CodeHostGitlab
+}
+
+// GitlabProjectPath returns the full path of the project's repository on
+// GitLab.
+func (p *ProjectRef) GitlabProjectPath() string {
+	return p.Owner + "/" + p.Repo
+}
+
 func validateOwner(owner string, validOrgs []string) error {
 	if len(validOrgs) > 0 && !utility.StringSliceContains(validOrgs, owner) {
 		return errors.New("owner not authorized")
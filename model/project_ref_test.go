@@ -1945,6 +1945,84 @@ func TestValidatePeriodicBuildDefinition(t *testing.T) {
 	}
 }
 
+func TestCommitQueueParamsValidate(t *testing.T) {
+	for threshold, shouldPass := range map[float64]bool{
+		0:    true,
+		0.1:  true,
+		0.99: true,
+		-0.1: false,
+		1:    false,
+		2:    false,
+	} {
+		cq := CommitQueueParams{FlakyTestThreshold: threshold}
+		if shouldPass {
+			assert.NoError(t, cq.Validate(), threshold)
+		} else {
+			assert.Error(t, cq.Validate(), threshold)
+		}
+	}
+}
+
+func TestCommitQueueRequiredChecks(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		for tName, tCase := range map[string]struct {
+			checks     MergeQueueRequiredChecks
+			shouldPass bool
+		}{
+			"Regexes": {
+				checks:     MergeQueueRequiredChecks{BranchRegex: "^release/", VariantRegex: ".*", TaskRegex: "^test"},
+				shouldPass: true,
+			},
+			"Tags": {
+				checks:     MergeQueueRequiredChecks{BranchRegex: "^main$", VariantTags: []string{"required"}, TaskTags: []string{"required"}},
+				shouldPass: true,
+			},
+			"MissingBranchRegex": {
+				checks: MergeQueueRequiredChecks{VariantRegex: ".*", TaskRegex: ".*"},
+			},
+			"InvalidBranchRegex": {
+				checks: MergeQueueRequiredChecks{BranchRegex: "[", VariantRegex: ".*", TaskRegex: ".*"},
+			},
+			"MissingTasks": {
+				checks: MergeQueueRequiredChecks{BranchRegex: "^main$", VariantRegex: ".*"},
+			},
+			"VariantRegexAndTags": {
+				checks: MergeQueueRequiredChecks{BranchRegex: "^main$", VariantRegex: ".*", VariantTags: []string{"required"}, TaskRegex: ".*"},
+			},
+		} {
+			t.Run(tName, func(t *testing.T) {
+				cq := CommitQueueParams{RequiredChecks: []MergeQueueRequiredChecks{tCase.checks}}
+				if tCase.shouldPass {
+					assert.NoError(t, cq.Validate())
+				} else {
+					assert.Error(t, cq.Validate())
+				}
+			})
+		}
+	})
+	t.Run("AliasesForBranch", func(t *testing.T) {
+		cq := CommitQueueParams{RequiredChecks: []MergeQueueRequiredChecks{
+			{BranchRegex: ".*", VariantRegex: "^ubuntu$", TaskRegex: "^unit$"},
+			{BranchRegex: "^release/", VariantTags: []string{"release"}, TaskRegex: ".*"},
+		}}
+
+		aliases, err := cq.RequiredChecksAliases("main")
+		require.NoError(t, err)
+		require.Len(t, aliases, 1)
+		assert.Equal(t, "^ubuntu$", aliases[0].Variant)
+		assert.Equal(t, "^unit$", aliases[0].Task)
+
+		aliases, err = cq.RequiredChecksAliases("release/1.0")
+		require.NoError(t, err)
+		require.Len(t, aliases, 2)
+		assert.Equal(t, []string{"release"}, aliases[1].VariantTags)
+
+		aliases, err = CommitQueueParams{}.RequiredChecksAliases("main")
+		require.NoError(t, err)
+		assert.Empty(t, aliases)
+	})
+}
+
 func TestContainerSecretValidate(t *testing.T) {
 	t.Run("FailsWithInvalidSecretType", func(t *testing.T) {
 		cs := ContainerSecret{
@@ -3249,6 +3327,47 @@ func TestValidateOwnerAndRepo(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateGitRemoteURL(t *testing.T) {
+	for _, remote := range []string{
+		"",
+		"https://example.com/owner/repo.git",
+		"ssh://git@example.com/owner/repo.git",
+		"git://example.com/owner/repo.git",
+		"git@example.com:owner/repo.git",
+	} {
+		pRef := ProjectRef{GitRemoteURL: remote}
+		assert.NoError(t, pRef.ValidateGitRemoteURL(false), remote)
+		assert.NoError(t, pRef.ValidateGitRemoteURL(true), remote)
+	}
+	for _, remote := range []string{
+		"file:///tmp/repo",
+		"file://localhost/tmp/repo",
+		"/tmp/repo",
+	} {
+		pRef := ProjectRef{GitRemoteURL: remote}
+		assert.Error(t, pRef.ValidateGitRemoteURL(false), remote)
+		assert.NoError(t, pRef.ValidateGitRemoteURL(true), remote)
+	}
+	for _, remote := range []string{
+		"--upload-pack=touch /tmp/pwned;",
+		"-uhttps://example.com/owner/repo.git",
+		"-/tmp/repo",
+		"file://example.com/tmp/repo",
+		"file:tmp/repo",
+		"tmp/repo",
+		"/tmp/my repo",
+		"ext::sh -c touch% /tmp/pwned",
+		"http://example.com/owner/repo.git",
+		"ssh://-oProxyCommand=touch/repo",
+		"git@-oProxyCommand=touch:repo",
+		"https:///owner/repo.git",
+	} {
+		pRef := ProjectRef{GitRemoteURL: remote}
+		assert.Error(t, pRef.ValidateGitRemoteURL(false), remote)
+		assert.Error(t, pRef.ValidateGitRemoteURL(true), remote)
+	}
+}
+
 func TestProjectCanDispatchTask(t *testing.T) {
 	t.Run("ReturnsTrueWithEnabledProject", func(t *testing.T) {
 		pRef := ProjectRef{
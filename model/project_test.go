@@ -270,6 +270,103 @@ func TestIgnoresAllFiles(t *testing.T) {
 	})
 }
 
+func TestMatchesChangedFiles(t *testing.T) {
+	files := []string{
+		"src/server/main.go",
+		"docs/README.md",
+	}
+	for tName, tCase := range map[string]struct {
+		bvt      BuildVariantTaskUnit
+		files    []string
+		expected bool
+	}{
+		"MatchesWithoutPathFilters": {
+			bvt:      BuildVariantTaskUnit{},
+			files:    files,
+			expected: true,
+		},
+		"MatchesWithUnknownChangedFiles": {
+			bvt:      BuildVariantTaskUnit{Paths: []string{"src/client/*"}},
+			expected: true,
+		},
+		"MatchesWithMatchingPath": {
+			bvt:      BuildVariantTaskUnit{Paths: []string{"src/server/"}},
+			files:    files,
+			expected: true,
+		},
+		"DoesNotMatchWithoutMatchingPath": {
+			bvt:      BuildVariantTaskUnit{Paths: []string{"src/client/"}},
+			files:    files,
+			expected: false,
+		},
+		"DoesNotMatchWhenAllFilesAreIgnored": {
+			bvt:      BuildVariantTaskUnit{IgnorePaths: []string{"*.go", "docs/"}},
+			files:    files,
+			expected: false,
+		},
+		"MatchesWhenSomeFilesAreNotIgnored": {
+			bvt:      BuildVariantTaskUnit{IgnorePaths: []string{"docs/"}},
+			files:    files,
+			expected: true,
+		},
+		"DoesNotMatchWhenMatchingPathIsIgnored": {
+			bvt:      BuildVariantTaskUnit{Paths: []string{"src/"}, IgnorePaths: []string{"*.go"}},
+			files:    files,
+			expected: false,
+		},
+		"MatchesWithNegatedIgnorePath": {
+			bvt:      BuildVariantTaskUnit{IgnorePaths: []string{"*", "!src/server/*"}},
+			files:    files,
+			expected: true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			assert.Equal(t, tCase.expected, tCase.bvt.MatchesChangedFiles(tCase.files))
+		})
+	}
+}
+
+func TestResolvePatchVTsWithPathFilters(t *testing.T) {
+	p := &Project{
+		Tasks: []ProjectTask{
+			{Name: "server_test"},
+			{Name: "client_test"},
+			{Name: "lint"},
+		},
+		BuildVariants: []BuildVariant{
+			{
+				Name:  "bv",
+				Paths: []string{"src/server/"},
+				Tasks: []BuildVariantTaskUnit{
+					{Name: "server_test", Variant: "bv", Paths: []string{"src/server/"}},
+					{Name: "client_test", Variant: "bv", Paths: []string{"src/client/"}},
+					{Name: "lint", Variant: "bv"},
+				},
+			},
+		},
+	}
+	makePatch := func(files ...string) *patch.Patch {
+		summaries := []thirdparty.Summary{}
+		for _, f := range files {
+			summaries = append(summaries, thirdparty.Summary{Name: f})
+		}
+		return &patch.Patch{
+			BuildVariants: []string{"all"},
+			Tasks:         []string{"all"},
+			Patches:       []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: summaries}}},
+		}
+	}
+
+	t.Run("OnlyIncludesTasksMatchingChangedFiles", func(t *testing.T) {
+		_, tasks, _ := p.ResolvePatchVTs(makePatch("src/server/main.go"), evergreen.PatchVersionRequester, "", true)
+		assert.ElementsMatch(t, []string{"server_test", "lint"}, tasks)
+	})
+	t.Run("IncludesAllTasksWithoutChangedFiles", func(t *testing.T) {
+		_, tasks, _ := p.ResolvePatchVTs(makePatch(), evergreen.PatchVersionRequester, "", true)
+		assert.ElementsMatch(t, []string{"server_test", "client_test", "lint"}, tasks)
+	})
+}
+
 func TestPopulateExpansions(t *testing.T) {
 	assert := assert.New(t)
 	assert.NoError(db.ClearCollections(VersionCollection, patch.Collection, ProjectRefCollection,
@@ -1124,6 +1221,37 @@ func (s *projectSuite) TestBuildProjectTVPairsWithAlias() {
 	}
 }
 
+func (s *projectSuite) TestBuildProjectTVPairsSmartSelectionOnlyForPatchAliases() {
+	s.Require().NoError(db.ClearCollections(patch.Collection))
+	for _, aliasName := range []string{"smart", evergreen.CommitQueueAlias} {
+		alias := ProjectAlias{
+			ProjectID:      "project",
+			Alias:          aliasName,
+			Variant:        ".*",
+			Task:           ".*_2",
+			SmartSelection: &SmartSelection{MaxTasks: 1},
+		}
+		s.Require().NoError(alias.Upsert())
+	}
+	makePatch := func(alias string) patch.Patch {
+		return patch.Patch{
+			Id:      mgobson.NewObjectId(),
+			Project: "project",
+			Alias:   alias,
+			Patches: []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: []thirdparty.Summary{{Name: "src/foo.go"}}}}},
+		}
+	}
+
+	patchDoc := makePatch("smart")
+	s.project.BuildProjectTVPairs(&patchDoc, "smart")
+	s.NotEmpty(patchDoc.SkippedTasks, "patch aliases should use smart selection")
+
+	patchDoc = makePatch(evergreen.CommitQueueAlias)
+	s.project.BuildProjectTVPairs(&patchDoc, evergreen.CommitQueueAlias)
+	s.Empty(patchDoc.SkippedTasks, "commit queue aliases should run all of their tasks")
+	s.ElementsMatch([]string{"a_task_2", "b_task_2"}, patchDoc.Tasks)
+}
+
 func (s *projectSuite) TestBuildProjectTVPairsWithBadBuildVariant() {
 	patchDoc := patch.Patch{
 		BuildVariants: []string{"bv_1", "bv_2", "totallynotreal"},
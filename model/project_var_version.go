@@ -0,0 +1,350 @@
+package model
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const ProjectVarVersionsCollection = "project_var_versions"
+
+// maxProjectVarVersionInsertAttempts is the number of times that a version is
+// inserted before giving up when concurrent changes to the same variable keep
+// claiming its version number.
+const maxProjectVarVersionInsertAttempts = 5
+
+var (
+	projectVarVersionProjectIDKey = bsonutil.MustHaveTag(ProjectVarVersion{}, "ProjectID")
+	projectVarVersionNameKey      = bsonutil.MustHaveTag(ProjectVarVersion{}, "Name")
+	projectVarVersionVersionKey   = bsonutil.MustHaveTag(ProjectVarVersion{}, "Version")
+)
+
+// ProjectVarVersion is a version of a project variable. A new version is
+// recorded each time a variable's value or options change, including when
+// it's deleted.
+type ProjectVarVersion struct {
+	Id string `bson:"_id" json:"id"`
+	// ProjectID is the project or repo that the variable belongs to.
+	ProjectID string `bson:"project_id" json:"project_id"`
+	Name      string `bson:"name" json:"name"`
+	// Version starts at 1 and increases with each change to the variable.
+	Version int `bson:"version" json:"version"`
+	// Value is the variable's value. For private variables, it's encrypted.
+	Value string `bson:"value,omitempty" json:"value,omitempty"`
+	// Encrypted is whether the value is encrypted.
+	Encrypted bool `bson:"encrypted,omitempty" json:"encrypted,omitempty"`
+	// ValueOmitted is whether the value was not kept because the variable
+	// is private and there was no key to encrypt it with.
+	ValueOmitted   bool      `bson:"value_omitted,omitempty" json:"value_omitted,omitempty"`
+	Private        bool      `bson:"private,omitempty" json:"private,omitempty"`
+	AdminOnly      bool      `bson:"admin_only,omitempty" json:"admin_only,omitempty"`
+	ExternalSecret bool      `bson:"external_secret,omitempty" json:"external_secret,omitempty"`
+	Deleted        bool      `bson:"deleted,omitempty" json:"deleted,omitempty"`
+	User           string    `bson:"user,omitempty" json:"user,omitempty"`
+	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
+}
+
+// FindProjectVarVersions returns the versions of the project variable that
+// are kept, from newest to oldest.
+func FindProjectVarVersions(projectID, name string) ([]ProjectVarVersion, error) {
+	versions := []ProjectVarVersion{}
+	q := db.Query(bson.M{
+		projectVarVersionProjectIDKey: projectID,
+		projectVarVersionNameKey:      name,
+	}).Sort([]string{"-" + projectVarVersionVersionKey})
+	if err := db.FindAllQ(ProjectVarVersionsCollection, q, &versions); err != nil {
+		return nil, errors.Wrapf(err, "finding versions of variable '%s' for project '%s'", name, projectID)
+	}
+	return versions, nil
+}
+
+// FindProjectVarVersion returns the given version of the project variable.
+func FindProjectVarVersion(projectID, name string, version int) (*ProjectVarVersion, error) {
+	v := &ProjectVarVersion{}
+	q := db.Query(bson.M{
+		projectVarVersionProjectIDKey: projectID,
+		projectVarVersionNameKey:      name,
+		projectVarVersionVersionKey:   version,
+	})
+	err := db.FindOneQ(ProjectVarVersionsCollection, q, v)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding version %d of variable '%s' for project '%s'", version, name, projectID)
+	}
+	return v, nil
+}
+
+// findLatestProjectVarVersions returns the latest version of each of the
+// project's variables, including deleted ones.
+func findLatestProjectVarVersions(projectID string) (map[string]ProjectVarVersion, error) {
+	versions := []ProjectVarVersion{}
+	pipeline := []bson.M{
+		{"$match": bson.M{projectVarVersionProjectIDKey: projectID}},
+		{"$sort": bson.M{projectVarVersionVersionKey: -1}},
+		{"$group": bson.M{
+			"_id":    "$" + projectVarVersionNameKey,
+			"latest": bson.M{"$first": "$$ROOT"},
+		}},
+		{"$replaceRoot": bson.M{"newRoot": "$latest"}},
+	}
+	if err := db.Aggregate(ProjectVarVersionsCollection, pipeline, &versions); err != nil {
+		return nil, errors.Wrapf(err, "finding latest variable versions for project '%s'", projectID)
+	}
+
+	latest := make(map[string]ProjectVarVersion, len(versions))
+	for _, v := range versions {
+		latest[v.Name] = v
+	}
+	return latest, nil
+}
+
+// GetProjectVarVersionSnapshot returns the current version of each of the
+// named variables for a project, checking the repo's variables for any that the
+// project doesn't define. Variables that have no recorded versions are
+// omitted.
+func GetProjectVarVersionSnapshot(projectID, repoID string, names []string) ([]task.VariableVersion, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	projectVersions, err := findLatestProjectVarVersions(projectID)
+	if err != nil {
+		return nil, err
+	}
+	repoVersions := map[string]ProjectVarVersion{}
+	if repoID != "" {
+		if repoVersions, err = findLatestProjectVarVersions(repoID); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := []task.VariableVersion{}
+	for _, name := range names {
+		v, ok := projectVersions[name]
+		if !ok || v.Deleted {
+			if v, ok = repoVersions[name]; !ok || v.Deleted {
+				continue
+			}
+		}
+		snapshot = append(snapshot, task.VariableVersion{
+			Name:      v.Name,
+			ProjectID: v.ProjectID,
+			Version:   v.Version,
+		})
+	}
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].Name < snapshot[j].Name })
+	return snapshot, nil
+}
+
+// RecordProjectVarVersions records a new version of each variable that was
+// added, modified, or deleted between before and after, and removes versions
+// beyond the configured history limit.
+func RecordProjectVarVersions(projectID, username string, before, after ProjectVars) error {
+	conf := evergreen.GetEnvironment().Settings().ProjectVars
+	if err := conf.ValidateAndDefault(); err != nil {
+		return errors.Wrap(err, "invalid project vars config")
+	}
+
+	now := time.Now()
+	changed := []ProjectVarVersion{}
+	for name, val := range after.Vars {
+		v := ProjectVarVersion{
+			ProjectID:      projectID,
+			Name:           name,
+			Private:        after.PrivateVars[name],
+			AdminOnly:      after.AdminOnlyVars[name],
+			ExternalSecret: after.ExternalSecretVars[name],
+			User:           username,
+			CreatedAt:      now,
+		}
+		beforeVal, existed := before.Vars[name]
+		if existed && beforeVal == val && before.PrivateVars[name] == v.Private &&
+			before.AdminOnlyVars[name] == v.AdminOnly && before.ExternalSecretVars[name] == v.ExternalSecret {
+			continue
+		}
+		if err := v.setValue(val, conf.EncryptionKey); err != nil {
+			return errors.Wrapf(err, "setting value for variable '%s'", name)
+		}
+		changed = append(changed, v)
+	}
+	for name := range before.Vars {
+		if _, ok := after.Vars[name]; ok {
+			continue
+		}
+		changed = append(changed, ProjectVarVersion{
+			ProjectID: projectID,
+			Name:      name,
+			Deleted:   true,
+			User:      username,
+			CreatedAt: now,
+		})
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	latest, err := findLatestProjectVarVersions(projectID)
+	if err != nil {
+		return err
+	}
+	catcher := grip.NewBasicCatcher()
+	for _, v := range changed {
+		if err := v.insert(latest[v.Name].Version); err != nil {
+			catcher.Add(err)
+			continue
+		}
+		catcher.Wrapf(db.RemoveAll(ProjectVarVersionsCollection, bson.M{
+			projectVarVersionProjectIDKey: projectID,
+			projectVarVersionNameKey:      v.Name,
+			projectVarVersionVersionKey:   bson.M{"$lte": v.Version - conf.VersionHistoryLimit},
+		}), "removing old versions of variable '%s'", v.Name)
+	}
+	return catcher.Resolve()
+}
+
+// insert inserts the version as the one after the given latest version. The
+// unique index on the project, name and version rejects the insert if a
+// concurrent change already claimed the version number, in which case it's
+// retried after the new latest version.
+func (v *ProjectVarVersion) insert(latestVersion int) error {
+	for attempt := 1; ; attempt++ {
+		v.Id = mgobson.NewObjectId().Hex()
+		v.Version = latestVersion + 1
+		err := db.Insert(ProjectVarVersionsCollection, v)
+		if !db.IsDuplicateKey(err) || attempt >= maxProjectVarVersionInsertAttempts {
+			return errors.Wrapf(err, "inserting version %d of variable '%s'", v.Version, v.Name)
+		}
+
+		latest := &ProjectVarVersion{}
+		q := db.Query(bson.M{
+			projectVarVersionProjectIDKey: v.ProjectID,
+			projectVarVersionNameKey:      v.Name,
+		}).Sort([]string{"-" + projectVarVersionVersionKey})
+		if err = db.FindOneQ(ProjectVarVersionsCollection, q, latest); err != nil && !adb.ResultsNotFound(err) {
+			return errors.Wrapf(err, "finding latest version of variable '%s'", v.Name)
+		}
+		latestVersion = latest.Version
+	}
+}
+
+// RollbackProjectVar sets the project variable back to the value and options
+// it had at the given version. The rollback itself should be recorded as a new
+// version.
+func RollbackProjectVar(projectID, name string, version int) error {
+	v, err := FindProjectVarVersion(projectID, name, version)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return errors.Errorf("version %d of variable '%s' not found", version, name)
+	}
+	if v.Deleted {
+		return errors.Errorf("version %d of variable '%s' is a deletion and cannot be rolled back to", version, name)
+	}
+	val, err := v.GetValue(evergreen.GetEnvironment().Settings().ProjectVars.EncryptionKey)
+	if err != nil {
+		return errors.Wrapf(err, "getting value of version %d of variable '%s'", version, name)
+	}
+
+	vars := &ProjectVars{
+		Id:                 projectID,
+		Vars:               map[string]string{name: val},
+		PrivateVars:        map[string]bool{name: v.Private},
+		AdminOnlyVars:      map[string]bool{name: v.AdminOnly},
+		ExternalSecretVars: map[string]bool{name: v.ExternalSecret},
+	}
+	_, err = vars.FindAndModify(nil)
+	return errors.Wrapf(err, "rolling back variable '%s' to version %d", name, version)
+}
+
+// setValue sets the version's value, encrypting it if the variable is
+// private.
+func (v *ProjectVarVersion) setValue(val, key string) error {
+	if !v.Private {
+		v.Value = val
+		return nil
+	}
+	if key == "" {
+		v.ValueOmitted = true
+		return nil
+	}
+	encrypted, err := encryptProjectVarValue(val, key)
+	if err != nil {
+		return err
+	}
+	v.Value = encrypted
+	v.Encrypted = true
+	return nil
+}
+
+// GetValue returns the version's value, decrypting it if necessary.
+func (v *ProjectVarVersion) GetValue(key string) (string, error) {
+	if v.ValueOmitted {
+		return "", errors.New("value was not kept because no encryption key was configured")
+	}
+	if !v.Encrypted {
+		return v.Value, nil
+	}
+	if key == "" {
+		return "", errors.New("value is encrypted but no encryption key is configured")
+	}
+	return decryptProjectVarValue(v.Value, key)
+}
+
+func projectVarsCipher(key string) (cipher.AEAD, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "creating cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	return gcm, errors.Wrap(err, "creating GCM")
+}
+
+func encryptProjectVarValue(val, key string) (string, error) {
+	gcm, err := projectVarsCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.Wrap(err, "generating nonce")
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(val), nil)), nil
+}
+
+func decryptProjectVarValue(encrypted, key string) (string, error) {
+	gcm, err := projectVarsCipher(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrap(err, "decoding encrypted value")
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	val, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypting value")
+	}
+	return string(val), nil
+}
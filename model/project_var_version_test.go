@@ -0,0 +1,188 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestProjectVarValueEncryption(t *testing.T) {
+	encrypted, err := encryptProjectVarValue("hunter2", "key")
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, "hunter2")
+
+	decrypted, err := decryptProjectVarValue(encrypted, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", decrypted)
+
+	_, err = decryptProjectVarValue(encrypted, "wrong_key")
+	assert.Error(t, err)
+
+	v := ProjectVarVersion{Private: true}
+	require.NoError(t, v.setValue("hunter2", ""))
+	assert.True(t, v.ValueOmitted)
+	assert.Empty(t, v.Value)
+	_, err = v.GetValue("key")
+	assert.Error(t, err, "omitted values cannot be read")
+}
+
+func TestRecordProjectVarVersions(t *testing.T) {
+	conf := evergreen.GetEnvironment().Settings().ProjectVars
+	require.NoError(t, conf.ValidateAndDefault())
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"RecordsOnlyChangedVars": func(t *testing.T) {
+			before := ProjectVars{Vars: map[string]string{"a": "1", "b": "2"}}
+			after := ProjectVars{Vars: map[string]string{"a": "1", "b": "3", "c": "4"}}
+			require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, before))
+			require.NoError(t, RecordProjectVarVersions("p", "me", before, after))
+
+			versions, err := FindProjectVarVersions("p", "a")
+			require.NoError(t, err)
+			require.Len(t, versions, 1)
+			assert.Equal(t, 1, versions[0].Version)
+
+			versions, err = FindProjectVarVersions("p", "b")
+			require.NoError(t, err)
+			require.Len(t, versions, 2)
+			assert.Equal(t, 2, versions[0].Version)
+			assert.Equal(t, "3", versions[0].Value)
+			assert.Equal(t, "me", versions[0].User)
+			assert.Equal(t, 1, versions[1].Version)
+			assert.Equal(t, "2", versions[1].Value)
+		},
+		"RecordsDeletions": func(t *testing.T) {
+			before := ProjectVars{Vars: map[string]string{"a": "1"}}
+			require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, before))
+			require.NoError(t, RecordProjectVarVersions("p", "me", before, ProjectVars{}))
+
+			versions, err := FindProjectVarVersions("p", "a")
+			require.NoError(t, err)
+			require.Len(t, versions, 2)
+			assert.True(t, versions[0].Deleted)
+			assert.Empty(t, versions[0].Value)
+		},
+		"EncryptsPrivateVars": func(t *testing.T) {
+			after := ProjectVars{
+				Vars:        map[string]string{"secret": "hunter2"},
+				PrivateVars: map[string]bool{"secret": true},
+			}
+			require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, after))
+
+			v, err := FindProjectVarVersion("p", "secret", 1)
+			require.NoError(t, err)
+			require.NotNil(t, v)
+			assert.True(t, v.Private)
+			assert.True(t, v.Encrypted)
+			assert.NotEqual(t, "hunter2", v.Value)
+			val, err := v.GetValue(conf.EncryptionKey)
+			require.NoError(t, err)
+			assert.Equal(t, "hunter2", val)
+		},
+		"RetriesConflictingVersion": func(t *testing.T) {
+			require.NoError(t, db.EnsureIndex(ProjectVarVersionsCollection, mongo.IndexModel{
+				Keys: bson.D{
+					{Key: projectVarVersionProjectIDKey, Value: 1},
+					{Key: projectVarVersionNameKey, Value: 1},
+					{Key: projectVarVersionVersionKey, Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			}))
+			require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, ProjectVars{Vars: map[string]string{"a": "1"}}))
+
+			v := ProjectVarVersion{ProjectID: "p", Name: "a", Value: "2"}
+			require.NoError(t, v.insert(0), "insert should retry after a concurrent change claimed its version")
+			assert.Equal(t, 2, v.Version)
+
+			versions, err := FindProjectVarVersions("p", "a")
+			require.NoError(t, err)
+			require.Len(t, versions, 2)
+			assert.Equal(t, "2", versions[0].Value)
+			assert.Equal(t, "1", versions[1].Value)
+		},
+		"PrunesOldVersions": func(t *testing.T) {
+			prev := ProjectVars{}
+			for i := 0; i < conf.VersionHistoryLimit+2; i++ {
+				next := ProjectVars{Vars: map[string]string{"a": string(rune('a' + i))}}
+				require.NoError(t, RecordProjectVarVersions("p", "me", prev, next))
+				prev = next
+			}
+
+			versions, err := FindProjectVarVersions("p", "a")
+			require.NoError(t, err)
+			require.Len(t, versions, conf.VersionHistoryLimit)
+			assert.Equal(t, conf.VersionHistoryLimit+2, versions[0].Version)
+			assert.Equal(t, 3, versions[len(versions)-1].Version)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(ProjectVarVersionsCollection))
+			require.NoError(t, db.DropAllIndexes(ProjectVarVersionsCollection))
+			tCase(t)
+		})
+	}
+}
+
+func TestRollbackProjectVar(t *testing.T) {
+	require.NoError(t, db.ClearCollections(ProjectVarsCollection, ProjectVarVersionsCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(ProjectVarsCollection, ProjectVarVersionsCollection))
+	}()
+
+	v1 := ProjectVars{
+		Id:          "p",
+		Vars:        map[string]string{"secret": "old"},
+		PrivateVars: map[string]bool{"secret": true},
+	}
+	v2 := ProjectVars{
+		Id:   "p",
+		Vars: map[string]string{"secret": "new"},
+	}
+	_, err := v2.Upsert()
+	require.NoError(t, err)
+	require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, v1))
+	require.NoError(t, RecordProjectVarVersions("p", "me", v1, v2))
+	require.NoError(t, RecordProjectVarVersions("p", "me", v2, ProjectVars{}))
+
+	require.NoError(t, RollbackProjectVar("p", "secret", 1))
+	vars, err := FindOneProjectVars("p")
+	require.NoError(t, err)
+	require.NotNil(t, vars)
+	assert.Equal(t, "old", vars.Vars["secret"])
+	assert.True(t, vars.PrivateVars["secret"])
+
+	assert.Error(t, RollbackProjectVar("p", "secret", 3), "deletions cannot be rolled back to")
+	assert.Error(t, RollbackProjectVar("p", "secret", 10), "nonexistent versions cannot be rolled back to")
+}
+
+func TestGetProjectVarVersionSnapshot(t *testing.T) {
+	require.NoError(t, db.ClearCollections(ProjectVarVersionsCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(ProjectVarVersionsCollection))
+	}()
+
+	repoVars := ProjectVars{Vars: map[string]string{"a": "repo", "b": "repo"}}
+	require.NoError(t, RecordProjectVarVersions("repo", "me", ProjectVars{}, repoVars))
+	projectBefore := ProjectVars{Vars: map[string]string{"a": "1", "c": "1"}}
+	projectAfter := ProjectVars{Vars: map[string]string{"a": "2"}}
+	require.NoError(t, RecordProjectVarVersions("p", "me", ProjectVars{}, projectBefore))
+	require.NoError(t, RecordProjectVarVersions("p", "me", projectBefore, projectAfter))
+
+	snapshot, err := GetProjectVarVersionSnapshot("p", "repo", []string{"a", "b", "c", "d"})
+	require.NoError(t, err)
+	assert.Equal(t, []task.VariableVersion{
+		{Name: "a", ProjectID: "p", Version: 2},
+		{Name: "b", ProjectID: "repo", Version: 1},
+	}, snapshot)
+
+	snapshot, err = GetProjectVarVersionSnapshot("p", "", nil)
+	require.NoError(t, err)
+	assert.Empty(t, snapshot)
+}
@@ -22,6 +22,7 @@ var (
 	projectVarsMapKey   = bsonutil.MustHaveTag(ProjectVars{}, "Vars")
 	privateVarsMapKey   = bsonutil.MustHaveTag(ProjectVars{}, "PrivateVars")
 	adminOnlyVarsMapKey = bsonutil.MustHaveTag(ProjectVars{}, "AdminOnlyVars")
+	externalVarsMapKey  = bsonutil.MustHaveTag(ProjectVars{}, "ExternalSecretVars")
 )
 
 const (
@@ -48,6 +49,11 @@ type ProjectVars struct {
 
 	// AdminOnlyVars keeps track of variables that are only accessible by project admins
 	AdminOnlyVars map[string]bool `bson:"admin_only_vars" json:"admin_only_vars"`
+
+	// ExternalSecretVars keeps track of variables whose values are references
+	// to secrets in an external secret store rather than the secrets
+	// themselves. They are resolved when a task fetches its variables.
+	ExternalSecretVars map[string]bool `bson:"external_secret_vars" json:"external_secret_vars"`
 }
 
 type AWSSSHKey struct {
@@ -212,6 +218,7 @@ func (projectVars *ProjectVars) Upsert() (*adb.ChangeInfo, error) {
 				projectVarsMapKey:   projectVars.Vars,
 				privateVarsMapKey:   projectVars.PrivateVars,
 				adminOnlyVarsMapKey: projectVars.AdminOnlyVars,
+				externalVarsMapKey:  projectVars.ExternalSecretVars,
 			},
 		},
 	)
@@ -229,7 +236,8 @@ func (projectVars *ProjectVars) FindAndModify(varsToDelete []string) (*adb.Chang
 	unsetUpdate := bson.M{}
 	update := bson.M{}
 	if len(projectVars.Vars) == 0 && len(projectVars.PrivateVars) == 0 &&
-		len(projectVars.AdminOnlyVars) == 0 && len(varsToDelete) == 0 {
+		len(projectVars.AdminOnlyVars) == 0 && len(projectVars.ExternalSecretVars) == 0 &&
+		len(varsToDelete) == 0 {
 		return nil, nil
 	}
 	for key, val := range projectVars.Vars {
@@ -241,6 +249,9 @@ func (projectVars *ProjectVars) FindAndModify(varsToDelete []string) (*adb.Chang
 	for key, val := range projectVars.AdminOnlyVars {
 		setUpdate[bsonutil.GetDottedKeyName(adminOnlyVarsMapKey, key)] = val
 	}
+	for key, val := range projectVars.ExternalSecretVars {
+		setUpdate[bsonutil.GetDottedKeyName(externalVarsMapKey, key)] = val
+	}
 	if len(setUpdate) > 0 {
 		update["$set"] = setUpdate
 	}
@@ -249,6 +260,7 @@ func (projectVars *ProjectVars) FindAndModify(varsToDelete []string) (*adb.Chang
 		unsetUpdate[bsonutil.GetDottedKeyName(projectVarsMapKey, val)] = 1
 		unsetUpdate[bsonutil.GetDottedKeyName(privateVarsMapKey, val)] = 1
 		unsetUpdate[bsonutil.GetDottedKeyName(adminOnlyVarsMapKey, val)] = 1
+		unsetUpdate[bsonutil.GetDottedKeyName(externalVarsMapKey, val)] = 1
 	}
 	if len(unsetUpdate) > 0 {
 		update["$unset"] = unsetUpdate
@@ -305,9 +317,10 @@ func (projectVars *ProjectVars) ShouldGetAdminOnlyVars(t *task.Task) bool {
 
 func (projectVars *ProjectVars) RedactPrivateVars() *ProjectVars {
 	res := &ProjectVars{
-		Vars:          map[string]string{},
-		PrivateVars:   map[string]bool{},
-		AdminOnlyVars: map[string]bool{},
+		Vars:               map[string]string{},
+		PrivateVars:        map[string]bool{},
+		AdminOnlyVars:      map[string]bool{},
+		ExternalSecretVars: map[string]bool{},
 	}
 	if projectVars == nil {
 		return res
@@ -333,6 +346,11 @@ func (projectVars *ProjectVars) RedactPrivateVars() *ProjectVars {
 		if val, ok := projectVars.AdminOnlyVars[k]; ok && val {
 			res.AdminOnlyVars[k] = projectVars.AdminOnlyVars[k]
 		}
+		// External secret references are not secret themselves, so they are
+		// not redacted unless they're also private.
+		if val, ok := projectVars.ExternalSecretVars[k]; ok && val {
+			res.ExternalSecretVars[k] = projectVars.ExternalSecretVars[k]
+		}
 	}
 
 	return res
@@ -368,6 +386,9 @@ func (projectVars *ProjectVars) MergeWithRepoVars(repoVars *ProjectVars) {
 	if projectVars.AdminOnlyVars == nil {
 		projectVars.AdminOnlyVars = map[string]bool{}
 	}
+	if projectVars.ExternalSecretVars == nil {
+		projectVars.ExternalSecretVars = map[string]bool{}
+	}
 	if repoVars == nil {
 		return
 	}
@@ -381,6 +402,9 @@ func (projectVars *ProjectVars) MergeWithRepoVars(repoVars *ProjectVars) {
 			if v, ok := repoVars.AdminOnlyVars[key]; ok {
 				projectVars.AdminOnlyVars[key] = v
 			}
+			if v, ok := repoVars.ExternalSecretVars[key]; ok {
+				projectVars.ExternalSecretVars[key] = v
+			}
 		}
 	}
 }
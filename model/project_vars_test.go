@@ -63,10 +63,11 @@ func TestFindMergedProjectVars(t *testing.T) {
 	require.NoError(t, project1.Insert())
 
 	repoVars := ProjectVars{
-		Id:            repo.Id,
-		Vars:          map[string]string{"hello": "world", "world": "hello", "beep": "boop", "admin": "only"},
-		PrivateVars:   map[string]bool{"world": true},
-		AdminOnlyVars: map[string]bool{"admin": true},
+		Id:                 repo.Id,
+		Vars:               map[string]string{"hello": "world", "world": "hello", "beep": "boop", "admin": "only", "secret": "vault:secret/data/app#password"},
+		PrivateVars:        map[string]bool{"world": true},
+		AdminOnlyVars:      map[string]bool{"admin": true},
+		ExternalSecretVars: map[string]bool{"secret": true},
 	}
 	project0Vars := ProjectVars{
 		Id:   project0.Id,
@@ -77,10 +78,11 @@ func TestFindMergedProjectVars(t *testing.T) {
 
 	// Testing merging of project vars and repo vars
 	expectedMergedVars := ProjectVars{
-		Id:            project0.Id,
-		Vars:          map[string]string{"hello": "world", "world": "goodbye", "beep": "boop", "new": "var", "admin": "only"},
-		PrivateVars:   map[string]bool{},
-		AdminOnlyVars: map[string]bool{"admin": true},
+		Id:                 project0.Id,
+		Vars:               map[string]string{"hello": "world", "world": "goodbye", "beep": "boop", "new": "var", "admin": "only", "secret": "vault:secret/data/app#password"},
+		PrivateVars:        map[string]bool{},
+		AdminOnlyVars:      map[string]bool{"admin": true},
+		ExternalSecretVars: map[string]bool{"secret": true},
 	}
 	mergedVars, err := FindMergedProjectVars(project0.Id)
 	assert.NoError(err)
@@ -187,18 +189,22 @@ func TestRedactPrivateVars(t *testing.T) {
 	vars := map[string]string{
 		"a": "a",
 		"b": "b",
+		"c": "vault:secret/data/app#password",
 	}
 	privateVars := map[string]bool{
 		"a": true,
 	}
 	projectVars := &ProjectVars{
-		Id:          "mongodb",
-		Vars:        vars,
-		PrivateVars: privateVars,
+		Id:                 "mongodb",
+		Vars:               vars,
+		PrivateVars:        privateVars,
+		ExternalSecretVars: map[string]bool{"c": true},
 	}
 	newVars := projectVars.RedactPrivateVars()
 	assert.Equal("", newVars.Vars["a"], "redacted variables should be empty strings")
 	assert.NotEqual("", projectVars.Vars["a"], "original vars should not be modified")
+	assert.Equal(vars["c"], newVars.Vars["c"], "external secret references should not be redacted")
+	assert.True(newVars.ExternalSecretVars["c"])
 }
 
 func TestGetVarsByValue(t *testing.T) {
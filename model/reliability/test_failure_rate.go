@@ -0,0 +1,108 @@
+package reliability
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// DefaultTestFailureRateRuns is the default number of recent mainline runs of
+// a task considered when computing the failure rate of its tests.
+const DefaultTestFailureRateRuns = 20
+
+// TestFailureRate is the historical failure rate of a single test in a task.
+type TestFailureRate struct {
+	TestName   string
+	FailedRuns int
+	TotalRuns  int
+}
+
+// Rate returns the fraction of runs in which the test failed.
+func (r TestFailureRate) Rate() float64 {
+	if r.TotalRuns == 0 {
+		return 0
+	}
+	return float64(r.FailedRuns) / float64(r.TotalRuns)
+}
+
+// GetTestFailureRates returns the failure rates of the given tests over the
+// most recent finished mainline runs of the given task, keyed by test name.
+// Every requested test is present in the result; tests that have not failed
+// in any of the runs have a failure rate of zero.
+func GetTestFailureRates(ctx context.Context, env evergreen.Environment, project, variant, taskName string, testNames []string, runs int) (map[string]TestFailureRate, error) {
+	if runs <= 0 {
+		runs = DefaultTestFailureRateRuns
+	}
+
+	tasks, err := task.FindAll(db.Query(bson.M{
+		task.ProjectKey:      project,
+		task.BuildVariantKey: variant,
+		task.DisplayNameKey:  taskName,
+		task.RequesterKey:    evergreen.RepotrackerVersionRequester,
+		task.StatusKey:       bson.M{"$in": evergreen.TaskCompletedStatuses},
+	}).Sort([]string{"-" + task.RevisionOrderNumberKey}).Limit(runs))
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding recent mainline runs of task '%s' in build variant '%s'", taskName, variant)
+	}
+
+	rates := make(map[string]TestFailureRate, len(testNames))
+	for _, name := range testNames {
+		rates[name] = TestFailureRate{TestName: name, TotalRuns: len(tasks)}
+	}
+	if len(tasks) == 0 || len(testNames) == 0 {
+		return rates, nil
+	}
+
+	// Test results of display tasks belong to their execution tasks, so
+	// track which run each set of results belongs to.
+	var allTaskOpts []testresult.TaskOptions
+	runIDs := map[string]string{}
+	for _, t := range tasks {
+		taskOpts, err := t.CreateTestResultsTaskOptions()
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating test results task options for task '%s'", t.Id)
+		}
+		for _, opts := range taskOpts {
+			runIDs[opts.TaskID] = t.Id
+		}
+		allTaskOpts = append(allTaskOpts, taskOpts...)
+	}
+	if len(allTaskOpts) == 0 {
+		return rates, nil
+	}
+
+	regexFilters := make([]string, 0, len(testNames))
+	for _, name := range testNames {
+		regexFilters = append(regexFilters, "^"+regexp.QuoteMeta(name)+"$")
+	}
+	samples, err := testresult.GetFailedTestSamples(ctx, env, allTaskOpts, regexFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting failed test samples")
+	}
+
+	failedRuns := map[string]map[string]bool{}
+	for _, sample := range samples {
+		for _, name := range sample.MatchingFailedTestNames {
+			if _, ok := rates[name]; !ok {
+				continue
+			}
+			if failedRuns[name] == nil {
+				failedRuns[name] = map[string]bool{}
+			}
+			failedRuns[name][runIDs[sample.TaskID]] = true
+		}
+	}
+	for name, runs := range failedRuns {
+		rate := rates[name]
+		rate.FailedRuns = len(runs)
+		rates[name] = rate
+	}
+
+	return rates, nil
+}
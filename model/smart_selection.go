@@ -0,0 +1,192 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// smartSelectionHistoryLimit is the maximum number of past patches that
+// changed the same files that are considered when ranking tasks.
+const smartSelectionHistoryLimit = 50
+
+// smartSelectPairs ranks the task/variant pairs selected by an alias by how
+// often they failed in past patches that changed the same files as the given
+// patch. It keeps the mandatory tasks and the top-ranked tasks, and returns
+// the rest as skipped tasks.
+func smartSelectPairs(ctx context.Context, env evergreen.Environment, patchDoc *patch.Patch, opts *SmartSelection, pairs TaskVariantPairs) (TaskVariantPairs, []patch.SkippedTask, error) {
+	files := patchDoc.FilesChanged()
+	if len(files) == 0 {
+		return pairs, nil, nil
+	}
+
+	scores, err := getTaskCoFailureScores(ctx, env, patchDoc, files)
+	if err != nil {
+		return pairs, nil, errors.Wrap(err, "getting task co-failure scores")
+	}
+
+	type candidate struct {
+		pair      TVPair
+		isDisplay bool
+	}
+	var selected TaskVariantPairs
+	var candidates []candidate
+	for _, pair := range pairs.ExecTasks {
+		if opts.isMandatoryTask(pair.TaskName) {
+			selected.ExecTasks = append(selected.ExecTasks, pair)
+			continue
+		}
+		candidates = append(candidates, candidate{pair: pair})
+	}
+	for _, pair := range pairs.DisplayTasks {
+		if opts.isMandatoryTask(pair.TaskName) {
+			selected.DisplayTasks = append(selected.DisplayTasks, pair)
+			continue
+		}
+		candidates = append(candidates, candidate{pair: pair, isDisplay: true})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		iScore, jScore := scores[candidates[i].pair], scores[candidates[j].pair]
+		if iScore != jScore {
+			return iScore > jScore
+		}
+		return candidates[i].pair.String() < candidates[j].pair.String()
+	})
+
+	var skipped []patch.SkippedTask
+	for i, c := range candidates {
+		if i < opts.MaxTasks {
+			if c.isDisplay {
+				selected.DisplayTasks = append(selected.DisplayTasks, c.pair)
+			} else {
+				selected.ExecTasks = append(selected.ExecTasks, c.pair)
+			}
+			continue
+		}
+
+		reason := fmt.Sprintf("did not fail in any of the recent patches that changed the same files, and only the top %d tasks are scheduled", opts.MaxTasks)
+		if score := scores[c.pair]; score > 0 {
+			reason = fmt.Sprintf("ranked %d of %d by co-failure with the changed files (score %d), and only the top %d tasks are scheduled", i+1, len(candidates), score, opts.MaxTasks)
+		}
+		skipped = append(skipped, patch.SkippedTask{
+			Variant:  c.pair.Variant,
+			TaskName: c.pair.TaskName,
+			Reason:   reason,
+		})
+	}
+
+	return selected, skipped, nil
+}
+
+// getTaskCoFailureScores scores each task/variant pair by how often it failed
+// in recent patches that changed any of the given files. A task scores a point
+// for each of those patches in which it failed, and another point if any of
+// its failed tests are named after one of the changed files.
+func getTaskCoFailureScores(ctx context.Context, env evergreen.Environment, patchDoc *patch.Patch, files []string) (map[TVPair]int, error) {
+	pastPatches, err := patch.Find(db.Query(bson.M{
+		patch.IdKey:      bson.M{"$ne": patchDoc.Id},
+		patch.ProjectKey: patchDoc.Project,
+		patch.VersionKey: bson.M{"$exists": true, "$ne": ""},
+		bsonutil.GetDottedKeyName(patch.PatchesKey, patch.ModulePatchSetKey, patch.PatchSetSummaryKey, patch.SummaryNameKey): bson.M{"$in": files},
+	}).WithFields(patch.VersionKey).Sort([]string{"-" + patch.CreateTimeKey}).Limit(smartSelectionHistoryLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding past patches that changed the same files")
+	}
+	scores := map[TVPair]int{}
+	if len(pastPatches) == 0 {
+		return scores, nil
+	}
+
+	versions := make([]string, 0, len(pastPatches))
+	for _, p := range pastPatches {
+		versions = append(versions, p.Version)
+	}
+	failedTasks, err := task.FindAll(db.Query(bson.M{
+		task.VersionKey: bson.M{"$in": versions},
+		task.StatusKey:  evergreen.TaskFailed,
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding failed tasks in past patches")
+	}
+
+	displayPairs := map[string]TVPair{}
+	for _, t := range failedTasks {
+		pair := TVPair{Variant: t.BuildVariant, TaskName: t.DisplayName}
+		scores[pair]++
+		for _, execTaskID := range t.ExecutionTasks {
+			displayPairs[execTaskID] = pair
+		}
+	}
+
+	testNameFilters := changedFileTestNameFilters(files)
+	if len(testNameFilters) == 0 {
+		return scores, nil
+	}
+	var taskOpts []testresult.TaskOptions
+	pairsByTaskID := map[string]TVPair{}
+	for _, t := range failedTasks {
+		if t.DisplayOnly || !t.HasResults() {
+			continue
+		}
+		opts, err := t.CreateTestResultsTaskOptions()
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating test results task options for task '%s'", t.Id)
+		}
+		taskOpts = append(taskOpts, opts...)
+		pairsByTaskID[t.Id] = TVPair{Variant: t.BuildVariant, TaskName: t.DisplayName}
+	}
+	if len(taskOpts) == 0 {
+		return scores, nil
+	}
+	samples, err := testresult.GetFailedTestSamples(ctx, env, taskOpts, testNameFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting failed test samples")
+	}
+	for _, sample := range samples {
+		if len(sample.MatchingFailedTestNames) == 0 {
+			continue
+		}
+		if pair, ok := pairsByTaskID[sample.TaskID]; ok {
+			scores[pair]++
+		}
+		if pair, ok := displayPairs[sample.TaskID]; ok {
+			scores[pair]++
+		}
+	}
+
+	return scores, nil
+}
+
+// changedFileTestNameFilters returns regexes matching the names of tests that
+// are named after the changed files, ignoring their directories and
+// extensions.
+func changedFileTestNameFilters(files []string) []string {
+	// Very short file names match too many unrelated tests to be useful.
+	const minNameLength = 3
+
+	seen := map[string]bool{}
+	var filters []string
+	for _, file := range files {
+		name := path.Base(file)
+		name = strings.TrimSuffix(name, path.Ext(name))
+		if len(name) < minNameLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+		filters = append(filters, regexp.QuoteMeta(name))
+	}
+	return filters
+}
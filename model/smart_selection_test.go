@@ -0,0 +1,109 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartSelectPairs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	makePatch := func(version string, files ...string) patch.Patch {
+		var summaries []thirdparty.Summary
+		for _, f := range files {
+			summaries = append(summaries, thirdparty.Summary{Name: f})
+		}
+		return patch.Patch{
+			Id:         mgobson.NewObjectId(),
+			Project:    "project",
+			Version:    version,
+			CreateTime: time.Now(),
+			Patches:    []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: summaries}}},
+		}
+	}
+	pairs := TaskVariantPairs{
+		ExecTasks: TVPairSet{
+			{Variant: "bv", TaskName: "unit"},
+			{Variant: "bv", TaskName: "integration"},
+			{Variant: "bv", TaskName: "docs"},
+			{Variant: "bv", TaskName: "lint"},
+		},
+	}
+	opts := &SmartSelection{MaxTasks: 1, MandatoryTasks: []string{"^lint$"}}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"RanksTasksByCoFailure": func(t *testing.T) {
+			for _, p := range []patch.Patch{
+				makePatch("v1", "src/foo.go"),
+				makePatch("v2", "src/foo.go", "src/bar.go"),
+				makePatch("v3", "src/other.go"),
+			} {
+				require.NoError(t, p.Insert())
+			}
+			for _, tsk := range []task.Task{
+				{Id: "t1", Version: "v1", BuildVariant: "bv", DisplayName: "integration", Status: evergreen.TaskFailed},
+				{Id: "t2", Version: "v2", BuildVariant: "bv", DisplayName: "integration", Status: evergreen.TaskFailed},
+				{Id: "t3", Version: "v2", BuildVariant: "bv", DisplayName: "unit", Status: evergreen.TaskFailed},
+				{Id: "t4", Version: "v1", BuildVariant: "bv", DisplayName: "unit", Status: evergreen.TaskSucceeded},
+				{Id: "t5", Version: "v3", BuildVariant: "bv", DisplayName: "docs", Status: evergreen.TaskFailed},
+			} {
+				require.NoError(t, tsk.Insert())
+			}
+
+			current := makePatch("", "src/foo.go")
+			selected, skipped, err := smartSelectPairs(ctx, env, &current, opts, pairs)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, TVPairSet{
+				{Variant: "bv", TaskName: "integration"},
+				{Variant: "bv", TaskName: "lint"},
+			}, selected.ExecTasks)
+
+			require.Len(t, skipped, 2)
+			assert.Equal(t, "unit", skipped[0].TaskName)
+			assert.Contains(t, skipped[0].Reason, "ranked 2 of 3")
+			assert.Equal(t, "docs", skipped[1].TaskName, "tasks that only failed for other files should not be ranked")
+			assert.Contains(t, skipped[1].Reason, "did not fail")
+		},
+		"KeepsAllTasksWithoutChangedFiles": func(t *testing.T) {
+			current := makePatch("")
+			selected, skipped, err := smartSelectPairs(ctx, env, &current, opts, pairs)
+			require.NoError(t, err)
+			assert.Equal(t, pairs, selected)
+			assert.Empty(t, skipped)
+		},
+		"SkipsUnrankedTasksWithoutHistory": func(t *testing.T) {
+			current := makePatch("", "src/foo.go")
+			selected, skipped, err := smartSelectPairs(ctx, env, &current, opts, pairs)
+			require.NoError(t, err)
+			assert.Len(t, selected.ExecTasks, 2)
+			assert.Len(t, skipped, 2)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(patch.Collection, task.Collection))
+			tCase(t)
+		})
+	}
+}
+
+func TestChangedFileTestNameFilters(t *testing.T) {
+	filters := changedFileTestNameFilters([]string{
+		"src/foo_test.go",
+		"other/foo_test.go",
+		"src/a.go",
+		"docs/index.v2.md",
+	})
+	assert.Equal(t, []string{"foo_test", `index\.v2`}, filters)
+}
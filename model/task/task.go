@@ -113,6 +113,10 @@ type Task struct {
 
 	BuildId  string `bson:"build_id" json:"build_id"`
 	DistroId string `bson:"distro" json:"distro"`
+	// ImageRolloutID is the distro image rollout that this task is a canary
+	// for. While it's set, the task only runs on the rollout's candidate
+	// hosts.
+	ImageRolloutID string `bson:"image_rollout_id,omitempty" json:"image_rollout_id,omitempty"`
 	// Container is the name of the container configuration for running a
 	// container task.
 	Container string `bson:"container,omitempty" json:"container,omitempty"`
@@ -241,6 +245,9 @@ type Task struct {
 	LatestParentExecution int      `bson:"latest_parent_execution" json:"latest_parent_execution"`
 
 	StepbackInfo *StepbackInfo `bson:"stepback_info,omitempty" json:"stepback_info,omitempty"`
+	// StepbackCulprit is the commit that bisect stepback determined caused
+	// this task to start failing.
+	StepbackCulprit *StepbackCulprit `bson:"stepback_culprit,omitempty" json:"stepback_culprit,omitempty"`
 
 	// ResetWhenFinished indicates that a task should be reset once it is
 	// finished running. This is typically to deal with tasks that should be
@@ -287,6 +294,18 @@ type Task struct {
 	// before its build or version can be reported as successful, but tasks
 	// manually scheduled by the user afterwards are not required.
 	IsEssentialToSucceed bool `bson:"is_essential_to_succeed" json:"is_essential_to_succeed"`
+
+	// ProjectVarVersions are the versions of the project variables that the
+	// task ran with, recorded when the task fetches its variables.
+	ProjectVarVersions []VariableVersion `bson:"project_var_versions,omitempty" json:"project_var_versions,omitempty"`
+}
+
+// VariableVersion identifies a version of a project variable.
+type VariableVersion struct {
+	Name string `bson:"name" json:"name"`
+	// ProjectID is the project or repo that the variable belongs to.
+	ProjectID string `bson:"project_id" json:"project_id"`
+	Version   int    `bson:"version" json:"version"`
 }
 
 // StepbackInfo helps determine which task to bisect to when performing stepback.
@@ -298,6 +317,25 @@ type StepbackInfo struct {
 	// NextStepbackTaskId stores the next task id to stepback to when doing bisect stepback. This
 	// is the middle of LastFailingStepbackTaskId and LastPassingStepbackTaskId.
 	NextStepbackTaskId string `bson:"next_stepback_task_id,omitempty" json:"next_stepback_task_id"`
+	// FirstFailingStepbackTaskId stores the failing task that started stepback.
+	FirstFailingStepbackTaskId string `bson:"first_failing_stepback_task_id,omitempty" json:"first_failing_stepback_task_id"`
+	// TestName is the failing test that stepback tracks, if any. If set,
+	// stepback tasks are judged by whether this test failed rather than by
+	// the task's outcome.
+	TestName string `bson:"test_name,omitempty" json:"test_name,omitempty"`
+}
+
+// StepbackCulprit identifies the commit that bisect stepback determined
+// caused a task to start failing.
+type StepbackCulprit struct {
+	// TaskId is the first failing run of the task.
+	TaskId string `bson:"task_id" json:"task_id"`
+	// VersionId is the version of the first failing run of the task.
+	VersionId string `bson:"version_id" json:"version_id"`
+	// Revision is the commit that caused the task to start failing.
+	Revision string `bson:"revision" json:"revision"`
+	// TestName is the test that stepback tracked, if any.
+	TestName string `bson:"test_name,omitempty" json:"test_name,omitempty"`
 }
 
 // ExecutionPlatform indicates the type of environment that the task runs in.
@@ -470,6 +508,12 @@ func (t *Task) IsHostDispatchable() bool {
 	return t.IsHostTask() && t.WillRun()
 }
 
+// CanRunOnHost returns whether the task can run on the host. Canary tasks for
+// a distro image rollout can only run on the rollout's candidate hosts.
+func (t *Task) CanRunOnHost(imageRolloutID string) bool {
+	return t.ImageRolloutID == "" || t.ImageRolloutID == imageRolloutID
+}
+
 // IsHostTask returns true if it's a task that runs on hosts.
 func (t *Task) IsHostTask() bool {
 	return (t.ExecutionPlatform == "" || t.ExecutionPlatform == ExecutionPlatformHost) && !t.DisplayOnly
@@ -1253,6 +1297,29 @@ func (t *Task) SetGeneratedTasksToActivate(buildVariantName, taskName string) er
 	)
 }
 
+// SetImageRolloutCanaries marks the tasks as canary tasks for the distro image
+// rollout, so that they only run on the rollout's candidate hosts.
+func SetImageRolloutCanaries(taskIDs []string, rolloutID string) error {
+	_, err := UpdateAll(
+		bson.M{IdKey: bson.M{"$in": taskIDs}},
+		bson.M{"$set": bson.M{ImageRolloutIDKey: rolloutID}},
+	)
+	return errors.Wrapf(err, "marking tasks as canary tasks for image rollout '%s'", rolloutID)
+}
+
+// UnsetImageRolloutCanaries releases the canary tasks for the distro image
+// rollout, so that they can run on any of their distro's hosts again.
+func UnsetImageRolloutCanaries(taskIDs []string, rolloutID string) error {
+	_, err := UpdateAll(
+		bson.M{
+			IdKey:             bson.M{"$in": taskIDs},
+			ImageRolloutIDKey: rolloutID,
+		},
+		bson.M{"$unset": bson.M{ImageRolloutIDKey: 1}},
+	)
+	return errors.Wrapf(err, "releasing canary tasks for image rollout '%s'", rolloutID)
+}
+
 // SetTasksScheduledTime takes a list of tasks and a time, and then sets
 // the scheduled time in the database for the tasks if it is currently unset
 func SetTasksScheduledTime(tasks []Task, scheduledTime time.Time) error {
@@ -1518,6 +1585,31 @@ func (t *Task) SetStepbackInfo(s StepbackInfo) error {
 		})
 }
 
+// SetStepbackCulprit records the culprit on the culprit task and on the later
+// failed mainline runs of the same task up to and including the given revision
+// order number.
+func SetStepbackCulprit(culpritTask *Task, lastOrder int, culprit StepbackCulprit) error {
+	if lastOrder < culpritTask.RevisionOrderNumber {
+		lastOrder = culpritTask.RevisionOrderNumber
+	}
+	_, err := UpdateAll(
+		bson.M{
+			ProjectKey:             culpritTask.Project,
+			BuildVariantKey:        culpritTask.BuildVariant,
+			DisplayNameKey:         culpritTask.DisplayName,
+			RequesterKey:           culpritTask.Requester,
+			StatusKey:              evergreen.TaskFailed,
+			RevisionOrderNumberKey: bson.M{"$gte": culpritTask.RevisionOrderNumber, "$lte": lastOrder},
+		},
+		bson.M{"$set": bson.M{StepbackCulpritKey: culprit}},
+	)
+	if err != nil {
+		return err
+	}
+	culpritTask.StepbackCulprit = &culprit
+	return nil
+}
+
 // initializeTaskOutputInfo returns the task output information with the most
 // up-to-date configuration for the task run. Returns false if the task will
 // never have output. This function should only be used to set the task output
@@ -1615,6 +1707,19 @@ func (t *Task) GetTestLogs(ctx context.Context, env evergreen.Environment, getOp
 	return output.TestLogs.Get(ctx, env, taskOpts, getOpts)
 }
 
+// SetProjectVarVersions records the versions of the project variables that the
+// task ran with.
+func (t *Task) SetProjectVarVersions(versions []VariableVersion) error {
+	if err := UpdateOne(
+		bson.M{IdKey: t.Id},
+		bson.M{"$set": bson.M{ProjectVarVersionsKey: versions}},
+	); err != nil {
+		return err
+	}
+	t.ProjectVarVersions = versions
+	return nil
+}
+
 // SetResultsInfo sets the task's test results info.
 //
 // Note that if failedResults is false, ResultsFailed is not set. This is
@@ -2203,6 +2308,7 @@ func resetTaskUpdate(t *Task) []bson.M {
 		t.OverrideDependencies = false
 		t.ContainerAllocationAttempts = 0
 		t.CanReset = false
+		t.StepbackCulprit = nil
 	}
 	update := []bson.M{
 		{
@@ -2242,6 +2348,7 @@ func resetTaskUpdate(t *Task) []bson.M {
 				HostCreateDetailsKey,
 				OverrideDependenciesKey,
 				CanResetKey,
+				StepbackCulpritKey,
 			},
 		},
 	}
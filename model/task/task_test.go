@@ -2778,6 +2778,17 @@ func TestIsHostDispatchable(t *testing.T) {
 	}
 }
 
+func TestCanRunOnHost(t *testing.T) {
+	tsk := Task{Id: "task-id"}
+	assert.True(t, tsk.CanRunOnHost(""))
+	assert.True(t, tsk.CanRunOnHost("rollout"))
+
+	tsk.ImageRolloutID = "rollout"
+	assert.False(t, tsk.CanRunOnHost(""), "canary task should not run on a host that uses the distro's current image")
+	assert.False(t, tsk.CanRunOnHost("other_rollout"))
+	assert.True(t, tsk.CanRunOnHost("rollout"))
+}
+
 func TestIsContainerDispatchable(t *testing.T) {
 	for tName, tCase := range map[string]func(t *testing.T, tsk Task){
 		"ReturnsTrueForExpectedTask": func(t *testing.T, tsk Task) {
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
 	adb "github.com/mongodb/anser/db"
@@ -599,25 +601,43 @@ func doBisectStepback(ctx context.Context, t *task.Task) error {
 			return nil
 		}
 		s = task.StepbackInfo{
-			LastPassingStepbackTaskId: lastPassing.Id,
+			LastPassingStepbackTaskId:  lastPassing.Id,
+			FirstFailingStepbackTaskId: t.Id,
+		}
+		if t.Status == evergreen.TaskFailed {
+			// Track a specific failing test so that stepback finds the
+			// commit that broke that test, even if the task fails for
+			// other reasons in between.
+			failedTests, err := t.GetFailedTestSample(ctx, evergreen.GetEnvironment())
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message":    "could not get failed tests for bisect stepback, falling back to the task outcome",
+				"task_id":    t.Id,
+				"project_id": t.Project,
+			}))
+			if len(failedTests) > 0 {
+				s.TestName = failedTests[0]
+			}
 		}
 		grip.Info(message.Fields{
 			"message":                       "starting bisect stepback",
 			"last_passing_stepback_task_id": s.LastPassingStepbackTaskId,
 			"task_id":                       t.Id,
+			"test_name":                     s.TestName,
 			"gap":                           t.RevisionOrderNumber - lastPassing.RevisionOrderNumber,
 			"project_id":                    t.Project,
 		})
 	}
 
-	// Depending on the task status, we want to update the
+	// Depending on the task outcome, we want to update the
 	// last failing or last passing task.
-	if t.Status == evergreen.TaskSucceeded {
-		s.LastPassingStepbackTaskId = t.Id
-	} else if t.Status == evergreen.TaskFailed {
+	failed, err := isFailingStepbackTask(ctx, t, s.TestName)
+	if err != nil {
+		return errors.Wrapf(err, "determining stepback outcome of task '%s'", t.Id)
+	}
+	if failed {
 		s.LastFailingStepbackTaskId = t.Id
 	} else {
-		return errors.Errorf("stopping task stepback due to status '%s'", t.Status)
+		s.LastPassingStepbackTaskId = t.Id
 	}
 
 	// The midway task is our next stepback target.
@@ -628,9 +648,10 @@ func doBisectStepback(ctx context.Context, t *task.Task) error {
 	if nextTask == nil {
 		return errors.Errorf("midway task could not be found for tasks '%s' '%s'", s.LastFailingStepbackTaskId, s.LastPassingStepbackTaskId)
 	}
-	// If our next task is last passing Id, we have finished stepback.
-	if nextTask.Id == s.LastPassingStepbackTaskId {
-		return nil
+	// If there is no task between the last failing and last passing tasks,
+	// we have finished stepback and the last failing task is the culprit.
+	if nextTask.Id == s.LastPassingStepbackTaskId || nextTask.Id == s.LastFailingStepbackTaskId {
+		return errors.Wrap(recordStepbackCulprit(s), "recording stepback culprit")
 	}
 	// If the next task has finished, negative priority, or already activated, no-op.
 	if nextTask.IsFinished() || nextTask.Priority < 0 || nextTask.Activated {
@@ -673,6 +694,97 @@ func doBisectStepback(ctx context.Context, t *task.Task) error {
 	return nil
 }
 
+// isFailingStepbackTask returns whether the task counts as failing for bisect
+// stepback. If stepback tracks a test, a failed task only counts as failing if
+// that test failed or if the task has no test results to judge by.
+func isFailingStepbackTask(ctx context.Context, t *task.Task, testName string) (bool, error) {
+	switch t.Status {
+	case evergreen.TaskSucceeded:
+		return false, nil
+	case evergreen.TaskFailed:
+	default:
+		return false, errors.Errorf("stopping task stepback due to status '%s'", t.Status)
+	}
+	if testName == "" {
+		return true, nil
+	}
+
+	env := evergreen.GetEnvironment()
+	taskOpts, err := t.CreateTestResultsTaskOptions()
+	if err != nil {
+		return false, errors.Wrap(err, "creating test results task options")
+	}
+	if len(taskOpts) == 0 {
+		return true, nil
+	}
+	samples, err := testresult.GetFailedTestSamples(ctx, env, taskOpts, []string{"^" + regexp.QuoteMeta(testName) + "$"})
+	if err != nil {
+		return false, errors.Wrap(err, "getting failed test samples")
+	}
+	for _, sample := range samples {
+		if len(sample.MatchingFailedTestNames) > 0 {
+			return true, nil
+		}
+	}
+
+	stats, err := testresult.GetMergedTaskTestResultsStats(ctx, env, taskOpts)
+	if err != nil {
+		return false, errors.Wrap(err, "getting test results stats")
+	}
+	return stats.TotalCount == 0, nil
+}
+
+// recordStepbackCulprit records the culprit that bisect stepback converged on
+// and notifies subscribers that it was identified.
+func recordStepbackCulprit(s task.StepbackInfo) error {
+	culpritTask, err := task.FindOneId(s.LastFailingStepbackTaskId)
+	if err != nil {
+		return errors.Wrapf(err, "finding culprit task '%s'", s.LastFailingStepbackTaskId)
+	}
+	if culpritTask == nil {
+		return errors.Errorf("culprit task '%s' not found", s.LastFailingStepbackTaskId)
+	}
+	if culpritTask.StepbackCulprit != nil && culpritTask.StepbackCulprit.TaskId == culpritTask.Id {
+		// The culprit was already identified.
+		return nil
+	}
+
+	lastOrder := culpritTask.RevisionOrderNumber
+	if s.FirstFailingStepbackTaskId != "" {
+		firstFailing, err := task.FindOneId(s.FirstFailingStepbackTaskId)
+		if err != nil {
+			return errors.Wrapf(err, "finding task '%s' that started stepback", s.FirstFailingStepbackTaskId)
+		}
+		if firstFailing != nil {
+			lastOrder = firstFailing.RevisionOrderNumber
+		}
+	}
+
+	culprit := task.StepbackCulprit{
+		TaskId:    culpritTask.Id,
+		VersionId: culpritTask.Version,
+		Revision:  culpritTask.Revision,
+		TestName:  s.TestName,
+	}
+	if err = task.SetStepbackCulprit(culpritTask, lastOrder, culprit); err != nil {
+		return errors.Wrap(err, "setting stepback culprit")
+	}
+	if err = AddStepbackCulprit(culpritTask.Version, culprit); err != nil {
+		return errors.Wrapf(err, "recording stepback culprit on version '%s'", culpritTask.Version)
+	}
+	event.LogTaskStepbackCulpritIdentified(culpritTask.Id, culpritTask.Execution)
+
+	grip.Info(message.Fields{
+		"message":    "bisect stepback identified culprit",
+		"task_id":    culpritTask.Id,
+		"version_id": culpritTask.Version,
+		"revision":   culpritTask.Revision,
+		"test_name":  s.TestName,
+		"project_id": culpritTask.Project,
+	})
+	return nil
+}
+
 // MarkEnd updates the task as being finished, performs a stepback if necessary, and updates the build status
 func MarkEnd(ctx context.Context, settings *evergreen.Settings, t *task.Task, caller string, finishTime time.Time, detail *apimodels.TaskEndDetail,
 	deactivatePrevious bool) error {
@@ -1115,6 +1227,11 @@ func HandleEndTaskForCommitQueueTask(ctx context.Context, t *task.Task, status s
 				foundVersion = true
 				continue
 			}
+			if !item.IsBatchTip() {
+				// The item's own tasks don't run because a later item in
+				// its batch tests it.
+				continue
+			}
 			if foundVersion {
 				laterTask, err := task.FindTaskForVersion(item.Version, t.DisplayName, t.BuildVariant)
 				if err != nil {
@@ -1141,10 +1258,17 @@ func HandleEndTaskForCommitQueueTask(ctx context.Context, t *task.Task, status s
 // dequeueAndRestartWithStepback dequeues the current task and restarts later tasks, if earlier tasks have all run.
 // Otherwise, the failure may be a result of those untested commits so we will wait for the earlier tasks to run
 // and handle dequeuing (merge still won't run for failed task versions because of dependencies).
+// If the task only failed on known flaky tests, it's retried once before the
+// item is dequeued. If the task's version tests a batch of items, the batch is
+// bisected instead of dequeueing the item, since any item in the batch could
+// have caused the failure.
 func dequeueAndRestartWithStepback(ctx context.Context, cq *commitqueue.CommitQueue, t *task.Task, caller, reason string) error {
 	if i := cq.FindItem(t.Version); i > 0 {
 		prevVersions := []string{}
 		for j := 0; j < i; j++ {
+			if !cq.Queue[j].IsBatchTip() {
+				continue
+			}
 			prevVersions = append(prevVersions, cq.Queue[j].Version)
 		}
 		// if any of the commit queue tasks higher on the queue haven't finished, then they will handle dequeuing.
@@ -1157,6 +1281,32 @@ func dequeueAndRestartWithStepback(ctx context.Context, cq *commitqueue.CommitQu
 		}
 		// Otherwise, continue on and dequeue.
 	}
+	if !t.CommitQueueMerge {
+		flakyTests, err := getFlakyTestFailures(ctx, t)
+		grip.Error(message.WrapError(err, message.Fields{
+			"message": "could not check commit queue task for flaky test failures",
+			"project": t.Project,
+			"task":    t.Id,
+		}))
+		if len(flakyTests) > 0 {
+			retried, err := retryCommitQueueTaskForFlakyTests(ctx, cq, t, flakyTests, caller)
+			if err != nil {
+				return errors.Wrap(err, "retrying commit queue task for flaky tests")
+			}
+			if retried {
+				return nil
+			}
+			reason = flakyTestsDequeueReason(t, flakyTests)
+		}
+
+		bisected, err := bisectCommitQueueBatch(ctx, cq, t.Version, caller)
+		if err != nil {
+			return errors.Wrap(err, "bisecting commit queue batch")
+		}
+		if bisected {
+			return nil
+		}
+	}
 	return DequeueAndRestartForTask(ctx, cq, t, message.GithubStateFailure, caller, reason)
 }
 
@@ -1709,6 +1859,14 @@ func UpdatePatchStatus(p *patch.Patch, status string) error {
 // and the task's version based on all the builds in the version.
 // Also update build and version Github statuses based on the subset of tasks and builds included in github checks
 func UpdateBuildAndVersionStatusForTask(ctx context.Context, t *task.Task) error {
+	// The merge check can finish before the build does, so check it before
+	// the build status.
+	grip.Error(message.WrapError(UpdateMergeQueueRequiredStatusForTask(t), message.Fields{
+		"message": "could not update merge queue required status",
+		"task_id": t.Id,
+		"version": t.Version,
+	}))
+
 	taskBuild, err := build.FindOneId(t.BuildId)
 	if err != nil {
 		return errors.Wrapf(err, "getting build for task '%s'", t.Id)
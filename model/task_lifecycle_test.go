@@ -6210,9 +6210,99 @@ tasks:
 			assert.NoError(err)
 			assert.True(midTask.Activated)
 		},
+		"ConvergenceRecordsCulprit": func(t *testing.T, t10 task.Task) {
+			require.NoError(t, task.UpdateOne(
+				bson.M{"_id": "t2"},
+				bson.M{"$set": bson.M{"status": evergreen.TaskFailed}},
+			))
+			t2, err := task.FindOneId("t2")
+			require.NoError(t, err)
+			require.NotNil(t, t2)
+			require.NoError(t, t2.SetStepbackInfo(task.StepbackInfo{
+				LastFailingStepbackTaskId:  "t3",
+				LastPassingStepbackTaskId:  "t1",
+				NextStepbackTaskId:         "t2",
+				FirstFailingStepbackTaskId: "t10",
+			}))
+
+			require.NoError(t, evalStepback(ctx, t2, "", evergreen.TaskFailed, false))
+
+			expected := task.StepbackCulprit{TaskId: "t2", VersionId: "sample_version"}
+			for _, id := range []string{"t2", "t10"} {
+				dbTask, err := task.FindOneId(id)
+				require.NoError(t, err)
+				require.NotNil(t, dbTask)
+				require.NotNil(t, dbTask.StepbackCulprit, id)
+				assert.Equal(expected, *dbTask.StepbackCulprit)
+			}
+			dbTask, err := task.FindOneId("t5")
+			require.NoError(t, err)
+			assert.Nil(dbTask.StepbackCulprit, "unfinished tasks should not have a culprit")
+
+			v, err := VersionFindOneId("sample_version")
+			require.NoError(t, err)
+			require.NotNil(t, v)
+			assert.Equal([]task.StepbackCulprit{expected}, v.StepbackCulprits)
+
+			events, err := event.Find(event.TaskEventsForId("t2"))
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(event.TaskStepbackCulpritIdentified, events[0].EventType)
+
+			// Converging again should not notify again.
+			require.NoError(t, evalStepback(ctx, t2, "", evergreen.TaskFailed, false))
+			events, err = event.Find(event.TaskEventsForId("t2"))
+			require.NoError(t, err)
+			assert.Len(events, 1)
+		},
+		"TracksFailingTest": func(t *testing.T, t10 task.Task) {
+			env := evergreen.GetEnvironment()
+			require.NoError(t, testresult.ClearLocal(ctx, env))
+			defer func() {
+				assert.NoError(testresult.ClearLocal(ctx, env))
+			}()
+			require.NoError(t, task.UpdateOne(
+				bson.M{"_id": "t10"},
+				bson.M{"$set": bson.M{task.ResultsServiceKey: testresult.TestResultsServiceLocal}},
+			))
+			require.NoError(t, testresult.InsertLocal(ctx, env, testresult.TestResult{TaskID: "t10", TestName: "broken_test", Status: evergreen.TestFailedStatus}))
+			t10.ResultsService = testresult.TestResultsServiceLocal
+
+			require.NoError(t, evalStepback(ctx, &t10, "", evergreen.TaskFailed, false))
+			midTask, err := task.FindMidwayTaskFromIds("t1", "t10")
+			require.NoError(t, err)
+			require.True(t, midTask.Activated)
+			require.NotNil(t, midTask.StepbackInfo)
+			assert.Equal("broken_test", midTask.StepbackInfo.TestName)
+			assert.Equal("t10", midTask.StepbackInfo.FirstFailingStepbackTaskId)
+
+			// The midway task failed, but not on the tracked test, so
+			// stepback treats it as passing and moves toward newer commits.
+			require.NoError(t, task.UpdateOne(
+				bson.M{"_id": midTask.Id},
+				bson.M{"$set": bson.M{
+					task.StatusKey:         evergreen.TaskFailed,
+					task.ResultsServiceKey: testresult.TestResultsServiceLocal,
+				}},
+			))
+			require.NoError(t, testresult.InsertLocal(ctx, env,
+				testresult.TestResult{TaskID: midTask.Id, TestName: "broken_test", Status: evergreen.TestSucceededStatus},
+				testresult.TestResult{TaskID: midTask.Id, TestName: "other_test", Status: evergreen.TestFailedStatus},
+			))
+			midTask, err = task.FindOneId(midTask.Id)
+			require.NoError(t, err)
+			require.NoError(t, evalStepback(ctx, midTask, "", evergreen.TaskFailed, false))
+
+			nextTask, err := task.FindMidwayTaskFromIds(midTask.Id, "t10")
+			require.NoError(t, err)
+			assert.True(nextTask.Activated)
+			require.NotNil(t, nextTask.StepbackInfo)
+			assert.Equal(midTask.Id, nextTask.StepbackInfo.LastPassingStepbackTaskId)
+			assert.Equal("t10", nextTask.StepbackInfo.LastFailingStepbackTaskId)
+		},
 	} {
 		t.Run(tName, func(t *testing.T) {
-			assert.NoError(db.ClearCollections(task.Collection, ProjectRefCollection, ParserProjectCollection, distro.Collection, build.Collection, VersionCollection))
+			assert.NoError(db.ClearCollections(task.Collection, ProjectRefCollection, ParserProjectCollection, distro.Collection, build.Collection, VersionCollection, event.EventCollection))
 			proj := ProjectRef{
 				Id:             "proj",
 				StepbackBisect: utility.ToBoolPtr(true),
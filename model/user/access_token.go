@@ -0,0 +1,245 @@
+package user
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	AccessTokensCollection = "personal_access_tokens"
+
+	// AccessTokenPrefix is the prefix of every personal access token, which
+	// distinguishes them from users' API keys.
+	AccessTokenPrefix = "evgpat_"
+
+	// AccessTokenScopeReadOnly allows only requests that read data.
+	AccessTokenScopeReadOnly = "read-only"
+	// AccessTokenScopePatchSubmit allows reading data as well as creating and
+	// modifying patches.
+	AccessTokenScopePatchSubmit = "patch-submit"
+	// AccessTokenScopeAll allows everything that the user can do.
+	AccessTokenScopeAll = "all"
+
+	// MaxAccessTokenLifetime is the longest that a personal access token can
+	// be valid for.
+	MaxAccessTokenLifetime = 365 * 24 * time.Hour
+	// MaxAccessTokensPerUser is the maximum number of personal access tokens
+	// that a user can have at once.
+	MaxAccessTokensPerUser = 20
+
+	// accessTokenLastUsedInterval is how often a token's last used time is
+	// updated, to avoid writing on every request.
+	accessTokenLastUsedInterval = time.Minute
+)
+
+// AccessTokenScopes are all the valid personal access token scopes.
+var AccessTokenScopes = []string{AccessTokenScopeReadOnly, AccessTokenScopePatchSubmit, AccessTokenScopeAll}
+
+// apiPathPrefixes are the paths that tokens can make any requests to. Tokens
+// can't be used for the UI or GraphQL, which expose the user's API key.
+var apiPathPrefixes = []string{"/rest/v2", "/api"}
+
+// patchSubmitPathPrefixes are the paths that tokens with the patch submit
+// scope can make non-read requests to.
+var patchSubmitPathPrefixes = []string{"/api/patches", "/rest/v2/patches", "/api/rest/v2/patches"}
+
+var (
+	AccessTokenIDKey         = bsonutil.MustHaveTag(PersonalAccessToken{}, "ID")
+	AccessTokenUserIDKey     = bsonutil.MustHaveTag(PersonalAccessToken{}, "UserID")
+	AccessTokenNameKey       = bsonutil.MustHaveTag(PersonalAccessToken{}, "Name")
+	AccessTokenHashKey       = bsonutil.MustHaveTag(PersonalAccessToken{}, "TokenHash")
+	AccessTokenCreatedAtKey  = bsonutil.MustHaveTag(PersonalAccessToken{}, "CreatedAt")
+	AccessTokenExpiresAtKey  = bsonutil.MustHaveTag(PersonalAccessToken{}, "ExpiresAt")
+	AccessTokenLastUsedAtKey = bsonutil.MustHaveTag(PersonalAccessToken{}, "LastUsedAt")
+)
+
+// PersonalAccessToken is a named, expiring credential that a user can use in
+// place of their API key. Its scope and projects limit what it can be used for
+// to a subset of what the user can do.
+type PersonalAccessToken struct {
+	ID     string `bson:"_id" json:"id"`
+	UserID string `bson:"user_id" json:"user_id"`
+	Name   string `bson:"name" json:"name"`
+	// TokenHash is the SHA-256 hash of the token. The token itself is not
+	// stored and is only available when it's created.
+	TokenHash string `bson:"token_hash" json:"-"`
+	Scope     string `bson:"scope" json:"scope"`
+	// Projects are the projects and repos that the token is limited to. If
+	// it's empty, the token can be used for any project.
+	Projects   []string  `bson:"projects,omitempty" json:"projects,omitempty"`
+	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
+	ExpiresAt  time.Time `bson:"expires_at" json:"expires_at"`
+	LastUsedAt time.Time `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+}
+
+// Validate checks that the token's settings are valid.
+func (t *PersonalAccessToken) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(t.UserID == "", "user must be specified")
+	catcher.NewWhen(strings.TrimSpace(t.Name) == "", "name must be specified")
+	catcher.ErrorfWhen(!utility.StringSliceContains(AccessTokenScopes, t.Scope), "invalid scope '%s', must be one of: %s", t.Scope, strings.Join(AccessTokenScopes, ", "))
+	catcher.NewWhen(!t.ExpiresAt.After(time.Now()), "expiration must be in the future")
+	catcher.ErrorfWhen(time.Until(t.ExpiresAt) > MaxAccessTokenLifetime, "expiration cannot be more than %s in the future", MaxAccessTokenLifetime)
+	return catcher.Resolve()
+}
+
+// CreatePersonalAccessToken validates and inserts a new token for the user.
+// It returns the raw token, which is the only time that it's available.
+func CreatePersonalAccessToken(t *PersonalAccessToken) (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", errors.Wrap(err, "invalid personal access token")
+	}
+	existing, err := FindPersonalAccessTokensForUser(t.UserID)
+	if err != nil {
+		return "", errors.Wrapf(err, "finding existing personal access tokens for user '%s'", t.UserID)
+	}
+	if len(existing) >= MaxAccessTokensPerUser {
+		return "", errors.Errorf("user '%s' already has the maximum of %d personal access tokens", t.UserID, MaxAccessTokensPerUser)
+	}
+	for _, other := range existing {
+		if other.Name == t.Name {
+			return "", errors.Errorf("user '%s' already has a personal access token named '%s'", t.UserID, t.Name)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, "generating token")
+	}
+	token := AccessTokenPrefix + hex.EncodeToString(secret)
+
+	t.ID = utility.RandomString()
+	t.TokenHash = hashAccessToken(token)
+	t.CreatedAt = time.Now()
+	t.LastUsedAt = time.Time{}
+	if err = db.Insert(AccessTokensCollection, t); err != nil {
+		return "", errors.Wrap(err, "inserting personal access token")
+	}
+	return token, nil
+}
+
+// IsAccessToken returns whether the key is a personal access token rather than
+// a user's API key.
+func IsAccessToken(key string) bool {
+	return strings.HasPrefix(key, AccessTokenPrefix)
+}
+
+func hashAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// IsExpired returns whether the token can no longer be used.
+func (t *PersonalAccessToken) IsExpired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+// AllowsRequest returns whether the token's scope allows making a request with
+// the given method to the given path. Tokens can only be used for the REST
+// API.
+func (t *PersonalAccessToken) AllowsRequest(method, path string) bool {
+	if !hasAnyPathPrefix(path, apiPathPrefixes) {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	switch t.Scope {
+	case AccessTokenScopeAll:
+		return true
+	case AccessTokenScopePatchSubmit:
+		return hasAnyPathPrefix(path, patchSubmitPathPrefixes)
+	}
+	return false
+}
+
+func hasAnyPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsProject returns whether the token can be used for the given project or
+// repo.
+func (t *PersonalAccessToken) AllowsProject(id string) bool {
+	return len(t.Projects) == 0 || utility.StringSliceContains(t.Projects, id)
+}
+
+// AllowsPermission returns whether the token can be used for the resource that
+// the permission is for. Tokens limited to projects can't be used for any
+// other kind of resource.
+func (t *PersonalAccessToken) AllowsPermission(opts gimlet.PermissionOpts) bool {
+	if len(t.Projects) == 0 {
+		return true
+	}
+	return opts.ResourceType == evergreen.ProjectResourceType && t.AllowsProject(opts.Resource)
+}
+
+// UpdateLastUsed records that the token was just used. To avoid writing on
+// every request, it's only updated if it hasn't been updated recently.
+func (t *PersonalAccessToken) UpdateLastUsed() error {
+	now := time.Now()
+	if now.Sub(t.LastUsedAt) < accessTokenLastUsedInterval {
+		return nil
+	}
+	if err := db.UpdateId(AccessTokensCollection, t.ID, bson.M{"$set": bson.M{AccessTokenLastUsedAtKey: now}}); err != nil {
+		return errors.WithStack(err)
+	}
+	t.LastUsedAt = now
+	return nil
+}
+
+// Remove deletes the token, revoking it.
+func (t *PersonalAccessToken) Remove() error {
+	return db.Remove(AccessTokensCollection, bson.M{AccessTokenIDKey: t.ID})
+}
+
+// FindPersonalAccessTokenByID finds a token by its ID.
+func FindPersonalAccessTokenByID(id string) (*PersonalAccessToken, error) {
+	return findOnePersonalAccessToken(bson.M{AccessTokenIDKey: id})
+}
+
+// FindPersonalAccessTokenByToken finds the token matching the raw token.
+func FindPersonalAccessTokenByToken(token string) (*PersonalAccessToken, error) {
+	return findOnePersonalAccessToken(bson.M{AccessTokenHashKey: hashAccessToken(token)})
+}
+
+// FindPersonalAccessTokensForUser finds all of the user's tokens, sorted from
+// newest to oldest.
+func FindPersonalAccessTokensForUser(userID string) ([]PersonalAccessToken, error) {
+	tokens := []PersonalAccessToken{}
+	q := db.Query(bson.M{AccessTokenUserIDKey: userID}).Sort([]string{"-" + AccessTokenCreatedAtKey})
+	return tokens, db.FindAllQ(AccessTokensCollection, q, &tokens)
+}
+
+// RemovePersonalAccessTokensForUser revokes all of the user's tokens.
+func RemovePersonalAccessTokensForUser(userID string) error {
+	return db.RemoveAll(AccessTokensCollection, bson.M{AccessTokenUserIDKey: userID})
+}
+
+func findOnePersonalAccessToken(query bson.M) (*PersonalAccessToken, error) {
+	t := &PersonalAccessToken{}
+	err := db.FindOneQ(AccessTokensCollection, db.Query(query), t)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return t, err
+}
@@ -0,0 +1,179 @@
+package user
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessTokenValidate(t *testing.T) {
+	validToken := func() PersonalAccessToken {
+		return PersonalAccessToken{
+			UserID:    "me",
+			Name:      "ci",
+			Scope:     AccessTokenScopeReadOnly,
+			ExpiresAt: time.Now().Add(24 * time.Hour),
+		}
+	}
+
+	t.Run("Succeeds", func(t *testing.T) {
+		tok := validToken()
+		assert.NoError(t, tok.Validate())
+	})
+	t.Run("FailsWithoutName", func(t *testing.T) {
+		tok := validToken()
+		tok.Name = " "
+		assert.Error(t, tok.Validate())
+	})
+	t.Run("FailsWithInvalidScope", func(t *testing.T) {
+		tok := validToken()
+		tok.Scope = "admin"
+		assert.Error(t, tok.Validate())
+	})
+	t.Run("FailsWithPastExpiration", func(t *testing.T) {
+		tok := validToken()
+		tok.ExpiresAt = time.Now().Add(-time.Minute)
+		assert.Error(t, tok.Validate())
+	})
+	t.Run("FailsWithExpirationPastMaxLifetime", func(t *testing.T) {
+		tok := validToken()
+		tok.ExpiresAt = time.Now().Add(MaxAccessTokenLifetime + time.Hour)
+		assert.Error(t, tok.Validate())
+	})
+}
+
+func TestPersonalAccessTokenAllowsRequest(t *testing.T) {
+	readOnly := PersonalAccessToken{Scope: AccessTokenScopeReadOnly}
+	patchSubmit := PersonalAccessToken{Scope: AccessTokenScopePatchSubmit}
+	all := PersonalAccessToken{Scope: AccessTokenScopeAll}
+
+	for _, tok := range []PersonalAccessToken{readOnly, patchSubmit, all} {
+		assert.True(t, tok.AllowsRequest(http.MethodGet, "/rest/v2/hosts"), tok.Scope)
+	}
+
+	assert.False(t, readOnly.AllowsRequest(http.MethodPost, "/rest/v2/patches/p1"))
+	assert.False(t, readOnly.AllowsRequest(http.MethodPut, "/api/patches/"))
+
+	assert.True(t, patchSubmit.AllowsRequest(http.MethodPut, "/api/patches"))
+	assert.True(t, patchSubmit.AllowsRequest(http.MethodPost, "/rest/v2/patches/p1/configure"))
+	assert.False(t, patchSubmit.AllowsRequest(http.MethodPost, "/rest/v2/patchesfoo"))
+	assert.False(t, patchSubmit.AllowsRequest(http.MethodPost, "/rest/v2/hosts"))
+
+	assert.True(t, all.AllowsRequest(http.MethodDelete, "/rest/v2/hosts/h1"))
+
+	for _, tok := range []PersonalAccessToken{readOnly, patchSubmit, all} {
+		assert.True(t, tok.AllowsRequest(http.MethodGet, "/api/rest/v2/hosts"), tok.Scope)
+		assert.False(t, tok.AllowsRequest(http.MethodGet, "/settings"), tok.Scope)
+		assert.False(t, tok.AllowsRequest(http.MethodPost, "/graphql/query"), tok.Scope)
+		assert.False(t, tok.AllowsRequest(http.MethodGet, "/rest/v2foo"), tok.Scope)
+	}
+}
+
+func TestAPIKeyWithPersonalAccessToken(t *testing.T) {
+	u := DBUser{Id: "user", APIKey: "key"}
+	assert.Equal(t, "key", u.GetAPIKey())
+
+	u.SetAccessToken(&PersonalAccessToken{Scope: AccessTokenScopeAll})
+	assert.Empty(t, u.GetAPIKey(), "API key should not be exposed with a personal access token")
+	assert.Error(t, u.UpdateAPIKey("new_key"), "API key should not be rotated with a personal access token")
+	assert.Equal(t, "key", u.APIKey)
+}
+
+func TestPersonalAccessTokenAllowsPermission(t *testing.T) {
+	opts := gimlet.PermissionOpts{
+		Resource:      "p1",
+		ResourceType:  evergreen.ProjectResourceType,
+		Permission:    evergreen.PermissionTasks,
+		RequiredLevel: evergreen.TasksView.Value,
+	}
+
+	unlimited := PersonalAccessToken{}
+	assert.True(t, unlimited.AllowsPermission(opts))
+
+	limited := PersonalAccessToken{Projects: []string{"p1"}}
+	assert.True(t, limited.AllowsPermission(opts))
+
+	opts.Resource = "p2"
+	assert.False(t, limited.AllowsPermission(opts))
+
+	opts.Resource = evergreen.SuperUserPermissionsID
+	opts.ResourceType = evergreen.SuperUserResourceType
+	assert.False(t, limited.AllowsPermission(opts))
+}
+
+func TestPersonalAccessTokens(t *testing.T) {
+	require.NoError(t, db.Clear(AccessTokensCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(AccessTokensCollection))
+	}()
+
+	tok := &PersonalAccessToken{
+		UserID:    "me",
+		Name:      "ci",
+		Scope:     AccessTokenScopePatchSubmit,
+		Projects:  []string{"p1"},
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	raw, err := CreatePersonalAccessToken(tok)
+	require.NoError(t, err)
+	assert.True(t, IsAccessToken(raw))
+	assert.NotEmpty(t, tok.ID)
+	assert.NotEqual(t, raw, tok.TokenHash)
+
+	t.Run("FindByToken", func(t *testing.T) {
+		found, err := FindPersonalAccessTokenByToken(raw)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, tok.ID, found.ID)
+		assert.Equal(t, []string{"p1"}, found.Projects)
+		assert.False(t, found.IsExpired())
+
+		found, err = FindPersonalAccessTokenByToken(AccessTokenPrefix + "nonexistent")
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+	t.Run("DuplicateNameFails", func(t *testing.T) {
+		_, err := CreatePersonalAccessToken(&PersonalAccessToken{
+			UserID:    "me",
+			Name:      "ci",
+			Scope:     AccessTokenScopeReadOnly,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		assert.Error(t, err)
+	})
+	t.Run("UpdateLastUsed", func(t *testing.T) {
+		found, err := FindPersonalAccessTokenByID(tok.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		require.NoError(t, found.UpdateLastUsed())
+
+		found, err = FindPersonalAccessTokenByID(tok.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.False(t, found.LastUsedAt.IsZero())
+	})
+	t.Run("RemoveForUser", func(t *testing.T) {
+		_, err := CreatePersonalAccessToken(&PersonalAccessToken{
+			UserID:    "someone_else",
+			Name:      "ci",
+			Scope:     AccessTokenScopeReadOnly,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, RemovePersonalAccessTokensForUser("me"))
+		tokens, err := FindPersonalAccessTokensForUser("me")
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+
+		tokens, err = FindPersonalAccessTokensForUser("someone_else")
+		require.NoError(t, err)
+		assert.Len(t, tokens, 1)
+	})
+}
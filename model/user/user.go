@@ -33,6 +33,10 @@ type DBUser struct {
 	LoginCache       LoginCache   `bson:"login_cache,omitempty"`
 	FavoriteProjects []string     `bson:"favorite_projects"`
 	OnlyAPI          bool         `bson:"only_api,omitempty"`
+
+	// accessToken is the personal access token that the user authenticated
+	// the current request with, if any. It's not stored.
+	accessToken *PersonalAccessToken
 }
 
 func (u *DBUser) MarshalBSON() ([]byte, error)  { return mgobson.Marshal(u) }
@@ -86,6 +90,8 @@ type NotificationPreferences struct {
 	SpawnHostOutcomeID    string                     `bson:"spawn_host_outcome_id,omitempty" json:"-"`
 	CommitQueue           UserSubscriptionPreference `bson:"commit_queue" json:"commit_queue"`
 	CommitQueueID         string                     `bson:"commit_queue_id,omitempty" json:"-"`
+	StepbackCulprit       UserSubscriptionPreference `bson:"stepback_culprit,omitempty" json:"stepback_culprit"`
+	StepbackCulpritID     string                     `bson:"stepback_culprit_id,omitempty" json:"-"`
 }
 
 type UserSubscriptionPreference string
@@ -99,7 +105,6 @@ const (
 func (u *DBUser) Username() string        { return u.Id }
 func (u *DBUser) PublicKeys() []PubKey    { return u.PubKeys }
 func (u *DBUser) Email() string           { return u.EmailAddress }
-func (u *DBUser) GetAPIKey() string       { return u.APIKey }
 func (u *DBUser) GetAccessToken() string  { return u.LoginCache.AccessToken }
 func (u *DBUser) GetRefreshToken() string { return u.LoginCache.RefreshToken }
 func (u *DBUser) IsNil() bool             { return u == nil }
@@ -131,8 +136,21 @@ func (u *DBUser) GetPublicKey(keyname string) (string, error) {
 	return "", errors.Errorf("Unable to find public key '%v' for user '%v'", keyname, u.Username())
 }
 
+// GetAPIKey returns the user's API key. It's never returned when the user
+// authenticated with a personal access token, since that would let a scoped,
+// expiring token be exchanged for the user's permanent API key.
+func (u *DBUser) GetAPIKey() string {
+	if u.accessToken != nil {
+		return ""
+	}
+	return u.APIKey
+}
+
 // UpdateAPIKey updates the API key stored for the user.
 func (u *DBUser) UpdateAPIKey(newKey string) error {
+	if u.accessToken != nil {
+		return errors.Errorf("cannot change the API key for user '%s' with a personal access token", u.Id)
+	}
 	update := bson.M{"$set": bson.M{APIKeyKey: newKey}}
 	if err := UpdateOne(bson.M{IdKey: u.Id}, update); err != nil {
 		return errors.Wrapf(err, "setting API key for user '%s'", u.Id)
@@ -352,7 +370,22 @@ func (u *DBUser) GetViewableProjectSettings() ([]string, error) {
 	return viewProjects, nil
 }
 
+// SetAccessToken records that the user authenticated with the personal access
+// token, which limits their permissions to the resources that the token allows.
+func (u *DBUser) SetAccessToken(t *PersonalAccessToken) {
+	u.accessToken = t
+}
+
+// AccessToken returns the personal access token that the user authenticated
+// with, or nil if they didn't use one.
+func (u *DBUser) AccessToken() *PersonalAccessToken {
+	return u.accessToken
+}
+
 func (u *DBUser) HasPermission(opts gimlet.PermissionOpts) bool {
+	if u.accessToken != nil && !u.accessToken.AllowsPermission(opts) {
+		return false
+	}
 	if evergreen.PermissionsDisabledForTests() {
 		return true
 	}
@@ -458,6 +491,9 @@ func (u *DBUser) GeneralSubscriptionIDs() []string {
 	if id := u.Settings.Notifications.CommitQueueID; id != "" {
 		ids = append(ids, id)
 	}
+	if id := u.Settings.Notifications.StepbackCulpritID; id != "" {
+		ids = append(ids, id)
+	}
 
 	return ids
 }
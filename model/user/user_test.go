@@ -735,6 +735,7 @@ func TestGeneralSubscriptionIDs(t *testing.T) {
 		SpawnHostExpirationID: "SpawnHostExpirationID",
 		SpawnHostOutcomeID:    "SpawnHostOutcomeID",
 		CommitQueueID:         "CommitQueueID",
+		StepbackCulpritID:     "StepbackCulpritID",
 	}
 
 	assert.ElementsMatch(t, []string{
@@ -744,6 +745,7 @@ func TestGeneralSubscriptionIDs(t *testing.T) {
 		"SpawnHostExpirationID",
 		"SpawnHostOutcomeID",
 		"CommitQueueID",
+		"StepbackCulpritID",
 	}, u.GeneralSubscriptionIDs())
 }
 
@@ -79,6 +79,9 @@ type Version struct {
 	AuthorID string `bson:"author_id,omitempty" json:"author_id,omitempty"`
 
 	SatisfiedTriggers []string `bson:"satisfied_triggers,omitempty" json:"satisfied_triggers,omitempty"`
+	// StepbackCulprits are the tasks in this version that bisect stepback
+	// identified as the first failing run of a task.
+	StepbackCulprits []task.StepbackCulprit `bson:"stepback_culprits,omitempty" json:"stepback_culprits,omitempty"`
 	// Fields set if triggered by an upstream build
 	// TriggerID is the ID of the entity that triggered the downstream version. Depending on the trigger type, this
 	// could be a build ID, a task ID, or a project ID, for build, task, and push triggers respectively.
@@ -309,6 +312,9 @@ type VersionMetadata struct {
 	PeriodicBuildID     string
 	RemotePath          string
 	GitTag              GitTag
+	// ChangedFiles are the files changed by the revision. If nil, the changed
+	// files are unknown and tasks are not filtered by their paths.
+	ChangedFiles []string
 }
 
 var (
@@ -6,6 +6,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
@@ -51,6 +52,7 @@ var (
 	VersionAbortedKey              = bsonutil.MustHaveTag(Version{}, "Aborted")
 	VersionAuthorIDKey             = bsonutil.MustHaveTag(Version{}, "AuthorID")
 	VersionProjectStorageMethodKey = bsonutil.MustHaveTag(Version{}, "ProjectStorageMethod")
+	VersionStepbackCulpritsKey     = bsonutil.MustHaveTag(Version{}, "StepbackCulprits")
 )
 
 // ById returns a db.Q object which will filter on {_id : <the id param>}
@@ -371,6 +373,16 @@ func AddSatisfiedTrigger(versionID, definitionID string) error {
 		})
 }
 
+// AddStepbackCulprit records a stepback culprit on the version it belongs to.
+func AddStepbackCulprit(versionID string, culprit task.StepbackCulprit) error {
+	return VersionUpdateOne(bson.M{VersionIdKey: versionID},
+		bson.M{
+			"$addToSet": bson.M{
+				VersionStepbackCulpritsKey: culprit,
+			},
+		})
+}
+
 func GetVersionAuthorID(versionID string) (string, error) {
 	v, err := VersionFindOne(VersionById(versionID).WithFields(VersionAuthorIDKey))
 	if err != nil {
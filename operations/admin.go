@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
@@ -29,6 +30,7 @@ func Admin() cli.Command {
 			viewSettings(),
 			updateSettings(),
 			listEvents(),
+			adminAudit(),
 			revert(),
 			fetchAllProjectConfigs(),
 			amboyCmd(),
@@ -258,6 +260,84 @@ func listEvents() cli.Command {
 	}
 }
 
+func adminAudit() cli.Command {
+	const (
+		actorFlagName      = "user"
+		resourceIDFlagName = "resource"
+		afterFlagName      = "after"
+		beforeFlagName     = "before"
+	)
+
+	return cli.Command{
+		Name:   "audit",
+		Before: setPlainLogger,
+		Usage:  "print the audit log of user actions, from newest to oldest",
+		Flags: mergeFlagSlices(addLimitFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(actorFlagName, "u"),
+				Usage: "only show actions taken by this user",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(resourceIDFlagName, "r"),
+				Usage: "only show actions on this resource (e.g. a task, version, host or project ID)",
+			},
+			cli.StringFlag{
+				Name:  afterFlagName,
+				Usage: "only show actions at or after this time (RFC 3339 format)",
+			},
+			cli.StringFlag{
+				Name:  beforeFlagName,
+				Usage: "only show actions before this time (RFC 3339 format)",
+			},
+		)),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			opts := event.AuditEventsQueryOptions{
+				Actor:      c.String(actorFlagName),
+				ResourceID: c.String(resourceIDFlagName),
+				Limit:      c.Int(limitFlagName),
+			}
+			var err error
+			if after := c.String(afterFlagName); after != "" {
+				if opts.StartTime, err = time.Parse(time.RFC3339, after); err != nil {
+					return errors.Wrap(err, "parsing start time")
+				}
+			}
+			if before := c.String(beforeFlagName); before != "" {
+				if opts.EndTime, err = time.Parse(time.RFC3339, before); err != nil {
+					return errors.Wrap(err, "parsing end time")
+				}
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			events, err := client.GetAuditEvents(ctx, opts)
+			if err != nil {
+				return errors.Wrap(err, "retrieving audit events")
+			}
+
+			eventsPretty, err := json.MarshalIndent(events, " ", " ")
+			if err != nil {
+				return errors.Wrap(err, "marshalling audit events")
+			}
+			grip.Info(eventsPretty)
+
+			return nil
+		},
+	}
+}
+
 func revert() cli.Command {
 	return cli.Command{
 		Name:   "revert-event",
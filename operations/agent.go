@@ -10,6 +10,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent"
 	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/recovery"
@@ -17,7 +18,7 @@ import (
 	"github.com/urfave/cli"
 )
 
-const defaultAgentStatusPort = 2285
+const defaultAgentStatusPort = host.AgentStatusPort
 
 const (
 	agentAPIServerURLFlagName  = "api_server"
@@ -1,8 +1,14 @@
 package operations
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/evergreen-ci/evergreen/model/user"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
@@ -16,6 +22,9 @@ func Client() cli.Command {
 			getAPIKey(),
 			getAPIUrl(),
 			getUIUrl(),
+			createAccessToken(),
+			listAccessTokens(),
+			revokeAccessToken(),
 		},
 	}
 }
@@ -87,3 +96,166 @@ func getUIUrl() cli.Command {
 		},
 	}
 }
+
+func createAccessToken() cli.Command {
+	const (
+		tokenNameFlagName      = "name"
+		tokenScopeFlagName     = "scope"
+		tokenProjectsFlagName  = "projects"
+		tokenExpiresInFlagName = "expires-in"
+	)
+
+	return cli.Command{
+		Name:  "create-token",
+		Usage: "create a personal access token, which can be used in place of the API key",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  tokenNameFlagName,
+				Usage: "the name of the token",
+			},
+			cli.StringFlag{
+				Name:  tokenScopeFlagName,
+				Usage: fmt.Sprintf("the scope of the token (%s)", strings.Join(user.AccessTokenScopes, ", ")),
+				Value: user.AccessTokenScopeReadOnly,
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(tokenProjectsFlagName, "project", "p"),
+				Usage: "limit the token to the given projects (can be specified multiple times)",
+			},
+			cli.DurationFlag{
+				Name:  tokenExpiresInFlagName,
+				Usage: "how long until the token expires",
+				Value: 30 * 24 * time.Hour,
+			},
+		},
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			requireStringFlag(tokenNameFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			token, err := client.CreateAccessToken(ctx, restmodel.APIPersonalAccessTokenPostRequest{
+				Name:      c.String(tokenNameFlagName),
+				Scope:     c.String(tokenScopeFlagName),
+				Projects:  c.StringSlice(tokenProjectsFlagName),
+				ExpiresAt: time.Now().Add(c.Duration(tokenExpiresInFlagName)),
+			})
+			if err != nil {
+				return errors.Wrap(err, "creating personal access token")
+			}
+
+			fmt.Printf("Created token '%s' (ID '%s'), which expires at %s.\n", utility.FromStringPtr(token.Name), utility.FromStringPtr(token.ID), utility.FromTimePtr(token.ExpiresAt).Format(time.RFC3339))
+			fmt.Println("This token will not be shown again:")
+			fmt.Println(utility.FromStringPtr(token.Token))
+			return nil
+		},
+	}
+}
+
+func listAccessTokens() cli.Command {
+	return cli.Command{
+		Name:   "list-tokens",
+		Usage:  "list the current user's personal access tokens",
+		Before: setPlainLogger,
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			tokens, err := client.GetAccessTokens(ctx)
+			if err != nil {
+				return errors.Wrap(err, "fetching personal access tokens")
+			}
+			if len(tokens) == 0 {
+				fmt.Println("No personal access tokens found.")
+				return nil
+			}
+
+			for _, t := range tokens {
+				lastUsed := "never"
+				if lastUsedAt := utility.FromTimePtr(t.LastUsedAt); !utility.IsZeroTime(lastUsedAt) {
+					lastUsed = lastUsedAt.Format(time.RFC3339)
+				}
+				projects := "all"
+				if len(t.Projects) > 0 {
+					projects = strings.Join(t.Projects, ", ")
+				}
+				fmt.Printf("%s\t%s\tscope: %s\tprojects: %s\texpires: %s\tlast used: %s\n",
+					utility.FromStringPtr(t.ID), utility.FromStringPtr(t.Name), utility.FromStringPtr(t.Scope), projects,
+					utility.FromTimePtr(t.ExpiresAt).Format(time.RFC3339), lastUsed)
+			}
+			return nil
+		},
+	}
+}
+
+func revokeAccessToken() cli.Command {
+	const tokenIDFlagName = "id"
+
+	return cli.Command{
+		Name:  "revoke-token",
+		Usage: "revoke one of the current user's personal access tokens",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  tokenIDFlagName,
+				Usage: "the ID of the token to revoke",
+			},
+		},
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			requireStringFlag(tokenIDFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+			tokenID := c.String(tokenIDFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			if err = client.DeleteAccessToken(ctx, tokenID); err != nil {
+				return errors.Wrapf(err, "revoking personal access token '%s'", tokenID)
+			}
+
+			fmt.Printf("Revoked personal access token '%s'.\n", tokenID)
+			return nil
+		},
+	}
+}
@@ -38,6 +38,7 @@ func hostCreate() cli.Command {
 		noExpireFlagName     = "no-expire"
 		fileFlagName         = "file"
 		setupFlagName        = "setup"
+		fromSnapshotFlagName = "from-snapshot"
 	)
 
 	return cli.Command{
@@ -80,6 +81,10 @@ func hostCreate() cli.Command {
 				Name:  joinFlagNames(fileFlagName, "f"),
 				Usage: "name of a JSON or YAML file containing the spawn host params",
 			},
+			cli.StringFlag{
+				Name:  fromSnapshotFlagName,
+				Usage: "`ID` of a snapshot to restore into the home volume of a new virtual workstation",
+			},
 		},
 		Before: requireStringFlag(keyFlagName),
 		Action: func(c *cli.Context) error {
@@ -93,6 +98,7 @@ func hostCreate() cli.Command {
 			region := c.String(regionFlagName)
 			noExpire := c.Bool(noExpireFlagName)
 			file := c.String(fileFlagName)
+			fromSnapshot := c.String(fromSnapshotFlagName)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -137,6 +143,10 @@ func hostCreate() cli.Command {
 					NoExpiration: noExpire,
 				}
 			}
+			if fromSnapshot != "" {
+				spawnRequest.HomeVolumeSnapshotID = fromSnapshot
+				spawnRequest.IsVirtualWorkstation = true
+			}
 
 			if userdataFile != "" {
 				var out []byte
@@ -180,6 +190,12 @@ func hostModify() cli.Command {
 		extendFlagName       = "extend"
 		addSSHKeyFlag        = "add-ssh-key"
 		addSSHKeyNameFlag    = "add-ssh-key-name"
+		sleepTimeZoneFlag    = "sleep-time-zone"
+		sleepStartFlag       = "sleep-daily-start"
+		sleepStopFlag        = "sleep-daily-stop"
+		sleepWeekdaysOffFlag = "sleep-weekdays-off"
+		removeSleepFlag      = "remove-sleep-schedule"
+		keepAwakeFlag        = "keep-awake-hours"
 	)
 
 	return cli.Command{
@@ -222,14 +238,44 @@ func hostModify() cli.Command {
 				Name:  addSSHKeyNameFlag,
 				Usage: "add user defined public key named `KEY_NAME` to the host's authorized_keys",
 			},
+			cli.StringFlag{
+				Name:  sleepTimeZoneFlag,
+				Usage: "set the IANA time zone `ZONE` (e.g. America/New_York) of the host's sleep schedule, defaulting to UTC",
+			},
+			cli.StringFlag{
+				Name:  sleepStartFlag,
+				Usage: "set the time of day `HH:MM` when an unexpirable host's sleep schedule starts it",
+			},
+			cli.StringFlag{
+				Name:  sleepStopFlag,
+				Usage: "set the time of day `HH:MM` when an unexpirable host's sleep schedule stops it",
+			},
+			cli.StringSliceFlag{
+				Name:  sleepWeekdaysOffFlag,
+				Usage: "keep an unexpirable host stopped for the whole of `WEEKDAY` (e.g. Saturday), one weekday per flag",
+			},
+			cli.BoolFlag{
+				Name:  removeSleepFlag,
+				Usage: "remove the host's sleep schedule so that it is never automatically stopped",
+			},
+			cli.IntFlag{
+				Name:  keepAwakeFlag,
+				Usage: "keep the host running for the next `HOURS` in spite of its sleep schedule",
+			},
 		)),
 		Before: mergeBeforeFuncs(
 			setPlainLogger,
 			requireHostFlag,
-			requireAtLeastOneFlag(addTagFlagName, deleteTagFlagName, instanceTypeFlagName, expireFlagName, noExpireFlagName, extendFlagName, addSSHKeyFlag, addSSHKeyNameFlag),
+			requireAtLeastOneFlag(addTagFlagName, deleteTagFlagName, instanceTypeFlagName, expireFlagName, noExpireFlagName, extendFlagName, addSSHKeyFlag, addSSHKeyNameFlag,
+				sleepTimeZoneFlag, sleepStartFlag, sleepStopFlag, sleepWeekdaysOffFlag, removeSleepFlag, keepAwakeFlag),
 			mutuallyExclusiveArgs(false, noExpireFlagName, extendFlagName),
 			mutuallyExclusiveArgs(false, noExpireFlagName, expireFlagName),
 			mutuallyExclusiveArgs(false, addSSHKeyFlag, addSSHKeyNameFlag),
+			mutuallyExclusiveArgs(false, removeSleepFlag, sleepTimeZoneFlag),
+			mutuallyExclusiveArgs(false, removeSleepFlag, sleepStartFlag),
+			mutuallyExclusiveArgs(false, removeSleepFlag, sleepStopFlag),
+			mutuallyExclusiveArgs(false, removeSleepFlag, sleepWeekdaysOffFlag),
+			mutuallyExclusiveArgs(false, removeSleepFlag, keepAwakeFlag),
 		),
 		Action: func(c *cli.Context) error {
 			confPath := c.Parent().Parent().String(confFlagName)
@@ -244,6 +290,26 @@ func hostModify() cli.Command {
 			subscriptionType := c.String(subscriptionTypeFlag)
 			publicKeyFile := c.String(addSSHKeyFlag)
 			publicKeyName := c.String(addSSHKeyNameFlag)
+			keepAwakeHours := c.Int(keepAwakeFlag)
+
+			var sleepSchedule *host.SleepScheduleInfo
+			if c.Bool(removeSleepFlag) {
+				sleepSchedule = &host.SleepScheduleInfo{}
+			} else if c.IsSet(sleepTimeZoneFlag) || c.IsSet(sleepStartFlag) || c.IsSet(sleepStopFlag) || c.IsSet(sleepWeekdaysOffFlag) {
+				weekdaysOff, err := parseWeekdays(c.StringSlice(sleepWeekdaysOffFlag))
+				if err != nil {
+					return errors.Wrap(err, "parsing weekdays off")
+				}
+				sleepSchedule = &host.SleepScheduleInfo{
+					TimeZone:         c.String(sleepTimeZoneFlag),
+					DailyStartTime:   c.String(sleepStartFlag),
+					DailyStopTime:    c.String(sleepStopFlag),
+					WholeWeekdaysOff: weekdaysOff,
+				}
+				if err = sleepSchedule.Validate(); err != nil {
+					return errors.Wrap(err, "invalid sleep schedule")
+				}
+			}
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -276,6 +342,10 @@ func hostModify() cli.Command {
 				SubscriptionType:   subscriptionType,
 				NewName:            displayName,
 				AddKey:             publicKey,
+				SleepSchedule:      sleepSchedule,
+			}
+			if keepAwakeHours > 0 {
+				hostChanges.KeepAwakeUntil = time.Now().Add(time.Duration(keepAwakeHours) * time.Hour)
 			}
 
 			if noExpire {
@@ -299,6 +369,25 @@ func hostModify() cli.Command {
 	}
 }
 
+// parseWeekdays parses full or abbreviated English weekday names, such as
+// "Saturday" or "sat".
+func parseWeekdays(names []string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	catcher := grip.NewBasicCatcher()
+	for _, name := range names {
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+				weekdays = append(weekdays, day)
+				found = true
+				break
+			}
+		}
+		catcher.ErrorfWhen(!found, "unrecognized weekday '%s'", name)
+	}
+	return weekdays, catcher.Resolve()
+}
+
 func getPublicKey(ctx context.Context, client client.Communicator, keyFile, keyName string) (string, error) {
 	if keyFile != "" {
 		return readKeyFromFile(keyFile)
@@ -903,9 +992,10 @@ func printVolumes(volumes []restModel.APIVolume, userID string) {
 
 func hostCreateVolume() cli.Command {
 	const (
-		sizeFlag = "size"
-		typeFlag = "type"
-		zoneFlag = "zone"
+		sizeFlag         = "size"
+		typeFlag         = "type"
+		zoneFlag         = "zone"
+		fromSnapshotFlag = "from-snapshot"
 	)
 
 	return cli.Command{
@@ -928,14 +1018,19 @@ func hostCreateVolume() cli.Command {
 				Name:  displayNameFlagName,
 				Usage: "set a user-friendly name for volume",
 			},
+			cli.StringFlag{
+				Name:  fromSnapshotFlag,
+				Usage: "`ID` of a snapshot to restore the volume from (size defaults to the snapshot size)",
+			},
 		},
-		Before: mergeBeforeFuncs(setPlainLogger, requireStringFlag(sizeFlag)),
+		Before: mergeBeforeFuncs(setPlainLogger, requireAtLeastOneFlag(sizeFlag, fromSnapshotFlag)),
 		Action: func(c *cli.Context) error {
 			confPath := c.Parent().Parent().String(confFlagName)
 			volumeType := c.String(typeFlag)
 			volumeZone := c.String(zoneFlag)
 			volumeName := c.String(displayNameFlagName)
 			volumeSize := c.Int(sizeFlag)
+			snapshotID := c.String(fromSnapshotFlag)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -955,6 +1050,7 @@ func hostCreateVolume() cli.Command {
 				Size:             int32(volumeSize),
 				AvailabilityZone: volumeZone,
 				DisplayName:      volumeName,
+				SnapshotID:       snapshotID,
 			}
 
 			volume, err := client.CreateVolume(ctx, volumeRequest)
@@ -1022,6 +1118,161 @@ func hostDeleteVolume() cli.Command {
 	}
 }
 
+func hostCreateSnapshot() cli.Command {
+	const (
+		volumeFlagName = "volume"
+	)
+
+	return cli.Command{
+		Name:  "create",
+		Usage: "snapshot a volume or the home volume of a spawn host",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  hostFlagName,
+				Usage: "`ID` of the spawn host whose home volume should be snapshotted",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(volumeFlagName, "v"),
+				Usage: "`ID` of the volume to snapshot",
+			},
+			cli.StringFlag{
+				Name:  displayNameFlagName,
+				Usage: "set a user-friendly name for snapshot",
+			},
+		},
+		Before: mergeBeforeFuncs(setPlainLogger, mutuallyExclusiveArgs(true, hostFlagName, volumeFlagName)),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			hostID := c.String(hostFlagName)
+			volumeID := c.String(volumeFlagName)
+			snapshotName := c.String(displayNameFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, true)
+			if err != nil {
+				return errors.Wrap(err, "getting REST communicator")
+			}
+			defer client.Close()
+
+			snapshot, err := client.CreateSnapshot(ctx, &restModel.SnapshotPostRequest{
+				HostID:      hostID,
+				VolumeID:    volumeID,
+				DisplayName: snapshotName,
+			})
+			if err != nil {
+				return err
+			}
+
+			grip.Infof("Created snapshot '%s'.", utility.FromStringPtr(snapshot.ID))
+
+			return nil
+		},
+	}
+}
+
+func hostDeleteSnapshot() cli.Command {
+	const (
+		idFlagName = "id"
+	)
+
+	return cli.Command{
+		Name:  "delete",
+		Usage: "delete a snapshot",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  idFlagName,
+				Usage: "`ID` of snapshot to delete",
+			},
+		},
+		Before: mergeBeforeFuncs(setPlainLogger, requireStringFlag(idFlagName)),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			snapshotID := c.String(idFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, true)
+			if err != nil {
+				return errors.Wrap(err, "getting REST communicator")
+			}
+			defer client.Close()
+
+			if err = client.DeleteSnapshot(ctx, snapshotID); err != nil {
+				return err
+			}
+
+			grip.Infof("Deleted snapshot '%s'", snapshotID)
+
+			return nil
+		},
+	}
+}
+
+func hostListSnapshot() cli.Command {
+	return cli.Command{
+		Name:   "list",
+		Usage:  "list snapshots for user",
+		Before: setPlainLogger,
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "getting REST communicator")
+			}
+			defer client.Close()
+
+			snapshots, err := client.GetSnapshotsByUser(ctx)
+			if err != nil {
+				return err
+			}
+			printSnapshots(snapshots, conf.User)
+			return nil
+		},
+	}
+}
+
+func printSnapshots(snapshots []restModel.APISnapshot, userID string) {
+	if len(snapshots) == 0 {
+		grip.Infof("no snapshots created by user '%s'", userID)
+		return
+	}
+	grip.Infof("%d snapshots created by %s:", len(snapshots), userID)
+	for _, s := range snapshots {
+		grip.Infof("\n%-18s: %s\n", "ID", utility.FromStringPtr(s.ID))
+		if utility.FromStringPtr(s.DisplayName) != "" {
+			grip.Infof("%-18s: %s\n", "Name", utility.FromStringPtr(s.DisplayName))
+		}
+		grip.Infof("%-18s: %d\n", "Size", s.Size)
+		grip.Infof("%-18s: %s\n", "Region", utility.FromStringPtr(s.Region))
+		grip.Infof("%-18s: %s\n", "Source Volume", utility.FromStringPtr(s.SourceVolumeID))
+		if utility.FromStringPtr(s.SourceHostID) != "" {
+			grip.Infof("%-18s: %s\n", "Source Host", utility.FromStringPtr(s.SourceHostID))
+		}
+		t, err := restModel.FromTimePtr(s.Expiration)
+		if err == nil && !s.NoExpiration && !utility.IsZeroTime(t) {
+			grip.Infof("%-18s: %s\n", "Expiration", t.Format(time.RFC3339))
+		}
+	}
+}
+
 func hostList() cli.Command {
 	const (
 		mineFlagName = "mine"
@@ -1603,6 +1854,51 @@ func hostFindBy() cli.Command {
 	}
 }
 
+func hostAgentDebug() cli.Command {
+	const infoFlagName = "info"
+	return cli.Command{
+		Name:  "agent-debug",
+		Usage: "get debugging information from the agent running on a host",
+		Flags: addHostFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(infoFlagName, "i"),
+				Usage: fmt.Sprintf("the kind of debugging information to get (options: %s)", strings.Join(apimodels.AgentDebugInfoKinds, ", ")),
+				Value: apimodels.AgentDebugInfoTask,
+			},
+		),
+		Before: mergeBeforeFuncs(setPlainLogger, requireHostFlag),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			hostID := c.String(hostFlagName)
+			kind := c.String(infoFlagName)
+			if !utility.StringSliceContains(apimodels.AgentDebugInfoKinds, kind) {
+				return errors.Errorf("invalid debug info kind '%s', must be one of: %s", kind, strings.Join(apimodels.AgentDebugInfoKinds, ", "))
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, true)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			info, err := client.GetHostAgentDebugInfo(ctx, hostID, kind)
+			if err != nil {
+				return errors.Wrapf(err, "getting agent debug info for host '%s'", hostID)
+			}
+			fmt.Println(info)
+
+			return nil
+		},
+	}
+}
+
 // splitRsyncBinaryParams splits parameters to the rsync binary using shell
 // parsing rules.
 func splitRsyncBinaryParams(params ...string) ([]string, error) {
@@ -310,3 +310,16 @@ func TestHostRsync(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWeekdays(t *testing.T) {
+	weekdays, err := parseWeekdays([]string{"Saturday", "sun", "MONDAY"})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Weekday{time.Saturday, time.Sunday, time.Monday}, weekdays)
+
+	weekdays, err = parseWeekdays(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, weekdays)
+
+	_, err = parseWeekdays([]string{"Saturday", "someday"})
+	assert.Error(t, err)
+}
@@ -542,6 +542,7 @@ func (ac *legacyClient) PutPatch(incomingPatch patchSubmission) (*patch.Patch, e
 		TriggerAliases    []string           `json:"trigger_aliases"`
 		Parameters        []patch.Parameter  `json:"parameters"`
 		GitMetadata       patch.GitMetadata  `json:"git_metadata"`
+		Stack             patch.StackInfo    `json:"stack"`
 		RepeatDefinition  bool               `json:"reuse_definition"`
 		RepeatFailed      bool               `json:"repeat_failed"`
 		RepeatPatchId     string             `json:"repeat_patch_id"`
@@ -567,6 +568,7 @@ func (ac *legacyClient) PutPatch(incomingPatch patchSubmission) (*patch.Patch, e
 		TriggerAliases:    incomingPatch.triggerAliases,
 		Parameters:        incomingPatch.parameters,
 		GitMetadata:       incomingPatch.gitMetadata,
+		Stack:             incomingPatch.stack,
 		RepeatDefinition:  incomingPatch.repeatDefinition,
 		RepeatFailed:      incomingPatch.repeatFailed,
 		RepeatPatchId:     incomingPatch.repeatPatchId,
@@ -23,6 +23,8 @@ const (
 	repeatPatchIdFlag          = "repeat-patch"
 	includeModulesFlag         = "include-modules"
 	autoDescriptionFlag        = "auto-description"
+	stackFlagName              = "stack"
+	refreshStackFlagName       = "refresh-stack"
 )
 
 func getPatchFlags(flags ...cli.Flag) []cli.Flag {
@@ -91,6 +93,18 @@ func Patch() cli.Command {
 			mutuallyExclusiveArgs(false, preserveCommitsFlag, uncommittedChangesFlag),
 			mutuallyExclusiveArgs(false, repeatDefinitionFlag, repeatPatchIdFlag,
 				repeatFailedDefinitionFlag),
+			mutuallyExclusiveArgs(false, stackFlagName, refreshStackFlagName),
+			func(c *cli.Context) error {
+				if !c.Bool(stackFlagName) && c.String(refreshStackFlagName) == "" {
+					return nil
+				}
+				for _, flag := range []string{preserveCommitsFlag, uncommittedChangesFlag, includeModulesFlag, repeatFailedDefinitionFlag} {
+					if c.IsSet(flag) {
+						return errors.Errorf("cannot use '%s' with stacked patches", flag)
+					}
+				}
+				return nil
+			},
 			func(c *cli.Context) error {
 				catcher := grip.NewBasicCatcher()
 				for _, status := range utility.SplitCommas(c.StringSlice(syncStatusesFlagName)) {
@@ -108,6 +122,15 @@ func Patch() cli.Command {
 				Name:  includeModulesFlag,
 				Usage: "if this boolean is set, Evergreen will include module diffs using changes from defined module paths",
 			},
+			cli.BoolFlag{
+				Name:  stackFlagName,
+				Usage: "submit a stack of patches with one patch for each local commit, where each patch builds on the patch for the commit before it",
+			},
+			cli.StringFlag{
+				Name: refreshStackFlagName,
+				Usage: "submit the local commits as a new revision of the stack that the given patch belongs to, such as after a rebase; " +
+					"unless tasks are given, each patch reuses the tasks of the patch at the same position in the stack",
+			},
 		),
 		Action: func(c *cli.Context) error {
 			confPath := c.Parent().String(confFlagName)
@@ -140,6 +163,11 @@ func Patch() cli.Command {
 			var err error
 			params.addReuseFlags(c)
 			includeModules := c.Bool(includeModulesFlag)
+			refreshStackPatchID := c.String(refreshStackFlagName)
+			isStack := c.Bool(stackFlagName) || refreshStackPatchID != ""
+			if refreshStackPatchID != "" && !params.RepeatDefinition && len(params.Alias)+len(params.Tasks)+len(params.Variants)+len(params.RegexTasks)+len(params.RegexVariants) == 0 {
+				params.RepeatDefinition = true
+			}
 			paramsPairs := c.StringSlice(parameterFlagName)
 			params.Parameters, err = getParametersFromInput(paramsPairs)
 			if err != nil {
@@ -184,7 +212,6 @@ func Patch() cli.Command {
 			if err != nil {
 				return err
 			}
-			params.Description = params.getDescription()
 
 			isReusing := params.RepeatDefinition || params.RepeatFailed
 			hasTasksOrVariants := len(params.Tasks) > 0 || len(params.Variants) > 0
@@ -194,6 +221,23 @@ func Patch() cli.Command {
 				return errors.Errorf("can't define tasks, variants, regex tasks, regex variants or aliases when reusing previous patch's tasks and variants")
 			}
 
+			if isStack {
+				stackPatches, err := params.createPatchStack(ctx, ac, comm, ref, refreshStackPatchID)
+				if err != nil {
+					return err
+				}
+				browse := params.Browse
+				for i, stackPatch := range stackPatches {
+					params.Browse = browse && i == len(stackPatches)-1
+					if err = params.displayPatch(stackPatch, conf.UIServerHost, false); err != nil {
+						grip.Error(err)
+					}
+				}
+				params.setDefaultProject(conf)
+				return nil
+			}
+			params.Description = params.getDescription()
+
 			diffData, err := loadGitData("", ref.Branch, params.Ref, "", params.PreserveCommits, args...)
 			if err != nil {
 				return err
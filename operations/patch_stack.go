@@ -0,0 +1,154 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// stackEntry is a local commit to submit as one patch in a stack.
+type stackEntry struct {
+	commit  string
+	subject string
+	diff    *localDiff
+}
+
+// loadStackGitData returns an entry for each commit between the merge base
+// with the branch's upstream and the ref, ordered from oldest to newest. Each
+// entry's diff contains the changes of its commit and all the commits before
+// it, so that it can be applied on top of the merge base.
+func loadStackGitData(branch, ref string) ([]stackEntry, error) {
+	mergeBase, err := gitMergeBase("", branch+"@{upstream}", ref, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting merge base, "+
+			"may need to create local branch '%s' and have it track upstream", branch)
+	}
+	out, err := gitCmd("rev-list", "--reverse", fmt.Sprintf("%s..%s", mergeBase, getFeatureBranch(ref, "")))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing commits in stack")
+	}
+
+	var entries []stackEntry
+	for _, commit := range strings.Fields(out) {
+		subject, err := gitCmd("log", "-1", "--no-show-signature", "--pretty=format:%s", commit)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting commit message for commit '%s'", commit)
+		}
+		diff, err := loadGitData("", branch, commit, "", false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading diff for commit '%s'", commit)
+		}
+		entries = append(entries, stackEntry{
+			commit:  commit,
+			subject: strings.TrimSpace(subject),
+			diff:    diff,
+		})
+	}
+
+	return entries, nil
+}
+
+// getStackDescription returns the description for a patch in a stack.
+func (p *patchParams) getStackDescription(entry stackEntry) string {
+	if p.Description != "" {
+		return fmt.Sprintf("%s: %s", p.Description, entry.subject)
+	}
+	return entry.subject
+}
+
+// confirmStack shows the commits that will be submitted as a stack and asks
+// the user to confirm them.
+func (p *patchParams) confirmStack(entries []stackEntry) error {
+	catcher := grip.NewBasicCatcher()
+	for _, entry := range entries {
+		catcher.Wrapf(validatePatchSize(entry.diff, p.Large), "commit '%s'", entry.commit)
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+	if p.SkipConfirm {
+		return nil
+	}
+
+	lines := []string{"The following commits will be submitted as a stack of patches, from the bottom of the stack to the top:"}
+	for i, entry := range entries {
+		lines = append(lines, fmt.Sprintf("  %d. %s %s", i+1, entry.commit, entry.subject))
+	}
+	grip.Info(strings.Join(lines, "\n"))
+	if !confirm("Continue?", true) {
+		return errors.New("patch aborted")
+	}
+	return nil
+}
+
+// createPatchStack submits a patch for each local commit on top of the base
+// branch, where each patch builds on the patch for the commit before it. If
+// refreshPatchID is set, the commits are submitted as a new revision of the
+// stack that patch belongs to, such as after a rebase. When refreshing a stack
+// without explicit tasks, each new patch reuses the task definition of the
+// patch at the same position in the previous revision.
+func (p *patchParams) createPatchStack(ctx context.Context, ac *legacyClient, comm client.Communicator, ref *model.ProjectRef, refreshPatchID string) ([]*patch.Patch, error) {
+	entries, err := loadStackGitData(ref.Branch, p.Ref)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("there are no commits to submit as a stack")
+	}
+
+	stack := patch.StackInfo{ID: utility.RandomString()}
+	var previousRevision []restmodel.APIPatch
+	if refreshPatchID != "" {
+		previousRevision, err = comm.GetPatchStack(ctx, refreshPatchID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting stack for patch '%s'", refreshPatchID)
+		}
+		if len(previousRevision) == 0 || previousRevision[0].Stack == nil {
+			return nil, errors.Errorf("patch '%s' is not part of a stack", refreshPatchID)
+		}
+		stack.ID = utility.FromStringPtr(previousRevision[0].Stack.ID)
+		stack.Revision = previousRevision[0].Stack.Revision + 1
+	}
+
+	if err = p.confirmStack(entries); err != nil {
+		return nil, err
+	}
+
+	var patches []*patch.Patch
+	for i, entry := range entries {
+		entryParams := *p
+		entryParams.Description = p.getStackDescription(entry)
+		entryParams.Stack = stack
+		entryParams.Stack.Position = i
+		entryParams.Stack.Commit = entry.commit
+		if i > 0 {
+			entryParams.Stack.ParentPatchID = patches[i-1].Id.Hex()
+		}
+		if p.RepeatDefinition && p.RepeatPatchId == "" {
+			// Reuse the task definition of the patch at the same position
+			// in the previous revision of the stack, or of the patch below
+			// this one if the stack grew.
+			if i < len(previousRevision) {
+				entryParams.RepeatPatchId = utility.FromStringPtr(previousRevision[i].Id)
+			} else {
+				entryParams.RepeatPatchId = entryParams.Stack.ParentPatchID
+			}
+		}
+
+		newPatch, err := entryParams.createPatch(ac, entry.diff)
+		if err != nil {
+			return patches, errors.Wrapf(err, "creating patch for commit '%s'", entry.commit)
+		}
+		patches = append(patches, newPatch)
+	}
+
+	return patches, nil
+}
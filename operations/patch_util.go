@@ -86,6 +86,7 @@ type patchParams struct {
 	RepeatPatchId     string
 	GithubAuthor      string
 	PatchAuthor       string
+	Stack             patch.StackInfo
 }
 
 type patchSubmission struct {
@@ -113,6 +114,7 @@ type patchSubmission struct {
 	repeatPatchId     string
 	githubAuthor      string
 	patchAuthor       string
+	stack             patch.StackInfo
 }
 
 func (p *patchParams) createPatch(ac *legacyClient, diffData *localDiff) (*patch.Patch, error) {
@@ -141,6 +143,7 @@ func (p *patchParams) createPatch(ac *legacyClient, diffData *localDiff) (*patch
 		path:              p.Path,
 		githubAuthor:      p.GithubAuthor,
 		patchAuthor:       p.PatchAuthor,
+		stack:             p.Stack,
 	}
 
 	newPatch, err := ac.PutPatch(patchSub)
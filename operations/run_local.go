@@ -0,0 +1,99 @@
+package operations
+
+import (
+	"context"
+	"os"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func RunLocal() cli.Command {
+	const (
+		variantFlagName        = "variant"
+		taskFlagName           = "task"
+		expansionsFileFlagName = "expansions_file"
+	)
+
+	return cli.Command{
+		Name:  "run-local",
+		Usage: "run a task from a project configuration file on the local machine",
+		Flags: addPathFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(variantFlagName, "v"),
+				Usage: "the name of the build variant to run the task in",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "the name of the task to run",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(expansionsFileFlagName, "e"),
+				Usage: "path to a YAML file of expansions to make available to the task's commands",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(dirFlagName, "d"),
+				Usage: "the working directory to run the task in (defaults to a new temporary directory)",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(localModulesFlagName, "lm"),
+				Usage: "specify local modules as MODULE_NAME=PATH pairs",
+			}),
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			requirePathFlag,
+			requireStringFlag(variantFlagName),
+			requireStringFlag(taskFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			path := c.String(pathFlagName)
+			localModuleMap, err := getLocalModulesFromInput(c.StringSlice(localModulesFlagName))
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return errors.Wrapf(err, "reading project configuration file '%s'", path)
+			}
+			project := &model.Project{}
+			opts := &model.GetProjectOpts{
+				LocalModules: localModuleMap,
+				ReadFileFrom: model.ReadFromLocal,
+			}
+			if _, err = model.LoadProjectInto(ctx, data, opts, "", project); err != nil {
+				return errors.Wrapf(err, "loading project configuration file '%s'", path)
+			}
+
+			expansions := map[string]string{}
+			if fn := c.String(expansionsFileFlagName); fn != "" {
+				if err = utility.ReadYAMLFile(fn, &expansions); err != nil {
+					return errors.Wrapf(err, "reading expansions from file '%s'", fn)
+				}
+			}
+
+			status, err := agent.RunLocalTask(ctx, agent.LocalTaskOptions{
+				Project:          project,
+				BuildVariant:     c.String(variantFlagName),
+				TaskName:         c.String(taskFlagName),
+				Expansions:       expansions,
+				WorkingDirectory: c.String(dirFlagName),
+			})
+			if err != nil {
+				return errors.Wrap(err, "running task locally")
+			}
+			if status != evergreen.TaskSucceeded {
+				return errors.Errorf("task finished with status '%s'", status)
+			}
+
+			return nil
+		},
+	}
+}
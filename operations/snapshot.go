@@ -0,0 +1,15 @@
+package operations
+
+import "github.com/urfave/cli"
+
+func Snapshot() cli.Command {
+	return cli.Command{
+		Name:  "snapshot",
+		Usage: "manage Evergreen EBS volume snapshots",
+		Subcommands: []cli.Command{
+			hostCreateSnapshot(),
+			hostDeleteSnapshot(),
+			hostListSnapshot(),
+		},
+	}
+}
@@ -145,6 +145,21 @@ func validateFile(path string, ac *legacyClient, quiet, includeLong bool, localM
 	if err != nil {
 		return nil
 	}
+	if !quiet && project.HasPathFilters() {
+		// The server can only check path filters against the project's
+		// tracked branch, so also check them against the files in the local
+		// repository, which may include files that aren't on the branch yet.
+		repoFiles, err := getRepoFiles(filepath.Dir(path))
+		if err != nil {
+			grip.Warning(errors.Wrap(err, "getting repository files to check path filters"))
+		} else {
+			for _, pathErr := range validator.CheckPathFilters(project, repoFiles) {
+				if !containsValidationError(projErrors, pathErr) {
+					projErrors = append(projErrors, pathErr)
+				}
+			}
+		}
+	}
 
 	grip.Info(projErrors)
 	if projErrors.HasError() {
@@ -158,6 +173,38 @@ func validateFile(path string, ac *legacyClient, quiet, includeLong bool, localM
 	return nil
 }
 
+// containsValidationError returns whether the errors already include one with
+// the same level and message, such as one that the server also reported.
+func containsValidationError(errs validator.ValidationErrors, err validator.ValidationError) bool {
+	for _, other := range errs {
+		if other.Level == err.Level && other.Message == err.Message {
+			return true
+		}
+	}
+	return false
+}
+
+// getRepoFiles returns the paths of all the files tracked in the git
+// repository containing the given directory, relative to the repository root.
+func getRepoFiles(dir string) ([]string, error) {
+	root, err := gitExecCmd([]string{"-C", dir, "rev-parse", "--show-toplevel"})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding repository root")
+	}
+	out, err := gitExecCmd([]string{"-C", strings.TrimSpace(root), "ls-files", "-z"})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing repository files")
+	}
+
+	var files []string
+	for _, f := range strings.Split(out, "\x00") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
 // loadProjectIntoWithValidation returns a warning (instead of an error) if there's an error with unmarshalling strictly
 func loadProjectIntoWithValidation(ctx context.Context, data []byte, opts *model.GetProjectOpts,
 	project *model.Project) (*model.ParserProject, *model.ProjectConfig, validator.ValidationErrors) {
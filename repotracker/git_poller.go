@@ -0,0 +1,196 @@
+package repotracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// GitRepositoryPoller is a RepoPoller that uses plain git commands against the
+// project's git remote, so it works with any git host (including a repository
+// on the local filesystem) rather than only GitHub. It keeps a bare mirror of
+// the project's branch in a local directory and reads from it.
+type GitRepositoryPoller struct {
+	ProjectRef *model.ProjectRef
+	// RemoteURL is the URL of the git remote to poll.
+	RemoteURL string
+	// Dir is the directory of the local bare mirror.
+	Dir string
+}
+
+// NewGitRepositoryPoller constructs and returns a pointer to a
+// GitRepositoryPoller that polls the project's git remote URL.
+func NewGitRepositoryPoller(projectRef *model.ProjectRef) *GitRepositoryPoller {
+	return &GitRepositoryPoller{
+		ProjectRef: projectRef,
+		RemoteURL:  projectRef.GitRemoteURL,
+		Dir:        thirdparty.GitMirrorDir(projectRef.Id),
+	}
+}
+
+// gitCommitToRevision converts a git commit to Evergreen's revision model.
+func gitCommitToRevision(commit thirdparty.GitCommit) model.Revision {
+	return model.Revision{
+		Author:          commit.AuthorName,
+		AuthorEmail:     commit.AuthorEmail,
+		RevisionMessage: commit.Message,
+		Revision:        commit.SHA,
+		CreateTime:      commit.CommitTime,
+	}
+}
+
+func (p *GitRepositoryPoller) branchRef() string {
+	return "refs/heads/" + p.ProjectRef.Branch
+}
+
+// fetch updates the local mirror with the latest commits on the branch.
+func (p *GitRepositoryPoller) fetch(ctx context.Context) error {
+	return errors.Wrapf(thirdparty.GitFetchBranch(ctx, p.Dir, p.RemoteURL, p.ProjectRef.Branch), "fetching branch '%s' for project '%s'", p.ProjectRef.Branch, p.ProjectRef.Id)
+}
+
+// ensureRevision fetches the branch if the revision is not already in the
+// local mirror.
+func (p *GitRepositoryPoller) ensureRevision(ctx context.Context, revision string) error {
+	if thirdparty.GitHasCommit(ctx, p.Dir, revision) {
+		return nil
+	}
+	return p.fetch(ctx)
+}
+
+// GetRemoteConfig reads the project's configuration file at the given revision
+// from the local mirror.
+func (p *GitRepositoryPoller) GetRemoteConfig(ctx context.Context, revision string) (model.ProjectInfo, error) {
+	if err := p.ensureRevision(ctx, revision); err != nil {
+		return model.ProjectInfo{}, err
+	}
+	opts := model.GetProjectOpts{
+		Ref:          p.ProjectRef,
+		RemotePath:   p.ProjectRef.RemotePath,
+		Revision:     revision,
+		ReadFileFrom: model.ReadFromGit,
+		GitDir:       p.Dir,
+	}
+	return model.GetProjectFromFile(ctx, opts)
+}
+
+// GetChangedFiles returns the files modified by the given revision.
+func (p *GitRepositoryPoller) GetChangedFiles(ctx context.Context, revision string) ([]string, error) {
+	if err := p.ensureRevision(ctx, revision); err != nil {
+		return nil, err
+	}
+	files, err := thirdparty.GitChangedFiles(ctx, p.Dir, revision)
+	return files, errors.Wrapf(err, "getting files changed by revision '%s'", revision)
+}
+
+// GetRevisionsSince returns the commits on the project's branch that were made
+// after the given revision, in order of most recent to least recent. If it
+// cannot find the revision within maxRevisionsToSearch commits, it attempts to
+// use the merge base between the revision and the branch head as the new base
+// revision.
+func (p *GitRepositoryPoller) GetRevisionsSince(revision string, maxRevisionsToSearch int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.fetch(ctx); err != nil {
+		return nil, err
+	}
+	commits, err := thirdparty.GitLog(ctx, p.Dir, p.branchRef(), maxRevisionsToSearch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commits for project '%s'", p.ProjectRef.Id)
+	}
+
+	var foundLatest bool
+	revisions := []model.Revision{}
+	for _, commit := range commits {
+		if commit.SHA == revision {
+			foundLatest = true
+			break
+		}
+		revisions = append(revisions, gitCommitToRevision(commit))
+	}
+
+	if !foundLatest {
+		if len(revision) < 10 {
+			return nil, errors.Errorf("invalid revision '%s'", revision)
+		}
+
+		var baseRevision string
+		if thirdparty.GitHasCommit(ctx, p.Dir, revision) {
+			baseRevision, err = thirdparty.GitMergeBase(ctx, p.Dir, revision, p.branchRef())
+		} else {
+			err = errors.Errorf("revision '%s' is not in the repository", revision)
+		}
+		if err != nil {
+			// unable to get merge base commit so set projectRef revision details with a blank base revision
+			revisionDetails := &model.RepositoryErrorDetails{
+				Exists:            true,
+				InvalidRevision:   revision[:10],
+				MergeBaseRevision: "",
+			}
+			revisionError := errors.Wrapf(err,
+				"unable to find a suggested merge base commit for revision '%s', must fix on projects settings page",
+				revision)
+			if err := p.ProjectRef.SetRepotrackerError(revisionDetails); err != nil {
+				return []model.Revision{}, errors.Wrap(err, "setting repotracker error")
+			}
+			return []model.Revision{}, revisionError
+		}
+
+		// automatically set the newly found base revision as base revision and append revisions
+		commit, err := thirdparty.GitGetCommit(ctx, p.Dir, baseRevision)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading base commit '%s'", baseRevision)
+		}
+		revisions = append(revisions, gitCommitToRevision(*commit))
+
+		grip.Info(message.Fields{
+			"message":            "updating last repo revision for project",
+			"source":             "git poller",
+			"old_revision":       revision,
+			"new_revision":       baseRevision,
+			"project":            p.ProjectRef.Id,
+			"project_identifier": p.ProjectRef.Identifier,
+		})
+		if err = model.UpdateLastRevision(p.ProjectRef.Id, baseRevision); err != nil {
+			return nil, errors.Wrapf(err, "updating last revision to base revision '%s'", baseRevision)
+		}
+	}
+
+	if len(revisions) == 0 {
+		grip.Info(message.Fields{
+			"source":             "git poller",
+			"message":            "no new revisions",
+			"last_revision":      revision,
+			"project":            p.ProjectRef.Id,
+			"project_identifier": p.ProjectRef.Identifier,
+		})
+	}
+
+	return revisions, nil
+}
+
+// GetRecentRevisions returns the most recent maxRevisions commits on the
+// project's branch, in order of most recent to least recent.
+func (p *GitRepositoryPoller) GetRecentRevisions(maxRevisions int) ([]model.Revision, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+
+	if err := p.fetch(ctx); err != nil {
+		return nil, err
+	}
+	commits, err := thirdparty.GitLog(ctx, p.Dir, p.branchRef(), maxRevisions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting commits for project '%s'", p.ProjectRef.Id)
+	}
+
+	revisions := make([]model.Revision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, gitCommitToRevision(commit))
+	}
+	return revisions, nil
+}
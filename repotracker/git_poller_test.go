@@ -0,0 +1,183 @@
+package repotracker
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRepositoryPoller(t *testing.T) {
+	runGit := func(t *testing.T, dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(t *testing.T, dir, msg string, files map[string]string) string {
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		}
+		runGit(t, dir, "add", "-A")
+		runGit(t, dir, "commit", "-m", msg)
+		return runGit(t, dir, "rev-parse", "HEAD")
+	}
+
+	const config = `
+tasks:
+- name: compile
+buildvariants:
+- name: ubuntu
+  run_on: ubuntu
+  tasks:
+  - name: compile
+`
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string){
+		"GetRecentRevisionsReturnsMostRecentFirst": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			commit(t, remote, "first", map[string]string{"a.txt": "a"})
+			second := commit(t, remote, "second", map[string]string{"b.txt": "b"})
+			third := commit(t, remote, "third", map[string]string{"c.txt": "c"})
+
+			revisions, err := poller.GetRecentRevisions(2)
+			require.NoError(t, err)
+			require.Len(t, revisions, 2)
+			assert.Equal(t, third, revisions[0].Revision)
+			assert.Equal(t, "third", revisions[0].RevisionMessage)
+			assert.Equal(t, "Evergreen Tester", revisions[0].Author)
+			assert.Equal(t, "tester@example.com", revisions[0].AuthorEmail)
+			assert.False(t, revisions[0].CreateTime.IsZero())
+			assert.Equal(t, second, revisions[1].Revision)
+		},
+		"GetRevisionsSinceReturnsNewerRevisions": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			first := commit(t, remote, "first", map[string]string{"a.txt": "a"})
+			second := commit(t, remote, "second", map[string]string{"b.txt": "b"})
+			third := commit(t, remote, "third", map[string]string{"c.txt": "c"})
+
+			revisions, err := poller.GetRevisionsSince(first, 10)
+			require.NoError(t, err)
+			require.Len(t, revisions, 2)
+			assert.Equal(t, third, revisions[0].Revision)
+			assert.Equal(t, second, revisions[1].Revision)
+		},
+		"GetRevisionsSinceReturnsNothingWhenUpToDate": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			head := commit(t, remote, "first", map[string]string{"a.txt": "a"})
+
+			revisions, err := poller.GetRevisionsSince(head, 10)
+			require.NoError(t, err)
+			assert.Empty(t, revisions)
+		},
+		"GetRevisionsSincePicksUpNewCommits": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			first := commit(t, remote, "first", map[string]string{"a.txt": "a"})
+			revisions, err := poller.GetRevisionsSince(first, 10)
+			require.NoError(t, err)
+			assert.Empty(t, revisions)
+
+			second := commit(t, remote, "second", map[string]string{"b.txt": "b"})
+			revisions, err = poller.GetRevisionsSince(first, 10)
+			require.NoError(t, err)
+			require.Len(t, revisions, 1)
+			assert.Equal(t, second, revisions[0].Revision)
+		},
+		"GetChangedFilesReturnsModifiedFiles": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			commit(t, remote, "first", map[string]string{"a.txt": "a", "src/b.go": "b"})
+			second := commit(t, remote, "second", map[string]string{"src/b.go": "b2", "docs/c.md": "c"})
+
+			files, err := poller.GetChangedFiles(ctx, second)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"src/b.go", "docs/c.md"}, files)
+		},
+		"GetRemoteConfigReadsConfigAtRevision": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			first := commit(t, remote, "first", map[string]string{"evergreen.yml": config})
+			commit(t, remote, "second", map[string]string{"evergreen.yml": strings.Replace(config, "ubuntu", "windows", -1)})
+
+			projectInfo, err := poller.GetRemoteConfig(ctx, first)
+			require.NoError(t, err)
+			require.NotNil(t, projectInfo.Project)
+			require.Len(t, projectInfo.Project.BuildVariants, 1)
+			assert.Equal(t, "ubuntu", projectInfo.Project.BuildVariants[0].Name)
+			require.Len(t, projectInfo.Project.Tasks, 1)
+			assert.Equal(t, "compile", projectInfo.Project.Tasks[0].Name)
+		},
+		"GetRemoteConfigReturnsFileNotFoundForMissingConfig": func(ctx context.Context, t *testing.T, poller *GitRepositoryPoller, remote string) {
+			head := commit(t, remote, "first", map[string]string{"a.txt": "a"})
+
+			_, err := poller.GetRemoteConfig(ctx, head)
+			require.Error(t, err)
+			assert.True(t, thirdparty.IsFileNotFound(errors.Cause(err)))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			remote := t.TempDir()
+			runGit(t, remote, "init", "--initial-branch=main")
+			runGit(t, remote, "config", "user.name", "Evergreen Tester")
+			runGit(t, remote, "config", "user.email", "tester@example.com")
+
+			poller := NewGitRepositoryPoller(&model.ProjectRef{
+				Id:           "project",
+				Branch:       "main",
+				RemotePath:   "evergreen.yml",
+				GitRemoteURL: remote,
+			})
+			poller.Dir = filepath.Join(t.TempDir(), "mirror.git")
+
+			tCase(ctx, t, poller, remote)
+		})
+	}
+}
+
+func TestGitRepositoryPollerWithLocalRemote(t *testing.T) {
+	runGit := func(t *testing.T, dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	for tName, makeRemoteURL := range map[string]func(dir string) string{
+		"AbsolutePath": func(dir string) string { return dir },
+		"FileURL":      func(dir string) string { return "file://" + filepath.ToSlash(dir) },
+	} {
+		t.Run(tName, func(t *testing.T) {
+			remote := t.TempDir()
+			runGit(t, remote, "init", "--initial-branch=main")
+			runGit(t, remote, "config", "user.name", "Evergreen Tester")
+			runGit(t, remote, "config", "user.email", "tester@example.com")
+			require.NoError(t, os.WriteFile(filepath.Join(remote, "a.txt"), []byte("a"), 0644))
+			runGit(t, remote, "add", "-A")
+			runGit(t, remote, "commit", "-m", "first")
+			head := runGit(t, remote, "rev-parse", "HEAD")
+
+			pRef := &model.ProjectRef{
+				Id:           "project",
+				Branch:       "main",
+				RemotePath:   "evergreen.yml",
+				GitRemoteURL: makeRemoteURL(remote),
+			}
+			require.Error(t, pRef.ValidateGitRemoteURL(false), "local remotes should require the admin setting")
+			require.NoError(t, pRef.ValidateGitRemoteURL(true))
+
+			poller := NewGitRepositoryPoller(pRef)
+			poller.Dir = filepath.Join(t.TempDir(), "mirror.git")
+			revisions, err := poller.GetRecentRevisions(1)
+			require.NoError(t, err)
+			require.Len(t, revisions, 1)
+			assert.Equal(t, head, revisions[0].Revision)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package repotracker
+
+import (
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+)
+
+// NewGitlabRepositoryPoller constructs and returns a pointer to a
+// GitRepositoryPoller that polls the project's repository on the configured
+// GitLab instance. Unless the project overrides its git remote URL, the poller
+// authenticates to GitLab with the configured token.
+func NewGitlabRepositoryPoller(projectRef *model.ProjectRef, conf evergreen.GitlabConfig) *GitRepositoryPoller {
+	poller := NewGitRepositoryPoller(projectRef)
+	if poller.RemoteURL == "" {
+		poller.RemoteURL = conf.CloneURL(projectRef.GitlabProjectPath())
+	}
+	return poller
+}
@@ -302,14 +302,15 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 			return err
 		}
 
-		// "Ignore" a version if all changes are to ignored files
+		// "Ignore" a version if all changes are to ignored files, and only
+		// activate tasks whose paths match the changed files.
 		var ignore bool
-		if len(pInfo.Project.Ignore) > 0 {
-			var filenames []string
+		var filenames []string
+		if len(pInfo.Project.Ignore) > 0 || pInfo.Project.HasPathFilters() {
 			filenames, err = repoTracker.GetChangedFiles(ctx, revision)
 			if err != nil {
 				grip.Error(message.WrapError(err, message.Fields{
-					"message":            "error checking GitHub for ignored files",
+					"message":            "error checking GitHub for changed files",
 					"runner":             RunnerName,
 					"project":            ref.Id,
 					"project_identifier": ref.Identifier,
@@ -323,7 +324,8 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 		}
 
 		metadata := model.VersionMetadata{
-			Revision: revisions[i],
+			Revision:     revisions[i],
+			ChangedFiles: filenames,
 		}
 		projectInfo := &model.ProjectInfo{
 			Ref:                 ref,
@@ -938,6 +940,24 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 		if v.Requester == evergreen.RepotrackerVersionRequester && evergreen.ShouldConsiderBatchtime(v.Requester) {
 			activateVariantAt, err = projectInfo.Ref.GetActivationTimeForVariant(&buildvariant)
 			batchTimeCatcher.Add(errors.Wrapf(err, "unable to get activation time for variant '%s'", buildvariant.Name))
+
+			// Tasks whose paths don't match the changed files are never
+			// activated automatically.
+			skippedTasks, allSkipped := getTasksSkippedByPaths(projectInfo.Project, buildvariant.Name, tasks, metadata.ChangedFiles)
+			for _, t := range skippedTasks {
+				taskStatuses = append(taskStatuses, model.BatchTimeTaskStatus{
+					TaskName: t.DisplayName,
+					TaskId:   t.Id,
+					ActivationStatus: model.ActivationStatus{
+						ActivateAt: utility.ZeroTime,
+						Activated:  false,
+					},
+				})
+			}
+			if allSkipped {
+				activateVariantAt = utility.ZeroTime
+			}
+
 			// add only tasks that require activation times
 			for _, bvt := range buildvariant.Tasks {
 				tId, ok := taskNameToId[bvt.Name]
@@ -945,6 +965,9 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 				if !ok || !bvt.HasSpecificActivation() {
 					continue
 				}
+				if !bvt.MatchesChangedFiles(metadata.ChangedFiles) {
+					continue
+				}
 				activateTaskAt, err := projectInfo.Ref.GetActivationTimeForTask(&bvt, tId)
 				batchTimeCatcher.Add(errors.Wrapf(err, "unable to get activation time for task '%s' (variant '%s')", bvt.Name, buildvariant.Name))
 
@@ -1097,6 +1120,29 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 	return transactionWithRetries(ctx, v.Id, txFunc)
 }
 
+// getTasksSkippedByPaths returns the tasks in the build variant whose path
+// filters do not match any of the changed files, and whether every task in
+// the build variant was skipped.
+func getTasksSkippedByPaths(p *model.Project, variant string, tasks task.Tasks, changedFiles []string) (task.Tasks, bool) {
+	if len(changedFiles) == 0 {
+		return nil, false
+	}
+
+	var skipped task.Tasks
+	numExecTasks := 0
+	for _, t := range tasks {
+		if t.DisplayOnly {
+			continue
+		}
+		numExecTasks++
+		bvt := p.FindTaskForVariant(t.DisplayName, variant)
+		if bvt != nil && !bvt.MatchesChangedFiles(changedFiles) {
+			skipped = append(skipped, t)
+		}
+	}
+	return skipped, len(skipped) > 0 && len(skipped) == numExecTasks
+}
+
 // If we error in aborting transaction, we create a new session and start again.
 // If we abort successfully and the error is a transient transaction error, we retry using the same session.
 func transactionWithRetries(ctx context.Context, versionId string, sessionFunc func(sessCtx mongo.SessionContext) error) error {
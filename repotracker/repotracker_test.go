@@ -1085,6 +1085,73 @@ tasks:
 	}
 }
 
+func (s *CreateVersionFromConfigSuite) TestWithPathFilters() {
+	configYml := `
+buildvariants:
+- name: bv
+  display_name: "bv_display"
+  run_on: d
+  tasks:
+  - name: server
+    paths: ["src/server/"]
+  - name: client
+    paths: ["src/client/"]
+  - name: lint
+- name: bv2
+  display_name: bv2_display
+  run_on: d
+  ignore_paths: ["*.md", "docs/"]
+  tasks:
+  - name: server
+tasks:
+- name: server
+- name: client
+- name: lint
+`
+	p := &model.Project{}
+	pp, err := model.LoadProjectInto(s.ctx, []byte(configYml), nil, s.ref.Id, p)
+	s.NoError(err)
+	projectInfo := &model.ProjectInfo{
+		Ref:                 s.ref,
+		IntermediateProject: pp,
+		Project:             p,
+	}
+	metadata := model.VersionMetadata{
+		Revision:     *s.rev,
+		ChangedFiles: []string{"src/server/main.go", "docs/README.md"},
+	}
+	v, err := CreateVersionFromConfig(s.ctx, projectInfo, metadata, false, nil)
+	s.NoError(err)
+	s.Require().NotNil(v)
+	s.Len(v.Errors, 0)
+
+	s.Require().Len(v.BuildVariants, 2)
+	for _, bv := range v.BuildVariants {
+		if bv.BuildVariant == "bv" {
+			s.False(utility.IsZeroTime(bv.ActivateAt))
+			s.Require().Len(bv.BatchTimeTasks, 1, "only the task whose paths did not change should be skipped")
+			s.Equal("client", bv.BatchTimeTasks[0].TaskName)
+			s.True(utility.IsZeroTime(bv.BatchTimeTasks[0].ActivateAt))
+		}
+		if bv.BuildVariant == "bv2" {
+			s.Len(bv.BatchTimeTasks, 0)
+			s.False(utility.IsZeroTime(bv.ActivateAt))
+		}
+	}
+
+	s.NoError(db.ClearCollections(model.VersionCollection, model.ParserProjectCollection, build.Collection, task.Collection))
+	metadata.ChangedFiles = []string{"docs/README.md"}
+	v, err = CreateVersionFromConfig(s.ctx, projectInfo, metadata, false, nil)
+	s.NoError(err)
+	s.Require().NotNil(v)
+	for _, bv := range v.BuildVariants {
+		if bv.BuildVariant == "bv2" {
+			s.True(utility.IsZeroTime(bv.ActivateAt), "build variant should not activate if all of its tasks are skipped")
+			s.Len(bv.BatchTimeTasks, 1)
+		}
+	}
+}
+
 func (s *CreateVersionFromConfigSuite) TestVersionWithDependencies() {
 	configYml := `
 buildvariants:
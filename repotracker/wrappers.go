@@ -22,6 +22,24 @@ const (
 )
 
 func getTracker(conf *evergreen.Settings, project model.ProjectRef) (*RepoTracker, error) {
+	if project.IsGitlabProject() {
+		if !conf.Gitlab.IsConfigured() && project.GitRemoteURL == "" {
+			return nil, errors.Errorf("GitLab is not configured, cannot poll project '%s'", project.Id)
+		}
+		return &RepoTracker{
+			Settings:   conf,
+			ProjectRef: &project,
+			RepoPoller: NewGitlabRepositoryPoller(&project, conf.Gitlab),
+		}, nil
+	}
+	if project.GitRemoteURL != "" {
+		return &RepoTracker{
+			Settings:   conf,
+			ProjectRef: &project,
+			RepoPoller: NewGitRepositoryPoller(&project),
+		}, nil
+	}
+
 	token, err := conf.GetGithubOauthToken()
 	if err != nil {
 		grip.Warning(message.Fields{
@@ -42,6 +42,9 @@ type Communicator interface {
 	UpdateSettings(context.Context, *restmodel.APIAdminSettings) (*restmodel.APIAdminSettings, error)
 	GetEvents(context.Context, time.Time, int) ([]interface{}, error)
 	RevertSettings(context.Context, string) error
+	// GetAuditEvents returns the audit events matching the options, from
+	// newest to oldest.
+	GetAuditEvents(context.Context, event.AuditEventsQueryOptions) ([]restmodel.AuditAPIEventLogEntry, error)
 	ExecuteOnDistro(ctx context.Context, distro string, opts restmodel.APIDistroScriptOptions) (hostIDs []string, err error)
 	GetServiceUsers(ctx context.Context) ([]restmodel.APIDBUser, error)
 	UpdateServiceUser(context.Context, string, string, []string) error
@@ -65,8 +68,14 @@ type Communicator interface {
 	ModifyVolume(context.Context, string, *restmodel.VolumeModifyOptions) error
 	GetVolume(context.Context, string) (*restmodel.APIVolume, error)
 	GetVolumesByUser(context.Context) ([]restmodel.APIVolume, error)
+	CreateSnapshot(context.Context, *restmodel.SnapshotPostRequest) (*restmodel.APISnapshot, error)
+	DeleteSnapshot(context.Context, string) error
+	GetSnapshotsByUser(context.Context) ([]restmodel.APISnapshot, error)
 	StartHostProcesses(context.Context, []string, string, int) ([]restmodel.APIHostProcess, error)
 	GetHostProcessOutput(context.Context, []restmodel.APIHostProcess, int) ([]restmodel.APIHostProcess, error)
+	// GetHostAgentDebugInfo returns the given kind of debugging information
+	// from the agent running on the host.
+	GetHostAgentDebugInfo(context.Context, string, string) (string, error)
 	FindHostByIpAddress(context.Context, string) (*restmodel.APIHost, error)
 
 	// Fetch list of distributions evergreen can spawn
@@ -80,6 +89,15 @@ type Communicator interface {
 	// Delete a key with specified name from the current authenticated user
 	DeletePublicKey(context.Context, string) error
 
+	// GetAccessTokens returns the current authenticated user's personal
+	// access tokens, without the tokens themselves.
+	GetAccessTokens(context.Context) ([]restmodel.APIPersonalAccessToken, error)
+	// CreateAccessToken creates a personal access token for the current
+	// authenticated user. The response is the only time the token is returned.
+	CreateAccessToken(context.Context, restmodel.APIPersonalAccessTokenPostRequest) (*restmodel.APIPersonalAccessToken, error)
+	// DeleteAccessToken revokes the personal access token with the given ID.
+	DeleteAccessToken(context.Context, string) error
+
 	// List variant/task aliases
 	ListAliases(context.Context, string) ([]model.ProjectAlias, error)
 	ListPatchTriggerAliases(context.Context, string) ([]string, error)
@@ -132,4 +150,8 @@ type Communicator interface {
 
 	// GetRawPatchWithModules fetches the raw patch and module diffs for a given patch ID.
 	GetRawPatchWithModules(ctx context.Context, patchId string) (*restmodel.APIRawPatch, error)
+
+	// GetPatchStack returns the patches in the latest revision of the given
+	// patch's stack, ordered from the bottom of the stack to the top.
+	GetPatchStack(ctx context.Context, patchId string) ([]restmodel.APIPatch, error)
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -299,6 +301,81 @@ func (c *communicatorImpl) GetVolumesByUser(ctx context.Context) ([]model.APIVol
 	return getVolumesResp, nil
 }
 
+func (c *communicatorImpl) CreateSnapshot(ctx context.Context, opts *model.SnapshotPostRequest) (*model.APISnapshot, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   "snapshots",
+	}
+
+	resp, err := c.request(ctx, info, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to create snapshot")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "creating snapshot")
+	}
+
+	createSnapshotResp := model.APISnapshot{}
+	if err = utility.ReadJSON(resp.Body, &createSnapshotResp); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return &createSnapshotResp, nil
+}
+
+func (c *communicatorImpl) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	info := requestInfo{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("snapshots/%s", snapshotID),
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return errors.Wrapf(err, "sending request to delete snapshot '%s'", snapshotID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return util.RespErrorf(resp, "deleting snapshot '%s'", snapshotID)
+	}
+
+	return nil
+}
+
+func (c *communicatorImpl) GetSnapshotsByUser(ctx context.Context) ([]model.APISnapshot, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   "snapshots",
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get snapshots for user '%s'", c.apiUser)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting snapshots for user '%s'", c.apiUser)
+	}
+
+	getSnapshotsResp := []model.APISnapshot{}
+	if err = utility.ReadJSON(resp.Body, &getSnapshotsResp); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return getSnapshotsResp, nil
+}
+
 func (c *communicatorImpl) StartSpawnHost(ctx context.Context, hostID string, subscriptionType string, wait bool) error {
 	info := requestInfo{
 		method: http.MethodPost,
@@ -651,6 +728,48 @@ func (c *communicatorImpl) GetEvents(ctx context.Context, ts time.Time, limit in
 	return events, nil
 }
 
+func (c *communicatorImpl) GetAuditEvents(ctx context.Context, opts event.AuditEventsQueryOptions) ([]model.AuditAPIEventLogEntry, error) {
+	params := url.Values{}
+	if opts.Actor != "" {
+		params.Set("actor", opts.Actor)
+	}
+	if opts.ResourceID != "" {
+		params.Set("resource_id", opts.ResourceID)
+	}
+	if !opts.StartTime.IsZero() {
+		params.Set("start_time", opts.StartTime.Format(time.RFC3339Nano))
+	}
+	if !opts.EndTime.IsZero() {
+		params.Set("end_time", opts.EndTime.Format(time.RFC3339Nano))
+	}
+	if opts.Limit > 0 {
+		params.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   "admin/audit?" + params.Encode(),
+	}
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to get audit events")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting audit events")
+	}
+
+	events := []model.AuditAPIEventLogEntry{}
+	if err = utility.ReadJSON(resp.Body, &events); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return events, nil
+}
+
 func (c *communicatorImpl) RevertSettings(ctx context.Context, guid string) error {
 	info := requestInfo{
 		method: http.MethodPost,
@@ -876,6 +995,80 @@ func (c *communicatorImpl) DeletePublicKey(ctx context.Context, keyName string)
 	return nil
 }
 
+func (c *communicatorImpl) GetAccessTokens(ctx context.Context) ([]model.APIPersonalAccessToken, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   "user/access_tokens",
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get personal access tokens for user '%s'", c.apiUser)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting personal access tokens for user '%s'", c.apiUser)
+	}
+
+	tokens := []model.APIPersonalAccessToken{}
+	if err = utility.ReadJSON(resp.Body, &tokens); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return tokens, nil
+}
+
+func (c *communicatorImpl) CreateAccessToken(ctx context.Context, opts model.APIPersonalAccessTokenPostRequest) (*model.APIPersonalAccessToken, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   "user/access_tokens",
+	}
+
+	resp, err := c.request(ctx, info, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to create personal access token")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "creating personal access token")
+	}
+
+	token := model.APIPersonalAccessToken{}
+	if err = utility.ReadJSON(resp.Body, &token); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return &token, nil
+}
+
+func (c *communicatorImpl) DeleteAccessToken(ctx context.Context, tokenID string) error {
+	info := requestInfo{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("user/access_tokens/%s", tokenID),
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return errors.Wrapf(err, "sending request to delete personal access token '%s'", tokenID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return util.RespErrorf(resp, "deleting personal access token '%s'", tokenID)
+	}
+
+	return nil
+}
+
 func (c *communicatorImpl) ListAliases(ctx context.Context, project string) ([]serviceModel.ProjectAlias, error) {
 	path := fmt.Sprintf("alias/%s", project)
 	info := requestInfo{
@@ -1391,6 +1584,33 @@ func (c *communicatorImpl) GetHostProcessOutput(ctx context.Context, hostProcess
 	return result, nil
 }
 
+func (c *communicatorImpl) GetHostAgentDebugInfo(ctx context.Context, hostID, kind string) (string, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("hosts/%s/agent/debug/%s", hostID, kind),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "sending request to get agent debug info")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return "", util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", util.RespErrorf(resp, "getting agent debug info for host '%s'", hostID)
+	}
+
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "reading response body")
+	}
+
+	return string(out), nil
+}
+
 func (c *communicatorImpl) GetRecentVersionsForProject(ctx context.Context, projectID, requester string) ([]model.APIVersion, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -1622,3 +1842,31 @@ func (c *communicatorImpl) GetRawPatchWithModules(ctx context.Context, patchId s
 	}
 	return &rp, nil
 }
+
+// GetPatchStack returns the patches in the latest revision of the given patch's
+// stack, ordered from the bottom of the stack to the top.
+func (c *communicatorImpl) GetPatchStack(ctx context.Context, patchId string) ([]restmodel.APIPatch, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("patches/%s/stack", patchId),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get stack for patch '%s'", patchId)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespErrorf(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting stack for patch '%s'", patchId)
+	}
+
+	patches := []restmodel.APIPatch{}
+	if err = utility.ReadJSON(resp.Body, &patches); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return patches, nil
+}
@@ -129,6 +129,18 @@ func (c *Mock) GetVolume(context.Context, string) (*model.APIVolume, error) {
 	return nil, errors.New("(*Mock) GetVolume is not implemented")
 }
 
+func (*Mock) CreateSnapshot(context.Context, *model.SnapshotPostRequest) (*model.APISnapshot, error) {
+	return nil, errors.New("(*Mock) CreateSnapshot is not implemented")
+}
+
+func (*Mock) DeleteSnapshot(context.Context, string) error {
+	return errors.New("(*Mock) DeleteSnapshot is not implemented")
+}
+
+func (*Mock) GetSnapshotsByUser(context.Context) ([]model.APISnapshot, error) {
+	return nil, errors.New("(*Mock) GetSnapshotsByUser is not implemented")
+}
+
 // GetHosts will return an array with a single mock host
 func (c *Mock) GetHosts(ctx context.Context, data model.APIHostParams) ([]*model.APIHost, error) {
 	spawnRequest := &model.HostRequestOptions{
@@ -158,6 +170,9 @@ func (c *Mock) GetEvents(ctx context.Context, ts time.Time, limit int) ([]interf
 	return nil, nil
 }
 func (c *Mock) RevertSettings(ctx context.Context, guid string) error { return nil }
+func (c *Mock) GetAuditEvents(context.Context, event.AuditEventsQueryOptions) ([]model.AuditAPIEventLogEntry, error) {
+	return nil, errors.New("(c *Mock) GetAuditEvents not implemented")
+}
 func (c *Mock) ExecuteOnDistro(context.Context, string, model.APIDistroScriptOptions) ([]string, error) {
 	return nil, nil
 }
@@ -197,6 +212,18 @@ func (c *Mock) DeletePublicKey(ctx context.Context, keyName string) error {
 	return errors.New("(c *Mock) DeletePublicKey not implemented")
 }
 
+func (c *Mock) GetAccessTokens(context.Context) ([]model.APIPersonalAccessToken, error) {
+	return nil, errors.New("(c *Mock) GetAccessTokens not implemented")
+}
+
+func (c *Mock) CreateAccessToken(context.Context, model.APIPersonalAccessTokenPostRequest) (*model.APIPersonalAccessToken, error) {
+	return nil, errors.New("(c *Mock) CreateAccessToken not implemented")
+}
+
+func (c *Mock) DeleteAccessToken(context.Context, string) error {
+	return errors.New("(c *Mock) DeleteAccessToken not implemented")
+}
+
 func (c *Mock) ListAliases(ctx context.Context, keyName string) ([]serviceModel.ProjectAlias, error) {
 	return nil, errors.New("(c *Mock) ListAliases not implemented")
 }
@@ -304,6 +331,10 @@ func (c *Mock) GetHostProcessOutput(context.Context, []model.APIHostProcess, int
 	return nil, nil
 }
 
+func (c *Mock) GetHostAgentDebugInfo(context.Context, string, string) (string, error) {
+	return "", nil
+}
+
 func (c *Mock) GetMatchingHosts(context.Context, time.Time, time.Time, string, bool) ([]string, error) {
 	return nil, nil
 }
@@ -350,3 +381,7 @@ func (c *Mock) GetHostProvisioningOptions(context.Context, string, string) (*res
 func (c *Mock) GetRawPatchWithModules(context.Context, string) (*restmodel.APIRawPatch, error) {
 	return nil, nil
 }
+
+func (c *Mock) GetPatchStack(context.Context, string) ([]restmodel.APIPatch, error) {
+	return nil, nil
+}
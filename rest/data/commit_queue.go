@@ -6,11 +6,9 @@ import (
 	"net/http"
 	"runtime/debug"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
-	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
@@ -144,11 +142,11 @@ func getPatchInfo(ctx context.Context, settings *evergreen.Settings, githubToken
 	return patchContent, summaries, config, patchConfig.PatchedParserProject, nil
 }
 
-// writePatchInfo writes a PR patch's contents to gridFS and stores this info with the patch.
+// writePatchInfo stores a PR patch's contents and stores this info with the patch.
 func writePatchInfo(patchDoc *patch.Patch, patchSummaries []thirdparty.Summary, patchContent string) error {
-	patchFileID := fmt.Sprintf("%s_%s", patchDoc.Id.Hex(), patchDoc.Githash)
-	if err := db.WriteGridFile(patch.GridFSPrefix, patchFileID, strings.NewReader(patchContent)); err != nil {
-		return errors.Wrap(err, "writing patch file to DB")
+	patchFileID, err := patch.StorePatchDiff(patchContent)
+	if err != nil {
+		return errors.Wrap(err, "storing patch diff")
 	}
 
 	// no name for the main patch
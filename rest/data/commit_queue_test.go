@@ -373,7 +373,7 @@ func (s *CommitQueueSuite) TestWritePatchInfo() {
 	s.Equal(patchSummaries, patchDoc.Patches[0].PatchSet.Summary)
 	s.Require().Len(patchDoc.Patches[0].PatchSet.CommitMessages, 1)
 	s.Equal(patchDoc.Patches[0].PatchSet.CommitMessages[0], patchDoc.GithubPatchData.CommitTitle)
-	storedPatchContents, err := patch.FetchPatchContents(patchDoc.Patches[0].PatchSet.PatchFileId)
+	storedPatchContents, err := patch.FetchPatchContents(s.ctx, patchDoc.Patches[0].PatchSet.PatchFileId)
 	s.NoError(err)
 	s.Equal(patchContents, storedPatchContents)
 }
@@ -14,10 +14,12 @@ import (
 	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
-// UpdateDistro updates the given distro.Distro.
-func UpdateDistro(ctx context.Context, old, new *distro.Distro) error {
+// UpdateDistro updates the given distro.Distro. The project distros derived
+// from it are derived again so that they pick up its changes.
+func UpdateDistro(ctx context.Context, old, new *distro.Distro, userID string) error {
 	if old.Id != new.Id {
 		return gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
@@ -39,6 +41,12 @@ func UpdateDistro(ctx context.Context, old, new *distro.Distro) error {
 			Message:    errors.Wrapf(err, "updating distro '%s'", new.Id).Error(),
 		}
 	}
+	if err := model.UpdateProjectDistros(ctx, old, new, userID); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "updating project distros derived from distro '%s'", new.Id).Error(),
+		}
+	}
 	return nil
 }
 
@@ -175,3 +183,93 @@ func newDistro(ctx context.Context, d *distro.Distro, u *user.DBUser) error {
 	event.LogDistroAdded(d.Id, u.Username(), d.DistroData())
 	return nil
 }
+
+// ProjectDistroOpts is input for the SaveProjectDistro function.
+type ProjectDistroOpts struct {
+	ProjectID    string
+	DistroID     string
+	BaseDistroID string
+	Overrides    distro.ProjectDistroOptions
+}
+
+// SaveProjectDistro creates or replaces a project distro derived from the base
+// distro. It returns whether a new distro was created.
+func SaveProjectDistro(ctx context.Context, u *user.DBUser, opts ProjectDistroOpts) (bool, error) {
+	existing, err := distro.FindOneId(ctx, opts.DistroID)
+	if err != nil {
+		return false, errors.Wrapf(err, "finding distro '%s'", opts.DistroID)
+	}
+	if existing != nil && existing.ProjectID != opts.ProjectID {
+		return false, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("distro '%s' already exists and is not a project distro for project '%s'", opts.DistroID, opts.ProjectID),
+		}
+	}
+	base, err := distro.FindOneId(ctx, opts.BaseDistroID)
+	if err != nil {
+		return false, errors.Wrapf(err, "finding base distro '%s'", opts.BaseDistroID)
+	}
+	if base == nil {
+		return false, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("base distro '%s' not found", opts.BaseDistroID),
+		}
+	}
+	if existing == nil {
+		projectDistros, err := distro.Find(ctx, bson.M{
+			distro.BaseDistroIDKey: base.Id,
+			distro.ProjectIDKey:    opts.ProjectID,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "finding project distros derived from distro '%s'", base.Id)
+		}
+		if maxDistros := base.ProjectDistroSettings.GetMaxDistrosPerProject(); len(projectDistros) >= maxDistros {
+			return false, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("project '%s' already has the maximum of %d project distros derived from distro '%s'", opts.ProjectID, maxDistros, base.Id),
+			}
+		}
+	}
+	d, err := distro.NewProjectDistro(base, opts.DistroID, opts.ProjectID, opts.Overrides)
+	if err != nil {
+		return false, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+
+	settings, err := evergreen.GetConfig(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "getting admin settings")
+	}
+	vErrs, err := validator.CheckDistro(ctx, d, settings, existing == nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "validating distro '%s'", d.Id)
+	}
+	if len(vErrs) != 0 {
+		return false, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    vErrs.String(),
+		}
+	}
+
+	if existing == nil {
+		// Project admins don't get distro admin permissions because the
+		// distro routes can change settings that project distros must inherit
+		// from their base distro.
+		if err = d.Add(ctx, nil); err != nil {
+			return false, errors.Wrapf(err, "inserting project distro '%s'", d.Id)
+		}
+		event.LogDistroAdded(d.Id, u.Username(), d.DistroData())
+		return true, nil
+	}
+
+	if err = UpdateDistro(ctx, existing, d, u.Username()); err != nil {
+		return false, err
+	}
+	event.LogDistroModified(d.Id, u.Username(), existing.DistroData(), d.DistroData())
+	if d.GetDefaultAMI() != existing.GetDefaultAMI() {
+		event.LogDistroAMIModified(d.Id, u.Username())
+	}
+	return false, nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen"
@@ -13,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDeleteDistroById(t *testing.T) {
@@ -297,3 +299,169 @@ func TestCreateDistro(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveProjectDistro(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config, err := evergreen.GetConfig(ctx)
+	assert.NoError(t, err)
+	config.Keys = map[string]string{"abc": "123"}
+	assert.NoError(t, config.Set(ctx))
+	defer func() {
+		config.Keys = map[string]string{}
+		assert.NoError(t, config.Set(ctx))
+	}()
+
+	for tName, tCase := range map[string]func(t *testing.T, ctx context.Context, u user.DBUser){
+		"Successfully creates and updates project distro": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			opts := ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro",
+				BaseDistroID: "base",
+				Overrides:    distro.ProjectDistroOptions{Setup: "echo project"},
+			}
+			created, err := SaveProjectDistro(ctx, &u, opts)
+			assert.NoError(t, err)
+			assert.True(t, created)
+
+			projectDistro, err := distro.FindOneId(ctx, "project-distro")
+			assert.NoError(t, err)
+			assert.NotNil(t, projectDistro)
+			assert.Equal(t, "base", projectDistro.BaseDistroID)
+			assert.Equal(t, []string{"project"}, projectDistro.ValidProjects)
+			assert.Equal(t, "echo project", projectDistro.Setup)
+
+			opts.Overrides.Setup = "echo updated"
+			created, err = SaveProjectDistro(ctx, &u, opts)
+			assert.NoError(t, err)
+			assert.False(t, created)
+
+			projectDistro, err = distro.FindOneId(ctx, "project-distro")
+			assert.NoError(t, err)
+			assert.NotNil(t, projectDistro)
+			assert.Equal(t, "echo updated", projectDistro.Setup)
+
+			events, err := event.FindLatestPrimaryDistroEvents("project-distro", 10, utility.ZeroTime)
+			assert.NoError(t, err)
+			assert.Equal(t, len(events), 2)
+		},
+		"Fails when base distro does not allow project distros": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro",
+				BaseDistroID: "distro",
+			})
+			assert.Error(t, err)
+
+			projectDistro, err := distro.FindOneId(ctx, "project-distro")
+			assert.NoError(t, err)
+			assert.Nil(t, projectDistro)
+		},
+		"Fails when overriding the instance type of a static distro": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro",
+				BaseDistroID: "base",
+				Overrides:    distro.ProjectDistroOptions{InstanceType: "m5.xlarge"},
+			})
+			assert.Error(t, err)
+		},
+		"Fails when distro is not owned by the project": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "distro",
+				BaseDistroID: "base",
+			})
+			assert.Error(t, err)
+
+			d, err := distro.FindOneId(ctx, "distro")
+			assert.NoError(t, err)
+			assert.NotNil(t, d)
+			assert.False(t, d.IsProjectDistro())
+		},
+		"Fails when project has the maximum number of project distros": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			for i := 0; i < distro.DefaultMaxProjectDistrosPerProject; i++ {
+				_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+					ProjectID:    "project",
+					DistroID:     fmt.Sprintf("project-distro-%d", i),
+					BaseDistroID: "base",
+				})
+				require.NoError(t, err)
+			}
+
+			_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro",
+				BaseDistroID: "base",
+			})
+			assert.Error(t, err)
+
+			projectDistro, err := distro.FindOneId(ctx, "project-distro")
+			assert.NoError(t, err)
+			assert.Nil(t, projectDistro)
+
+			_, err = SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro-0",
+				BaseDistroID: "base",
+				Overrides:    distro.ProjectDistroOptions{Setup: "echo updated"},
+			})
+			assert.NoError(t, err, "existing project distros should still be updatable")
+		},
+		"Fails when base distro does not exist": func(t *testing.T, ctx context.Context, u user.DBUser) {
+			_, err := SaveProjectDistro(ctx, &u, ProjectDistroOpts{
+				ProjectID:    "project",
+				DistroID:     "project-distro",
+				BaseDistroID: "nonexistent",
+			})
+			assert.Error(t, err)
+			assert.Equal(t, err.Error(), "404 (Not Found): base distro 'nonexistent' not found")
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx, tcancel := context.WithCancel(ctx)
+			defer tcancel()
+
+			assert.NoError(t, db.ClearCollections(distro.Collection, event.EventCollection, user.Collection))
+
+			adminUser := user.DBUser{
+				Id: "admin",
+			}
+			assert.NoError(t, adminUser.Insert())
+
+			d := distro.Distro{
+				Id:                 "distro",
+				Arch:               "linux_amd64",
+				AuthorizedKeysFile: "keys.txt",
+				BootstrapSettings: distro.BootstrapSettings{
+					Method: distro.BootstrapMethodNone,
+				},
+				CloneMethod: evergreen.CloneMethodLegacySSH,
+				DispatcherSettings: distro.DispatcherSettings{
+					Version: evergreen.DispatcherVersionRevised,
+				},
+				FinderSettings: distro.FinderSettings{
+					Version: evergreen.FinderVersionParallel,
+				},
+				HostAllocatorSettings: distro.HostAllocatorSettings{
+					Version: evergreen.HostAllocatorUtilization,
+				},
+				PlannerSettings: distro.PlannerSettings{
+					Version: evergreen.PlannerVersionTunable,
+				},
+				Provider: evergreen.ProviderNameStatic,
+				SSHKey:   "abc",
+				WorkDir:  "/tmp",
+				User:     "admin",
+			}
+			assert.NoError(t, d.Insert(tctx))
+
+			d.Id = "base"
+			d.ProjectDistroSettings = distro.ProjectDistroSettings{Allowed: true}
+			assert.NoError(t, d.Insert(tctx))
+
+			tCase(t, tctx, adminUser)
+		})
+	}
+}
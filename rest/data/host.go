@@ -238,6 +238,7 @@ func makeSpawnOptions(options *restmodel.HostRequestOptions, user *user.DBUser)
 		IsCluster:             options.IsCluster,
 		HomeVolumeSize:        options.HomeVolumeSize,
 		HomeVolumeID:          options.HomeVolumeID,
+		HomeVolumeSnapshotID:  options.HomeVolumeSnapshotID,
 		Region:                options.Region,
 		Expiration:            options.Expiration,
 		UseProjectSetupScript: options.UseProjectSetupScript,
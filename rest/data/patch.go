@@ -90,6 +90,49 @@ func FindPatchById(patchId string) (*restModel.APIPatch, error) {
 	return &apiPatch, nil
 }
 
+// FindPatchStack returns the patches in the latest revision of the stack that
+// the patch matching patchId belongs to, ordered from the bottom of the stack
+// to the top.
+func FindPatchStack(patchId string) ([]restModel.APIPatch, error) {
+	if err := ValidatePatchID(patchId); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	p, err := patch.FindOneId(patchId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding patch '%s'", patchId)
+	}
+	if p == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("patch '%s' not found", patchId),
+		}
+	}
+	if !p.IsStackPatch() {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("patch '%s' is not part of a stack", patchId),
+		}
+	}
+
+	patches, err := patch.FindLatestStack(p.Project, p.Author, p.Stack.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding patches in stack '%s'", p.Stack.ID)
+	}
+	apiPatches := []restModel.APIPatch{}
+	for _, stackPatch := range patches {
+		apiPatch := restModel.APIPatch{}
+		if err = apiPatch.BuildFromService(stackPatch, &restModel.APIPatchArgs{
+			IncludeProjectIdentifier: true,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "converting patch '%s' to API model", stackPatch.Id.Hex())
+		}
+		apiPatches = append(apiPatches, apiPatch)
+	}
+
+	return apiPatches, nil
+}
+
 // AbortPatch uses the service level CancelPatch method to abort a single patch
 // with matching Id.
 func AbortPatch(patchId string, user string) error {
@@ -198,7 +241,7 @@ func AbortPatchesFromPullRequest(ctx context.Context, event *github.PullRequestE
 }
 
 // GetRawPatches fetches the raw patches for a patch.
-func GetRawPatches(patchID string) (*restModel.APIRawPatch, error) {
+func GetRawPatches(ctx context.Context, patchID string) (*restModel.APIRawPatch, error) {
 	patchDoc, err := patch.FindOneId(patchID)
 	if err != nil {
 		return nil, gimlet.ErrorResponse{
@@ -213,7 +256,7 @@ func GetRawPatches(patchID string) (*restModel.APIRawPatch, error) {
 		}
 	}
 
-	if err = patchDoc.FetchPatchFiles(false); err != nil {
+	if err = patchDoc.FetchPatchFiles(ctx, false); err != nil {
 		return nil, gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
 			Message:    errors.Wrap(err, "getting patch contents").Error(),
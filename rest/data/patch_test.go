@@ -519,6 +519,9 @@ func (s *PatchConnectorFetchByUserSuite) TestFetchKeyOutOfBound() {
 }
 
 func TestGetRawPatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	assert.NoError(t, db.Clear(patch.Collection))
 	p := patch.Patch{
 		Id:      mgobson.NewObjectId(),
@@ -528,7 +531,7 @@ func TestGetRawPatches(t *testing.T) {
 		},
 	}
 	assert.NoError(t, p.Insert())
-	raw, err := GetRawPatches(p.Id.Hex())
+	raw, err := GetRawPatches(ctx, p.Id.Hex())
 	assert.NoError(t, err)
 	// Verify that we populate the raw patch patch githash regardless of whether we have changes.
 	assert.Equal(t, p.Githash, raw.Patch.Githash)
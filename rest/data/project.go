@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -16,6 +17,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/thirdparty/secrets"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -114,6 +116,12 @@ func CreateProject(ctx context.Context, env evergreen.Environment, projectRef *m
 	if err := ValidateProjectName(projectRef.Id); err != nil {
 		return false, err
 	}
+	if err := projectRef.ValidateGitRemoteURL(env.Settings().RepoTracker.AllowLocalGitRemotes); err != nil {
+		return false, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "validating git remote URL").Error(),
+		}
+	}
 	// Always warn because created projects are never enabled.
 	warningCatcher := grip.NewBasicCatcher()
 	statusCode, err := model.ValidateEnabledProjectsLimit(projectRef.Id, env.Settings(), nil, projectRef)
@@ -294,6 +302,61 @@ func FindProjectVarsById(id string, repoId string, redact bool) (*restModel.APIP
 	return &varsModel, nil
 }
 
+// validateExternalSecretVars checks that the project or repo is allowed to
+// read the secrets that its external secret variables reference. Unless the
+// variables are being overwritten, variables that are already external stay
+// external, so their new values are checked too.
+func validateExternalSecretVars(vars *model.ProjectVars, overwrite bool) error {
+	external := map[string]bool{}
+	for key, isExternal := range vars.ExternalSecretVars {
+		external[key] = isExternal
+	}
+	if !overwrite {
+		existing, err := model.FindOneProjectVars(vars.Id)
+		if err != nil {
+			return errors.Wrapf(err, "finding existing variables for project '%s'", vars.Id)
+		}
+		if existing != nil {
+			for key, isExternal := range existing.ExternalSecretVars {
+				if _, ok := external[key]; !ok {
+					external[key] = isExternal
+				}
+			}
+		}
+	}
+
+	var toCheck []string
+	for key, isExternal := range external {
+		if isExternal && vars.Vars[key] != "" {
+			toCheck = append(toCheck, key)
+		}
+	}
+	if len(toCheck) == 0 {
+		return nil
+	}
+
+	conf := evergreen.GetEnvironment().Settings().SecretProviders
+	allowedPathPrefixes := conf.PathPrefixesForProjects(vars.Id)
+	pRef, err := model.FindBranchProjectRef(vars.Id)
+	if err != nil {
+		return errors.Wrapf(err, "finding project '%s'", vars.Id)
+	}
+	if pRef != nil {
+		allowedPathPrefixes = pRef.SecretPathPrefixes(conf)
+	}
+
+	sort.Strings(toCheck)
+	for _, key := range toCheck {
+		if err := secrets.ValidateReference(conf, vars.Vars[key], allowedPathPrefixes); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrapf(err, "invalid secret reference for variable '%s'", key).Error(),
+			}
+		}
+	}
+	return nil
+}
+
 // UpdateProjectVars adds new variables, overwrites variables, and deletes variables for the given project.
 func UpdateProjectVars(projectId string, varsModel *restModel.APIProjectVars, overwrite bool) error {
 	if varsModel == nil {
@@ -301,6 +364,9 @@ func UpdateProjectVars(projectId string, varsModel *restModel.APIProjectVars, ov
 	}
 	vars := varsModel.ToService()
 	vars.Id = projectId
+	if err := validateExternalSecretVars(vars, overwrite); err != nil {
+		return err
+	}
 
 	// Avoid accidentally overwriting private variables, for example if the GET route is used to populate PATCH.
 	for key, val := range varsModel.Vars {
@@ -323,6 +389,7 @@ func UpdateProjectVars(projectId string, varsModel *restModel.APIProjectVars, ov
 	varsModel.Vars = vars.Vars
 	varsModel.PrivateVars = vars.PrivateVars
 	varsModel.AdminOnlyVars = vars.AdminOnlyVars
+	varsModel.ExternalSecretVars = vars.ExternalSecretVars
 	varsModel.VarsToDelete = []string{}
 	return nil
 }
@@ -472,3 +539,49 @@ func HideBranch(projectID string) error {
 
 	return nil
 }
+
+// GetProjectVarVersions returns the kept versions of the project or repo
+// variable, from newest to oldest.
+func GetProjectVarVersions(projectId, name string) ([]restModel.APIProjectVarVersion, error) {
+	versions, err := model.FindProjectVarVersions(projectId, name)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]restModel.APIProjectVarVersion, 0, len(versions))
+	for _, v := range versions {
+		apiVersion := restModel.APIProjectVarVersion{}
+		apiVersion.BuildFromService(v)
+		res = append(res, apiVersion)
+	}
+	return res, nil
+}
+
+// RollbackProjectVar sets the project or repo variable back to the given
+// version and logs the modification, which records the rollback as a new
+// version.
+func RollbackProjectVar(projectId, name string, version int, isRepo bool, username string) error {
+	before, err := model.GetProjectSettingsById(projectId, isRepo)
+	if err != nil {
+		return errors.Wrapf(err, "getting settings for project '%s' before rollback", projectId)
+	}
+	v, err := model.FindProjectVarVersion(projectId, name, version)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version %d of variable '%s' not found", version, name),
+		}
+	}
+	if v.Deleted || v.ValueOmitted {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("version %d of variable '%s' is a deletion or has no kept value, so it cannot be rolled back to", version, name),
+		}
+	}
+	if err = model.RollbackProjectVar(projectId, name, version); err != nil {
+		return err
+	}
+	return errors.Wrapf(model.GetAndLogProjectModified(projectId, username, isRepo, before), "logging rollback of variable '%s'", name)
+}
@@ -133,6 +133,9 @@ func PromoteVarsToRepo(projectIdentifier string, varNames []string, userId strin
 	// Add each promoted variable to existing repo vars
 	apiRepoVars := &restModel.APIProjectVars{}
 	apiRepoVars.BuildFromService(*repoVars)
+	if apiRepoVars.ExternalSecretVars == nil {
+		apiRepoVars.ExternalSecretVars = map[string]bool{}
+	}
 	for _, varName := range varNames {
 		// Ignore nonexistent variables
 		if _, contains := projectVars.Vars[varName]; !contains {
@@ -146,6 +149,9 @@ func PromoteVarsToRepo(projectIdentifier string, varNames []string, userId strin
 		if _, contains := projectVars.AdminOnlyVars[varName]; contains {
 			apiRepoVars.AdminOnlyVars[varName] = true
 		}
+		if _, contains := projectVars.ExternalSecretVars[varName]; contains {
+			apiRepoVars.ExternalSecretVars[varName] = true
+		}
 	}
 
 	if err = UpdateProjectVars(repoId, apiRepoVars, true); err != nil {
@@ -163,9 +169,10 @@ func PromoteVarsToRepo(projectIdentifier string, varNames []string, userId strin
 
 	// Remove promoted variables from project
 	apiProjectVars := &restModel.APIProjectVars{
-		Vars:          map[string]string{},
-		PrivateVars:   map[string]bool{},
-		AdminOnlyVars: map[string]bool{},
+		Vars:               map[string]string{},
+		PrivateVars:        map[string]bool{},
+		AdminOnlyVars:      map[string]bool{},
+		ExternalSecretVars: map[string]bool{},
 	}
 	for key, value := range projectVars.Vars {
 		if !utility.StringSliceContains(varNames, key) {
@@ -185,6 +192,12 @@ func PromoteVarsToRepo(projectIdentifier string, varNames []string, userId strin
 		}
 	}
 
+	for key := range projectVars.ExternalSecretVars {
+		if _, ok := apiProjectVars.Vars[key]; ok {
+			apiProjectVars.ExternalSecretVars[key] = true
+		}
+	}
+
 	if err := UpdateProjectVars(projectId, apiProjectVars, true); err != nil {
 		return errors.Wrapf(err, "removing promoted project variables from project '%s'", projectIdentifier)
 	}
@@ -247,6 +260,13 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = mergedSection.ValidateEnabledRepotracker(); err != nil {
 			return nil, err
 		}
+		repoTrackerConfig := evergreen.RepoTrackerConfig{}
+		if err = repoTrackerConfig.Get(ctx); err != nil {
+			return nil, errors.Wrap(err, "getting repotracker config")
+		}
+		if err = mergedSection.ValidateGitRemoteURL(repoTrackerConfig.AllowLocalGitRemotes); err != nil {
+			return nil, errors.Wrap(err, "validating git remote URL")
+		}
 		// Validate owner/repo if the project is enabled or owner/repo is populated.
 		// This validation is cheap so it makes sense to be strict about this.
 		if mergedSection.Enabled || (mergedSection.Owner != "" && mergedSection.Repo != "") {
@@ -345,6 +365,16 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 				changes.Vars.Vars[key] = value
 			}
 		}
+		// The UI doesn't manage external secret variables, so keep them
+		// external as long as their references are unchanged.
+		if changes.Vars.ExternalSecretVars == nil {
+			changes.Vars.ExternalSecretVars = map[string]bool{}
+			for key, isExternal := range before.Vars.ExternalSecretVars {
+				if isExternal && changes.Vars.Vars[key] == before.Vars.Vars[key] {
+					changes.Vars.ExternalSecretVars[key] = true
+				}
+			}
+		}
 		if err = UpdateProjectVars(projectId, &changes.Vars, true); err != nil { // destructively modifies vars
 			return nil, errors.Wrapf(err, "updating project variables for project '%s'", projectId)
 		}
@@ -356,6 +386,9 @@ func SaveProjectSettingsForSection(ctx context.Context, projectId string, change
 		if err = handleGithubConflicts(mergedSection, "Toggling GitHub features"); err != nil {
 			return nil, err
 		}
+		if err = mergedSection.CommitQueue.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid commit queue settings")
+		}
 		// At project creation we now insert a commit queue, however older projects still may not have one
 		// so we need to validate that this exists if the feature is being toggled on.
 		if !mergedBeforeRef.CommitQueue.IsEnabled() && mergedSection.CommitQueue.IsEnabled() {
@@ -280,6 +280,21 @@ func (s *ProjectConnectorGetSuite) TestUpdateProjectVars() {
 	s.NoError(UpdateProjectVars("not-an-id", &newVars, false))
 }
 
+func (s *ProjectConnectorGetSuite) TestUpdateProjectVarsRejectsDisallowedSecretReferences() {
+	newVars := restModel.APIProjectVars{
+		Vars:               map[string]string{"secret": "vault:secret/data/other-team#password"},
+		ExternalSecretVars: map[string]bool{"secret": true},
+	}
+	err := UpdateProjectVars(projectId, &newVars, false)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "allowed secret path prefixes")
+
+	vars, err := model.FindOneProjectVars(projectId)
+	s.Require().NoError(err)
+	s.Require().NotNil(vars)
+	s.NotContains(vars.Vars, "secret")
+}
+
 func TestUpdateProjectVarsByValue(t *testing.T) {
 	require.NoError(t, db.ClearCollections(model.ProjectVarsCollection, event.EventCollection))
 
@@ -183,3 +183,56 @@ func validatePushEvent(event *github.PushEvent) (string, error) {
 	}
 	return strings.Join(refs[2:], "/"), nil
 }
+
+// TriggerRepotrackerForGitlabPush creates amboy jobs to get the commits pushed
+// to the branch of the GitLab project at the given path for the projects that
+// track it.
+func TriggerRepotrackerForGitlabPush(ctx context.Context, q amboy.Queue, msgID, projectPath, ref string) error {
+	if !strings.HasPrefix(ref, branchRefPrefix) {
+		// Not an error, but we're uninterested in tag pushes
+		return nil
+	}
+	branch := strings.TrimPrefix(ref, branchRefPrefix)
+
+	settings, err := evergreen.GetConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "retrieving admin settings")
+	}
+	if settings.ServiceFlags.RepotrackerDisabled {
+		return errors.New("repotracker is disabled")
+	}
+
+	refs, err := model.FindMergedEnabledGitlabProjectRefsByPathAndBranch(projectPath, branch)
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrapf(err, "finding projects for GitLab project '%s'", projectPath).Error(),
+		}
+	}
+
+	catcher := grip.NewBasicCatcher()
+	succeeded := []string{}
+	for i := range refs {
+		if !refs[i].DoesTrackPushEvents() || refs[i].IsRepotrackerDisabled() {
+			continue
+		}
+		j := units.NewRepotrackerJob(fmt.Sprintf("gitlab-push-%s", msgID), refs[i].Id)
+		if err := amboy.EnqueueUniqueJob(ctx, q, j); err != nil {
+			catcher.Wrapf(err, "enqueueing repotracker job for project '%s'", refs[i].Id)
+			continue
+		}
+		succeeded = append(succeeded, refs[i].Id)
+	}
+
+	grip.Info(message.Fields{
+		"source":       "GitLab hook",
+		"msg_id":       msgID,
+		"event":        "push",
+		"project_path": projectPath,
+		"branch":       branch,
+		"message":      "done processing push event",
+		"succeeded":    succeeded,
+	})
+
+	return catcher.Resolve()
+}
@@ -26,6 +26,7 @@ func UpdateSettings(dbUser *user.DBUser, settings user.UserSettings) error {
 	settings.Notifications.SpawnHostOutcomeID = dbUser.Settings.Notifications.SpawnHostOutcomeID
 	settings.Notifications.SpawnHostExpirationID = dbUser.Settings.Notifications.SpawnHostExpirationID
 	settings.Notifications.CommitQueueID = dbUser.Settings.Notifications.CommitQueueID
+	settings.Notifications.StepbackCulpritID = dbUser.Settings.Notifications.StepbackCulpritID
 
 	slackTarget := fmt.Sprintf("@%s", settings.SlackUsername)
 
@@ -141,6 +142,24 @@ func UpdateSettings(dbUser *user.DBUser, settings user.UserSettings) error {
 		settings.Notifications.CommitQueueID = ""
 	}
 
+	var stepbackCulpritSubscriber event.Subscriber
+	switch settings.Notifications.StepbackCulprit {
+	case user.PreferenceSlack:
+		stepbackCulpritSubscriber = event.NewSlackSubscriber(slackTarget)
+	case user.PreferenceEmail:
+		stepbackCulpritSubscriber = event.NewEmailSubscriber(dbUser.Email())
+	}
+	stepbackCulpritSubscription, err := event.CreateOrUpdateGeneralSubscription(event.GeneralSubscriptionStepbackCulprit,
+		dbUser.Settings.Notifications.StepbackCulpritID, stepbackCulpritSubscriber, dbUser.Id)
+	if err != nil {
+		return errors.Wrap(err, "creating stepback culprit subscription")
+	}
+	if stepbackCulpritSubscription != nil {
+		settings.Notifications.StepbackCulpritID = stepbackCulpritSubscription.ID
+	} else {
+		settings.Notifications.StepbackCulpritID = ""
+	}
+
 	return dbUser.UpdateSettings(settings)
 }
 
@@ -123,6 +123,34 @@ func (s *DBUserConnectorSuite) TestUpdateSettingsCommitQueue() {
 	s.Equal(event.SlackSubscriberType, sub.Subscriber.Type)
 }
 
+func (s *DBUserConnectorSuite) TestUpdateSettingsStepbackCulprit() {
+	settings := user.UserSettings{
+		SlackUsername: "@test",
+		SlackMemberId: "TESTA25BA",
+		Notifications: user.NotificationPreferences{
+			StepbackCulprit: user.PreferenceEmail,
+		},
+	}
+
+	// Should create a new subscription
+	s.NoError(UpdateSettings(s.users[0], settings))
+	pref := s.getNotificationSettings(0)
+	s.NotEqual("", pref.StepbackCulpritID)
+	sub, err := event.FindSubscriptionByID(pref.StepbackCulpritID)
+	s.NoError(err)
+	s.Require().NotNil(sub)
+	s.Equal(event.EmailSubscriberType, sub.Subscriber.Type)
+	s.Equal(event.TriggerCulpritIdentified, sub.Trigger)
+	s.Equal(event.ResourceTypeTask, sub.ResourceType)
+	settings.Notifications = *pref
+
+	// Should delete the existing subscription
+	settings.Notifications.StepbackCulprit = ""
+	s.NoError(UpdateSettings(s.users[0], settings))
+	pref = s.getNotificationSettings(0)
+	s.Equal("", pref.StepbackCulpritID)
+}
+
 func TestDBUserConnector(t *testing.T) {
 	s := &DBUserConnectorSuite{}
 	suite.Run(t, s)
@@ -34,9 +34,12 @@ func NewConfigModel() *APIAdminSettings {
 		Plugins:           map[string]map[string]interface{}{},
 		PodLifecycle:      &APIPodLifecycleConfig{},
 		ProjectCreation:   &APIProjectCreationConfig{},
+		ProjectVars:       &APIProjectVarsConfig{},
 		Providers:         &APICloudProviders{},
+		RateLimit:         &APIRateLimitConfig{},
 		RepoTracker:       &APIRepoTrackerConfig{},
 		Scheduler:         &APISchedulerConfig{},
+		SecretProviders:   &APISecretProvidersConfig{},
 		ServiceFlags:      &APIServiceFlags{},
 		Slack:             &APISlackConfig{},
 		Splunk:            &APISplunkConfig{},
@@ -45,6 +48,7 @@ func NewConfigModel() *APIAdminSettings {
 		Spawnhost:         &APISpawnHostConfig{},
 		Tracer:            &APITracerSettings{},
 		GitHubCheckRun:    &APIGitHubCheckRunConfig{},
+		Gitlab:            &APIGitlabConfig{},
 	}
 }
 
@@ -84,9 +88,12 @@ type APIAdminSettings struct {
 	PodLifecycle        *APIPodLifecycleConfig            `json:"pod_lifecycle,omitempty"`
 	PprofPort           *string                           `json:"pprof_port,omitempty"`
 	ProjectCreation     *APIProjectCreationConfig         `json:"project_creation,omitempty"`
+	ProjectVars         *APIProjectVarsConfig             `json:"project_vars,omitempty"`
 	Providers           *APICloudProviders                `json:"providers,omitempty"`
+	RateLimit           *APIRateLimitConfig               `json:"rate_limit,omitempty"`
 	RepoTracker         *APIRepoTrackerConfig             `json:"repotracker,omitempty"`
 	Scheduler           *APISchedulerConfig               `json:"scheduler,omitempty"`
+	SecretProviders     *APISecretProvidersConfig         `json:"secret_providers,omitempty"`
 	ServiceFlags        *APIServiceFlags                  `json:"service_flags,omitempty"`
 	Slack               *APISlackConfig                   `json:"slack,omitempty"`
 	SSHKeyDirectory     *string                           `json:"ssh_key_directory,omitempty"`
@@ -97,6 +104,7 @@ type APIAdminSettings struct {
 	Spawnhost           *APISpawnHostConfig               `json:"spawnhost,omitempty"`
 	Tracer              *APITracerSettings                `json:"tracer,omitempty"`
 	GitHubCheckRun      *APIGitHubCheckRunConfig          `json:"github_check_run,omitempty"`
+	Gitlab              *APIGitlabConfig                  `json:"gitlab,omitempty"`
 	ShutdownWaitSeconds *int                              `json:"shutdown_wait_seconds,omitempty"`
 }
 
@@ -522,6 +530,7 @@ func (a *APIapiConfig) ToService() (interface{}, error) {
 type APIAuthConfig struct {
 	LDAP                    *APILDAPConfig       `json:"ldap"`
 	Okta                    *APIOktaConfig       `json:"okta"`
+	OIDC                    *APIOIDCConfig       `json:"oidc"`
 	Naive                   *APINaiveAuthConfig  `json:"naive"`
 	Github                  *APIGithubAuthConfig `json:"github"`
 	Multi                   *APIMultiAuthConfig  `json:"multi"`
@@ -545,6 +554,12 @@ func (a *APIAuthConfig) BuildFromService(h interface{}) error {
 				return errors.Wrap(err, "converting Okta auth settings to API model")
 			}
 		}
+		if v.OIDC != nil {
+			a.OIDC = &APIOIDCConfig{}
+			if err := a.OIDC.BuildFromService(v.OIDC); err != nil {
+				return errors.Wrap(err, "converting OIDC auth settings to API model")
+			}
+		}
 		if v.Github != nil {
 			a.Github = &APIGithubAuthConfig{}
 			if err := a.Github.BuildFromService(v.Github); err != nil {
@@ -575,6 +590,7 @@ func (a *APIAuthConfig) BuildFromService(h interface{}) error {
 func (a *APIAuthConfig) ToService() (interface{}, error) {
 	var ldap *evergreen.LDAPConfig
 	var okta *evergreen.OktaConfig
+	var oidc *evergreen.OIDCConfig
 	var naive *evergreen.NaiveAuthConfig
 	var github *evergreen.GithubAuthConfig
 	var multi *evergreen.MultiAuthConfig
@@ -601,6 +617,17 @@ func (a *APIAuthConfig) ToService() (interface{}, error) {
 		}
 	}
 
+	i, err = a.OIDC.ToService()
+	if err != nil {
+		return nil, errors.Wrap(err, "converting OIDC auth config to service model")
+	}
+	if i != nil {
+		oidc, ok = i.(*evergreen.OIDCConfig)
+		if !ok {
+			return nil, errors.Errorf("programmatic error: expected OIDC auth config but got type %T", i)
+		}
+	}
+
 	i, err = a.Naive.ToService()
 	if err != nil {
 		return nil, errors.Wrap(err, "converting naive auth config to service model")
@@ -637,6 +664,7 @@ func (a *APIAuthConfig) ToService() (interface{}, error) {
 	return evergreen.AuthConfig{
 		LDAP:                    ldap,
 		Okta:                    okta,
+		OIDC:                    oidc,
 		Naive:                   naive,
 		Github:                  github,
 		Multi:                   multi,
@@ -647,7 +675,10 @@ func (a *APIAuthConfig) ToService() (interface{}, error) {
 }
 
 type APIBucketsConfig struct {
-	LogBucket APIBucketConfig `json:"log_bucket"`
+	LogBucket                   APIBucketConfig `json:"log_bucket"`
+	ArtifactBucket              APIBucketConfig `json:"artifact_bucket"`
+	ArtifactProjectDailyQuotaMB int             `json:"artifact_project_daily_quota_mb"`
+	PatchDiffBucket             APIBucketConfig `json:"patch_diff_bucket"`
 }
 
 type APIBucketConfig struct {
@@ -662,6 +693,13 @@ func (a *APIBucketsConfig) BuildFromService(h interface{}) error {
 		a.LogBucket.Name = utility.ToStringPtr(v.LogBucket.Name)
 		a.LogBucket.Type = utility.ToStringPtr(string(v.LogBucket.Type))
 		a.LogBucket.DBName = utility.ToStringPtr(v.LogBucket.DBName)
+		a.ArtifactBucket.Name = utility.ToStringPtr(v.ArtifactBucket.Name)
+		a.ArtifactBucket.Type = utility.ToStringPtr(string(v.ArtifactBucket.Type))
+		a.ArtifactBucket.DBName = utility.ToStringPtr(v.ArtifactBucket.DBName)
+		a.ArtifactProjectDailyQuotaMB = v.ArtifactProjectDailyQuotaMB
+		a.PatchDiffBucket.Name = utility.ToStringPtr(v.PatchDiffBucket.Name)
+		a.PatchDiffBucket.Type = utility.ToStringPtr(string(v.PatchDiffBucket.Type))
+		a.PatchDiffBucket.DBName = utility.ToStringPtr(v.PatchDiffBucket.DBName)
 	default:
 		return errors.Errorf("programmatic error: expected bucket config but got type %T", h)
 	}
@@ -675,6 +713,17 @@ func (a *APIBucketsConfig) ToService() (interface{}, error) {
 			Type:   evergreen.BucketType(utility.FromStringPtr(a.LogBucket.Type)),
 			DBName: utility.FromStringPtr(a.LogBucket.DBName),
 		},
+		ArtifactBucket: evergreen.BucketConfig{
+			Name:   utility.FromStringPtr(a.ArtifactBucket.Name),
+			Type:   evergreen.BucketType(utility.FromStringPtr(a.ArtifactBucket.Type)),
+			DBName: utility.FromStringPtr(a.ArtifactBucket.DBName),
+		},
+		ArtifactProjectDailyQuotaMB: a.ArtifactProjectDailyQuotaMB,
+		PatchDiffBucket: evergreen.BucketConfig{
+			Name:   utility.FromStringPtr(a.PatchDiffBucket.Name),
+			Type:   evergreen.BucketType(utility.FromStringPtr(a.PatchDiffBucket.Type)),
+			DBName: utility.FromStringPtr(a.PatchDiffBucket.DBName),
+		},
 	}, nil
 }
 
@@ -795,6 +844,77 @@ func (a *APIOktaConfig) ToService() (interface{}, error) {
 	}, nil
 }
 
+type APIOIDCConfig struct {
+	Issuer             *string             `json:"issuer"`
+	ClientID           *string             `json:"client_id"`
+	ClientSecret       *string             `json:"client_secret"`
+	Scopes             []string            `json:"scopes"`
+	UsernameClaim      *string             `json:"username_claim"`
+	EmailDomain        *string             `json:"email_domain"`
+	GroupsClaim        *string             `json:"groups_claim"`
+	UserGroup          *string             `json:"user_group"`
+	GroupRoles         []APIOIDCGroupRoles `json:"group_roles"`
+	ExpireAfterMinutes int                 `json:"expire_after_minutes"`
+}
+
+type APIOIDCGroupRoles struct {
+	Group *string  `json:"group"`
+	Roles []string `json:"roles"`
+}
+
+func (a *APIOIDCConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case *evergreen.OIDCConfig:
+		if v == nil {
+			return nil
+		}
+		a.Issuer = utility.ToStringPtr(v.Issuer)
+		a.ClientID = utility.ToStringPtr(v.ClientID)
+		a.ClientSecret = utility.ToStringPtr(v.ClientSecret)
+		a.Scopes = v.Scopes
+		a.UsernameClaim = utility.ToStringPtr(v.UsernameClaim)
+		a.EmailDomain = utility.ToStringPtr(v.EmailDomain)
+		a.GroupsClaim = utility.ToStringPtr(v.GroupsClaim)
+		a.UserGroup = utility.ToStringPtr(v.UserGroup)
+		a.GroupRoles = nil
+		for _, mapping := range v.GroupRoles {
+			a.GroupRoles = append(a.GroupRoles, APIOIDCGroupRoles{
+				Group: utility.ToStringPtr(mapping.Group),
+				Roles: mapping.Roles,
+			})
+		}
+		a.ExpireAfterMinutes = v.ExpireAfterMinutes
+		return nil
+	default:
+		return errors.Errorf("programmatic error: expected OIDC config but got type %T", h)
+	}
+}
+
+func (a *APIOIDCConfig) ToService() (interface{}, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var groupRoles []evergreen.OIDCGroupRoles
+	for _, mapping := range a.GroupRoles {
+		groupRoles = append(groupRoles, evergreen.OIDCGroupRoles{
+			Group: utility.FromStringPtr(mapping.Group),
+			Roles: mapping.Roles,
+		})
+	}
+	return &evergreen.OIDCConfig{
+		Issuer:             utility.FromStringPtr(a.Issuer),
+		ClientID:           utility.FromStringPtr(a.ClientID),
+		ClientSecret:       utility.FromStringPtr(a.ClientSecret),
+		Scopes:             a.Scopes,
+		UsernameClaim:      utility.FromStringPtr(a.UsernameClaim),
+		EmailDomain:        utility.FromStringPtr(a.EmailDomain),
+		GroupsClaim:        utility.FromStringPtr(a.GroupsClaim),
+		UserGroup:          utility.FromStringPtr(a.UserGroup),
+		GroupRoles:         groupRoles,
+		ExpireAfterMinutes: a.ExpireAfterMinutes,
+	}, nil
+}
+
 type APINaiveAuthConfig struct {
 	Users []APIAuthUser `json:"users"`
 }
@@ -1284,6 +1404,163 @@ func (a *APIOwnerRepo) ToService() (interface{}, error) {
 	return res, nil
 }
 
+type APIRateLimitConfig struct {
+	Enabled            bool                     `json:"enabled"`
+	RequestsPerMinute  int                      `json:"requests_per_minute"`
+	Burst              int                      `json:"burst"`
+	ExemptServiceUsers bool                     `json:"exempt_service_users"`
+	ExemptUsers        []string                 `json:"exempt_users"`
+	RouteGroups        []APIRateLimitRouteGroup `json:"route_groups"`
+}
+
+type APIRateLimitRouteGroup struct {
+	Name              *string  `json:"name"`
+	PathPrefixes      []string `json:"path_prefixes"`
+	RequestsPerMinute int      `json:"requests_per_minute"`
+	Burst             int      `json:"burst"`
+}
+
+func (a *APIRateLimitConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case evergreen.RateLimitConfig:
+		a.Enabled = v.Enabled
+		a.RequestsPerMinute = v.RequestsPerMinute
+		a.Burst = v.Burst
+		a.ExemptServiceUsers = v.ExemptServiceUsers
+		a.ExemptUsers = v.ExemptUsers
+		a.RouteGroups = nil
+		for _, group := range v.RouteGroups {
+			a.RouteGroups = append(a.RouteGroups, APIRateLimitRouteGroup{
+				Name:              utility.ToStringPtr(group.Name),
+				PathPrefixes:      group.PathPrefixes,
+				RequestsPerMinute: group.RequestsPerMinute,
+				Burst:             group.Burst,
+			})
+		}
+	default:
+		return errors.Errorf("programmatic error: expected rate limit config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APIRateLimitConfig) ToService() (interface{}, error) {
+	config := evergreen.RateLimitConfig{
+		Enabled:            a.Enabled,
+		RequestsPerMinute:  a.RequestsPerMinute,
+		Burst:              a.Burst,
+		ExemptServiceUsers: a.ExemptServiceUsers,
+		ExemptUsers:        a.ExemptUsers,
+	}
+	for _, group := range a.RouteGroups {
+		config.RouteGroups = append(config.RouteGroups, evergreen.RateLimitRouteGroup{
+			Name:              utility.FromStringPtr(group.Name),
+			PathPrefixes:      group.PathPrefixes,
+			RequestsPerMinute: group.RequestsPerMinute,
+			Burst:             group.Burst,
+		})
+	}
+	return config, nil
+}
+
+type APISecretProvidersConfig struct {
+	Vault               APIVaultSecretProviderConfig       `json:"vault"`
+	AWSSecretsManager   APIAWSSecretsManagerProviderConfig `json:"aws_secrets_manager"`
+	FilePath            *string                            `json:"file_path"`
+	ProjectPathPrefixes []APIProjectSecretPathPrefix       `json:"project_path_prefixes"`
+}
+
+type APIVaultSecretProviderConfig struct {
+	URL       *string  `json:"url"`
+	Token     *string  `json:"token"`
+	Namespace *string  `json:"namespace"`
+	KVMounts  []string `json:"kv_mounts"`
+}
+
+type APIProjectSecretPathPrefix struct {
+	ProjectID  *string `json:"project_id"`
+	PathPrefix *string `json:"path_prefix"`
+}
+
+type APIAWSSecretsManagerProviderConfig struct {
+	Enabled bool    `json:"enabled"`
+	Region  *string `json:"region"`
+	Role    *string `json:"role"`
+}
+
+func (a *APISecretProvidersConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case evergreen.SecretProvidersConfig:
+		a.Vault = APIVaultSecretProviderConfig{
+			URL:       utility.ToStringPtr(v.Vault.URL),
+			Token:     utility.ToStringPtr(v.Vault.Token),
+			Namespace: utility.ToStringPtr(v.Vault.Namespace),
+			KVMounts:  v.Vault.KVMounts,
+		}
+		a.AWSSecretsManager = APIAWSSecretsManagerProviderConfig{
+			Enabled: v.AWSSecretsManager.Enabled,
+			Region:  utility.ToStringPtr(v.AWSSecretsManager.Region),
+			Role:    utility.ToStringPtr(v.AWSSecretsManager.Role),
+		}
+		a.FilePath = utility.ToStringPtr(v.FilePath)
+		a.ProjectPathPrefixes = nil
+		for _, p := range v.ProjectPathPrefixes {
+			a.ProjectPathPrefixes = append(a.ProjectPathPrefixes, APIProjectSecretPathPrefix{
+				ProjectID:  utility.ToStringPtr(p.ProjectID),
+				PathPrefix: utility.ToStringPtr(p.PathPrefix),
+			})
+		}
+	default:
+		return errors.Errorf("programmatic error: expected secret providers config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APISecretProvidersConfig) ToService() (interface{}, error) {
+	config := evergreen.SecretProvidersConfig{
+		Vault: evergreen.VaultSecretProviderConfig{
+			URL:       utility.FromStringPtr(a.Vault.URL),
+			Token:     utility.FromStringPtr(a.Vault.Token),
+			Namespace: utility.FromStringPtr(a.Vault.Namespace),
+			KVMounts:  a.Vault.KVMounts,
+		},
+		AWSSecretsManager: evergreen.AWSSecretsManagerProviderConfig{
+			Enabled: a.AWSSecretsManager.Enabled,
+			Region:  utility.FromStringPtr(a.AWSSecretsManager.Region),
+			Role:    utility.FromStringPtr(a.AWSSecretsManager.Role),
+		},
+		FilePath: utility.FromStringPtr(a.FilePath),
+	}
+	for _, p := range a.ProjectPathPrefixes {
+		config.ProjectPathPrefixes = append(config.ProjectPathPrefixes, evergreen.ProjectSecretPathPrefix{
+			ProjectID:  utility.FromStringPtr(p.ProjectID),
+			PathPrefix: utility.FromStringPtr(p.PathPrefix),
+		})
+	}
+	return config, nil
+}
+
+// APIProjectVarsConfig omits the encryption key, which is only read from the
+// settings file.
+type APIProjectVarsConfig struct {
+	VersionHistoryLimit int `json:"version_history_limit"`
+}
+
+func (a *APIProjectVarsConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case evergreen.ProjectVarsConfig:
+		a.VersionHistoryLimit = v.VersionHistoryLimit
+	default:
+		return errors.Errorf("programmatic error: expected project vars config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APIProjectVarsConfig) ToService() (interface{}, error) {
+	return evergreen.ProjectVarsConfig{
+		VersionHistoryLimit: a.VersionHistoryLimit,
+	}, nil
+}
+
 type APIProjectCreationConfig struct {
 	TotalProjectLimit int            `json:"total_project_limit"`
 	RepoProjectLimit  int            `json:"repo_project_limit"`
@@ -1409,6 +1686,7 @@ type APICommitQueueConfig struct {
 	CommitterName              *string `json:"committer_name"`
 	CommitterEmail             *string `json:"committer_email"`
 	BatchSize                  int     `json:"batch_size"`
+	BatchMode                  bool    `json:"batch_mode"`
 	MaxSystemFailedTaskRetries int     `json:"max_system_failed_task_retries"`
 }
 
@@ -1418,6 +1696,7 @@ func (a *APICommitQueueConfig) BuildFromService(h interface{}) error {
 		a.CommitterName = utility.ToStringPtr(v.CommitterName)
 		a.CommitterEmail = utility.ToStringPtr(v.CommitterEmail)
 		a.BatchSize = v.BatchSize
+		a.BatchMode = v.BatchMode
 		a.MaxSystemFailedTaskRetries = v.MaxSystemFailedTaskRetries
 
 		return nil
@@ -1432,6 +1711,7 @@ func (a *APICommitQueueConfig) ToService() (interface{}, error) {
 		CommitterName:              utility.FromStringPtr(a.CommitterName),
 		CommitterEmail:             utility.FromStringPtr(a.CommitterEmail),
 		BatchSize:                  a.BatchSize,
+		BatchMode:                  a.BatchMode,
 		MaxSystemFailedTaskRetries: a.MaxSystemFailedTaskRetries,
 	}, nil
 }
@@ -2116,9 +2396,10 @@ func (a *APIVSphereConfig) ToService() (interface{}, error) {
 }
 
 type APIRepoTrackerConfig struct {
-	NumNewRepoRevisionsToFetch int `json:"revs_to_fetch"`
-	MaxRepoRevisionsToSearch   int `json:"max_revs_to_search"`
-	MaxConcurrentRequests      int `json:"max_con_requests"`
+	NumNewRepoRevisionsToFetch int  `json:"revs_to_fetch"`
+	MaxRepoRevisionsToSearch   int  `json:"max_revs_to_search"`
+	MaxConcurrentRequests      int  `json:"max_con_requests"`
+	AllowLocalGitRemotes       bool `json:"allow_local_git_remotes"`
 }
 
 func (a *APIRepoTrackerConfig) BuildFromService(h interface{}) error {
@@ -2127,6 +2408,7 @@ func (a *APIRepoTrackerConfig) BuildFromService(h interface{}) error {
 		a.NumNewRepoRevisionsToFetch = v.NumNewRepoRevisionsToFetch
 		a.MaxConcurrentRequests = v.MaxConcurrentRequests
 		a.MaxRepoRevisionsToSearch = v.MaxRepoRevisionsToSearch
+		a.AllowLocalGitRemotes = v.AllowLocalGitRemotes
 	default:
 		return errors.Errorf("programmatic error: expected repotracker config but got type %T", h)
 	}
@@ -2138,6 +2420,7 @@ func (a *APIRepoTrackerConfig) ToService() (interface{}, error) {
 		NumNewRepoRevisionsToFetch: a.NumNewRepoRevisionsToFetch,
 		MaxConcurrentRequests:      a.MaxConcurrentRequests,
 		MaxRepoRevisionsToSearch:   a.MaxRepoRevisionsToSearch,
+		AllowLocalGitRemotes:       a.AllowLocalGitRemotes,
 	}, nil
 }
 
@@ -2878,3 +3161,29 @@ func (c *APIGitHubCheckRunConfig) ToService() (interface{}, error) {
 
 	return config, nil
 }
+
+type APIGitlabConfig struct {
+	URL           *string `json:"url"`
+	Token         *string `json:"token"`
+	WebhookSecret *string `json:"webhook_secret"`
+}
+
+func (c *APIGitlabConfig) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case evergreen.GitlabConfig:
+		c.URL = utility.ToStringPtr(v.URL)
+		c.Token = utility.ToStringPtr(v.Token)
+		c.WebhookSecret = utility.ToStringPtr(v.WebhookSecret)
+	default:
+		return errors.Errorf("programmatic error: expected GitLab config but got type %T", h)
+	}
+	return nil
+}
+
+func (c *APIGitlabConfig) ToService() (interface{}, error) {
+	return evergreen.GitlabConfig{
+		URL:           utility.FromStringPtr(c.URL),
+		Token:         utility.FromStringPtr(c.Token),
+		WebhookSecret: utility.FromStringPtr(c.WebhookSecret),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -116,12 +117,20 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.AuthConfig.LDAP.URL, utility.FromStringPtr(apiSettings.AuthConfig.LDAP.URL))
 	assert.EqualValues(testSettings.AuthConfig.Naive.Users[0].Username, utility.FromStringPtr(apiSettings.AuthConfig.Naive.Users[0].Username))
 	assert.EqualValues(testSettings.AuthConfig.Okta.ClientID, utility.FromStringPtr(apiSettings.AuthConfig.Okta.ClientID))
+	assert.EqualValues(testSettings.AuthConfig.OIDC.Issuer, utility.FromStringPtr(apiSettings.AuthConfig.OIDC.Issuer))
+	assert.EqualValues(testSettings.AuthConfig.OIDC.EmailDomain, utility.FromStringPtr(apiSettings.AuthConfig.OIDC.EmailDomain))
+	assert.EqualValues(testSettings.AuthConfig.OIDC.GroupRoles[0].Group, utility.FromStringPtr(apiSettings.AuthConfig.OIDC.GroupRoles[0].Group))
 	assert.EqualValues(testSettings.AuthConfig.Github.ClientId, utility.FromStringPtr(apiSettings.AuthConfig.Github.ClientId))
 	assert.EqualValues(testSettings.AuthConfig.Multi.ReadWrite[0], apiSettings.AuthConfig.Multi.ReadWrite[0])
 	assert.Equal(len(testSettings.AuthConfig.Github.Users), len(apiSettings.AuthConfig.Github.Users))
 	assert.Equal(testSettings.Buckets.LogBucket.Name, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Name))
 	assert.EqualValues(testSettings.Buckets.LogBucket.Type, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Type))
 	assert.Equal(testSettings.Buckets.LogBucket.DBName, utility.FromStringPtr(apiSettings.Buckets.LogBucket.DBName))
+	assert.Equal(testSettings.Buckets.ArtifactBucket.Name, utility.FromStringPtr(apiSettings.Buckets.ArtifactBucket.Name))
+	assert.EqualValues(testSettings.Buckets.ArtifactBucket.Type, utility.FromStringPtr(apiSettings.Buckets.ArtifactBucket.Type))
+	assert.Equal(testSettings.Buckets.ArtifactProjectDailyQuotaMB, apiSettings.Buckets.ArtifactProjectDailyQuotaMB)
+	assert.Equal(testSettings.Buckets.PatchDiffBucket.Name, utility.FromStringPtr(apiSettings.Buckets.PatchDiffBucket.Name))
+	assert.EqualValues(testSettings.Buckets.PatchDiffBucket.Type, utility.FromStringPtr(apiSettings.Buckets.PatchDiffBucket.Type))
 	assert.Equal(testSettings.Cedar.BaseURL, utility.FromStringPtr(apiSettings.Cedar.BaseURL))
 	assert.Equal(testSettings.Cedar.RPCPort, utility.FromStringPtr(apiSettings.Cedar.RPCPort))
 	assert.Equal(testSettings.Cedar.User, utility.FromStringPtr(apiSettings.Cedar.User))
@@ -129,6 +138,8 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.CommitQueue.MergeTaskDistro, utility.FromStringPtr(apiSettings.CommitQueue.MergeTaskDistro))
 	assert.EqualValues(testSettings.CommitQueue.CommitterName, utility.FromStringPtr(apiSettings.CommitQueue.CommitterName))
 	assert.EqualValues(testSettings.CommitQueue.CommitterEmail, utility.FromStringPtr(apiSettings.CommitQueue.CommitterEmail))
+	assert.EqualValues(testSettings.CommitQueue.BatchSize, apiSettings.CommitQueue.BatchSize)
+	assert.EqualValues(testSettings.CommitQueue.BatchMode, apiSettings.CommitQueue.BatchMode)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Distro, utility.FromStringPtr(apiSettings.ContainerPools.Pools[0].Distro))
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Id, utility.FromStringPtr(apiSettings.ContainerPools.Pools[0].Id))
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].MaxContainers, apiSettings.ContainerPools.Pools[0].MaxContainers)
@@ -207,6 +218,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Providers.OpenStack.IdentityEndpoint, utility.FromStringPtr(apiSettings.Providers.OpenStack.IdentityEndpoint))
 	assert.EqualValues(testSettings.Providers.VSphere.Host, utility.FromStringPtr(apiSettings.Providers.VSphere.Host))
 	assert.EqualValues(testSettings.RepoTracker.MaxConcurrentRequests, apiSettings.RepoTracker.MaxConcurrentRequests)
+	assert.EqualValues(testSettings.RepoTracker.AllowLocalGitRemotes, apiSettings.RepoTracker.AllowLocalGitRemotes)
 	assert.EqualValues(testSettings.Scheduler.TaskFinder, utility.FromStringPtr(apiSettings.Scheduler.TaskFinder))
 	assert.EqualValues(testSettings.ServiceFlags.HostInitDisabled, apiSettings.ServiceFlags.HostInitDisabled)
 	assert.EqualValues(testSettings.ServiceFlags.PodInitDisabled, apiSettings.ServiceFlags.PodInitDisabled)
@@ -224,6 +236,25 @@ func TestModelConversion(t *testing.T) {
 	assert.Equal(testSettings.Tracer.Enabled, *apiSettings.Tracer.Enabled)
 	assert.Equal(testSettings.Tracer.CollectorEndpoint, *apiSettings.Tracer.CollectorEndpoint)
 	assert.Equal(testSettings.GitHubCheckRun.CheckRunLimit, *apiSettings.GitHubCheckRun.CheckRunLimit)
+	assert.Equal(testSettings.Gitlab.URL, utility.FromStringPtr(apiSettings.Gitlab.URL))
+	assert.Equal(testSettings.Gitlab.WebhookSecret, utility.FromStringPtr(apiSettings.Gitlab.WebhookSecret))
+	assert.Equal(testSettings.RateLimit.Enabled, apiSettings.RateLimit.Enabled)
+	assert.Equal(testSettings.RateLimit.RequestsPerMinute, apiSettings.RateLimit.RequestsPerMinute)
+	assert.Equal(testSettings.RateLimit.ExemptUsers, apiSettings.RateLimit.ExemptUsers)
+	require.Len(apiSettings.RateLimit.RouteGroups, len(testSettings.RateLimit.RouteGroups))
+	assert.Equal(testSettings.RateLimit.RouteGroups[0].Name, utility.FromStringPtr(apiSettings.RateLimit.RouteGroups[0].Name))
+	assert.Equal(testSettings.RateLimit.RouteGroups[0].PathPrefixes, apiSettings.RateLimit.RouteGroups[0].PathPrefixes)
+	assert.Equal(testSettings.ProjectVars.VersionHistoryLimit, apiSettings.ProjectVars.VersionHistoryLimit)
+	apiSettingsJSON, err := json.Marshal(apiSettings)
+	require.NoError(err)
+	assert.NotContains(string(apiSettingsJSON), testSettings.ProjectVars.EncryptionKey, "encryption key should never be returned")
+	assert.Equal(testSettings.SecretProviders.Vault.URL, utility.FromStringPtr(apiSettings.SecretProviders.Vault.URL))
+	assert.Equal(testSettings.SecretProviders.Vault.Token, utility.FromStringPtr(apiSettings.SecretProviders.Vault.Token))
+	assert.Equal(testSettings.SecretProviders.AWSSecretsManager.Enabled, apiSettings.SecretProviders.AWSSecretsManager.Enabled)
+	assert.Equal(testSettings.SecretProviders.AWSSecretsManager.Region, utility.FromStringPtr(apiSettings.SecretProviders.AWSSecretsManager.Region))
+	assert.Equal(testSettings.SecretProviders.Vault.KVMounts, apiSettings.SecretProviders.Vault.KVMounts)
+	require.Len(apiSettings.SecretProviders.ProjectPathPrefixes, len(testSettings.SecretProviders.ProjectPathPrefixes))
+	assert.Equal(testSettings.SecretProviders.ProjectPathPrefixes[0].PathPrefix, utility.FromStringPtr(apiSettings.SecretProviders.ProjectPathPrefixes[0].PathPrefix))
 
 	// test converting from the API model back to a DB model
 	dbInterface, err := apiSettings.ToService()
@@ -257,6 +288,11 @@ func TestModelConversion(t *testing.T) {
 	assert.Equal(testSettings.Buckets.LogBucket.Name, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Name))
 	assert.EqualValues(testSettings.Buckets.LogBucket.Type, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Type))
 	assert.Equal(testSettings.Buckets.LogBucket.DBName, utility.FromStringPtr(apiSettings.Buckets.LogBucket.DBName))
+	assert.Equal(testSettings.Buckets.ArtifactBucket.Name, utility.FromStringPtr(apiSettings.Buckets.ArtifactBucket.Name))
+	assert.EqualValues(testSettings.Buckets.ArtifactBucket.Type, utility.FromStringPtr(apiSettings.Buckets.ArtifactBucket.Type))
+	assert.Equal(testSettings.Buckets.ArtifactProjectDailyQuotaMB, apiSettings.Buckets.ArtifactProjectDailyQuotaMB)
+	assert.Equal(testSettings.Buckets.PatchDiffBucket.Name, utility.FromStringPtr(apiSettings.Buckets.PatchDiffBucket.Name))
+	assert.EqualValues(testSettings.Buckets.PatchDiffBucket.Type, utility.FromStringPtr(apiSettings.Buckets.PatchDiffBucket.Type))
 	assert.Equal(testSettings.Cedar.BaseURL, utility.FromStringPtr(apiSettings.Cedar.BaseURL))
 	assert.Equal(testSettings.Cedar.RPCPort, utility.FromStringPtr(apiSettings.Cedar.RPCPort))
 	assert.Equal(testSettings.Cedar.User, utility.FromStringPtr(apiSettings.Cedar.User))
@@ -264,6 +300,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.CommitQueue.MergeTaskDistro, dbSettings.CommitQueue.MergeTaskDistro)
 	assert.EqualValues(testSettings.CommitQueue.CommitterName, dbSettings.CommitQueue.CommitterName)
 	assert.EqualValues(testSettings.CommitQueue.CommitterEmail, dbSettings.CommitQueue.CommitterEmail)
+	assert.EqualValues(testSettings.CommitQueue.BatchMode, dbSettings.CommitQueue.BatchMode)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Distro, dbSettings.ContainerPools.Pools[0].Distro)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].Id, dbSettings.ContainerPools.Pools[0].Id)
 	assert.EqualValues(testSettings.ContainerPools.Pools[0].MaxContainers, dbSettings.ContainerPools.Pools[0].MaxContainers)
@@ -308,6 +345,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Providers.OpenStack.IdentityEndpoint, dbSettings.Providers.OpenStack.IdentityEndpoint)
 	assert.EqualValues(testSettings.Providers.VSphere.Host, dbSettings.Providers.VSphere.Host)
 	assert.EqualValues(testSettings.RepoTracker.MaxConcurrentRequests, dbSettings.RepoTracker.MaxConcurrentRequests)
+	assert.EqualValues(testSettings.RepoTracker.AllowLocalGitRemotes, dbSettings.RepoTracker.AllowLocalGitRemotes)
 	assert.EqualValues(testSettings.Scheduler.TaskFinder, dbSettings.Scheduler.TaskFinder)
 	assert.EqualValues(testSettings.ServiceFlags.HostInitDisabled, dbSettings.ServiceFlags.HostInitDisabled)
 	assert.EqualValues(testSettings.ServiceFlags.PodInitDisabled, dbSettings.ServiceFlags.PodInitDisabled)
@@ -332,6 +370,11 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Tracer.Enabled, dbSettings.Tracer.Enabled)
 	assert.EqualValues(testSettings.Tracer.CollectorEndpoint, dbSettings.Tracer.CollectorEndpoint)
 	assert.EqualValues(testSettings.GitHubCheckRun.CheckRunLimit, dbSettings.GitHubCheckRun.CheckRunLimit)
+	assert.EqualValues(testSettings.Gitlab, dbSettings.Gitlab)
+	assert.EqualValues(testSettings.RateLimit, dbSettings.RateLimit)
+	assert.EqualValues(testSettings.SecretProviders, dbSettings.SecretProviders)
+	assert.EqualValues(testSettings.ProjectVars.VersionHistoryLimit, dbSettings.ProjectVars.VersionHistoryLimit)
+	assert.Empty(dbSettings.ProjectVars.EncryptionKey, "encryption key should never be stored in the database")
 }
 
 func TestRestart(t *testing.T) {
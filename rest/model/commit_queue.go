@@ -29,6 +29,7 @@ type APICommitQueueItem struct {
 	MessageOverride      *string     `json:"message_override"`
 	Source               *string     `json:"source"`
 	QueueLengthAtEnqueue *int        `json:"queue_length_at_enqueue"`
+	BatchTip             *string     `json:"batch_tip,omitempty"`
 }
 
 type APICommitQueuePosition struct {
@@ -56,6 +57,9 @@ func (item *APICommitQueueItem) BuildFromService(cqItemService commitqueue.Commi
 	item.Source = utility.ToStringPtr(cqItemService.Source)
 	item.PatchId = utility.ToStringPtr(cqItemService.PatchId)
 	item.QueueLengthAtEnqueue = utility.ToIntPtr(cqItemService.QueueLengthAtEnqueue)
+	if cqItemService.BatchTip != "" {
+		item.BatchTip = utility.ToStringPtr(cqItemService.BatchTip)
+	}
 
 	for _, module := range cqItemService.Modules {
 		item.Modules = append(item.Modules, *APIModuleBuildFromService(module))
@@ -69,6 +73,7 @@ func (item *APICommitQueueItem) ToService() commitqueue.CommitQueueItem {
 		MessageOverride: utility.FromStringPtr(item.MessageOverride),
 		Source:          utility.FromStringPtr(item.Source),
 		PatchId:         utility.FromStringPtr(item.PatchId),
+		BatchTip:        utility.FromStringPtr(item.BatchTip),
 	}
 	for _, module := range item.Modules {
 		serviceItem.Modules = append(serviceItem.Modules, *APIModuleToService(module))
@@ -23,10 +23,14 @@ func TestCommitQueueBuildFromService(t *testing.T) {
 				},
 			},
 			commitqueue.CommitQueueItem{
-				Issue: "2",
+				Issue:    "2",
+				Version:  "v2",
+				BatchTip: "v3",
 			},
 			commitqueue.CommitQueueItem{
-				Issue: "3",
+				Issue:    "3",
+				Version:  "v3",
+				BatchTip: "v3",
 			},
 		},
 	}
@@ -40,6 +44,9 @@ func TestCommitQueueBuildFromService(t *testing.T) {
 	}
 	assert.Equal(cq.Queue[0].Modules[0].Module, utility.FromStringPtr(cqAPI.Queue[0].Modules[0].Module))
 	assert.Equal(cq.Queue[0].Modules[0].Issue, utility.FromStringPtr(cqAPI.Queue[0].Modules[0].Issue))
+	assert.Nil(cqAPI.Queue[0].BatchTip)
+	assert.Equal("v3", utility.FromStringPtr(cqAPI.Queue[1].BatchTip))
+	assert.Equal("v3", cqAPI.Queue[1].ToService().BatchTip)
 }
 
 func TestParseGitHubComment(t *testing.T) {
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/distro"
@@ -183,6 +185,7 @@ type APIBootstrapSettings struct {
 	RootDir               *string                 `json:"root_dir"`
 	Env                   []APIEnvVar             `json:"env"`
 	ResourceLimits        APIResourceLimits       `json:"resource_limits"`
+	TaskCgroups           APITaskCgroupSettings   `json:"task_cgroups"`
 	PreconditionScripts   []APIPreconditionScript `json:"precondition_scripts"`
 }
 
@@ -213,6 +216,35 @@ type APIResourceLimits struct {
 	VirtualMemoryKB int `json:"virtual_memory"`
 }
 
+// APITaskCgroupSettings is the model used by the API to represent a
+// distro.TaskCgroupSettings.
+type APITaskCgroupSettings struct {
+	Enabled       bool `json:"enabled"`
+	MemoryLimitMB int  `json:"memory_limit_mb"`
+	CPUPercent    int  `json:"cpu_percent"`
+	NumProcesses  int  `json:"num_processes"`
+}
+
+// BuildFromService converts a service-level distro.TaskCgroupSettings to an
+// APITaskCgroupSettings.
+func (s *APITaskCgroupSettings) BuildFromService(settings distro.TaskCgroupSettings) {
+	s.Enabled = settings.Enabled
+	s.MemoryLimitMB = settings.MemoryLimitMB
+	s.CPUPercent = settings.CPUPercent
+	s.NumProcesses = settings.NumProcesses
+}
+
+// ToService returns a service-level distro.TaskCgroupSettings using the data
+// from the APITaskCgroupSettings.
+func (s *APITaskCgroupSettings) ToService() distro.TaskCgroupSettings {
+	return distro.TaskCgroupSettings{
+		Enabled:       s.Enabled,
+		MemoryLimitMB: s.MemoryLimitMB,
+		CPUPercent:    s.CPUPercent,
+		NumProcesses:  s.NumProcesses,
+	}
+}
+
 // APIPreconditionScript is the model used by the API to represent a
 // distro.PreconditionScript.
 type APIPreconditionScript struct {
@@ -268,6 +300,7 @@ func (s *APIBootstrapSettings) BuildFromService(settings distro.BootstrapSetting
 	s.ResourceLimits.NumTasks = settings.ResourceLimits.NumTasks
 	s.ResourceLimits.LockedMemoryKB = settings.ResourceLimits.LockedMemoryKB
 	s.ResourceLimits.VirtualMemoryKB = settings.ResourceLimits.VirtualMemoryKB
+	s.TaskCgroups.BuildFromService(settings.TaskCgroups)
 }
 
 // ToService returns a service layer distro.BootstrapSettings using the data
@@ -299,6 +332,7 @@ func (s *APIBootstrapSettings) ToService() distro.BootstrapSettings {
 	settings.ResourceLimits.NumTasks = s.ResourceLimits.NumTasks
 	settings.ResourceLimits.LockedMemoryKB = s.ResourceLimits.LockedMemoryKB
 	settings.ResourceLimits.VirtualMemoryKB = s.ResourceLimits.VirtualMemoryKB
+	settings.TaskCgroups = s.TaskCgroups.ToService()
 
 	return settings
 }
@@ -368,6 +402,9 @@ type APIDistro struct {
 	IsCluster             bool                     `json:"is_cluster"`
 	Note                  *string                  `json:"note"`
 	ValidProjects         []*string                `json:"valid_projects"`
+	ProjectDistroSettings APIProjectDistroSettings `json:"project_distro_settings"`
+	BaseDistroID          *string                  `json:"base_distro_id"`
+	ProjectID             *string                  `json:"project_id"`
 }
 
 // BuildFromService converts from service level distro.Distro to an APIDistro
@@ -395,6 +432,9 @@ func (apiDistro *APIDistro) BuildFromService(d distro.Distro) {
 	apiDistro.DisableShallowClone = d.DisableShallowClone
 	apiDistro.Note = utility.ToStringPtr(d.Note)
 	apiDistro.ValidProjects = utility.ToStringPtrSlice(d.ValidProjects)
+	apiDistro.ProjectDistroSettings.BuildFromService(d.ProjectDistroSettings)
+	apiDistro.BaseDistroID = utility.ToStringPtr(d.BaseDistroID)
+	apiDistro.ProjectID = utility.ToStringPtr(d.ProjectID)
 	if d.Expansions != nil {
 		apiDistro.Expansions = []APIExpansion{}
 		for _, e := range d.Expansions {
@@ -472,6 +512,9 @@ func (apiDistro *APIDistro) ToService() *distro.Distro {
 	d.DisableShallowClone = apiDistro.DisableShallowClone
 	d.Note = utility.FromStringPtr(apiDistro.Note)
 	d.ValidProjects = utility.FromStringPtrSlice(apiDistro.ValidProjects)
+	d.ProjectDistroSettings = apiDistro.ProjectDistroSettings.ToService()
+	d.BaseDistroID = utility.FromStringPtr(apiDistro.BaseDistroID)
+	d.ProjectID = utility.FromStringPtr(apiDistro.ProjectID)
 
 	d.IsVirtualWorkstation = apiDistro.IsVirtualWorkstation
 	d.IsCluster = apiDistro.IsCluster
@@ -479,6 +522,35 @@ func (apiDistro *APIDistro) ToService() *distro.Distro {
 	return &d
 }
 
+// APIProjectDistroSettings is the model to be returned by the API whenever
+// distro.ProjectDistroSettings are fetched.
+type APIProjectDistroSettings struct {
+	Allowed              bool     `json:"allowed"`
+	AllowedInstanceTypes []string `json:"allowed_instance_types"`
+	MaxHosts             int      `json:"max_hosts"`
+	MaxDistrosPerProject int      `json:"max_distros_per_project"`
+}
+
+// BuildFromService converts from service level distro.ProjectDistroSettings to
+// an APIProjectDistroSettings.
+func (s *APIProjectDistroSettings) BuildFromService(settings distro.ProjectDistroSettings) {
+	s.Allowed = settings.Allowed
+	s.AllowedInstanceTypes = settings.AllowedInstanceTypes
+	s.MaxHosts = settings.MaxHosts
+	s.MaxDistrosPerProject = settings.MaxDistrosPerProject
+}
+
+// ToService returns a service layer distro.ProjectDistroSettings using the
+// data from APIProjectDistroSettings.
+func (s *APIProjectDistroSettings) ToService() distro.ProjectDistroSettings {
+	return distro.ProjectDistroSettings{
+		Allowed:              s.Allowed,
+		AllowedInstanceTypes: s.AllowedInstanceTypes,
+		MaxHosts:             s.MaxHosts,
+		MaxDistrosPerProject: s.MaxDistrosPerProject,
+	}
+}
+
 // APIExpansion is derived from a service layer distro.Expansion
 type APIExpansion struct {
 	Key   *string `json:"key"`
@@ -510,3 +582,103 @@ type APIDistroScriptOptions struct {
 	Sudo              bool   `json:"sudo"`
 	SudoUser          string `json:"sudo_user"`
 }
+
+// APIDistroImageRollout is the model to be returned by the API whenever a
+// distro.ImageRollout is fetched.
+type APIDistroImageRollout struct {
+	ID             *string `json:"id"`
+	DistroID       *string `json:"distro_id"`
+	Region         *string `json:"region"`
+	CandidateAMI   *string `json:"candidate_ami"`
+	PreviousAMI    *string `json:"previous_ami"`
+	HostPercentage int     `json:"host_percentage"`
+	MinHosts       int     `json:"min_hosts"`
+	MinCanaryTasks int     `json:"min_canary_tasks"`
+	// CanaryTasks select the tasks to run on candidate hosts.
+	CanaryTasks []APIImageRolloutCanaryTask `json:"canary_tasks"`
+	// CanaryTaskIDs are the tasks that were scheduled for CanaryTasks.
+	CanaryTaskIDs                    []string    `json:"canary_task_ids"`
+	MaxProvisioningFailurePercentage int         `json:"max_provisioning_failure_percentage"`
+	MaxSystemFailurePercentage       int         `json:"max_system_failure_percentage"`
+	Timeout                          APIDuration `json:"timeout_ms"`
+	Status                           *string     `json:"status"`
+	StartedBy                        *string     `json:"started_by"`
+	StartedAt                        *time.Time  `json:"started_at"`
+	FinishedAt                       *time.Time  `json:"finished_at"`
+	Reason                           *string     `json:"reason"`
+}
+
+// APIImageRolloutCanaryTask selects tasks to run on an image rollout's
+// candidate hosts.
+type APIImageRolloutCanaryTask struct {
+	Project *string `json:"project"`
+	Variant *string `json:"variant"`
+	Task    *string `json:"task"`
+}
+
+// BuildFromService converts from service level distro.ImageRolloutCanaryTask
+// to an APIImageRolloutCanaryTask.
+func (c *APIImageRolloutCanaryTask) BuildFromService(canary distro.ImageRolloutCanaryTask) {
+	c.Project = utility.ToStringPtr(canary.Project)
+	c.Variant = utility.ToStringPtr(canary.Variant)
+	c.Task = utility.ToStringPtr(canary.Task)
+}
+
+// ToService returns a service layer distro.ImageRolloutCanaryTask using the
+// data from APIImageRolloutCanaryTask.
+func (c *APIImageRolloutCanaryTask) ToService() distro.ImageRolloutCanaryTask {
+	return distro.ImageRolloutCanaryTask{
+		Project: utility.FromStringPtr(c.Project),
+		Variant: utility.FromStringPtr(c.Variant),
+		Task:    utility.FromStringPtr(c.Task),
+	}
+}
+
+// BuildFromService converts from service level distro.ImageRollout to an
+// APIDistroImageRollout.
+func (r *APIDistroImageRollout) BuildFromService(rollout distro.ImageRollout) {
+	r.ID = utility.ToStringPtr(rollout.ID)
+	r.DistroID = utility.ToStringPtr(rollout.DistroID)
+	r.Region = utility.ToStringPtr(rollout.Region)
+	r.CandidateAMI = utility.ToStringPtr(rollout.CandidateAMI)
+	r.PreviousAMI = utility.ToStringPtr(rollout.PreviousAMI)
+	r.HostPercentage = rollout.HostPercentage
+	r.MinHosts = rollout.MinHosts
+	r.MinCanaryTasks = rollout.MinCanaryTasks
+	r.CanaryTasks = nil
+	for _, canary := range rollout.CanaryTasks {
+		apiCanary := APIImageRolloutCanaryTask{}
+		apiCanary.BuildFromService(canary)
+		r.CanaryTasks = append(r.CanaryTasks, apiCanary)
+	}
+	r.CanaryTaskIDs = rollout.CanaryTaskIDs
+	r.MaxProvisioningFailurePercentage = rollout.MaxProvisioningFailurePercentage
+	r.MaxSystemFailurePercentage = rollout.MaxSystemFailurePercentage
+	r.Timeout = NewAPIDuration(rollout.Timeout)
+	r.Status = utility.ToStringPtr(rollout.Status)
+	r.StartedBy = utility.ToStringPtr(rollout.StartedBy)
+	r.StartedAt = ToTimePtr(rollout.StartedAt)
+	r.FinishedAt = ToTimePtr(rollout.FinishedAt)
+	r.Reason = utility.ToStringPtr(rollout.Reason)
+}
+
+// ToService returns a service layer distro.ImageRollout using the data from
+// APIDistroImageRollout. Only the fields that can be set when starting a
+// rollout are converted.
+func (r *APIDistroImageRollout) ToService() distro.ImageRollout {
+	var canaries []distro.ImageRolloutCanaryTask
+	for _, canary := range r.CanaryTasks {
+		canaries = append(canaries, canary.ToService())
+	}
+	return distro.ImageRollout{
+		Region:                           utility.FromStringPtr(r.Region),
+		CandidateAMI:                     utility.FromStringPtr(r.CandidateAMI),
+		HostPercentage:                   r.HostPercentage,
+		MinHosts:                         r.MinHosts,
+		MinCanaryTasks:                   r.MinCanaryTasks,
+		CanaryTasks:                      canaries,
+		MaxProvisioningFailurePercentage: r.MaxProvisioningFailurePercentage,
+		MaxSystemFailurePercentage:       r.MaxSystemFailurePercentage,
+		Timeout:                          r.Timeout.ToDuration(),
+	}
+}
@@ -32,6 +32,8 @@ func TestDistroBuildFromService(t *testing.T) {
 			SchedulerHost: "host",
 			ConfigPath:    "config_path",
 		},
+		BaseDistroID: "base",
+		ProjectID:    "project",
 	}
 	apiDistro := &APIDistro{}
 	apiDistro.BuildFromService(d)
@@ -47,6 +49,8 @@ func TestDistroBuildFromService(t *testing.T) {
 	assert.Equal(t, d.HomeVolumeSettings.FormatCommand, utility.FromStringPtr(apiDistro.HomeVolumeSettings.FormatCommand))
 	assert.Equal(t, d.IceCreamSettings.SchedulerHost, utility.FromStringPtr(apiDistro.IcecreamSettings.SchedulerHost))
 	assert.Equal(t, d.IceCreamSettings.ConfigPath, utility.FromStringPtr(apiDistro.IcecreamSettings.ConfigPath))
+	assert.Equal(t, d.BaseDistroID, utility.FromStringPtr(apiDistro.BaseDistroID))
+	assert.Equal(t, d.ProjectID, utility.FromStringPtr(apiDistro.ProjectID))
 }
 
 func TestDistroBuildFromServiceDefaults(t *testing.T) {
@@ -97,6 +101,12 @@ func TestDistroToService(t *testing.T) {
 			SchedulerHost: utility.ToStringPtr("host"),
 			ConfigPath:    utility.ToStringPtr("config_path"),
 		},
+		ProjectDistroSettings: APIProjectDistroSettings{
+			Allowed:              true,
+			AllowedInstanceTypes: []string{"m5.xlarge"},
+			MaxHosts:             5,
+			MaxDistrosPerProject: 2,
+		},
 	}
 
 	d := apiDistro.ToService()
@@ -124,6 +134,10 @@ func TestDistroToService(t *testing.T) {
 	assert.Equal(t, utility.FromStringPtr(apiDistro.HomeVolumeSettings.FormatCommand), d.HomeVolumeSettings.FormatCommand)
 	assert.Equal(t, utility.FromStringPtr(apiDistro.IcecreamSettings.SchedulerHost), d.IceCreamSettings.SchedulerHost)
 	assert.Equal(t, utility.FromStringPtr(apiDistro.IcecreamSettings.ConfigPath), d.IceCreamSettings.ConfigPath)
+	assert.True(t, d.ProjectDistroSettings.Allowed)
+	assert.Equal(t, apiDistro.ProjectDistroSettings.AllowedInstanceTypes, d.ProjectDistroSettings.AllowedInstanceTypes)
+	assert.Equal(t, 5, d.ProjectDistroSettings.MaxHosts)
+	assert.Equal(t, 2, d.ProjectDistroSettings.MaxDistrosPerProject)
 }
 
 func TestDistroToServiceDefaults(t *testing.T) {
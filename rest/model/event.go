@@ -187,3 +187,44 @@ func (el *PodAPIEventData) BuildFromService(v *event.PodData) {
 	el.TaskExecution = utility.ToIntPtr(v.TaskExecution)
 	el.TaskStatus = utility.ToStringPtr(v.TaskStatus)
 }
+
+type AuditAPIEventLogEntry struct {
+	Timestamp  *time.Time         `bson:"ts" json:"timestamp"`
+	ResourceId *string            `bson:"r_id" json:"resource_id"`
+	EventType  *string            `bson:"e_type" json:"event_type"`
+	Data       *AuditAPIEventData `bson:"data" json:"data"`
+}
+
+type AuditAPIEventData struct {
+	Actor         *string                `bson:"actor" json:"actor"`
+	AccessTokenID *string                `bson:"access_token_id,omitempty" json:"access_token_id,omitempty"`
+	Method        *string                `bson:"method,omitempty" json:"method,omitempty"`
+	Operation     *string                `bson:"operation" json:"operation"`
+	Params        map[string]interface{} `bson:"params,omitempty" json:"params,omitempty"`
+	StatusCode    int                    `bson:"status_code,omitempty" json:"status_code,omitempty"`
+	Error         *string                `bson:"error,omitempty" json:"error,omitempty"`
+}
+
+func (el *AuditAPIEventLogEntry) BuildFromService(entry event.EventLogEntry) error {
+	d, ok := entry.Data.(*event.AuditEventData)
+	if !ok {
+		return errors.Errorf("programmatic error: expected audit event data but got type %T", entry.Data)
+	}
+	auditAPIEventData := AuditAPIEventData{}
+	auditAPIEventData.BuildFromService(d)
+	el.Timestamp = ToTimePtr(entry.Timestamp)
+	el.ResourceId = utility.ToStringPtr(entry.ResourceId)
+	el.EventType = utility.ToStringPtr(entry.EventType)
+	el.Data = &auditAPIEventData
+	return nil
+}
+
+func (el *AuditAPIEventData) BuildFromService(v *event.AuditEventData) {
+	el.Actor = utility.ToStringPtr(v.Actor)
+	el.AccessTokenID = utility.ToStringPtr(v.AccessTokenID)
+	el.Method = utility.ToStringPtr(v.Method)
+	el.Operation = utility.ToStringPtr(v.Operation)
+	el.Params = v.Params
+	el.StatusCode = v.StatusCode
+	el.Error = utility.ToStringPtr(v.Error)
+}
@@ -42,6 +42,59 @@ type APIHost struct {
 	CreationTime          *time.Time  `json:"creation_time"`
 	Expiration            *time.Time  `json:"expiration_time"`
 	AttachedVolumeIDs     []string    `json:"attached_volume_ids"`
+	// The schedule on which an unexpirable host is automatically stopped and
+	// started
+	SleepSchedule *APISleepSchedule `json:"sleep_schedule,omitempty"`
+}
+
+// APISleepSchedule is the recurring schedule for when an unexpirable spawn
+// host is automatically stopped and started.
+type APISleepSchedule struct {
+	// The IANA time zone of the schedule, such as America/New_York
+	TimeZone *string `json:"time_zone"`
+	// The time of day in the form HH:MM when the host is started
+	DailyStartTime *string `json:"daily_start_time"`
+	// The time of day in the form HH:MM when the host is stopped
+	DailyStopTime *string `json:"daily_stop_time"`
+	// The days of the week when the host is stopped for the entire day, where
+	// Sunday is 0 and Saturday is 6
+	WholeWeekdaysOff []int `json:"whole_weekdays_off"`
+	// The time until which the host is kept awake in spite of the schedule
+	TemporarilyExemptUntil *time.Time `json:"temporarily_exempt_until"`
+	// The next time the host will be stopped
+	NextStopTime *time.Time `json:"next_stop_time"`
+	// The next time the host will be started
+	NextStartTime *time.Time `json:"next_start_time"`
+}
+
+func (s *APISleepSchedule) BuildFromService(schedule host.SleepScheduleInfo) {
+	s.TimeZone = utility.ToStringPtr(schedule.TimeZone)
+	s.DailyStartTime = utility.ToStringPtr(schedule.DailyStartTime)
+	s.DailyStopTime = utility.ToStringPtr(schedule.DailyStopTime)
+	s.WholeWeekdaysOff = []int{}
+	for _, day := range schedule.WholeWeekdaysOff {
+		s.WholeWeekdaysOff = append(s.WholeWeekdaysOff, int(day))
+	}
+	s.TemporarilyExemptUntil = ToTimePtr(schedule.TemporarilyExemptUntil)
+	s.NextStopTime = ToTimePtr(schedule.NextStopTime)
+	s.NextStartTime = ToTimePtr(schedule.NextStartTime)
+}
+
+// ToService returns the service layer sleep schedule. The next stop and start
+// times are computed by the service, so they are not converted.
+func (s *APISleepSchedule) ToService() host.SleepScheduleInfo {
+	schedule := host.SleepScheduleInfo{
+		TimeZone:       utility.FromStringPtr(s.TimeZone),
+		DailyStartTime: utility.FromStringPtr(s.DailyStartTime),
+		DailyStopTime:  utility.FromStringPtr(s.DailyStopTime),
+	}
+	for _, day := range s.WholeWeekdaysOff {
+		schedule.WholeWeekdaysOff = append(schedule.WholeWeekdaysOff, time.Weekday(day))
+	}
+	if s.TemporarilyExemptUntil != nil {
+		schedule.TemporarilyExemptUntil = *s.TemporarilyExemptUntil
+	}
+	return schedule
 }
 
 // HostRequestOptions is a struct that holds the format of a POST request to
@@ -64,6 +117,7 @@ type HostRequestOptions struct {
 	IsCluster             bool       `json:"is_cluster" yaml:"is_cluster"`
 	HomeVolumeSize        int        `json:"home_volume_size" yaml:"home_volume_size"`
 	HomeVolumeID          string     `json:"home_volume_id" yaml:"home_volume_id"`
+	HomeVolumeSnapshotID  string     `json:"home_volume_snapshot_id" yaml:"home_volume_snapshot_id"`
 	Expiration            *time.Time `json:"expiration" yaml:"expiration"`
 }
 
@@ -138,6 +192,10 @@ func (apiHost *APIHost) buildFromHostStruct(h *host.Host) {
 		attachedVolumeIds = append(attachedVolumeIds, volAttachment.VolumeID)
 	}
 	apiHost.AttachedVolumeIDs = attachedVolumeIds
+	if !h.SleepSchedule.IsZero() {
+		apiHost.SleepSchedule = &APISleepSchedule{}
+		apiHost.SleepSchedule.BuildFromService(h.SleepSchedule)
+	}
 	imageId, err := h.Distro.GetImageID()
 	if err != nil {
 		// report error but do not fail function because of a bad imageId
@@ -193,6 +251,7 @@ type APIVolume struct {
 	HomeVolume       bool       `json:"home_volume"`
 	CreationTime     *time.Time `json:"creation_time"`
 	Migrating        bool       `json:"migrating"`
+	SnapshotID       *string    `json:"snapshot_id,omitempty"`
 }
 
 type VolumePostRequest struct {
@@ -222,6 +281,9 @@ func (apiVolume *APIVolume) BuildFromService(v host.Volume) {
 	apiVolume.HomeVolume = v.HomeVolume
 	apiVolume.CreationTime = ToTimePtr(v.CreationDate)
 	apiVolume.Migrating = v.Migrating
+	if v.SnapshotID != "" {
+		apiVolume.SnapshotID = utility.ToStringPtr(v.SnapshotID)
+	}
 }
 
 func (apiVolume *APIVolume) ToService() (host.Volume, error) {
@@ -241,9 +303,46 @@ func (apiVolume *APIVolume) ToService() (host.Volume, error) {
 		NoExpiration:     apiVolume.NoExpiration,
 		HomeVolume:       apiVolume.HomeVolume,
 		Migrating:        apiVolume.Migrating,
+		SnapshotID:       utility.FromStringPtr(apiVolume.SnapshotID),
 	}, nil
 }
 
+// APISnapshot is the model to be returned by the API whenever snapshots are
+// fetched.
+type APISnapshot struct {
+	ID             *string    `json:"snapshot_id"`
+	DisplayName    *string    `json:"display_name"`
+	CreatedBy      *string    `json:"created_by"`
+	SourceVolumeID *string    `json:"source_volume_id"`
+	SourceHostID   *string    `json:"source_host_id"`
+	Region         *string    `json:"region"`
+	Size           int        `json:"size"`
+	Expiration     *time.Time `json:"expiration"`
+	NoExpiration   bool       `json:"no_expiration"`
+	CreationTime   *time.Time `json:"creation_time"`
+}
+
+// SnapshotPostRequest contains the options for taking a snapshot. Exactly one
+// of the host ID or volume ID must be set; a host's home volume is snapshotted.
+type SnapshotPostRequest struct {
+	HostID      string `json:"host_id"`
+	VolumeID    string `json:"volume_id"`
+	DisplayName string `json:"display_name"`
+}
+
+func (apiSnapshot *APISnapshot) BuildFromService(s host.Snapshot) {
+	apiSnapshot.ID = utility.ToStringPtr(s.ID)
+	apiSnapshot.DisplayName = utility.ToStringPtr(s.DisplayName)
+	apiSnapshot.CreatedBy = utility.ToStringPtr(s.CreatedBy)
+	apiSnapshot.SourceVolumeID = utility.ToStringPtr(s.SourceVolumeID)
+	apiSnapshot.SourceHostID = utility.ToStringPtr(s.SourceHostID)
+	apiSnapshot.Region = utility.ToStringPtr(s.Region)
+	apiSnapshot.Size = int(s.Size)
+	apiSnapshot.Expiration = ToTimePtr(s.Expiration)
+	apiSnapshot.NoExpiration = s.NoExpiration
+	apiSnapshot.CreationTime = ToTimePtr(s.CreationDate)
+}
+
 type APISpawnHostModify struct {
 	Action       *string    `json:"action"`
 	HostID       *string    `json:"host_id"`
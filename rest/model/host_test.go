@@ -89,3 +89,31 @@ func TestHostBuildFromService(t *testing.T) {
 		})
 	})
 }
+
+func TestSleepScheduleBuildFromServiceAndToService(t *testing.T) {
+	Convey("A sleep schedule should round trip between the service and API models", t, func() {
+		exemptUntil := time.Now().Add(time.Hour).Round(time.Second)
+		schedule := host.SleepScheduleInfo{
+			TimeZone:               "America/New_York",
+			DailyStartTime:         "08:00",
+			DailyStopTime:          "20:00",
+			WholeWeekdaysOff:       []time.Weekday{time.Saturday, time.Sunday},
+			TemporarilyExemptUntil: exemptUntil,
+			NextStopTime:           exemptUntil.Add(time.Hour),
+		}
+
+		apiSchedule := APISleepSchedule{}
+		apiSchedule.BuildFromService(schedule)
+		So(utility.FromStringPtr(apiSchedule.TimeZone), ShouldEqual, schedule.TimeZone)
+		So(apiSchedule.WholeWeekdaysOff, ShouldResemble, []int{6, 0})
+		So(apiSchedule.NextStopTime, ShouldNotBeNil)
+		So(apiSchedule.NextStartTime, ShouldBeNil)
+
+		roundTripped := apiSchedule.ToService()
+		So(roundTripped.DailyStartTime, ShouldEqual, schedule.DailyStartTime)
+		So(roundTripped.DailyStopTime, ShouldEqual, schedule.DailyStopTime)
+		So(roundTripped.WholeWeekdaysOff, ShouldResemble, schedule.WholeWeekdaysOff)
+		So(roundTripped.TemporarilyExemptUntil.Equal(exemptUntil), ShouldBeTrue)
+		So(roundTripped.NextStopTime.IsZero(), ShouldBeTrue)
+	})
+}
@@ -63,6 +63,15 @@ type APIPatch struct {
 	ChildPatchAliases       []APIChildPatchAlias `json:"child_patch_aliases,omitempty"`
 	Requester               *string              `json:"requester"`
 	MergedFrom              *string              `json:"merged_from"`
+	// SkippedTasks are the tasks selected by the patch's alias that were not
+	// scheduled by smart selection.
+	SkippedTasks []APISkippedTask `json:"skipped_tasks"`
+	// RequiredVariantsTasks are the tasks that must succeed for a GitHub merge
+	// queue patch to pass its merge check. If empty, all tasks are required.
+	RequiredVariantsTasks []VariantTask `json:"required_variants_tasks"`
+	// Stack is the patch's place in a stack of patches. It is only populated
+	// for patches that were submitted as part of a stack.
+	Stack *APIStackInfo `json:"stack,omitempty"`
 	// Only populated for commit queue patches: returns the 0-indexed position of the patch on the queue, or -1 if not on the queue anymore
 	CommitQueuePosition *int `json:"commit_queue_position,omitempty"`
 }
@@ -93,6 +102,59 @@ type FileDiff struct {
 	Description string  `json:"description"`
 }
 
+// APISkippedTask is a task selected by a patch's alias that was not scheduled.
+type APISkippedTask struct {
+	Variant  *string `json:"variant"`
+	TaskName *string `json:"task_name"`
+	Reason   *string `json:"reason"`
+}
+
+func (st *APISkippedTask) BuildFromService(t patch.SkippedTask) {
+	st.Variant = utility.ToStringPtr(t.Variant)
+	st.TaskName = utility.ToStringPtr(t.TaskName)
+	st.Reason = utility.ToStringPtr(t.Reason)
+}
+
+func (st *APISkippedTask) ToService() patch.SkippedTask {
+	return patch.SkippedTask{
+		Variant:  utility.FromStringPtr(st.Variant),
+		TaskName: utility.FromStringPtr(st.TaskName),
+		Reason:   utility.FromStringPtr(st.Reason),
+	}
+}
+
+// APIStackInfo describes a patch's place in a stack of patches.
+type APIStackInfo struct {
+	// ID identifies the stack and is shared by all of its patches.
+	ID *string `json:"id"`
+	// ParentPatchID is the patch this patch builds on, if any.
+	ParentPatchID *string `json:"parent_patch_id"`
+	// Position is the 0-indexed position of the patch from the bottom of the stack.
+	Position int `json:"position"`
+	// Revision is the number of times the stack had been refreshed when this patch was submitted.
+	Revision int `json:"revision"`
+	// Commit is the local commit that the patch tests the changes up to.
+	Commit *string `json:"commit"`
+}
+
+func (s *APIStackInfo) BuildFromService(info patch.StackInfo) {
+	s.ID = utility.ToStringPtr(info.ID)
+	s.ParentPatchID = utility.ToStringPtr(info.ParentPatchID)
+	s.Position = info.Position
+	s.Revision = info.Revision
+	s.Commit = utility.ToStringPtr(info.Commit)
+}
+
+func (s *APIStackInfo) ToService() patch.StackInfo {
+	return patch.StackInfo{
+		ID:            utility.FromStringPtr(s.ID),
+		ParentPatchID: utility.FromStringPtr(s.ParentPatchID),
+		Position:      s.Position,
+		Revision:      s.Revision,
+		Commit:        utility.FromStringPtr(s.Commit),
+	}
+}
+
 type APIChildPatchAlias struct {
 	Alias   *string `json:"alias"`
 	PatchID *string `json:"patch_id"`
@@ -230,6 +292,12 @@ func (apiPatch *APIPatch) buildBasePatch(p patch.Patch) {
 	apiPatch.StartTime = ToTimePtr(p.StartTime)
 	apiPatch.FinishTime = ToTimePtr(p.FinishTime)
 	apiPatch.MergedFrom = utility.ToStringPtr(p.MergedFrom)
+	apiPatch.SkippedTasks = []APISkippedTask{}
+	for _, t := range p.SkippedTasks {
+		var skippedTask APISkippedTask
+		skippedTask.BuildFromService(t)
+		apiPatch.SkippedTasks = append(apiPatch.SkippedTasks, skippedTask)
+	}
 	apiPatch.Status = utility.ToStringPtr(p.Status)
 	// Ensure we're returning a consistent successful status.
 	if p.Status == evergreen.LegacyPatchSucceeded {
@@ -257,6 +325,25 @@ func (apiPatch *APIPatch) buildBasePatch(p patch.Patch) {
 		})
 	}
 	apiPatch.VariantsTasks = variantTasks
+	requiredVariantTasks := []VariantTask{}
+	for _, vt := range p.RequiredVariantsTasks {
+		vtasks := make([]*string, 0, len(vt.Tasks)+len(vt.DisplayTasks))
+		for _, task := range vt.Tasks {
+			vtasks = append(vtasks, utility.ToStringPtr(task))
+		}
+		for _, dt := range vt.DisplayTasks {
+			vtasks = append(vtasks, utility.ToStringPtr(dt.Name))
+		}
+		requiredVariantTasks = append(requiredVariantTasks, VariantTask{
+			Name:  utility.ToStringPtr(vt.Variant),
+			Tasks: vtasks,
+		})
+	}
+	apiPatch.RequiredVariantsTasks = requiredVariantTasks
+	if p.IsStackPatch() {
+		apiPatch.Stack = &APIStackInfo{}
+		apiPatch.Stack.BuildFromService(p.Stack)
+	}
 	apiPatch.Activated = p.Activated
 	apiPatch.Alias = utility.ToStringPtr(p.Alias)
 	apiPatch.GithubPatchData = githubPatch{}
@@ -422,6 +509,12 @@ func (apiPatch *APIPatch) ToService() (patch.Patch, error) {
 		tasks[i] = utility.FromStringPtr(t)
 	}
 	res.Tasks = tasks
+	for _, t := range apiPatch.SkippedTasks {
+		res.SkippedTasks = append(res.SkippedTasks, t.ToService())
+	}
+	if apiPatch.Stack != nil {
+		res.Stack = apiPatch.Stack.ToService()
+	}
 	if apiPatch.Parameters != nil {
 		res.Parameters = []patch.Parameter{}
 		for _, param := range apiPatch.Parameters {
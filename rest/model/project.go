@@ -251,12 +251,53 @@ type APICommitQueueParams struct {
 	// merge queue to use (EVERGREEN or GITHUB)
 	MergeQueue model.MergeQueue `json:"merge_queue"`
 	Message    *string          `json:"message"`
+	// historical failure rate above which a test is considered flaky
+	FlakyTestThreshold *float64 `json:"flaky_test_threshold"`
+	// variants and tasks that must succeed for GitHub merge queue versions, by target branch
+	RequiredChecks []APIMergeQueueRequiredChecks `json:"required_checks"`
+}
+
+type APIMergeQueueRequiredChecks struct {
+	// regex matching the target branches the checks are required for
+	BranchRegex *string `json:"branch_regex"`
+	// regex matching the required build variants
+	VariantRegex *string `json:"variant_regex"`
+	// tags of the required build variants
+	VariantTags []string `json:"variant_tags"`
+	// regex matching the required tasks
+	TaskRegex *string `json:"task_regex"`
+	// tags of the required tasks
+	TaskTags []string `json:"task_tags"`
+}
+
+func (c *APIMergeQueueRequiredChecks) BuildFromService(checks model.MergeQueueRequiredChecks) {
+	c.BranchRegex = utility.ToStringPtr(checks.BranchRegex)
+	c.VariantRegex = utility.ToStringPtr(checks.VariantRegex)
+	c.VariantTags = checks.VariantTags
+	c.TaskRegex = utility.ToStringPtr(checks.TaskRegex)
+	c.TaskTags = checks.TaskTags
+}
+
+func (c *APIMergeQueueRequiredChecks) ToService() model.MergeQueueRequiredChecks {
+	return model.MergeQueueRequiredChecks{
+		BranchRegex:  utility.FromStringPtr(c.BranchRegex),
+		VariantRegex: utility.FromStringPtr(c.VariantRegex),
+		VariantTags:  c.VariantTags,
+		TaskRegex:    utility.FromStringPtr(c.TaskRegex),
+		TaskTags:     c.TaskTags,
+	}
 }
 
 func (cqParams *APICommitQueueParams) BuildFromService(params model.CommitQueueParams) {
 	cqParams.Enabled = utility.BoolPtrCopy(params.Enabled)
 	cqParams.MergeMethod = utility.ToStringPtr(params.MergeMethod)
 	cqParams.Message = utility.ToStringPtr(params.Message)
+	cqParams.FlakyTestThreshold = utility.ToFloat64Ptr(params.FlakyTestThreshold)
+	for _, checks := range params.RequiredChecks {
+		var apiChecks APIMergeQueueRequiredChecks
+		apiChecks.BuildFromService(checks)
+		cqParams.RequiredChecks = append(cqParams.RequiredChecks, apiChecks)
+	}
 
 	if params.MergeQueue == "" {
 		params.MergeQueue = model.MergeQueueEvergreen
@@ -269,6 +310,10 @@ func (cqParams *APICommitQueueParams) ToService() model.CommitQueueParams {
 	serviceParams.Enabled = utility.BoolPtrCopy(cqParams.Enabled)
 	serviceParams.MergeMethod = utility.FromStringPtr(cqParams.MergeMethod)
 	serviceParams.Message = utility.FromStringPtr(cqParams.Message)
+	serviceParams.FlakyTestThreshold = utility.FromFloat64Ptr(cqParams.FlakyTestThreshold)
+	for _, checks := range cqParams.RequiredChecks {
+		serviceParams.RequiredChecks = append(serviceParams.RequiredChecks, checks.ToService())
+	}
 
 	if cqParams.MergeQueue == "" {
 		cqParams.MergeQueue = model.MergeQueueEvergreen
@@ -558,6 +603,10 @@ type APIProjectRef struct {
 	DeactivatePrevious *bool `json:"deactivate_previous"`
 	// If true, repotracker is run on github push events. If false, repotracker is run periodically every few minutes.
 	TracksPushEvents *bool `json:"tracks_push_events"`
+	// Git remote that the repotracker polls with plain git instead of the GitHub API.
+	GitRemoteURL *string `json:"git_remote_url"`
+	// Service hosting the project's repository, either "github" (the default) or "gitlab".
+	CodeHost *string `json:"code_host"`
 	// Enable github pull request testing
 	PRTestingEnabled       *bool   `json:"pr_testing_enabled"`
 	ManualPRTestingEnabled *bool   `json:"manual_pr_testing_enabled"`
@@ -634,6 +683,8 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		DisplayName:            utility.FromStringPtr(p.DisplayName),
 		DeactivatePrevious:     utility.BoolPtrCopy(p.DeactivatePrevious),
 		TracksPushEvents:       utility.BoolPtrCopy(p.TracksPushEvents),
+		GitRemoteURL:           utility.FromStringPtr(p.GitRemoteURL),
+		CodeHost:               utility.FromStringPtr(p.CodeHost),
 		PRTestingEnabled:       utility.BoolPtrCopy(p.PRTestingEnabled),
 		ManualPRTestingEnabled: utility.BoolPtrCopy(p.ManualPRTestingEnabled),
 		GitTagVersionsEnabled:  utility.BoolPtrCopy(p.GitTagVersionsEnabled),
@@ -747,6 +798,8 @@ func (p *APIProjectRef) BuildPublicFields(projectRef model.ProjectRef) error {
 	p.RemotePath = utility.ToStringPtr(projectRef.RemotePath)
 	p.DeactivatePrevious = projectRef.DeactivatePrevious
 	p.TracksPushEvents = utility.BoolPtrCopy(projectRef.TracksPushEvents)
+	p.GitRemoteURL = utility.ToStringPtr(projectRef.GitRemoteURL)
+	p.CodeHost = utility.ToStringPtr(projectRef.CodeHost)
 	p.PRTestingEnabled = utility.BoolPtrCopy(projectRef.PRTestingEnabled)
 	p.ManualPRTestingEnabled = utility.BoolPtrCopy(projectRef.ManualPRTestingEnabled)
 	p.GitTagVersionsEnabled = utility.BoolPtrCopy(projectRef.GitTagVersionsEnabled)
@@ -39,7 +39,10 @@ type APIProjectVars struct {
 	Vars          map[string]string `json:"vars"`
 	PrivateVars   map[string]bool   `json:"private_vars"`
 	AdminOnlyVars map[string]bool   `json:"admin_only_vars"`
-	VarsToDelete  []string          `json:"vars_to_delete,omitempty"`
+	// ExternalSecretVars are the variables whose values reference secrets in
+	// an external secret store.
+	ExternalSecretVars map[string]bool `json:"external_secret_vars,omitempty"`
+	VarsToDelete       []string        `json:"vars_to_delete,omitempty"`
 
 	// to use for the UI
 	PrivateVarsList   []string `json:"-"`
@@ -58,6 +61,28 @@ type APIProjectAlias struct {
 	Delete      bool            `json:"delete,omitempty"`
 	ID          *string         `json:"_id,omitempty"`
 	Parameters  []*APIParameter `json:"parameters,omitempty"`
+	// SmartSelection limits the alias's tasks to the ones most likely to fail
+	// given the patch's changed files.
+	SmartSelection *APISmartSelection `json:"smart_selection,omitempty"`
+}
+
+type APISmartSelection struct {
+	// MaxTasks is the maximum number of ranked tasks to schedule.
+	MaxTasks int `json:"max_tasks"`
+	// MandatoryTasks are regexes matching tasks that are always scheduled.
+	MandatoryTasks []string `json:"mandatory_tasks"`
+}
+
+func (s *APISmartSelection) BuildFromService(in model.SmartSelection) {
+	s.MaxTasks = in.MaxTasks
+	s.MandatoryTasks = in.MandatoryTasks
+}
+
+func (s *APISmartSelection) ToService() *model.SmartSelection {
+	return &model.SmartSelection{
+		MaxTasks:       s.MaxTasks,
+		MandatoryTasks: s.MandatoryTasks,
+	}
 }
 
 func (e *APIProjectEvent) BuildFromService(entry model.ProjectChangeEventEntry) error {
@@ -113,6 +138,7 @@ func DbProjectSettingsToRestModel(settings model.ProjectSettings) (APIProjectSet
 func (p *APIProjectVars) ToService() *model.ProjectVars {
 	privateVars := map[string]bool{}
 	adminOnlyVars := map[string]bool{}
+	externalSecretVars := map[string]bool{}
 	// ignore false inputs
 	for key, val := range p.PrivateVars {
 		if val {
@@ -124,6 +150,11 @@ func (p *APIProjectVars) ToService() *model.ProjectVars {
 			adminOnlyVars[key] = val
 		}
 	}
+	for key, val := range p.ExternalSecretVars {
+		if val {
+			externalSecretVars[key] = val
+		}
+	}
 
 	// handle UI list
 	for _, each := range p.PrivateVarsList {
@@ -133,9 +164,10 @@ func (p *APIProjectVars) ToService() *model.ProjectVars {
 		adminOnlyVars[each] = true
 	}
 	return &model.ProjectVars{
-		Vars:          p.Vars,
-		AdminOnlyVars: adminOnlyVars,
-		PrivateVars:   privateVars,
+		Vars:               p.Vars,
+		AdminOnlyVars:      adminOnlyVars,
+		PrivateVars:        privateVars,
+		ExternalSecretVars: externalSecretVars,
 	}
 }
 
@@ -143,6 +175,42 @@ func (p *APIProjectVars) BuildFromService(v model.ProjectVars) {
 	p.PrivateVars = v.PrivateVars
 	p.Vars = v.Vars
 	p.AdminOnlyVars = v.AdminOnlyVars
+	p.ExternalSecretVars = v.ExternalSecretVars
+}
+
+// APIProjectVarVersion is a version of a project variable. The values of
+// private variables are never returned.
+type APIProjectVarVersion struct {
+	Name      *string `json:"name"`
+	ProjectID *string `json:"project_id"`
+	Version   int     `json:"version"`
+	// Value is the variable's value, which is empty for private variables.
+	Value          *string `json:"value"`
+	Private        bool    `json:"private"`
+	AdminOnly      bool    `json:"admin_only"`
+	ExternalSecret bool    `json:"external_secret"`
+	Deleted        bool    `json:"deleted"`
+	// CanRollback is whether the variable can be rolled back to this version.
+	CanRollback bool       `json:"can_rollback"`
+	User        *string    `json:"user"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+func (v *APIProjectVarVersion) BuildFromService(in model.ProjectVarVersion) {
+	v.Name = utility.ToStringPtr(in.Name)
+	v.ProjectID = utility.ToStringPtr(in.ProjectID)
+	v.Version = in.Version
+	v.Value = utility.ToStringPtr("")
+	if !in.Private {
+		v.Value = utility.ToStringPtr(in.Value)
+	}
+	v.Private = in.Private
+	v.AdminOnly = in.AdminOnly
+	v.ExternalSecret = in.ExternalSecret
+	v.Deleted = in.Deleted
+	v.CanRollback = !in.Deleted && !in.ValueOmitted
+	v.User = utility.ToStringPtr(in.User)
+	v.CreatedAt = ToTimePtr(in.CreatedAt)
 }
 
 func (a *APIProjectAlias) ToService() model.ProjectAlias {
@@ -161,6 +229,10 @@ func (a *APIProjectAlias) ToService() model.ProjectAlias {
 		res.Parameters = append(res.Parameters, param.ToService())
 	}
 
+	if a.SmartSelection != nil {
+		res.SmartSelection = a.SmartSelection.ToService()
+	}
+
 	if model.IsValidId(utility.FromStringPtr(a.ID)) {
 		res.ID = model.NewId(utility.FromStringPtr(a.ID))
 	}
@@ -187,6 +259,10 @@ func (a *APIProjectAlias) BuildFromService(in model.ProjectAlias) {
 		APIParameters = append(APIParameters, APIParam)
 	}
 	a.Parameters = APIParameters
+	if in.SmartSelection != nil {
+		a.SmartSelection = &APISmartSelection{}
+		a.SmartSelection.BuildFromService(*in.SmartSelection)
+	}
 }
 
 func dbProjectAliasesToRestModel(aliases []model.ProjectAlias) []APIProjectAlias {
@@ -203,6 +279,10 @@ func dbProjectAliasesToRestModel(aliases []model.ProjectAlias) []APIProjectAlias
 			TaskTags:    utility.ToStringPtrSlice(alias.TaskTags),
 			VariantTags: utility.ToStringPtrSlice(alias.VariantTags),
 		}
+		if alias.SmartSelection != nil {
+			apiAlias.SmartSelection = &APISmartSelection{}
+			apiAlias.SmartSelection.BuildFromService(*alias.SmartSelection)
+		}
 		result = append(result, apiAlias)
 	}
 
@@ -44,6 +44,26 @@ func TestRepoBuildFromService(t *testing.T) {
 	assert.False(t, *apiRef.CommitQueue.Enabled)
 }
 
+func TestCommitQueueParams(t *testing.T) {
+	params := model.CommitQueueParams{
+		Enabled:            utility.TruePtr(),
+		MergeMethod:        "squash",
+		FlakyTestThreshold: 0.25,
+		RequiredChecks: []model.MergeQueueRequiredChecks{
+			{BranchRegex: "^release/", VariantTags: []string{"release"}, TaskRegex: ".*"},
+		},
+	}
+	apiParams := APICommitQueueParams{}
+	apiParams.BuildFromService(params)
+	assert.Equal(t, 0.25, utility.FromFloat64Ptr(apiParams.FlakyTestThreshold))
+	require.Len(t, apiParams.RequiredChecks, 1)
+	assert.Equal(t, "^release/", utility.FromStringPtr(apiParams.RequiredChecks[0].BranchRegex))
+	assert.Equal(t, model.MergeQueueEvergreen, apiParams.MergeQueue)
+
+	params.MergeQueue = model.MergeQueueEvergreen
+	assert.Equal(t, params, apiParams.ToService())
+}
+
 func TestRecursivelyDefaultBooleans(t *testing.T) {
 	type insideStruct struct {
 		InsideBool *bool
@@ -37,6 +37,11 @@ type APIGithubMergeSubscriber struct {
 	Ref   *string `json:"ref" mapstructure:"ref"`
 }
 
+type APIGitlabCommitStatusSubscriber struct {
+	ProjectPath *string `json:"project_path" mapstructure:"project_path"`
+	Ref         *string `json:"ref" mapstructure:"ref"`
+}
+
 type APIPRInfo struct {
 	Owner       *string `json:"owner" mapstructure:"owner"`
 	Repo        *string `json:"repo" mapstructure:"repo"`
@@ -89,6 +94,14 @@ func (s *APISubscriber) BuildFromService(in event.Subscriber) error {
 		}
 		target = sub
 
+	case event.GitlabCommitStatusSubscriberType:
+		sub := APIGitlabCommitStatusSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+
 	case event.EvergreenWebhookSubscriberType:
 		sub := APIWebhookSubscriber{}
 		err := sub.BuildFromService(in.Target)
@@ -154,6 +167,16 @@ func (s *APISubscriber) ToService() (event.Subscriber, error) {
 		}
 		target = apiModel.ToService()
 
+	case event.GitlabCommitStatusSubscriberType:
+		apiModel := APIGitlabCommitStatusSubscriber{}
+		if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+			return event.Subscriber{}, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "GitLab commit status subscriber target is malformed").Error(),
+			}
+		}
+		target = apiModel.ToService()
+
 	case event.EvergreenWebhookSubscriberType:
 		apiModel := APIWebhookSubscriber{}
 		if s.WebhookSubscriber != nil {
@@ -256,6 +279,29 @@ func (s *APIGithubMergeSubscriber) BuildFromService(h interface{}) error {
 	return nil
 }
 
+func (s *APIGitlabCommitStatusSubscriber) ToService() event.GitlabCommitStatusSubscriber {
+	return event.GitlabCommitStatusSubscriber{
+		ProjectPath: utility.FromStringPtr(s.ProjectPath),
+		Ref:         utility.FromStringPtr(s.Ref),
+	}
+}
+
+func (s *APIGitlabCommitStatusSubscriber) BuildFromService(h interface{}) error {
+	switch v := h.(type) {
+	case *event.GitlabCommitStatusSubscriber:
+		s.ProjectPath = utility.ToStringPtr(v.ProjectPath)
+		s.Ref = utility.ToStringPtr(v.Ref)
+	case event.GitlabCommitStatusSubscriber:
+		s.ProjectPath = utility.ToStringPtr(v.ProjectPath)
+		s.Ref = utility.ToStringPtr(v.Ref)
+
+	default:
+		return errors.Errorf("programmatic error: expected GitLab commit status subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
 func (s *APIGithubPRSubscriber) ToService() event.GithubPullRequestSubscriber {
 	return event.GithubPullRequestSubscriber{
 		Owner:    utility.FromStringPtr(s.Owner),
@@ -125,6 +125,11 @@ type APITask struct {
 	MustHaveResults   bool                `json:"must_have_test_results"`
 	BaseTask          APIBaseTaskInfo     `json:"base_task"`
 	ResetWhenFinished bool                `json:"reset_when_finished"`
+	// The commit that bisect stepback determined caused this task to start
+	// failing, if stepback identified one.
+	StepbackCulprit *APIStepbackCulprit `json:"stepback_culprit,omitempty"`
+	// The versions of the project variables that the task ran with.
+	ProjectVarVersions []APIVariableVersion `json:"project_var_versions,omitempty"`
 	// These fields are used by graphql gen, but do not need to be exposed
 	// via Evergreen's user-facing API.
 	OverrideDependencies bool   `json:"-"`
@@ -141,6 +146,56 @@ type APIAbortInfo struct {
 	PRClosed   bool   `json:"pr_closed,omitempty"`
 }
 
+type APIStepbackCulprit struct {
+	// The ID of the first failing run of the task
+	TaskId *string `json:"task_id"`
+	// The version of the first failing run of the task
+	VersionId *string `json:"version_id"`
+	// The commit that caused the task to start failing
+	Revision *string `json:"revision"`
+	// The test that stepback tracked, if any
+	TestName *string `json:"test_name,omitempty"`
+}
+
+func (c *APIStepbackCulprit) BuildFromService(culprit task.StepbackCulprit) {
+	c.TaskId = utility.ToStringPtr(culprit.TaskId)
+	c.VersionId = utility.ToStringPtr(culprit.VersionId)
+	c.Revision = utility.ToStringPtr(culprit.Revision)
+	c.TestName = utility.ToStringPtr(culprit.TestName)
+}
+
+func (c *APIStepbackCulprit) ToService() task.StepbackCulprit {
+	return task.StepbackCulprit{
+		TaskId:    utility.FromStringPtr(c.TaskId),
+		VersionId: utility.FromStringPtr(c.VersionId),
+		Revision:  utility.FromStringPtr(c.Revision),
+		TestName:  utility.FromStringPtr(c.TestName),
+	}
+}
+
+type APIVariableVersion struct {
+	// The name of the variable
+	Name *string `json:"name"`
+	// The project or repo that the variable belongs to
+	ProjectID *string `json:"project_id"`
+	// The version of the variable
+	Version int `json:"version"`
+}
+
+func (v *APIVariableVersion) BuildFromService(version task.VariableVersion) {
+	v.Name = utility.ToStringPtr(version.Name)
+	v.ProjectID = utility.ToStringPtr(version.ProjectID)
+	v.Version = version.Version
+}
+
+func (v *APIVariableVersion) ToService() task.VariableVersion {
+	return task.VariableVersion{
+		Name:      utility.FromStringPtr(v.Name),
+		ProjectID: utility.FromStringPtr(v.ProjectID),
+		Version:   v.Version,
+	}
+}
+
 type LogLinks struct {
 	// Link to logs containing merged copy of all other logs
 	AllLogLink *string `json:"all_log"`
@@ -165,6 +220,8 @@ type ApiTaskEndDetail struct {
 	TimeoutType *string           `json:"timeout_type"`
 	OOMTracker  APIOomTrackerInfo `json:"oom_tracker_info"`
 	TraceID     *string           `json:"trace_id"`
+	// Summary of the resources used on the machine while the task ran
+	ResourceUsage *APITaskResourceUsage `json:"resource_usage,omitempty"`
 }
 
 func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
@@ -179,11 +236,16 @@ func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
 	at.OOMTracker = apiOomTracker
 	at.TraceID = utility.ToStringPtr(t.TraceID)
 
+	if t.ResourceUsage != nil {
+		at.ResourceUsage = &APITaskResourceUsage{}
+		at.ResourceUsage.BuildFromService(*t.ResourceUsage)
+	}
+
 	return nil
 }
 
 func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
-	return apimodels.TaskEndDetail{
+	detail := apimodels.TaskEndDetail{
 		Status:      utility.FromStringPtr(ad.Status),
 		Type:        utility.FromStringPtr(ad.Type),
 		Description: utility.FromStringPtr(ad.Description),
@@ -192,6 +254,52 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 		OOMTracker:  ad.OOMTracker.ToService(),
 		TraceID:     utility.FromStringPtr(ad.TraceID),
 	}
+	if ad.ResourceUsage != nil {
+		usage := ad.ResourceUsage.ToService()
+		detail.ResourceUsage = &usage
+	}
+	return detail
+}
+
+// APITaskResourceUsage is a summary of the resources used on the machine
+// while a task ran.
+type APITaskResourceUsage struct {
+	// Peak memory usage in bytes
+	PeakMemoryBytes int64 `json:"peak_memory_bytes"`
+	// Average CPU utilization across all CPUs, as a percentage
+	AvgCPUPercent float64 `json:"avg_cpu_percent"`
+	// Bytes read from disk
+	DiskReadBytes int64 `json:"disk_read_bytes"`
+	// Bytes written to disk
+	DiskWriteBytes int64 `json:"disk_write_bytes"`
+	// Bytes sent over the network
+	NetworkBytesSent int64 `json:"network_bytes_sent"`
+	// Bytes received over the network
+	NetworkBytesReceived int64 `json:"network_bytes_received"`
+	// How long resource usage was measured for, in seconds
+	DurationSecs float64 `json:"duration_secs"`
+}
+
+func (u *APITaskResourceUsage) BuildFromService(usage apimodels.TaskResourceUsage) {
+	u.PeakMemoryBytes = usage.PeakMemoryBytes
+	u.AvgCPUPercent = usage.AvgCPUPercent
+	u.DiskReadBytes = usage.DiskReadBytes
+	u.DiskWriteBytes = usage.DiskWriteBytes
+	u.NetworkBytesSent = usage.NetworkBytesSent
+	u.NetworkBytesReceived = usage.NetworkBytesReceived
+	u.DurationSecs = usage.DurationSecs
+}
+
+func (u *APITaskResourceUsage) ToService() apimodels.TaskResourceUsage {
+	return apimodels.TaskResourceUsage{
+		PeakMemoryBytes:      u.PeakMemoryBytes,
+		AvgCPUPercent:        u.AvgCPUPercent,
+		DiskReadBytes:        u.DiskReadBytes,
+		DiskWriteBytes:       u.DiskWriteBytes,
+		NetworkBytesSent:     u.NetworkBytesSent,
+		NetworkBytesReceived: u.NetworkBytesReceived,
+		DurationSecs:         u.DurationSecs,
+	}
 }
 
 type APIOomTrackerInfo struct {
@@ -307,6 +415,17 @@ func (at *APITask) buildTask(t *task.Task) error {
 
 	at.ContainerOpts.BuildFromService(t.ContainerOpts)
 
+	if t.StepbackCulprit != nil {
+		at.StepbackCulprit = &APIStepbackCulprit{}
+		at.StepbackCulprit.BuildFromService(*t.StepbackCulprit)
+	}
+
+	for _, v := range t.ProjectVarVersions {
+		apiVersion := APIVariableVersion{}
+		apiVersion.BuildFromService(v)
+		at.ProjectVarVersions = append(at.ProjectVarVersions, apiVersion)
+	}
+
 	if t.BaseTask.Id != "" {
 		at.BaseTask = APIBaseTaskInfo{
 			Id:     utility.ToStringPtr(t.BaseTask.Id),
@@ -520,6 +639,15 @@ func (at *APITask) ToService() (*task.Task, error) {
 		return nil, catcher.Resolve()
 	}
 
+	if at.StepbackCulprit != nil {
+		culprit := at.StepbackCulprit.ToService()
+		st.StepbackCulprit = &culprit
+	}
+
+	for _, v := range at.ProjectVarVersions {
+		st.ProjectVarVersions = append(st.ProjectVarVersions, v.ToService())
+	}
+
 	if len(at.ExecutionTasks) > 0 {
 		ets := []string{}
 		for _, t := range at.ExecutionTasks {
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/utility"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type taskCompare struct {
@@ -256,3 +259,34 @@ func TestTaskBuildFromService(t *testing.T) {
 		})
 	})
 }
+
+func TestAPITaskEndDetailResourceUsage(t *testing.T) {
+	t.Run("RoundTrips", func(t *testing.T) {
+		detail := apimodels.TaskEndDetail{
+			Status: evergreen.TaskSucceeded,
+			ResourceUsage: &apimodels.TaskResourceUsage{
+				PeakMemoryBytes:      1024,
+				AvgCPUPercent:        42.5,
+				DiskReadBytes:        10,
+				DiskWriteBytes:       20,
+				NetworkBytesSent:     30,
+				NetworkBytesReceived: 40,
+				DurationSecs:         60,
+			},
+		}
+		var apiDetail ApiTaskEndDetail
+		require.NoError(t, apiDetail.BuildFromService(detail))
+		require.NotZero(t, apiDetail.ResourceUsage)
+		assert.EqualValues(t, 1024, apiDetail.ResourceUsage.PeakMemoryBytes)
+		assert.Equal(t, 42.5, apiDetail.ResourceUsage.AvgCPUPercent)
+
+		roundTripped := apiDetail.ToService()
+		assert.Equal(t, detail.ResourceUsage, roundTripped.ResourceUsage)
+	})
+	t.Run("OmitsMissingResourceUsage", func(t *testing.T) {
+		var apiDetail ApiTaskEndDetail
+		require.NoError(t, apiDetail.BuildFromService(apimodels.TaskEndDetail{Status: evergreen.TaskFailed}))
+		assert.Nil(t, apiDetail.ResourceUsage)
+		assert.Nil(t, apiDetail.ToService().ResourceUsage)
+	})
+}
@@ -116,6 +116,8 @@ type APINotificationPreferences struct {
 	SpawnHostOutcomeID    *string `json:"spawn_host_outcome_id,omitempty"`
 	CommitQueue           *string `json:"commit_queue"`
 	CommitQueueID         *string `json:"commit_queue_id,omitempty"`
+	StepbackCulprit       *string `json:"stepback_culprit"`
+	StepbackCulpritID     *string `json:"stepback_culprit_id,omitempty"`
 }
 
 func (n *APINotificationPreferences) BuildFromService(in user.NotificationPreferences) {
@@ -125,6 +127,7 @@ func (n *APINotificationPreferences) BuildFromService(in user.NotificationPrefer
 	n.SpawnHostOutcome = utility.ToStringPtr(string(in.SpawnHostOutcome))
 	n.SpawnHostExpiration = utility.ToStringPtr(string(in.SpawnHostExpiration))
 	n.CommitQueue = utility.ToStringPtr(string(in.CommitQueue))
+	n.StepbackCulprit = utility.ToStringPtr(string(in.StepbackCulprit))
 	if in.BuildBreakID != "" {
 		n.BuildBreakID = utility.ToStringPtr(in.BuildBreakID)
 	}
@@ -143,6 +146,9 @@ func (n *APINotificationPreferences) BuildFromService(in user.NotificationPrefer
 	if in.CommitQueueID != "" {
 		n.CommitQueueID = utility.ToStringPtr(in.CommitQueueID)
 	}
+	if in.StepbackCulpritID != "" {
+		n.StepbackCulpritID = utility.ToStringPtr(in.StepbackCulpritID)
+	}
 }
 
 func (n *APINotificationPreferences) ToService() (user.NotificationPreferences, error) {
@@ -155,6 +161,7 @@ func (n *APINotificationPreferences) ToService() (user.NotificationPreferences,
 	spawnHostExpiration := utility.FromStringPtr(n.SpawnHostExpiration)
 	spawnHostOutcome := utility.FromStringPtr(n.SpawnHostOutcome)
 	commitQueue := utility.FromStringPtr(n.CommitQueue)
+	stepbackCulprit := utility.FromStringPtr(n.StepbackCulprit)
 	if !user.IsValidSubscriptionPreference(buildBreak) {
 		return user.NotificationPreferences{}, errors.Errorf("invalid build break subscription preference '%s'", buildBreak)
 	}
@@ -173,6 +180,9 @@ func (n *APINotificationPreferences) ToService() (user.NotificationPreferences,
 	if !user.IsValidSubscriptionPreference(commitQueue) {
 		return user.NotificationPreferences{}, errors.Errorf("invalid commit queue subscription preference '%s'", commitQueue)
 	}
+	if !user.IsValidSubscriptionPreference(stepbackCulprit) {
+		return user.NotificationPreferences{}, errors.Errorf("invalid stepback culprit subscription preference '%s'", stepbackCulprit)
+	}
 	preferences := user.NotificationPreferences{
 		BuildBreak:          user.UserSubscriptionPreference(buildBreak),
 		PatchFinish:         user.UserSubscriptionPreference(patchFinish),
@@ -180,6 +190,7 @@ func (n *APINotificationPreferences) ToService() (user.NotificationPreferences,
 		SpawnHostOutcome:    user.UserSubscriptionPreference(spawnHostOutcome),
 		SpawnHostExpiration: user.UserSubscriptionPreference(spawnHostExpiration),
 		CommitQueue:         user.UserSubscriptionPreference(commitQueue),
+		StepbackCulprit:     user.UserSubscriptionPreference(stepbackCulprit),
 	}
 	preferences.BuildBreakID = utility.FromStringPtr(n.BuildBreakID)
 	preferences.PatchFinishID = utility.FromStringPtr(n.PatchFinishID)
@@ -187,6 +198,7 @@ func (n *APINotificationPreferences) ToService() (user.NotificationPreferences,
 	preferences.SpawnHostOutcomeID = utility.FromStringPtr(n.SpawnHostOutcomeID)
 	preferences.SpawnHostExpirationID = utility.FromStringPtr(n.SpawnHostExpirationID)
 	preferences.CommitQueueID = utility.FromStringPtr(n.CommitQueueID)
+	preferences.StepbackCulpritID = utility.FromStringPtr(n.StepbackCulpritID)
 	return preferences, nil
 }
 
@@ -271,3 +283,43 @@ func UpdateUserSettings(ctx context.Context, usr *user.DBUser, userSettings APIU
 
 	return &updatedUserSettings, nil
 }
+
+// APIPersonalAccessToken is a personal access token that a user can use in
+// place of their API key.
+type APIPersonalAccessToken struct {
+	ID   *string `json:"id"`
+	Name *string `json:"name"`
+	// Token is the token itself, which is only returned when it's created.
+	Token *string `json:"token,omitempty"`
+	// Scope limits which requests the token can make. It's one of
+	// "read-only", "patch-submit" or "all".
+	Scope *string `json:"scope"`
+	// Projects are the projects and repos that the token is limited to. If
+	// it's empty, the token can be used for any project.
+	Projects   []string   `json:"projects"`
+	CreatedAt  *time.Time `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// BuildFromService converts from a service level personal access token to an
+// APIPersonalAccessToken.
+func (t *APIPersonalAccessToken) BuildFromService(in user.PersonalAccessToken) {
+	t.ID = utility.ToStringPtr(in.ID)
+	t.Name = utility.ToStringPtr(in.Name)
+	t.Scope = utility.ToStringPtr(in.Scope)
+	t.Projects = in.Projects
+	t.CreatedAt = ToTimePtr(in.CreatedAt)
+	t.ExpiresAt = ToTimePtr(in.ExpiresAt)
+	t.LastUsedAt = ToTimePtr(in.LastUsedAt)
+}
+
+// APIPersonalAccessTokenPostRequest is the request to create a personal access
+// token.
+type APIPersonalAccessTokenPostRequest struct {
+	Name     string   `json:"name"`
+	Scope    string   `json:"scope"`
+	Projects []string `json:"projects"`
+	// ExpiresAt is when the token expires. It can't be more than a year away.
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -50,6 +50,8 @@ type APIVersion struct {
 	GitTags   []APIGitTag `json:"git_tags"`
 	// Indicates if the version was ignored due to only making changes to ignored files.
 	Ignored *bool `json:"ignored"`
+	// Tasks in this version that bisect stepback identified as the first failing run of a task.
+	StepbackCulprits []APIStepbackCulprit `json:"stepback_culprits,omitempty"`
 }
 
 type APIGitTag struct {
@@ -104,6 +106,12 @@ func (apiVersion *APIVersion) BuildFromService(v model.Version) {
 		})
 	}
 
+	for _, culprit := range v.StepbackCulprits {
+		apiCulprit := APIStepbackCulprit{}
+		apiCulprit.BuildFromService(culprit)
+		apiVersion.StepbackCulprits = append(apiVersion.StepbackCulprits, apiCulprit)
+	}
+
 	for _, gt := range v.GitTags {
 		apiVersion.GitTags = append(apiVersion.GitTags, APIGitTag{
 			Pusher: utility.ToStringPtr(gt.Pusher),
@@ -0,0 +1,166 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/user/access_tokens
+
+type accessTokensGetHandler struct{}
+
+func makeFetchAccessTokens() gimlet.RouteHandler {
+	return &accessTokensGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get current user's personal access tokens
+//	@Description	Fetch the personal access tokens of the current user. The tokens themselves are not returned.
+//	@Tags			users
+//	@Router			/user/access_tokens [get]
+//	@Security		Api-User || Api-Key
+//	@Success		200	{array}	model.APIPersonalAccessToken
+func (h *accessTokensGetHandler) Factory() gimlet.RouteHandler                     { return &accessTokensGetHandler{} }
+func (h *accessTokensGetHandler) Parse(ctx context.Context, r *http.Request) error { return nil }
+
+func (h *accessTokensGetHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	tokens, err := user.FindPersonalAccessTokensForUser(u.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding personal access tokens for user '%s'", u.Id))
+	}
+
+	apiTokens := []model.APIPersonalAccessToken{}
+	for _, t := range tokens {
+		apiToken := model.APIPersonalAccessToken{}
+		apiToken.BuildFromService(t)
+		apiTokens = append(apiTokens, apiToken)
+	}
+	return gimlet.NewJSONResponse(apiTokens)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/user/access_tokens
+
+type accessTokenPostHandler struct {
+	opts model.APIPersonalAccessTokenPostRequest
+}
+
+func makeCreateAccessToken() gimlet.RouteHandler {
+	return &accessTokenPostHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Create a personal access token
+//	@Description	Creates a personal access token for the current user, which can be used in place of the API key. The token is only returned in this response.
+//	@Tags			users
+//	@Router			/user/access_tokens [post]
+//	@Security		Api-User || Api-Key
+//	@Param			{object}	body	model.APIPersonalAccessTokenPostRequest	true	"parameters"
+//	@Success		200			{object}	model.APIPersonalAccessToken
+func (h *accessTokenPostHandler) Factory() gimlet.RouteHandler {
+	return &accessTokenPostHandler{}
+}
+
+func (h *accessTokenPostHandler) Parse(ctx context.Context, r *http.Request) error {
+	body := utility.NewRequestReader(r)
+	defer body.Close()
+	return errors.Wrap(utility.ReadJSON(body, &h.opts), "reading personal access token options from JSON request body")
+}
+
+func (h *accessTokenPostHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	if u.AccessToken() != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    "cannot create a personal access token using another personal access token",
+		})
+	}
+
+	t := &user.PersonalAccessToken{
+		UserID:    u.Id,
+		Name:      h.opts.Name,
+		Scope:     h.opts.Scope,
+		Projects:  h.opts.Projects,
+		ExpiresAt: h.opts.ExpiresAt,
+	}
+	if err := t.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid personal access token").Error(),
+		})
+	}
+	token, err := user.CreatePersonalAccessToken(t)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "creating personal access token"))
+	}
+
+	apiToken := model.APIPersonalAccessToken{}
+	apiToken.BuildFromService(*t)
+	apiToken.Token = utility.ToStringPtr(token)
+	return gimlet.NewJSONResponse(apiToken)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/user/access_tokens/{token_id}
+
+type accessTokenDeleteHandler struct {
+	tokenID string
+}
+
+func makeDeleteAccessToken() gimlet.RouteHandler {
+	return &accessTokenDeleteHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Revoke a personal access token
+//	@Description	Deletes the current user's personal access token, so that it can no longer be used.
+//	@Tags			users
+//	@Router			/user/access_tokens/{token_id} [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			token_id	path	string	true	"the token ID"
+//	@Success		200
+func (h *accessTokenDeleteHandler) Factory() gimlet.RouteHandler {
+	return &accessTokenDeleteHandler{}
+}
+
+func (h *accessTokenDeleteHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.tokenID = gimlet.GetVars(r)["token_id"]
+	if h.tokenID == "" {
+		return errors.New("personal access token ID cannot be empty")
+	}
+	return nil
+}
+
+func (h *accessTokenDeleteHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	t, err := user.FindPersonalAccessTokenByID(h.tokenID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding personal access token '%s'", h.tokenID))
+	}
+	if t == nil || t.UserID != u.Id {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("personal access token '%s' not found", h.tokenID),
+		})
+	}
+	if err = t.Remove(); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "deleting personal access token '%s'", h.tokenID))
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
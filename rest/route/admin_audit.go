@@ -0,0 +1,90 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/admin/audit
+
+type adminAuditGetHandler struct {
+	opts event.AuditEventsQueryOptions
+}
+
+func makeFetchAuditEvents() gimlet.RouteHandler {
+	return &adminAuditGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get audit events
+//	@Description	Returns the audit log of user actions taken through the REST API and GraphQL mutations, from newest to oldest. To page through older events, pass the timestamp of the last event as the end time.
+//	@Tags			admin
+//	@Router			/admin/audit [get]
+//	@Security		Api-User || Api-Key
+//	@Param			actor		query	string	false	"only return actions taken by this user"
+//	@Param			resource_id	query	string	false	"only return actions on this resource"
+//	@Param			start_time	query	string	false	"only return actions at or after this time, in RFC-3339 format"
+//	@Param			end_time	query	string	false	"only return actions before this time, in RFC-3339 format"
+//	@Param			limit		query	int		false	"the maximum number of events to return"
+//	@Success		200			{array}	model.AuditAPIEventLogEntry
+func (h *adminAuditGetHandler) Factory() gimlet.RouteHandler {
+	return &adminAuditGetHandler{}
+}
+
+func (h *adminAuditGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+	h.opts.Actor = vals.Get("actor")
+	h.opts.ResourceID = vals.Get("resource_id")
+
+	var err error
+	if startTime := vals.Get("start_time"); startTime != "" {
+		if h.opts.StartTime, err = time.Parse(time.RFC3339, startTime); err != nil {
+			return errors.Wrap(err, "parsing start time as RFC-3339")
+		}
+	}
+	if endTime := vals.Get("end_time"); endTime != "" {
+		if h.opts.EndTime, err = time.Parse(time.RFC3339, endTime); err != nil {
+			return errors.Wrap(err, "parsing end time as RFC-3339")
+		}
+	}
+	if !h.opts.StartTime.IsZero() && !h.opts.EndTime.IsZero() && !h.opts.EndTime.After(h.opts.StartTime) {
+		return errors.New("end time must be after start time")
+	}
+
+	h.opts.Limit, err = getLimit(vals)
+	return errors.WithStack(err)
+}
+
+func (h *adminAuditGetHandler) Run(ctx context.Context) gimlet.Responder {
+	resp := gimlet.NewResponseBuilder()
+
+	events, err := event.FindAuditEvents(h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding audit events"))
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for i := range events {
+		apiEvent := model.AuditAPIEventLogEntry{}
+		if err := apiEvent.BuildFromService(events[i]); err != nil {
+			catcher.Wrapf(err, "converting audit event at index %d to API model", i)
+			continue
+		}
+		catcher.Wrapf(resp.AddData(apiEvent), "adding data for audit event at index %d", i)
+	}
+	if catcher.HasErrors() {
+		return gimlet.MakeJSONInternalErrorResponder(catcher.Resolve())
+	}
+
+	return resp
+}
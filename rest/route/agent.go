@@ -20,6 +20,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/evergreen/thirdparty/secrets"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
@@ -423,6 +424,20 @@ func (h *getExpansionsAndVarsHandler) Run(ctx context.Context) gimlet.Responder
 			res.PrivateVars = projectVars.PrivateVars
 		}
 	}
+	if projectVars != nil && len(projectVars.ExternalSecretVars) > 0 {
+		if err = resolveExternalSecretVars(ctx, h.settings.SecretProviders, pRef.SecretPathPrefixes(h.settings.SecretProviders), &res, projectVars.ExternalSecretVars); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "resolving external secret variables for project '%s'", t.Project))
+		}
+	}
+	if len(res.Vars) > 0 {
+		// Failing to record the variable versions shouldn't stop the task
+		// from running.
+		grip.Error(message.WrapError(recordTaskProjectVarVersions(t, pRef, res.Vars), message.Fields{
+			"message": "could not record project variable versions for task",
+			"task":    t.Id,
+			"project": t.Project,
+		}))
+	}
 
 	v, err := model.VersionFindOneId(t.Version)
 	if err != nil {
@@ -445,6 +460,50 @@ func (h *getExpansionsAndVarsHandler) Run(ctx context.Context) gimlet.Responder
 	return gimlet.NewJSONResponse(res)
 }
 
+// resolveExternalSecretVars replaces the external secret references in the
+// variables with the secrets they reference and marks them private so they're
+// redacted from task logs. Only secrets under the project's allowed path
+// prefixes are resolved.
+func resolveExternalSecretVars(ctx context.Context, conf evergreen.SecretProvidersConfig, allowedPathPrefixes []string, res *apimodels.ExpansionsAndVars, external map[string]bool) error {
+	resolver, err := secrets.NewResolverFromConfig(ctx, conf, allowedPathPrefixes)
+	if err != nil {
+		return errors.Wrap(err, "creating secret resolver")
+	}
+	defer func() {
+		grip.Warning(message.WrapError(resolver.Close(ctx), message.Fields{
+			"message": "could not close secret resolver",
+		}))
+	}()
+
+	if err := resolver.ResolveVars(ctx, res.Vars, external); err != nil {
+		return err
+	}
+	for name, isExternal := range external {
+		if _, ok := res.Vars[name]; ok && isExternal {
+			res.PrivateVars[name] = true
+		}
+	}
+	return nil
+}
+
+// recordTaskProjectVarVersions records the current versions of the variables
+// on the task so it's possible to tell which values it ran with.
+func recordTaskProjectVarVersions(t *task.Task, pRef *model.ProjectRef, vars map[string]string) error {
+	repoID := ""
+	if pRef.UseRepoSettings() {
+		repoID = pRef.RepoRefId
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	snapshot, err := model.GetProjectVarVersionSnapshot(pRef.Id, repoID, names)
+	if err != nil {
+		return errors.Wrap(err, "getting variable versions")
+	}
+	return errors.Wrap(t.SetProjectVarVersions(snapshot), "setting variable versions on task")
+}
+
 // GET /task/{task_id}/project_ref
 type getProjectRefHandler struct {
 	taskID string
@@ -591,9 +650,16 @@ func (h *getDistroViewHandler) Run(ctx context.Context) gimlet.Responder {
 		)
 	}
 
+	cgroupSettings := host.Distro.BootstrapSettings.TaskCgroups
 	dv := apimodels.DistroView{
 		CloneMethod:         host.Distro.CloneMethod,
 		DisableShallowClone: host.Distro.DisableShallowClone,
+		TaskCgroupsEnabled:  cgroupSettings.Enabled,
+		TaskCgroupLimits: apimodels.CgroupLimits{
+			MemoryLimitMB: cgroupSettings.MemoryLimitMB,
+			CPUPercent:    cgroupSettings.CPUPercent,
+			NumProcesses:  cgroupSettings.NumProcesses,
+		},
 	}
 	return gimlet.NewJSONResponse(dv)
 }
@@ -1057,7 +1123,7 @@ func (h *gitServePatchFileHandler) Parse(ctx context.Context, r *http.Request) e
 }
 
 func (h *gitServePatchFileHandler) Run(ctx context.Context) gimlet.Responder {
-	patchContents, err := patch.FetchPatchContents(h.patchID)
+	patchContents, err := patch.FetchPatchContents(ctx, h.patchID)
 	if err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "reading patch file from db"))
 	}
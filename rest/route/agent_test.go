@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -81,6 +83,67 @@ func TestAgentGetExpansionsAndVars(t *testing.T) {
 			assert.Equal(t, data.PrivateVars, map[string]bool{"b": true})
 			assert.Equal(t, data.Vars, map[string]string{"a": "4", "b": "3"})
 		},
+		"RunResolvesExternalSecretVars": func(ctx context.Context, t *testing.T, rh *getExpansionsAndVarsHandler) {
+			secretsFile := filepath.Join(t.TempDir(), "secrets.yml")
+			require.NoError(t, os.WriteFile(secretsFile, []byte("app:\n  password: hunter2\n"), 0600))
+			settings := *rh.settings
+			settings.SecretProviders.FilePath = secretsFile
+			settings.SecretProviders.ProjectPathPrefixes = []evergreen.ProjectSecretPathPrefix{{ProjectID: "p1", PathPrefix: "app"}}
+			rh.settings = &settings
+
+			vars := &model.ProjectVars{
+				Id:                 "p1",
+				Vars:               map[string]string{"a": "1", "c": "file:app#password"},
+				ExternalSecretVars: map[string]bool{"c": true},
+			}
+			_, err := vars.Upsert()
+			require.NoError(t, err)
+
+			rh.taskID = "t2"
+			resp := rh.Run(ctx)
+			require.NotZero(t, resp)
+			assert.Equal(t, http.StatusOK, resp.Status())
+			data, ok := resp.Data().(apimodels.ExpansionsAndVars)
+			require.True(t, ok)
+			assert.Equal(t, map[string]string{"a": "1", "c": "hunter2"}, data.Vars)
+			assert.True(t, data.PrivateVars["c"], "resolved secrets should be redacted")
+		},
+		"RunFailsWithExternalSecretVarsOutsideAllowedPathPrefixes": func(ctx context.Context, t *testing.T, rh *getExpansionsAndVarsHandler) {
+			secretsFile := filepath.Join(t.TempDir(), "secrets.yml")
+			require.NoError(t, os.WriteFile(secretsFile, []byte("other-team:\n  password: hunter2\n"), 0600))
+			settings := *rh.settings
+			settings.SecretProviders.FilePath = secretsFile
+			settings.SecretProviders.ProjectPathPrefixes = []evergreen.ProjectSecretPathPrefix{{ProjectID: "p1", PathPrefix: "app"}}
+			rh.settings = &settings
+
+			vars := &model.ProjectVars{
+				Id:                 "p1",
+				Vars:               map[string]string{"c": "file:other-team#password"},
+				ExternalSecretVars: map[string]bool{"c": true},
+			}
+			_, err := vars.Upsert()
+			require.NoError(t, err)
+
+			rh.taskID = "t2"
+			resp := rh.Run(ctx)
+			require.NotZero(t, resp)
+			assert.Equal(t, http.StatusInternalServerError, resp.Status())
+			assert.NotContains(t, fmt.Sprint(resp.Data()), "hunter2")
+		},
+		"RunFailsWithUnresolvableExternalSecretVars": func(ctx context.Context, t *testing.T, rh *getExpansionsAndVarsHandler) {
+			vars := &model.ProjectVars{
+				Id:                 "p1",
+				Vars:               map[string]string{"c": "vault:secret/data/app#password"},
+				ExternalSecretVars: map[string]bool{"c": true},
+			}
+			_, err := vars.Upsert()
+			require.NoError(t, err)
+
+			rh.taskID = "t2"
+			resp := rh.Run(ctx)
+			require.NotZero(t, resp)
+			assert.Equal(t, http.StatusInternalServerError, resp.Status())
+		},
 	} {
 		t.Run(tName, func(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
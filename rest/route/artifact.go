@@ -0,0 +1,408 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// artifactUploadVisibilities are the visibilities that artifacts uploaded to
+// the artifact bucket can have. Signed artifacts are not supported because
+// downloads are always authorized by the app server.
+var artifactUploadVisibilities = []string{"", artifact.Public, artifact.Private, artifact.None}
+
+// validateArtifactName checks that the artifact name is a relative path that
+// stays within the task's artifact directory.
+func validateArtifactName(name string) error {
+	if name == "" {
+		return errors.New("artifact name must be specified")
+	}
+	if path.IsAbs(name) {
+		return errors.Errorf("artifact name '%s' must be a relative path", name)
+	}
+	if cleaned := path.Clean(name); cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return errors.Errorf("artifact name '%s' cannot refer to a parent directory", name)
+	}
+	return nil
+}
+
+// artifactBucketKey returns the key in the artifact bucket for a task's
+// artifact.
+func artifactBucketKey(t *task.Task, name string) string {
+	return path.Join(t.Project, t.Id, strconv.Itoa(t.Execution), path.Clean(name))
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// PUT /rest/v2/task/{task_id}/artifacts/upload
+
+type artifactUploadHandler struct {
+	env         evergreen.Environment
+	taskID      string
+	name        string
+	visibility  string
+	contentType string
+	size        int64
+	body        io.Reader
+}
+
+func makeUploadArtifact(env evergreen.Environment) gimlet.RouteHandler {
+	return &artifactUploadHandler{env: env}
+}
+
+func (h *artifactUploadHandler) Factory() gimlet.RouteHandler {
+	return &artifactUploadHandler{env: h.env}
+}
+
+func (h *artifactUploadHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+
+	query := r.URL.Query()
+	h.name = query.Get("name")
+	if err := validateArtifactName(h.name); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+	h.visibility = query.Get("visibility")
+	if !utility.StringSliceContains(artifactUploadVisibilities, h.visibility) {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid artifact visibility '%s'", h.visibility),
+		}
+	}
+	h.contentType = query.Get("content_type")
+
+	if r.ContentLength < 0 {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusLengthRequired,
+			Message:    "artifact size must be specified in the content length",
+		}
+	}
+	h.size = r.ContentLength
+	h.body = r.Body
+
+	return nil
+}
+
+// Run uploads the artifact to the artifact bucket and attaches it to the task.
+// The artifact counts towards the project's daily artifact quota.
+func (h *artifactUploadHandler) Run(ctx context.Context) gimlet.Responder {
+	settings := h.env.Settings()
+	bucketConf := settings.Buckets.ArtifactBucket
+	if bucketConf.Name == "" {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "artifact bucket is not configured",
+		})
+	}
+
+	t, err := task.FindOneId(h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	key := artifactBucketKey(t, h.name)
+	if err = h.releasePreviousUpload(ctx, t, bucketConf.Name, key); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "releasing previous upload of artifact '%s'", h.name))
+	}
+
+	now := utility.BSONTime(time.Now())
+	quota := int64(settings.Buckets.ArtifactProjectDailyQuotaMB) * 1024 * 1024
+	reserved, err := artifact.ReserveProjectUsage(ctx, t.Project, h.size, quota, now)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "reserving artifact usage for project '%s'", t.Project))
+	}
+	if !reserved {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusRequestEntityTooLarge,
+			Message:    fmt.Sprintf("uploading artifact of %d bytes would exceed project '%s' daily artifact quota of %d MB", h.size, t.Project, settings.Buckets.ArtifactProjectDailyQuotaMB),
+		})
+	}
+
+	if err = h.upload(ctx, bucketConf, key); err != nil {
+		grip.Error(message.WrapError(artifact.ReleaseProjectUsage(ctx, t.Project, h.size, now), message.Fields{
+			"message": "could not release artifact usage for failed upload",
+			"task_id": t.Id,
+			"project": t.Project,
+			"size":    h.size,
+		}))
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "uploading artifact '%s'", h.name))
+	}
+
+	file := artifact.File{
+		Name:        h.name,
+		Link:        artifactDownloadURL(settings.ApiUrl, t.Id, t.Execution, h.name),
+		Visibility:  h.visibility,
+		ContentType: h.contentType,
+		Bucket:      bucketConf.Name,
+		FileKey:     key,
+		Size:        h.size,
+		UploadTime:  now,
+	}
+	entry := artifact.Entry{
+		TaskId:          t.Id,
+		TaskDisplayName: t.DisplayName,
+		BuildId:         t.BuildId,
+		Execution:       t.Execution,
+		CreateTime:      now,
+		Files:           []artifact.File{file},
+	}
+	if err = entry.Upsert(); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "attaching artifact '%s' to task '%s'", h.name, t.Id))
+	}
+
+	return gimlet.NewJSONResponse(file)
+}
+
+// releasePreviousUpload detaches an artifact previously uploaded under the same
+// name from the task and releases its size from the project's artifact usage,
+// since the new upload overwrites it in the bucket. The size is released from
+// the day it was reserved, and only by the request that detaches the artifact,
+// so that concurrent uploads cannot release it twice.
+func (h *artifactUploadHandler) releasePreviousUpload(ctx context.Context, t *task.Task, bucketName, key string) error {
+	entry, err := artifact.FindOne(artifact.ByTaskIdAndExecution(t.Id, t.Execution))
+	if err != nil {
+		return errors.Wrap(err, "finding task artifacts")
+	}
+	if entry == nil {
+		return nil
+	}
+	var previous *artifact.File
+	for i := range entry.Files {
+		if f := entry.Files[i]; f.Name == h.name && f.FileKey == key && f.Bucket == bucketName {
+			previous = &f
+			break
+		}
+	}
+	if previous == nil {
+		return nil
+	}
+
+	removed, err := artifact.RemoveFile(ctx, t.Id, t.Execution, previous.Name, previous.FileKey)
+	if err != nil {
+		return errors.Wrap(err, "detaching previous artifact")
+	}
+	if !removed || previous.Size == 0 {
+		return nil
+	}
+
+	return errors.Wrap(artifact.ReleaseProjectUsage(ctx, t.Project, previous.Size, previous.UploadTime), "releasing previous artifact usage")
+}
+
+func (h *artifactUploadHandler) upload(ctx context.Context, bucketConf evergreen.BucketConfig, key string) error {
+	bucket, err := taskoutput.NewBucket(ctx, bucketConf)
+	if err != nil {
+		return errors.Wrap(err, "getting artifact bucket")
+	}
+
+	body := &countingReader{r: io.LimitReader(h.body, h.size)}
+	if err = bucket.Put(ctx, key, body); err != nil {
+		return errors.Wrap(err, "writing artifact to bucket")
+	}
+	if body.n != h.size {
+		return errors.Errorf("artifact had %d bytes but expected %d bytes", body.n, h.size)
+	}
+
+	return nil
+}
+
+// countingReader counts the number of bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func artifactDownloadURL(apiURL, taskID string, execution int, name string) string {
+	return fmt.Sprintf("%s/rest/v2/tasks/%s/artifacts/download?execution=%d&name=%s", apiURL, url.PathEscape(taskID), execution, url.QueryEscape(name))
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/tasks/{task_id}/artifacts/download
+
+type artifactDownloadHandler struct {
+	env       evergreen.Environment
+	taskID    string
+	execution int
+	name      string
+}
+
+// makeDownloadArtifact returns an HTTP handler rather than a route handler so
+// that the artifact can be streamed from the bucket and the bucket reader closed
+// once the request finishes, even if the client disconnects early. The handler
+// authorizes the request itself because public artifacts can be downloaded
+// without a user.
+func makeDownloadArtifact(env evergreen.Environment) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h := &artifactDownloadHandler{env: env}
+		h.ServeHTTP(w, r)
+	}
+}
+
+func (h *artifactDownloadHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+
+	query := r.URL.Query()
+	h.name = query.Get("name")
+	if err := validateArtifactName(h.name); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+	var err error
+	if h.execution, err = strconv.Atoi(query.Get("execution")); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "execution must be an integer",
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP streams an artifact that the task uploaded to the artifact bucket.
+// Artifacts that aren't public can only be downloaded by users who can view
+// the task.
+func (h *artifactDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := h.Parse(ctx, r); err != nil {
+		gimlet.WriteResponse(w, gimlet.MakeJSONErrorResponder(err))
+		return
+	}
+
+	file, resp := h.findFile()
+	if resp != nil {
+		gimlet.WriteResponse(w, resp)
+		return
+	}
+	if file.Visibility != artifact.Public {
+		if resp = h.checkTaskViewPermission(ctx); resp != nil {
+			gimlet.WriteResponse(w, resp)
+			return
+		}
+	}
+
+	bucket, err := taskoutput.NewBucket(ctx, h.env.Settings().Buckets.ArtifactBucket)
+	if err != nil {
+		gimlet.WriteResponse(w, gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting artifact bucket")))
+		return
+	}
+	rc, err := bucket.Get(ctx, file.FileKey)
+	if err != nil {
+		gimlet.WriteResponse(w, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting artifact '%s'", h.name)))
+		return
+	}
+	defer func() {
+		grip.Warning(message.WrapError(rc.Close(), message.Fields{
+			"message": "could not close artifact reader",
+			"task_id": h.taskID,
+			"name":    h.name,
+		}))
+	}()
+
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, rc); err != nil {
+		grip.Debug(message.WrapError(err, message.Fields{
+			"message": "could not write artifact to response",
+			"task_id": h.taskID,
+			"name":    h.name,
+		}))
+	}
+}
+
+// findFile returns the downloadable artifact file that the request refers to.
+func (h *artifactDownloadHandler) findFile() (*artifact.File, gimlet.Responder) {
+	entry, err := artifact.FindOne(artifact.ByTaskIdAndExecution(h.taskID, h.execution))
+	if err != nil {
+		return nil, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding artifacts for task '%s' execution %d", h.taskID, h.execution))
+	}
+	if entry != nil {
+		bucketName := h.env.Settings().Buckets.ArtifactBucket.Name
+		for i := range entry.Files {
+			f := entry.Files[i]
+			if f.Name == h.name && f.FileKey != "" && f.Bucket == bucketName && f.Visibility != artifact.None {
+				return &f, nil
+			}
+		}
+	}
+	return nil, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("artifact '%s' not found for task '%s' execution %d", h.name, h.taskID, h.execution),
+	})
+}
+
+// checkTaskViewPermission checks that the request's user can view the task's
+// project's tasks.
+func (h *artifactDownloadHandler) checkTaskViewPermission(ctx context.Context) gimlet.Responder {
+	u := gimlet.GetUser(ctx)
+	if u == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "not authorized",
+		})
+	}
+
+	t, err := task.FindOneIdOldOrNew(h.taskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s' execution %d", h.taskID, h.execution))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' execution %d not found", h.taskID, h.execution),
+		})
+	}
+	if !u.HasPermission(gimlet.PermissionOpts{
+		Resource:      t.Project,
+		ResourceType:  evergreen.ProjectResourceType,
+		Permission:    evergreen.PermissionTasks,
+		RequiredLevel: evergreen.TasksView.Value,
+	}) {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    fmt.Sprintf("not authorized to view tasks in project '%s'", t.Project),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactUploadAndDownload(t *testing.T) {
+	const content = "artifact content"
+
+	makeUploadRequest := func(t *testing.T, taskID string, query url.Values, body string) *http.Request {
+		r, err := http.NewRequest(http.MethodPut, "/task/"+taskID+"/artifacts/upload?"+query.Encode(), strings.NewReader(body))
+		require.NoError(t, err)
+		return gimlet.SetURLVars(r, map[string]string{"task_id": taskID})
+	}
+	makeDownloadRequest := func(t *testing.T, taskID string, query url.Values) *http.Request {
+		r, err := http.NewRequest(http.MethodGet, "/tasks/"+taskID+"/artifacts/download?"+query.Encode(), nil)
+		require.NoError(t, err)
+		return gimlet.SetURLVars(r, map[string]string{"task_id": taskID})
+	}
+	download := func(ctx context.Context, t *testing.T, env evergreen.Environment, query url.Values) *httptest.ResponseRecorder {
+		r := makeDownloadRequest(t, "t1", query).WithContext(ctx)
+		rw := httptest.NewRecorder()
+		makeDownloadArtifact(env).ServeHTTP(rw, r)
+		return rw
+	}
+	upload := func(ctx context.Context, t *testing.T, env evergreen.Environment, name, visibility, body string) gimlet.Responder {
+		h := makeUploadArtifact(env)
+		r := makeUploadRequest(t, "t1", url.Values{"name": []string{name}, "visibility": []string{visibility}}, body)
+		require.NoError(t, h.Parse(ctx, r))
+		return h.Run(ctx)
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, env *mock.Environment){
+		"UploadParseFailsWithoutName": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			r := makeUploadRequest(t, "t1", url.Values{}, content)
+			assert.Error(t, makeUploadArtifact(env).Parse(ctx, r))
+		},
+		"UploadParseFailsWithParentDirectory": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			r := makeUploadRequest(t, "t1", url.Values{"name": []string{"../secret"}}, content)
+			assert.Error(t, makeUploadArtifact(env).Parse(ctx, r))
+		},
+		"UploadParseFailsWithAbsolutePath": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			r := makeUploadRequest(t, "t1", url.Values{"name": []string{"/etc/passwd"}}, content)
+			assert.Error(t, makeUploadArtifact(env).Parse(ctx, r))
+		},
+		"UploadParseFailsWithSignedVisibility": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			r := makeUploadRequest(t, "t1", url.Values{"name": []string{"file.txt"}, "visibility": []string{artifact.Signed}}, content)
+			assert.Error(t, makeUploadArtifact(env).Parse(ctx, r))
+		},
+		"UploadsAndAttachesArtifact": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "dir/file.txt", artifact.Private, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			file, ok := resp.Data().(artifact.File)
+			require.True(t, ok)
+			assert.Equal(t, "dir/file.txt", file.Name)
+			assert.Equal(t, "project/t1/0/dir/file.txt", file.FileKey)
+			assert.Contains(t, file.Link, "/rest/v2/tasks/t1/artifacts/download?execution=0&name=dir%2Ffile.txt")
+
+			entry, err := artifact.FindOne(artifact.ByTaskIdAndExecution("t1", 0))
+			require.NoError(t, err)
+			require.NotZero(t, entry)
+			require.Len(t, entry.Files, 1)
+			assert.Equal(t, file, entry.Files[0])
+
+			assert.EqualValues(t, len(content), file.Size)
+
+			usage, err := artifact.FindProjectUsage(ctx, "project", file.UploadTime)
+			require.NoError(t, err)
+			require.NotZero(t, usage)
+			assert.EqualValues(t, len(content), usage.Bytes)
+		},
+		"ReuploadReplacesArtifactAndReleasesPreviousUsage": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "file.txt", artifact.Private, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			newContent := content + " with more content"
+			resp = upload(ctx, t, env, "file.txt", artifact.Public, newContent)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+			file, ok := resp.Data().(artifact.File)
+			require.True(t, ok)
+
+			entry, err := artifact.FindOne(artifact.ByTaskIdAndExecution("t1", 0))
+			require.NoError(t, err)
+			require.NotZero(t, entry)
+			require.Len(t, entry.Files, 1)
+			assert.Equal(t, artifact.Public, entry.Files[0].Visibility)
+
+			usage, err := artifact.FindProjectUsage(ctx, "project", file.UploadTime)
+			require.NoError(t, err)
+			require.NotZero(t, usage)
+			assert.EqualValues(t, len(newContent), usage.Bytes)
+		},
+		"UploadFailsWhenExceedingQuota": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			env.EvergreenSettings.Buckets.ArtifactProjectDailyQuotaMB = 1
+
+			resp := upload(ctx, t, env, "file.txt", "", strings.Repeat("a", 1024*1024+1))
+			assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Status())
+
+			entry, err := artifact.FindOne(artifact.ByTaskIdAndExecution("t1", 0))
+			require.NoError(t, err)
+			assert.Zero(t, entry)
+		},
+		"UploadFailsWithoutBucket": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			env.EvergreenSettings.Buckets.ArtifactBucket = evergreen.BucketConfig{}
+
+			resp := upload(ctx, t, env, "file.txt", "", content)
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+		"UploadFailsForNonexistentTask": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			h := makeUploadArtifact(env)
+			require.NoError(t, h.Parse(ctx, makeUploadRequest(t, "nonexistent", url.Values{"name": []string{"file.txt"}}, content)))
+			assert.Equal(t, http.StatusNotFound, h.Run(ctx).Status())
+		},
+		"DownloadsPublicArtifactWithoutUser": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "dir/file.txt", artifact.Public, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			rw := download(ctx, t, env, url.Values{"execution": []string{"0"}, "name": []string{"dir/file.txt"}})
+			require.Equal(t, http.StatusOK, rw.Code, rw.Body.String())
+			assert.Equal(t, content, rw.Body.String())
+		},
+		"DownloadFailsForPrivateArtifactWithoutUser": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "file.txt", artifact.Private, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			rw := download(ctx, t, env, url.Values{"execution": []string{"0"}, "name": []string{"file.txt"}})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+		"DownloadFailsForPrivateArtifactWithoutPermission": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "file.txt", artifact.Private, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user"})
+			rw := download(ctx, t, env, url.Values{"execution": []string{"0"}, "name": []string{"file.txt"}})
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+		},
+		"DownloadFailsForHiddenArtifact": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			resp := upload(ctx, t, env, "file.txt", artifact.None, content)
+			require.Equal(t, http.StatusOK, resp.Status(), resp.Data())
+
+			rw := download(ctx, t, env, url.Values{"execution": []string{"0"}, "name": []string{"file.txt"}})
+			assert.Equal(t, http.StatusNotFound, rw.Code)
+		},
+		"DownloadFailsForNonexistentArtifact": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			rw := download(ctx, t, env, url.Values{"execution": []string{"0"}, "name": []string{"file.txt"}})
+			assert.Equal(t, http.StatusNotFound, rw.Code)
+		},
+		"DownloadFailsWithoutExecution": func(ctx context.Context, t *testing.T, env *mock.Environment) {
+			rw := download(ctx, t, env, url.Values{"name": []string{"file.txt"}})
+			assert.Equal(t, http.StatusBadRequest, rw.Code)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			require.NoError(t, db.ClearCollections(task.Collection, artifact.Collection, artifact.UsageCollection))
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(ctx))
+			env.EvergreenSettings.Buckets.ArtifactBucket = evergreen.BucketConfig{
+				Name: t.TempDir(),
+				Type: evergreen.BucketTypeLocal,
+			}
+			env.EvergreenSettings.Buckets.ArtifactProjectDailyQuotaMB = 0
+
+			tsk := task.Task{
+				Id:          "t1",
+				Project:     "project",
+				DisplayName: "task",
+				BuildId:     "b1",
+			}
+			require.NoError(t, tsk.Insert())
+
+			tCase(ctx, t, env)
+		})
+	}
+}
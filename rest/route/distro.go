@@ -9,6 +9,7 @@ import (
 
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
@@ -106,7 +107,7 @@ func (h *distroIDChangeSetupHandler) Run(ctx context.Context) gimlet.Responder {
 	}
 
 	d.Setup = h.Setup
-	if err = data.UpdateDistro(ctx, d, d); err != nil {
+	if err = data.UpdateDistro(ctx, d, d, MustHaveUser(ctx).Username()); err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "updating distro '%s'", h.distroID))
 	}
 
@@ -193,7 +194,7 @@ func (h *distroIDPutHandler) Run(ctx context.Context) gimlet.Responder {
 			return respErr
 		}
 
-		if err = data.UpdateDistro(ctx, original, newDistro); err != nil {
+		if err = data.UpdateDistro(ctx, original, newDistro, user.Username()); err != nil {
 			return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "updating existing distro '%s'", h.distroID))
 		}
 		event.LogDistroModified(h.distroID, user.Username(), original.DistroData(), newDistro.DistroData())
@@ -327,7 +328,7 @@ func (h *distroIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return respErr
 	}
 
-	if err = data.UpdateDistro(ctx, old, d); err != nil {
+	if err = data.UpdateDistro(ctx, old, d, user.Username()); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "updating distro '%s'", h.distroID))
 	}
 	event.LogDistroModified(h.distroID, user.Username(), old.DistroData(), d.DistroData())
@@ -569,6 +570,7 @@ func (h *modifyDistrosSettingsHandler) Run(ctx context.Context) gimlet.Responder
 				continue
 			}
 			event.LogDistroModified(d.Id, u.Username(), old.DistroData(), d.DistroData())
+			catcher.Wrapf(dbModel.UpdateProjectDistros(ctx, old, &d, u.Username()), "updating project distros derived from distro '%s'", d.Id)
 		}
 
 		modifiedIDs = append(modifiedIDs, d.Id)
@@ -0,0 +1,236 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/distros/{distro_id}/image_rollouts
+
+type distroImageRolloutsGetHandler struct {
+	distroID string
+}
+
+// distroImageRolloutResponse is an image rollout along with the stats of its
+// candidate hosts and canary tasks, which are only included for the
+// in-progress rollout.
+type distroImageRolloutResponse struct {
+	model.APIDistroImageRollout
+	Stats *dbModel.ImageRolloutStats `json:"stats,omitempty"`
+}
+
+func makeGetDistroImageRollouts() gimlet.RouteHandler {
+	return &distroImageRolloutsGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a distro's image rollouts
+//	@Description	Returns the distro's image rollouts from newest to oldest. The in-progress rollout includes stats on its candidate hosts and canary tasks.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/image_rollouts [get]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path	string	true	"distro ID"
+//	@Success		200			{array}	distroImageRolloutResponse
+func (h *distroImageRolloutsGetHandler) Factory() gimlet.RouteHandler {
+	return &distroImageRolloutsGetHandler{}
+}
+
+func (h *distroImageRolloutsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	return nil
+}
+
+func (h *distroImageRolloutsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	rollouts, err := distro.FindImageRollouts(ctx, h.distroID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding image rollouts for distro '%s'", h.distroID))
+	}
+
+	resp := []distroImageRolloutResponse{}
+	for i := range rollouts {
+		r := distroImageRolloutResponse{}
+		r.BuildFromService(rollouts[i])
+		if rollouts[i].Status == distro.ImageRolloutStatusInProgress {
+			r.Stats, err = dbModel.GetImageRolloutStats(ctx, &rollouts[i])
+			if err != nil {
+				return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting stats for image rollout '%s'", rollouts[i].ID))
+			}
+		}
+		resp = append(resp, r)
+	}
+	return gimlet.NewJSONResponse(resp)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/distros/{distro_id}/image_rollouts
+
+type distroImageRolloutPostHandler struct {
+	distroID string
+	opts     model.APIDistroImageRollout
+}
+
+func makeStartDistroImageRollout() gimlet.RouteHandler {
+	return &distroImageRolloutPostHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Start a distro image rollout
+//	@Description	Starts a staged rollout of a new image for an EC2 distro. A percentage of the distro's new hosts use the candidate image, and the candidate image is automatically promoted or rolled back based on how those hosts provision and how the tasks that run on them fare. Only candidate_ami is required; region defaults to the default EC2 region and any unset thresholds use their defaults. A distro can only have one rollout in progress.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/image_rollouts [post]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path		string						true	"distro ID"
+//	@Param			{object}	body		model.APIDistroImageRollout	true	"parameters"
+//	@Success		201			{object}	model.APIDistroImageRollout
+func (h *distroImageRolloutPostHandler) Factory() gimlet.RouteHandler {
+	return &distroImageRolloutPostHandler{}
+}
+
+func (h *distroImageRolloutPostHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	if err := utility.ReadJSON(r.Body, &h.opts); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "reading image rollout from JSON request body").Error(),
+		}
+	}
+	if utility.FromStringPtr(h.opts.CandidateAMI) == "" {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify a candidate image",
+		}
+	}
+	return nil
+}
+
+func (h *distroImageRolloutPostHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	d, err := distro.FindOneId(ctx, h.distroID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding distro '%s'", h.distroID))
+	}
+	if d == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("distro '%s' not found", h.distroID),
+		})
+	}
+	active, err := distro.FindActiveImageRollout(ctx, d.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding in-progress image rollout for distro '%s'", d.Id))
+	}
+	if active != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("distro '%s' already has image rollout '%s' in progress", d.Id, active.ID),
+		})
+	}
+
+	rollout, err := distro.NewImageRollout(d, u.Username(), h.opts.ToService())
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	if err = rollout.Insert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "starting image rollout for distro '%s'", d.Id))
+	}
+	event.LogDistroImageRolloutStarted(d.Id, u.Username(), rollout)
+	if err = dbModel.ScheduleImageRolloutCanaryTasks(ctx, rollout, u.Username(), evergreen.RESTV2Package); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "scheduling canary tasks")
+		catcher.Wrap(dbModel.RollBackImageRollout(ctx, rollout, u.Username(), fmt.Sprintf("could not schedule canary tasks: %s", err)), "rolling back image rollout")
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    catcher.Resolve().Error(),
+		})
+	}
+
+	apiRollout := model.APIDistroImageRollout{}
+	apiRollout.BuildFromService(*rollout)
+	responder := gimlet.NewJSONResponse(apiRollout)
+	if err = responder.SetStatus(http.StatusCreated); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "setting HTTP status code to %d", http.StatusCreated))
+	}
+	return responder
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/distros/{distro_id}/image_rollouts/{rollout_id}/rollback
+
+type distroImageRolloutRollbackHandler struct {
+	distroID  string
+	rolloutID string
+}
+
+func makeRollBackDistroImageRollout() gimlet.RouteHandler {
+	return &distroImageRolloutRollbackHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Roll back a distro image rollout
+//	@Description	Rolls back an in-progress image rollout. The distro keeps its current image and hosts using the candidate image are decommissioned.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/image_rollouts/{rollout_id}/rollback [post]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path		string	true	"distro ID"
+//	@Param			rollout_id	path		string	true	"image rollout ID"
+//	@Success		200			{object}	model.APIDistroImageRollout
+func (h *distroImageRolloutRollbackHandler) Factory() gimlet.RouteHandler {
+	return &distroImageRolloutRollbackHandler{}
+}
+
+func (h *distroImageRolloutRollbackHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.distroID = vars["distro_id"]
+	h.rolloutID = vars["rollout_id"]
+	return nil
+}
+
+func (h *distroImageRolloutRollbackHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	rollout, err := distro.FindImageRolloutByID(ctx, h.rolloutID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding image rollout '%s'", h.rolloutID))
+	}
+	if rollout == nil || rollout.DistroID != h.distroID {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("image rollout '%s' not found for distro '%s'", h.rolloutID, h.distroID),
+		})
+	}
+	if rollout.Status != distro.ImageRolloutStatusInProgress {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("image rollout '%s' is already %s", rollout.ID, rollout.Status),
+		})
+	}
+
+	reason := fmt.Sprintf("manually rolled back by '%s'", u.Username())
+	if err = dbModel.RollBackImageRollout(ctx, rollout, u.Username(), reason); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "rolling back image rollout '%s'", rollout.ID))
+	}
+
+	apiRollout := model.APIDistroImageRollout{}
+	apiRollout.BuildFromService(*rollout)
+	return gimlet.NewJSONResponse(apiRollout)
+}
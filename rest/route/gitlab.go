@@ -0,0 +1,238 @@
+package route
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	gitlabTokenHeader     = "X-Gitlab-Token"
+	gitlabEventHeader     = "X-Gitlab-Event"
+	gitlabEventUUIDHeader = "X-Gitlab-Event-UUID"
+
+	gitlabMergeRequestEvent = "Merge Request Hook"
+	gitlabPushEvent         = "Push Hook"
+
+	gitlabActionOpen   = "open"
+	gitlabActionReopen = "reopen"
+	gitlabActionUpdate = "update"
+)
+
+// gitlabMergeRequestPayload is the subset of a GitLab merge request webhook
+// payload that Evergreen uses.
+type gitlabMergeRequestPayload struct {
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		URL          string `json:"url"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		// AuthorID is the ID of the merge request's author. The payload's
+		// top-level user is whoever triggered the event, which is not
+		// necessarily the author.
+		AuthorID int    `json:"author_id"`
+		Action   string `json:"action"`
+		// OldRev is only set on update events that pushed new commits.
+		OldRev     string `json:"oldrev"`
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+// gitlabPushPayload is the subset of a GitLab push webhook payload that
+// Evergreen uses.
+type gitlabPushPayload struct {
+	Ref     string `json:"ref"`
+	After   string `json:"after"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+}
+
+type gitlabHookApi struct {
+	queue    amboy.Queue
+	settings *evergreen.Settings
+
+	eventType    string
+	msgID        string
+	mergeRequest *gitlabMergeRequestPayload
+	push         *gitlabPushPayload
+}
+
+func makeGitlabHooksRoute(queue amboy.Queue, settings *evergreen.Settings) gimlet.RouteHandler {
+	return &gitlabHookApi{
+		queue:    queue,
+		settings: settings,
+	}
+}
+
+func (gh *gitlabHookApi) Factory() gimlet.RouteHandler {
+	return &gitlabHookApi{
+		queue:    gh.queue,
+		settings: gh.settings,
+	}
+}
+
+func (gh *gitlabHookApi) Parse(ctx context.Context, r *http.Request) error {
+	secret := gh.settings.Gitlab.WebhookSecret
+	if secret == "" || gh.queue == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    "GitLab webhooks are not configured and therefore disabled",
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(gitlabTokenHeader)), []byte(secret)) != 1 {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "invalid GitLab webhook token",
+		}
+	}
+
+	gh.eventType = r.Header.Get(gitlabEventHeader)
+	gh.msgID = r.Header.Get(gitlabEventUUIDHeader)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading webhook payload")
+	}
+
+	switch gh.eventType {
+	case gitlabMergeRequestEvent:
+		gh.mergeRequest = &gitlabMergeRequestPayload{}
+		if err = json.Unmarshal(body, gh.mergeRequest); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "parsing merge request webhook").Error(),
+			}
+		}
+		if gh.msgID == "" {
+			// Older GitLab versions do not identify events, so identify the
+			// event by the commit it tests.
+			attrs := gh.mergeRequest.ObjectAttributes
+			gh.msgID = fmt.Sprintf("%s!%d@%s", gh.mergeRequest.Project.PathWithNamespace, attrs.IID, attrs.LastCommit.ID)
+		}
+	case gitlabPushEvent:
+		gh.push = &gitlabPushPayload{}
+		if err = json.Unmarshal(body, gh.push); err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "parsing push webhook").Error(),
+			}
+		}
+		if gh.msgID == "" {
+			gh.msgID = fmt.Sprintf("%s@%s", gh.push.Project.PathWithNamespace, gh.push.After)
+		}
+	}
+
+	return nil
+}
+
+func (gh *gitlabHookApi) Run(ctx context.Context) gimlet.Responder {
+	switch {
+	case gh.mergeRequest != nil:
+		mr, ok := gh.mergeRequestToTest()
+		if !ok {
+			break
+		}
+		grip.Info(message.Fields{
+			"source":       "GitLab hook",
+			"msg_id":       gh.msgID,
+			"event":        gh.eventType,
+			"project_path": mr.ProjectPath,
+			"iid":          mr.IID,
+			"hash":         mr.HeadSHA,
+			"author_id":    mr.AuthorID,
+			"message":      "merge request accepted, attempting to queue",
+		})
+		intent, err := patch.NewGitlabIntent(gh.msgID, patch.AutomatedCaller, mr)
+		if err != nil {
+			return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "creating GitLab patch intent").Error(),
+			})
+		}
+		if err = data.AddPatchIntent(intent, gh.queue); err != nil {
+			grip.Error(message.WrapError(err, message.Fields{
+				"source":       "GitLab hook",
+				"msg_id":       gh.msgID,
+				"event":        gh.eventType,
+				"project_path": mr.ProjectPath,
+				"iid":          mr.IID,
+				"message":      "can't add intent",
+			}))
+			return gimlet.NewJSONInternalErrorResponse(errors.Wrap(err, "adding patch intent"))
+		}
+
+	case gh.push != nil:
+		if err := data.TriggerRepotrackerForGitlabPush(ctx, gh.queue, gh.msgID, gh.push.Project.PathWithNamespace, gh.push.Ref); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "triggering repotracker"))
+		}
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// mergeRequestToTest returns the merge request from the webhook and whether
+// Evergreen should test it. Merge requests are tested when they are opened or
+// reopened, or when new commits are pushed to them.
+func (gh *gitlabHookApi) mergeRequestToTest() (thirdparty.GitlabMergeRequest, bool) {
+	attrs := gh.mergeRequest.ObjectAttributes
+	switch attrs.Action {
+	case gitlabActionOpen, gitlabActionReopen:
+	case gitlabActionUpdate:
+		if attrs.OldRev == "" {
+			// The update did not change the merge request's commits.
+			return thirdparty.GitlabMergeRequest{}, false
+		}
+	default:
+		return thirdparty.GitlabMergeRequest{}, false
+	}
+
+	description := strings.ToLower(attrs.Description)
+	if len(description) >= skipCIDescriptionCharLimit {
+		description = description[:skipCIDescriptionCharLimit]
+	}
+	for _, label := range skipCILabels {
+		if strings.Contains(strings.ToLower(attrs.Title), label) || strings.Contains(description, label) {
+			grip.Info(message.Fields{
+				"source":       "GitLab hook",
+				"message":      "skipping CI on merge request",
+				"project_path": gh.mergeRequest.Project.PathWithNamespace,
+				"iid":          attrs.IID,
+				"label":        label,
+			})
+			return thirdparty.GitlabMergeRequest{}, false
+		}
+	}
+
+	return thirdparty.GitlabMergeRequest{
+		ProjectPath:  gh.mergeRequest.Project.PathWithNamespace,
+		IID:          attrs.IID,
+		Title:        attrs.Title,
+		URL:          attrs.URL,
+		SourceBranch: attrs.SourceBranch,
+		TargetBranch: attrs.TargetBranch,
+		HeadSHA:      attrs.LastCommit.ID,
+		AuthorID:     attrs.AuthorID,
+	}, true
+}
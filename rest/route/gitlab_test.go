@@ -0,0 +1,179 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/amboy/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readGitlabTestPayload(t *testing.T, name string) []byte {
+	body, err := os.ReadFile(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "gitlab", name))
+	require.NoError(t, err)
+	return body
+}
+
+func makeGitlabTestRequest(t *testing.T, ctx context.Context, token, event, uuid string, body []byte) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/rest/v2/hooks/gitlab", bytes.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set(gitlabTokenHeader, token)
+	}
+	req.Header.Set(gitlabEventHeader, event)
+	if uuid != "" {
+		req.Header.Set(gitlabEventUUIDHeader, uuid)
+	}
+	return req
+}
+
+func TestGitlabHookParse(t *testing.T) {
+	const secret = "gitlab-secret"
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, gh *gitlabHookApi){
+		"FailsWithoutToken": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, "", gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+			err := gh.Parse(ctx, req)
+			require.Error(t, err)
+			resp, ok := err.(gimlet.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		},
+		"FailsWithWrongToken": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, "not-the-secret", gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+			err := gh.Parse(ctx, req)
+			require.Error(t, err)
+			resp, ok := err.(gimlet.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		},
+		"FailsWhenWebhooksAreNotConfigured": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			gh.settings = &evergreen.Settings{}
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+			err := gh.Parse(ctx, req)
+			require.Error(t, err)
+			resp, ok := err.(gimlet.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		},
+		"FailsWithMalformedPayload": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", []byte("{"))
+			err := gh.Parse(ctx, req)
+			require.Error(t, err)
+			resp, ok := err.(gimlet.ErrorResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		},
+		"ParsesMergeRequest": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			assert.Equal(t, "uuid", gh.msgID)
+			require.NotNil(t, gh.mergeRequest)
+			assert.Nil(t, gh.push)
+
+			mr, ok := gh.mergeRequestToTest()
+			require.True(t, ok)
+			assert.Equal(t, "platform/tools/widgets", mr.ProjectPath)
+			assert.Equal(t, 7, mr.IID)
+			assert.Equal(t, "Add the frobnicator", mr.Title)
+			assert.Equal(t, "https://gitlab.example.com/platform/tools/widgets/-/merge_requests/7", mr.URL)
+			assert.Equal(t, "add-frobnicator", mr.SourceBranch)
+			assert.Equal(t, "main", mr.TargetBranch)
+			assert.Equal(t, "8f3ca1d4b2e6f0a9c7d5e3b1a0f9e8d7c6b5a4f3", mr.HeadSHA)
+			assert.Equal(t, 1, mr.AuthorID)
+		},
+		"IdentifiesMergeRequestByCommitWithoutEventUUID": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "", readGitlabTestPayload(t, "merge_request_open.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			assert.Equal(t, "platform/tools/widgets!7@8f3ca1d4b2e6f0a9c7d5e3b1a0f9e8d7c6b5a4f3", gh.msgID)
+		},
+		"TestsUpdateWithNewCommits": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_update.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			mr, ok := gh.mergeRequestToTest()
+			require.True(t, ok)
+			assert.Equal(t, "1e2d3c4b5a69788796a5b4c3d2e1f0a9b8c7d6e5", mr.HeadSHA)
+			assert.Equal(t, 5, mr.AuthorID, "author should be the merge request's author rather than the user who updated it")
+		},
+		"IgnoresUpdateWithoutNewCommits": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_update_labels.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			_, ok := gh.mergeRequestToTest()
+			assert.False(t, ok)
+		},
+		"IgnoresClosedMergeRequest": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_close.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			_, ok := gh.mergeRequestToTest()
+			assert.False(t, ok)
+		},
+		"IgnoresMergeRequestWithSkipCILabel": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			gh.mergeRequest.ObjectAttributes.Title = "[skip ci] Add the frobnicator"
+			_, ok := gh.mergeRequestToTest()
+			assert.False(t, ok)
+		},
+		"ParsesPush": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, gitlabPushEvent, "", readGitlabTestPayload(t, "push.json"))
+			require.NoError(t, gh.Parse(ctx, req))
+			assert.Nil(t, gh.mergeRequest)
+			require.NotNil(t, gh.push)
+			assert.Equal(t, "refs/heads/main", gh.push.Ref)
+			assert.Equal(t, "platform/tools/widgets", gh.push.Project.PathWithNamespace)
+			assert.Equal(t, "platform/tools/widgets@da1560886d4f094c3e6c9ef40349f7d38b5d27d7", gh.msgID)
+		},
+		"IgnoresOtherEvents": func(ctx context.Context, t *testing.T, gh *gitlabHookApi) {
+			req := makeGitlabTestRequest(t, ctx, secret, "Note Hook", "uuid", []byte(`{"object_kind": "note"}`))
+			require.NoError(t, gh.Parse(ctx, req))
+			assert.Nil(t, gh.mergeRequest)
+			assert.Nil(t, gh.push)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			settings := &evergreen.Settings{Gitlab: evergreen.GitlabConfig{WebhookSecret: secret}}
+			gh, ok := makeGitlabHooksRoute(queue.NewLocalLimitedSize(1, 10), settings).Factory().(*gitlabHookApi)
+			require.True(t, ok)
+
+			tCase(ctx, t, gh)
+		})
+	}
+}
+
+func TestGitlabHookRunCreatesIntent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.Clear(patch.IntentCollection))
+	defer func() {
+		assert.NoError(t, db.Clear(patch.IntentCollection))
+	}()
+
+	const secret = "gitlab-secret"
+	settings := &evergreen.Settings{Gitlab: evergreen.GitlabConfig{WebhookSecret: secret}}
+	gh, ok := makeGitlabHooksRoute(queue.NewLocalLimitedSize(1, 10), settings).Factory().(*gitlabHookApi)
+	require.True(t, ok)
+
+	req := makeGitlabTestRequest(t, ctx, secret, gitlabMergeRequestEvent, "uuid", readGitlabTestPayload(t, "merge_request_open.json"))
+	require.NoError(t, gh.Parse(ctx, req))
+	resp := gh.Run(ctx)
+	require.Equal(t, http.StatusOK, resp.Status())
+
+	intent, err := patch.FindIntent("uuid", patch.GitlabIntentType)
+	require.NoError(t, err)
+	require.NotNil(t, intent)
+	assert.Equal(t, patch.GitlabIntentType, intent.GetType())
+	assert.Equal(t, evergreen.GitlabMRRequester, intent.RequesterIdentity())
+}
@@ -452,6 +452,8 @@ func (ch *offboardUserHandler) Run(ctx context.Context) gimlet.Responder {
 		}))
 		err = user.ClearUser(ch.user)
 		catcher.Wrapf(err, "clearing user '%s'", ch.user)
+		err = user.RemovePersonalAccessTokensForUser(ch.user)
+		catcher.Wrapf(err, "revoking personal access tokens for user '%s'", ch.user)
 	}
 
 	if catcher.HasErrors() {
@@ -678,3 +680,76 @@ func (h *hostIpAddressGetHandler) Run(ctx context.Context) gimlet.Responder {
 	hostModel.BuildFromService(host, nil)
 	return gimlet.NewJSONResponse(hostModel)
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/hosts/{host_id}/agent/debug/{kind}
+
+type hostAgentDebugInfoGetHandler struct {
+	hostID string
+	kind   string
+	env    evergreen.Environment
+}
+
+func makeGetHostAgentDebugInfo(env evergreen.Environment) gimlet.RouteHandler {
+	return &hostAgentDebugInfoGetHandler{
+		env: env,
+	}
+}
+
+func (h *hostAgentDebugInfoGetHandler) Factory() gimlet.RouteHandler {
+	return &hostAgentDebugInfoGetHandler{
+		env: h.env,
+	}
+}
+
+func (h *hostAgentDebugInfoGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.hostID = vars["host_id"]
+	if h.hostID == "" {
+		return errors.New("host ID must be specified")
+	}
+	h.kind = vars["kind"]
+	if !utility.StringSliceContains(apimodels.AgentDebugInfoKinds, h.kind) {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("invalid agent debug info kind '%s', must be one of: %s", h.kind, strings.Join(apimodels.AgentDebugInfoKinds, ", ")),
+		}
+	}
+
+	return nil
+}
+
+// Run fetches debugging information from the status server of the agent
+// running on the host.
+func (h *hostAgentDebugInfoGetHandler) Run(ctx context.Context) gimlet.Responder {
+	foundHost, err := host.FindOneId(ctx, h.hostID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding host '%s'", h.hostID))
+	}
+	if foundHost == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("host '%s' not found", h.hostID),
+		})
+	}
+	if foundHost.Status != evergreen.HostRunning {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("can't get agent debug info for host with status '%s' because it is not running", foundHost.Status),
+		})
+	}
+	if !foundHost.Distro.JasperCommunication() {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("can't get agent debug info for host of distro '%s' because it doesn't support Jasper communication", foundHost.Distro.Id),
+		})
+	}
+
+	output, err := foundHost.GetAgentDebugInfo(ctx, h.env, h.kind)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting agent debug info '%s' from host '%s' (output: %s)", h.kind, h.hostID, output))
+	}
+
+	return gimlet.NewTextResponse(output)
+}
@@ -590,6 +590,20 @@ func assignNextAvailableTask(ctx context.Context, env evergreen.Environment, tas
 			continue
 		}
 
+		// Canary tasks for a distro image rollout can only run on the
+		// rollout's candidate hosts. The task is put back on the queue the
+		// next time the scheduler runs, so a candidate host can pick it up.
+		if !nextTask.CanRunOnHost(currentHost.ImageRolloutID) {
+			grip.Warning(message.WrapError(taskQueue.DequeueTask(nextTask.Id), message.Fields{
+				"message":          "task is a canary for an image rollout that the host is not a candidate for, but there was an issue dequeuing the task",
+				"host_id":          currentHost.Id,
+				"distro_id":        nextTask.DistroId,
+				"task_id":          nextTask.Id,
+				"image_rollout_id": nextTask.ImageRolloutID,
+			}))
+			continue
+		}
+
 		// If the current task group is finished we leave the task on the queue, and indicate the current group needs to be torn down.
 		if details.TaskGroup != "" && details.TaskGroup != nextTask.TaskGroup {
 			grip.DebugWhen(nextTask.TaskGroup != "", message.Fields{
@@ -942,6 +942,32 @@ func TestAssignNextAvailableTaskWithDispatcherSettingsVersionLegacy(t *testing.T
 			So(err, ShouldBeNil)
 			So(currentTq.Length(), ShouldEqual, 0)
 		})
+		Convey("image rollout canary tasks should only be assigned to the rollout's candidate hosts", func() {
+			So(task.UpdateOne(bson.M{task.IdKey: task1.Id}, bson.M{"$set": bson.M{task.ImageRolloutIDKey: "rollout"}}), ShouldBeNil)
+
+			t, shouldTeardown, err := assignNextAvailableTask(ctx, env, taskQueue, model.NewTaskDispatchService(time.Minute), &theHostWhoCanBoastTheMostRoast, details)
+			So(err, ShouldBeNil)
+			So(t, ShouldNotBeNil)
+			So(shouldTeardown, ShouldBeFalse)
+			So(t.Id, ShouldEqual, "task2")
+
+			candidate := host.Host{
+				Id:             "candidate",
+				Distro:         theHostWhoCanBoastTheMostRoast.Distro,
+				Secret:         hostSecret,
+				Status:         evergreen.HostRunning,
+				ImageRolloutID: "rollout",
+			}
+			So(candidate.Insert(ctx), ShouldBeNil)
+			taskQueue.Queue = []model.TaskQueueItem{{Id: "task1"}}
+			So(taskQueue.Save(), ShouldBeNil)
+
+			t, shouldTeardown, err = assignNextAvailableTask(ctx, env, taskQueue, model.NewTaskDispatchService(time.Minute), &candidate, details)
+			So(err, ShouldBeNil)
+			So(t, ShouldNotBeNil)
+			So(shouldTeardown, ShouldBeFalse)
+			So(t.Id, ShouldEqual, "task1")
+		})
 		Convey("a completed task group should return a nil task", func() {
 			currentTq, err := model.LoadTaskQueue(distroID)
 			So(err, ShouldBeNil)
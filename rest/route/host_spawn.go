@@ -140,6 +140,7 @@ func (h *hostModifyHandler) Run(ctx context.Context) gimlet.Responder {
 		catcher.AddWhen(h.options.AddHours != 0, errors.New("can't specify no expiration and new expiration"))
 		catcher.Add(CheckUnexpirableHostLimitExceeded(ctx, user.Id, h.env.Settings().Spawnhost.UnexpirableHostsPerUser))
 	}
+	catcher.Add(host.ValidateSleepScheduleModification(foundHost, *h.options))
 	if catcher.HasErrors() {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(catcher.Resolve(), "invalid host modify request"))
 	}
@@ -611,7 +612,7 @@ func (h *createVolumeHandler) Parse(ctx context.Context, r *http.Request) error
 	if err := utility.ReadJSON(r.Body, h.volume); err != nil {
 		return errors.Wrap(err, "reading volume from JSON request body")
 	}
-	if h.volume.Size == 0 {
+	if h.volume.Size == 0 && h.volume.SnapshotID == "" {
 		return errors.New("volume size is required")
 	}
 	h.provider = evergreen.ProviderNameEc2OnDemand
@@ -623,14 +624,18 @@ func (h *createVolumeHandler) Run(ctx context.Context) gimlet.Responder {
 
 	h.volume.CreatedBy = u.Id
 
+	if h.volume.AvailabilityZone == "" {
+		h.volume.AvailabilityZone = evergreen.DefaultEBSAvailabilityZone
+	}
+	if err := cloud.ApplyVolumeSnapshot(h.volume); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid snapshot"))
+	}
+
 	if h.volume.Type == "" {
 		h.volume.Type = evergreen.DefaultEBSType
 		h.volume.IOPS = cloud.Gp2EquivalentIOPSForGp3(h.volume.Size)
 		h.volume.Throughput = cloud.Gp2EquivalentThroughputForGp3(h.volume.Size)
 	}
-	if h.volume.AvailabilityZone == "" {
-		h.volume.AvailabilityZone = evergreen.DefaultEBSAvailabilityZone
-	}
 
 	if err := cloud.ValidVolumeOptions(h.volume, h.env.Settings()); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid volume options"))
@@ -957,6 +962,176 @@ func (h *getVolumeByIDHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(volumeDoc)
 }
 
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/snapshots
+
+type getSnapshotsHandler struct{}
+
+func makeGetSnapshots() gimlet.RouteHandler {
+	return &getSnapshotsHandler{}
+}
+
+func (h *getSnapshotsHandler) Factory() gimlet.RouteHandler {
+	return &getSnapshotsHandler{}
+}
+
+func (h *getSnapshotsHandler) Parse(ctx context.Context, r *http.Request) error {
+	return nil
+}
+
+func (h *getSnapshotsHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	snapshots, err := host.FindSnapshotsByUser(u.Username())
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding snapshots for user '%s'", u.Username()))
+	}
+
+	snapshotDocs := []model.APISnapshot{}
+	for _, s := range snapshots {
+		snapshotDoc := model.APISnapshot{}
+		snapshotDoc.BuildFromService(s)
+		snapshotDocs = append(snapshotDocs, snapshotDoc)
+	}
+	return gimlet.NewJSONResponse(snapshotDocs)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/snapshots
+
+type createSnapshotHandler struct {
+	options *model.SnapshotPostRequest
+}
+
+func makeCreateSnapshot() gimlet.RouteHandler {
+	return &createSnapshotHandler{}
+}
+
+func (h *createSnapshotHandler) Factory() gimlet.RouteHandler {
+	return &createSnapshotHandler{}
+}
+
+func (h *createSnapshotHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.options = &model.SnapshotPostRequest{}
+	if err := utility.ReadJSON(r.Body, h.options); err != nil {
+		return errors.Wrap(err, "reading snapshot options from JSON request body")
+	}
+	if (h.options.HostID == "") == (h.options.VolumeID == "") {
+		return errors.New("must specify exactly one of host ID or volume ID")
+	}
+	return nil
+}
+
+func (h *createSnapshotHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+
+	var snapshot *host.Snapshot
+	var statusCode int
+	var err error
+	if h.options.HostID != "" {
+		spawnHost, findErr := host.FindOneId(ctx, h.options.HostID)
+		if findErr != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(findErr, "finding host '%s'", h.options.HostID))
+		}
+		if spawnHost == nil {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("host '%s' not found", h.options.HostID),
+			})
+		}
+		if u.Id != spawnHost.StartedBy {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusUnauthorized,
+				Message:    fmt.Sprintf("not authorized to snapshot host '%s'", spawnHost.Id),
+			})
+		}
+		snapshot, statusCode, err = cloud.SnapshotHostHomeVolume(ctx, spawnHost, h.options.DisplayName, u.Id)
+	} else {
+		vol, findErr := host.FindVolumeByID(h.options.VolumeID)
+		if findErr != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(findErr, "finding volume '%s'", h.options.VolumeID))
+		}
+		if vol == nil {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("volume '%s' not found", h.options.VolumeID),
+			})
+		}
+		if u.Id != vol.CreatedBy {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusUnauthorized,
+				Message:    fmt.Sprintf("not authorized to snapshot volume '%s'", vol.ID),
+			})
+		}
+		snapshot, statusCode, err = cloud.CreateSnapshot(ctx, vol, h.options.DisplayName, u.Id)
+	}
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: statusCode,
+			Message:    errors.Wrap(err, "creating snapshot").Error(),
+		})
+	}
+
+	snapshotModel := &model.APISnapshot{}
+	snapshotModel.BuildFromService(*snapshot)
+	return gimlet.NewJSONResponse(snapshotModel)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/snapshots/{snapshot_id}
+
+type deleteSnapshotHandler struct {
+	snapshotID string
+}
+
+func makeDeleteSnapshot() gimlet.RouteHandler {
+	return &deleteSnapshotHandler{}
+}
+
+func (h *deleteSnapshotHandler) Factory() gimlet.RouteHandler {
+	return &deleteSnapshotHandler{}
+}
+
+func (h *deleteSnapshotHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.snapshotID, err = validateID(gimlet.GetVars(r)["snapshot_id"])
+	return err
+}
+
+func (h *deleteSnapshotHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	snapshot, err := host.FindSnapshotByID(h.snapshotID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding snapshot '%s'", h.snapshotID))
+	}
+	if snapshot == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("snapshot '%s' not found", h.snapshotID),
+		})
+	}
+
+	// Only allow users to delete their own snapshots
+	if u.Id != snapshot.CreatedBy {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    fmt.Sprintf("not authorized to delete snapshot '%s'", snapshot.ID),
+		})
+	}
+
+	statusCode, err := cloud.DeleteSnapshot(ctx, h.snapshotID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: statusCode,
+			Message:    errors.Wrap(err, "deleting snapshot").Error(),
+		})
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
 ////////////////////////////////////////////////////////////////////////
 //
 // POST /rest/v2/hosts/{host_id}/terminate
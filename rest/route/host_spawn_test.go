@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -553,6 +554,112 @@ func TestGetVolumesHandler(t *testing.T) {
 	}
 }
 
+func TestGetSnapshotsHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, db.ClearCollections(host.SnapshotsCollection))
+	h := &getSnapshotsHandler{}
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user"})
+
+	snapshotsToAdd := []host.Snapshot{
+		{ID: "snapshot1", CreatedBy: "user", SourceVolumeID: "volume1", Size: 64},
+		{ID: "snapshot2", CreatedBy: "different-user", SourceVolumeID: "volume2", Size: 36},
+	}
+	for _, snapshotToAdd := range snapshotsToAdd {
+		assert.NoError(t, snapshotToAdd.Insert())
+	}
+	resp := h.Run(ctx)
+	assert.NotNil(t, resp)
+	assert.Equal(t, http.StatusOK, resp.Status())
+
+	snapshots, ok := resp.Data().([]model.APISnapshot)
+	assert.True(t, ok)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "snapshot1", utility.FromStringPtr(snapshots[0].ID))
+	assert.Equal(t, "volume1", utility.FromStringPtr(snapshots[0].SourceVolumeID))
+	assert.Equal(t, 64, snapshots[0].Size)
+}
+
+func TestCreateSnapshotHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, db.ClearCollections(host.SnapshotsCollection, host.VolumesCollection, host.Collection))
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user"})
+
+	hosts := []host.Host{
+		{Id: "no-home-volume", StartedBy: "user", Status: evergreen.HostRunning},
+		{Id: "someone-elses-host", StartedBy: "different-user", HomeVolumeID: "volume1", Status: evergreen.HostRunning},
+	}
+	for _, hostToAdd := range hosts {
+		assert.NoError(t, hostToAdd.Insert(ctx))
+	}
+	vol := host.Volume{ID: "volume1", CreatedBy: "user", AvailabilityZone: evergreen.DefaultEBSAvailabilityZone}
+	assert.NoError(t, vol.Insert())
+
+	t.Run("ParseRequiresExactlyOneSource", func(t *testing.T) {
+		h := &createSnapshotHandler{}
+		for _, opts := range []model.SnapshotPostRequest{{}, {HostID: "h", VolumeID: "v"}} {
+			jsonBody, err := json.Marshal(opts)
+			require.NoError(t, err)
+			r, err := http.NewRequest(http.MethodPost, "/snapshots", bytes.NewBuffer(jsonBody))
+			require.NoError(t, err)
+			assert.Error(t, h.Parse(ctx, r))
+		}
+	})
+	t.Run("FailsForHostWithoutHomeVolume", func(t *testing.T) {
+		h := &createSnapshotHandler{options: &model.SnapshotPostRequest{HostID: "no-home-volume"}}
+		resp := h.Run(ctx)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.Status())
+	})
+	t.Run("FailsForOtherUsersHost", func(t *testing.T) {
+		h := &createSnapshotHandler{options: &model.SnapshotPostRequest{HostID: "someone-elses-host"}}
+		resp := h.Run(ctx)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusUnauthorized, resp.Status())
+	})
+	t.Run("FailsForNonexistentVolume", func(t *testing.T) {
+		h := &createSnapshotHandler{options: &model.SnapshotPostRequest{VolumeID: "nonexistent"}}
+		resp := h.Run(ctx)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+	t.Run("FailsWhenUserIsAtSnapshotLimit", func(t *testing.T) {
+		for i := 0; i < evergreen.DefaultMaxSnapshotsPerUser; i++ {
+			s := host.Snapshot{ID: fmt.Sprintf("snapshot%d", i), CreatedBy: "user"}
+			require.NoError(t, s.Insert())
+		}
+		h := &createSnapshotHandler{options: &model.SnapshotPostRequest{VolumeID: "volume1"}}
+		resp := h.Run(ctx)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.Status())
+	})
+}
+
+func TestDeleteSnapshotHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, db.ClearCollections(host.SnapshotsCollection))
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user"})
+
+	snapshot := host.Snapshot{ID: "snapshot1", CreatedBy: "different-user"}
+	assert.NoError(t, snapshot.Insert())
+
+	h := &deleteSnapshotHandler{snapshotID: "nonexistent"}
+	resp := h.Run(ctx)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusNotFound, resp.Status())
+
+	h = &deleteSnapshotHandler{snapshotID: "snapshot1"}
+	resp = h.Run(ctx)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusUnauthorized, resp.Status())
+
+	dbSnapshot, err := host.FindSnapshotByID("snapshot1")
+	assert.NoError(t, err)
+	assert.NotNil(t, dbSnapshot)
+}
+
 func TestGetVolumeByIDHandler(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
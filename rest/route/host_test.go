@@ -1182,3 +1182,78 @@ func TestHostProvisioningOptionsGetHandler(t *testing.T) {
 		assert.NotEqual(t, http.StatusOK, resp.Status())
 	})
 }
+
+func TestHostAgentDebugInfoGetHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(ctx))
+	require.NoError(t, db.Clear(host.Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(host.Collection))
+	}()
+
+	for tName, tCase := range map[string]func(t *testing.T, rh *hostAgentDebugInfoGetHandler){
+		"ParseSucceedsWithValidKind": func(t *testing.T, rh *hostAgentDebugInfoGetHandler) {
+			r, err := http.NewRequest(http.MethodGet, "/hosts/h1/agent/debug/timeouts", nil)
+			require.NoError(t, err)
+			r = gimlet.SetURLVars(r, map[string]string{"host_id": "h1", "kind": "timeouts"})
+			require.NoError(t, rh.Parse(ctx, r))
+			assert.Equal(t, "h1", rh.hostID)
+			assert.Equal(t, "timeouts", rh.kind)
+		},
+		"ParseFailsWithInvalidKind": func(t *testing.T, rh *hostAgentDebugInfoGetHandler) {
+			r, err := http.NewRequest(http.MethodGet, "/hosts/h1/agent/debug/foo", nil)
+			require.NoError(t, err)
+			r = gimlet.SetURLVars(r, map[string]string{"host_id": "h1", "kind": "foo"})
+			assert.Error(t, rh.Parse(ctx, r))
+		},
+		"RunFailsWithNonexistentHost": func(t *testing.T, rh *hostAgentDebugInfoGetHandler) {
+			rh.hostID = "foo"
+			rh.kind = "task"
+			resp := rh.Run(ctx)
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+		"RunFailsWithHostThatIsNotRunning": func(t *testing.T, rh *hostAgentDebugInfoGetHandler) {
+			h := host.Host{
+				Id:     "h1",
+				Status: evergreen.HostStopped,
+				Distro: distro.Distro{
+					BootstrapSettings: distro.BootstrapSettings{
+						Method:        distro.BootstrapMethodUserData,
+						Communication: distro.CommunicationMethodRPC,
+					},
+				},
+			}
+			require.NoError(t, h.Insert(ctx))
+			rh.hostID = h.Id
+			rh.kind = "task"
+			resp := rh.Run(ctx)
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+		"RunFailsWithHostWithoutJasper": func(t *testing.T, rh *hostAgentDebugInfoGetHandler) {
+			h := host.Host{
+				Id:     "h1",
+				Status: evergreen.HostRunning,
+				Distro: distro.Distro{
+					BootstrapSettings: distro.BootstrapSettings{
+						Method:        distro.BootstrapMethodLegacySSH,
+						Communication: distro.CommunicationMethodLegacySSH,
+					},
+				},
+			}
+			require.NoError(t, h.Insert(ctx))
+			rh.hostID = h.Id
+			rh.kind = "task"
+			resp := rh.Run(ctx)
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.Clear(host.Collection))
+			rh, ok := makeGetHostAgentDebugInfo(env).(*hostAgentDebugInfoGetHandler)
+			require.True(t, ok)
+			tCase(t, rh)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package route
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -535,6 +536,74 @@ func (m *CommitQueueItemOwnerMiddleware) ServeHTTP(rw http.ResponseWriter, r *ht
 	next(rw, r)
 }
 
+type accessTokenMiddleware struct{}
+
+// NewAccessTokenMiddleware returns a middleware that authenticates requests
+// that pass a personal access token in place of an API key. It rejects
+// requests that the token's scope doesn't allow, limits the user's
+// permissions to the token's projects and records when the token was last
+// used. It must run before the user middleware, which only accepts API keys.
+func NewAccessTokenMiddleware() gimlet.Middleware {
+	return &accessTokenMiddleware{}
+}
+
+func (m *accessTokenMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	key := r.Header.Get(evergreen.APIKeyHeader)
+	if !user.IsAccessToken(key) {
+		next(rw, r)
+		return
+	}
+	userID := r.Header.Get(evergreen.APIUserHeader)
+	// Remove the credentials so that the user middleware doesn't reject the
+	// token as an invalid API key.
+	r.Header.Del(evergreen.APIKeyHeader)
+	r.Header.Del(evergreen.APIUserHeader)
+
+	token, err := user.FindPersonalAccessTokenByToken(key)
+	if err != nil {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding personal access token")))
+		return
+	}
+	if token == nil || token.IsExpired() || (userID != "" && userID != token.UserID) {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "invalid or expired personal access token",
+		}))
+		return
+	}
+	if !token.AllowsRequest(r.Method, r.URL.Path) {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    fmt.Sprintf("personal access token '%s' with scope '%s' cannot make %s requests to '%s'", token.Name, token.Scope, r.Method, r.URL.Path),
+		}))
+		return
+	}
+
+	usr, err := user.FindOneById(token.UserID)
+	if err != nil {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding user '%s'", token.UserID)))
+		return
+	}
+	if usr == nil {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "invalid or expired personal access token",
+		}))
+		return
+	}
+	usr.SetAccessToken(token)
+
+	grip.Warning(message.WrapError(token.UpdateLastUsed(), message.Fields{
+		"message":  "could not update personal access token last used time",
+		"token_id": token.ID,
+		"user":     token.UserID,
+	}))
+
+	gimlet.AddLoggingAnnotation(r, "access_token", token.ID)
+	r = r.WithContext(gimlet.AttachUser(r.Context(), usr))
+	next(rw, r)
+}
+
 // updateHostAccessTime updates the host access time and disables the host's flags to deploy new a new agent
 // or agent monitor if they are set.
 func updateHostAccessTime(ctx context.Context, h *host.Host) {
@@ -979,3 +1048,149 @@ func allowCORS(next http.HandlerFunc) http.HandlerFunc {
 	}
 	return AddCORSHeaders(origins, next)
 }
+
+// auditResourceVars are the route variables identifying the resource that a
+// request acts on, in order of precedence.
+var auditResourceVars = []string{"task_id", "version_id", "patch_id", "build_id", "host_id", "volume_id", "pod_id", "distro_id", "project_id", "repo_id", "user_id"}
+
+// auditBodyOmittedResources are the resources whose request bodies are never
+// recorded in audit events. Their bodies hold secrets, such as admin
+// expansions, distro setup scripts, project variables and spawn host setup
+// scripts and user data, under names that redaction can't recognize.
+var auditBodyOmittedResources = []string{"admin", "distros", "projects", "repos", "hosts", "volumes", "spawn", "spawn_hosts"}
+
+// maxAuditBodySize is the largest request body that's recorded in an audit
+// event.
+const maxAuditBodySize = 64 * 1024
+
+type auditMiddleware struct{}
+
+// NewAuditMiddleware returns a middleware that records an audit event for
+// every mutating request made by a user. It must be added as a wrapper so that
+// it runs after routing.
+func NewAuditMiddleware() gimlet.Middleware {
+	return &auditMiddleware{}
+}
+
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (m *auditMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		next(rw, r)
+		return
+	}
+	usr, ok := gimlet.GetUser(r.Context()).(*user.DBUser)
+	if !ok || usr == nil {
+		// Requests from agents and other services are not user actions.
+		next(rw, r)
+		return
+	}
+
+	vars := gimlet.GetVars(r)
+	params := map[string]interface{}{}
+	for k, v := range vars {
+		params[k] = v
+	}
+	for k, v := range r.URL.Query() {
+		params[k] = strings.Join(v, ",")
+	}
+	if !auditOmitsBody(r.URL.Path) {
+		if body := readAuditBody(r); body != nil {
+			params["body"] = body
+		}
+	}
+
+	arw := &auditResponseWriter{ResponseWriter: rw, status: http.StatusOK}
+	next(arw, r)
+
+	data := event.AuditEventData{
+		Actor:      usr.Id,
+		Method:     r.Method,
+		Operation:  r.URL.Path,
+		Params:     params,
+		StatusCode: arw.status,
+	}
+	if t := usr.AccessToken(); t != nil {
+		data.AccessTokenID = t.ID
+	}
+	grip.Error(message.WrapError(event.LogAuditEvent(event.EventTypeAuditRESTRequest, auditResourceID(vars), data), message.Fields{
+		"message":   "could not log audit event",
+		"user":      usr.Id,
+		"method":    r.Method,
+		"path":      r.URL.Path,
+		"request":   gimlet.GetRequestID(r.Context()),
+		"operation": "audit",
+	}))
+}
+
+// logVariablesReadAuditEvent records an audit event for the user in the
+// context reading the variables of the given project or repo.
+func logVariablesReadAuditEvent(ctx context.Context, operation, resourceID string) {
+	usr, ok := gimlet.GetUser(ctx).(*user.DBUser)
+	if !ok || usr == nil {
+		return
+	}
+	data := event.AuditEventData{
+		Actor:     usr.Id,
+		Method:    http.MethodGet,
+		Operation: operation,
+	}
+	if t := usr.AccessToken(); t != nil {
+		data.AccessTokenID = t.ID
+	}
+	grip.Error(message.WrapError(event.LogAuditEvent(event.EventTypeAuditVariablesRead, resourceID, data), message.Fields{
+		"message":   "could not log audit event",
+		"user":      usr.Id,
+		"path":      operation,
+		"resource":  resourceID,
+		"request":   gimlet.GetRequestID(ctx),
+		"operation": "audit",
+	}))
+}
+
+// readAuditBody returns the request's JSON object body, if it has one that's
+// small enough to record. The body is left intact for the handler to read.
+func readAuditBody(r *http.Request) map[string]interface{} {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	b, err := io.ReadAll(io.LimitReader(r.Body, maxAuditBodySize+1))
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(b), r.Body))
+	if err != nil || len(b) > maxAuditBodySize {
+		return nil
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil
+	}
+	return body
+}
+
+// auditOmitsBody returns whether the request body for the path must not be
+// recorded because the path is for one of the audit body omitted resources.
+func auditOmitsBody(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if utility.StringSliceContains(auditBodyOmittedResources, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+func auditResourceID(vars map[string]string) string {
+	for _, v := range auditResourceVars {
+		if id := vars[v]; id != "" {
+			return id
+		}
+	}
+	return ""
+}
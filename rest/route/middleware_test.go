@@ -2,9 +2,12 @@ package route
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
@@ -969,3 +972,203 @@ func TestEventLogPermission(t *testing.T) {
 	assert.Equal(http.StatusOK, rw.Code)
 	assert.Equal(3, counter)
 }
+
+func TestAccessTokenMiddleware(t *testing.T) {
+	m := NewAccessTokenMiddleware()
+	newRequest := func(method, path, key, userID string) *http.Request {
+		r := httptest.NewRequest(method, path, nil)
+		r.Header.Set(evergreen.APIKeyHeader, key)
+		r.Header.Set(evergreen.APIUserHeader, userID)
+		return r
+	}
+	for testName, testCase := range map[string]func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder){
+		"AttachesUserForValidToken": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodPost, "/rest/v2/patches/p1", token, usr.Id)
+			var attached *user.DBUser
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				attached, _ = gimlet.GetUser(r.Context()).(*user.DBUser)
+				assert.Empty(t, r.Header.Get(evergreen.APIKeyHeader))
+			})
+			assert.Equal(t, http.StatusOK, rw.Code)
+			require.NotNil(t, attached)
+			assert.Equal(t, usr.Id, attached.Id)
+			require.NotNil(t, attached.AccessToken())
+			assert.Equal(t, user.AccessTokenScopePatchSubmit, attached.AccessToken().Scope)
+		},
+		"IgnoresAPIKeys": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodPost, "/rest/v2/hosts", usr.APIKey, usr.Id)
+			called := false
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				called = true
+				assert.Equal(t, usr.APIKey, r.Header.Get(evergreen.APIKeyHeader))
+				assert.Nil(t, gimlet.GetUser(r.Context()))
+			})
+			assert.True(t, called)
+		},
+		"FailsForRequestOutsideScope": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodDelete, "/rest/v2/hosts/h1", token, usr.Id)
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.Fail(t, "should not have called next handler")
+			})
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+		},
+		"FailsForNonAPIRoutes": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodGet, "/settings", token, usr.Id)
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.Fail(t, "should not have called next handler")
+			})
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+		},
+		"FailsForMismatchedUser": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodGet, "/rest/v2/hosts", token, "someone_else")
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.Fail(t, "should not have called next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+		"FailsForNonexistentToken": func(t *testing.T, usr *user.DBUser, token string, rw *httptest.ResponseRecorder) {
+			r := newRequest(http.MethodGet, "/rest/v2/hosts", user.AccessTokenPrefix+"foo", usr.Id)
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.Fail(t, "should not have called next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(user.Collection, user.AccessTokensCollection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(user.Collection, user.AccessTokensCollection))
+			}()
+			usr := &user.DBUser{Id: "me", APIKey: "api_key"}
+			require.NoError(t, usr.Insert())
+			token, err := user.CreatePersonalAccessToken(&user.PersonalAccessToken{
+				UserID:    usr.Id,
+				Name:      "ci",
+				Scope:     user.AccessTokenScopePatchSubmit,
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+
+			testCase(t, usr, token, httptest.NewRecorder())
+		})
+	}
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	m := NewAuditMiddleware()
+	usr := &user.DBUser{Id: "me"}
+	for testName, testCase := range map[string]func(t *testing.T){
+		"LogsMutatingRequest": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/rest/v2/tasks/t1?dry_run=true", strings.NewReader(`{"priority": 100, "github_token": "abc"}`))
+			r = gimlet.SetURLVars(r.WithContext(gimlet.AttachUser(r.Context(), usr)), map[string]string{"task_id": "t1"})
+			rw := httptest.NewRecorder()
+			m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), "priority", "handler should still be able to read the body")
+				rw.WriteHeader(http.StatusAccepted)
+			})
+			assert.Equal(t, http.StatusAccepted, rw.Code)
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{Actor: usr.Id})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, "t1", events[0].ResourceId)
+			data, ok := events[0].Data.(*event.AuditEventData)
+			require.True(t, ok)
+			assert.Equal(t, http.MethodPatch, data.Method)
+			assert.Equal(t, "/rest/v2/tasks/t1", data.Operation)
+			assert.Equal(t, http.StatusAccepted, data.StatusCode)
+			assert.EqualValues(t, "true", data.Params["dry_run"])
+			body, ok := data.Params["body"].(map[string]interface{})
+			require.True(t, ok)
+			assert.EqualValues(t, 100, body["priority"])
+			assert.Equal(t, event.AuditRedactedValue, body["github_token"])
+		},
+		"OmitsBodyForAdminSettings": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/rest/v2/admin/settings", strings.NewReader(`{"expansions": {"aws_secret": "abc", "api_url": "https://example.com"}}`))
+			r = r.WithContext(gimlet.AttachUser(r.Context(), usr))
+			m.ServeHTTP(httptest.NewRecorder(), r, func(rw http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), "expansions", "handler should still be able to read the body")
+			})
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{Actor: usr.Id})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			data, ok := events[0].Data.(*event.AuditEventData)
+			require.True(t, ok)
+			assert.Equal(t, "/rest/v2/admin/settings", data.Operation)
+			assert.NotContains(t, data.Params, "body")
+		},
+		"OmitsBodyForDistroSetup": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/rest/v2/distros/d1/setup", strings.NewReader(`{"setup": "export TOKEN=abc"}`))
+			r = gimlet.SetURLVars(r.WithContext(gimlet.AttachUser(r.Context(), usr)), map[string]string{"distro_id": "d1"})
+			m.ServeHTTP(httptest.NewRecorder(), r, func(rw http.ResponseWriter, r *http.Request) {})
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{ResourceID: "d1"})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			data, ok := events[0].Data.(*event.AuditEventData)
+			require.True(t, ok)
+			assert.Equal(t, "d1", data.Params["distro_id"])
+			assert.NotContains(t, data.Params, "body")
+		},
+		"OmitsBodyForSpawnHost": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/rest/v2/hosts", strings.NewReader(`{"distro": "d1", "setup_script": "export TOKEN=abc", "userdata": "password=abc"}`))
+			r = r.WithContext(gimlet.AttachUser(r.Context(), usr))
+			m.ServeHTTP(httptest.NewRecorder(), r, func(rw http.ResponseWriter, r *http.Request) {})
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{Actor: usr.Id})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			data, ok := events[0].Data.(*event.AuditEventData)
+			require.True(t, ok)
+			assert.Equal(t, "/rest/v2/hosts", data.Operation)
+			assert.NotContains(t, data.Params, "body")
+		},
+		"LogsVariablesRead": func(t *testing.T) {
+			ctx := gimlet.AttachUser(context.Background(), usr)
+			logVariablesReadAuditEvent(ctx, "/rest/v2/projects/p1", "p1")
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{ResourceID: "p1"})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.EventTypeAuditVariablesRead, events[0].EventType)
+			data, ok := events[0].Data.(*event.AuditEventData)
+			require.True(t, ok)
+			assert.Equal(t, usr.Id, data.Actor)
+			assert.Equal(t, http.MethodGet, data.Method)
+			assert.Equal(t, "/rest/v2/projects/p1", data.Operation)
+		},
+		"IgnoresReadRequest": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/rest/v2/tasks/t1", nil)
+			r = r.WithContext(gimlet.AttachUser(r.Context(), usr))
+			m.ServeHTTP(httptest.NewRecorder(), r, func(rw http.ResponseWriter, r *http.Request) {})
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, events)
+		},
+		"IgnoresRequestWithoutUser": func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/rest/v2/task/t1/start", nil)
+			called := false
+			m.ServeHTTP(httptest.NewRecorder(), r, func(rw http.ResponseWriter, r *http.Request) { called = true })
+			assert.True(t, called)
+
+			events, err := event.FindAuditEvents(event.AuditEventsQueryOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, events)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			require.NoError(t, db.Clear(event.EventCollection))
+			defer func() {
+				assert.NoError(t, db.Clear(event.EventCollection))
+			}()
+
+			testCase(t)
+		})
+	}
+}
@@ -174,7 +174,7 @@ func (p *patchRawHandler) Parse(ctx context.Context, r *http.Request) error {
 }
 
 func (p *patchRawHandler) Run(ctx context.Context) gimlet.Responder {
-	rawPatches, err := data.GetRawPatches(p.patchID)
+	rawPatches, err := data.GetRawPatches(ctx, p.patchID)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "getting raw patches for patch '%s'", p.patchID))
 	}
@@ -200,6 +200,45 @@ func (p *patchRawHandler) Run(ctx context.Context) gimlet.Responder {
 	})
 }
 
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/patches/{patch_id}/stack
+
+type patchStackHandler struct {
+	patchID string
+}
+
+func makeFetchPatchStack() gimlet.RouteHandler {
+	return &patchStackHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get patch stack
+//	@Description	Fetch the patches in the latest revision of the stack that the patch belongs to, ordered from the bottom of the stack to the top
+//	@Tags			patches
+//	@Router			/patches/{patch_id}/stack [get]
+//	@Security		Api-User || Api-Key
+//	@Param			patch_id	path	string	true	"patch ID"
+//	@Success		200			{array}	model.APIPatch
+func (p *patchStackHandler) Factory() gimlet.RouteHandler {
+	return &patchStackHandler{}
+}
+
+func (p *patchStackHandler) Parse(ctx context.Context, r *http.Request) error {
+	p.patchID = gimlet.GetVars(r)["patch_id"]
+	return nil
+}
+
+func (p *patchStackHandler) Run(ctx context.Context) gimlet.Responder {
+	patches, err := data.FindPatchStack(p.patchID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "finding stack for patch '%s'", p.patchID))
+	}
+
+	return gimlet.NewJSONResponse(patches)
+}
+
 ////////////////////////////////////////////////////////////////////////
 //
 // GET /rest/v2/patches/{patch_id}/raw_modules
@@ -231,7 +270,7 @@ func (p *moduleRawHandler) Parse(ctx context.Context, r *http.Request) error {
 }
 
 func (p *moduleRawHandler) Run(ctx context.Context) gimlet.Responder {
-	rawPatches, err := data.GetRawPatches(p.patchID)
+	rawPatches, err := data.GetRawPatches(ctx, p.patchID)
 	if err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting raw patches for patch '%s'", p.patchID))
 	}
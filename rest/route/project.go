@@ -360,6 +360,9 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 	if err := h.newProjectRef.ValidateEnabledRepotracker(); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating project repotracker"))
 	}
+	if err := h.newProjectRef.ValidateGitRemoteURL(h.settings.RepoTracker.AllowLocalGitRemotes); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating git remote URL"))
+	}
 
 	before, err := dbModel.GetProjectSettings(h.newProjectRef)
 	if err != nil {
@@ -472,6 +475,10 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(catcher.Resolve(), "invalid triggers"))
 	}
 
+	if err = h.newProjectRef.CommitQueue.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid commit queue settings"))
+	}
+
 	// Validate Parsley filters before updating project.
 	err = dbModel.ValidateParsleyFilters(h.newProjectRef.ParsleyFilters)
 	if err != nil {
@@ -860,6 +867,7 @@ func (h *projectIDGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding vars for project '%s'", project.Id))
 	}
 	projectModel.Variables = *variables
+	logVariablesReadAuditEvent(ctx, fmt.Sprintf("/rest/v2/projects/%s", h.projectName), project.Id)
 	if projectModel.Aliases, err = data.FindMergedProjectAliases(project.Id, repoId, nil, h.includeProjectConfig); err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding aliases for project '%s'", project.Id))
 	}
@@ -143,6 +143,7 @@ func (p *copyVariablesHandler) Run(ctx context.Context) gimlet.Responder {
 			if isPrivate {
 				delete(varsToCopy.Vars, key)
 				delete(varsToCopy.AdminOnlyVars, key)
+				delete(varsToCopy.ExternalSecretVars, key)
 			}
 		}
 		varsToCopy.PrivateVars = map[string]bool{}
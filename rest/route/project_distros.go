@@ -0,0 +1,196 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/distros
+
+type projectDistrosGetHandler struct{}
+
+func makeGetProjectDistros() gimlet.RouteHandler {
+	return &projectDistrosGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's distros
+//	@Description	Returns the project distros that the project's admins have derived from base distros. Project distros can only be used by their own project.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/distros [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Success		200			{array}	model.APIDistro
+func (h *projectDistrosGetHandler) Factory() gimlet.RouteHandler {
+	return &projectDistrosGetHandler{}
+}
+
+func (h *projectDistrosGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	return nil
+}
+
+func (h *projectDistrosGetHandler) Run(ctx context.Context) gimlet.Responder {
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	distros, err := distro.Find(ctx, distro.ByProjectID(pRef.Id))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding distros for project '%s'", pRef.Id))
+	}
+
+	apiDistros := []model.APIDistro{}
+	for _, d := range distros {
+		apiDistro := model.APIDistro{}
+		apiDistro.BuildFromService(d)
+		apiDistros = append(apiDistros, apiDistro)
+	}
+	return gimlet.NewJSONResponse(apiDistros)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// PUT /rest/v2/projects/{project_id}/distros/{distro_id}
+
+type projectDistroPutHandler struct {
+	distroID string
+	opts     projectDistroPutOptions
+}
+
+type projectDistroPutOptions struct {
+	// Required. The admin-approved distro to derive the project distro from.
+	BaseDistro string `json:"base_distro"`
+	// The setup script to use in place of the base distro's setup script.
+	Setup string `json:"setup"`
+	// Expansions to add to the base distro's expansions. These replace any
+	// base distro expansions with the same key.
+	Expansions []model.APIExpansion `json:"expansions"`
+	// The instance type to use in place of the base distro's instance type.
+	// It must be one of the base distro's allowed instance types.
+	InstanceType string `json:"instance_type"`
+}
+
+func makePutProjectDistro() gimlet.RouteHandler {
+	return &projectDistroPutHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Create or update a project distro
+//	@Description	Restricted to project admins. Creates or replaces a project distro derived from a base distro that allows project distros. Only the setup script, expansions, and instance type can differ from the base distro, and the project distro can only be used by this project.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/distros/{distro_id} [put]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string					true	"the project ID"
+//	@Param			distro_id	path	string					true	"the project distro ID"
+//	@Param			{object}	body	projectDistroPutOptions	true	"parameters"
+//	@Success		200
+func (h *projectDistroPutHandler) Factory() gimlet.RouteHandler {
+	return &projectDistroPutHandler{}
+}
+
+func (h *projectDistroPutHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	if err := utility.ReadJSON(r.Body, &h.opts); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+	if h.opts.BaseDistro == "" {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify a base distro",
+		}
+	}
+	return nil
+}
+
+func (h *projectDistroPutHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+
+	overrides := distro.ProjectDistroOptions{
+		Setup:        h.opts.Setup,
+		InstanceType: h.opts.InstanceType,
+	}
+	for _, e := range h.opts.Expansions {
+		overrides.Expansions = append(overrides.Expansions, e.ToService())
+	}
+	created, err := data.SaveProjectDistro(ctx, u, data.ProjectDistroOpts{
+		ProjectID:    pRef.Id,
+		DistroID:     h.distroID,
+		BaseDistroID: h.opts.BaseDistro,
+		Overrides:    overrides,
+	})
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "saving project distro '%s'", h.distroID))
+	}
+
+	responder := gimlet.NewJSONResponse(struct{}{})
+	if created {
+		if err = responder.SetStatus(http.StatusCreated); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "setting HTTP status code to %d", http.StatusCreated))
+		}
+	}
+	return responder
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/projects/{project_id}/distros/{distro_id}
+
+type projectDistroDeleteHandler struct {
+	distroID string
+}
+
+func makeDeleteProjectDistro() gimlet.RouteHandler {
+	return &projectDistroDeleteHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Delete a project distro
+//	@Description	Restricted to project admins. Deletes one of the project's distros.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/distros/{distro_id} [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Param			distro_id	path	string	true	"the project distro ID"
+//	@Success		200
+func (h *projectDistroDeleteHandler) Factory() gimlet.RouteHandler {
+	return &projectDistroDeleteHandler{}
+}
+
+func (h *projectDistroDeleteHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.distroID = gimlet.GetVars(r)["distro_id"]
+	return nil
+}
+
+func (h *projectDistroDeleteHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	pRef := MustHaveProjectContext(ctx).ProjectRef
+	d, err := distro.FindOneId(ctx, h.distroID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding distro '%s'", h.distroID))
+	}
+	if d == nil || d.ProjectID != pRef.Id {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project distro '%s' not found for project '%s'", h.distroID, pRef.Id),
+		})
+	}
+	if err = data.DeleteDistroById(ctx, u, h.distroID); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "deleting project distro '%s'", h.distroID))
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
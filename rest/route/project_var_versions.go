@@ -0,0 +1,167 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// getVarOwnerId returns the ID of the project or repo that owns the variable.
+func getVarOwnerId(identifier string, isRepo bool) (string, error) {
+	if isRepo {
+		repoRef, err := dbModel.FindOneRepoRef(identifier)
+		if err != nil {
+			return "", errors.Wrapf(err, "finding repo '%s'", identifier)
+		}
+		if repoRef == nil {
+			return "", gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("repo '%s' not found", identifier),
+			}
+		}
+		return repoRef.Id, nil
+	}
+	pRef, err := dbModel.FindBranchProjectRef(identifier)
+	if err != nil {
+		return "", errors.Wrapf(err, "finding project '%s'", identifier)
+	}
+	if pRef == nil {
+		return "", gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project '%s' not found", identifier),
+		}
+	}
+	return pRef.Id, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/variables/{var_name}/versions
+// GET /rest/v2/repos/{repo_id}/variables/{var_name}/versions
+
+type projectVarVersionsGetHandler struct {
+	isRepo     bool
+	identifier string
+	varName    string
+}
+
+func makeGetProjectVarVersions(isRepo bool) gimlet.RouteHandler {
+	return &projectVarVersionsGetHandler{isRepo: isRepo}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project variable's versions
+//	@Description	Returns the kept versions of a project or repo variable, from newest to oldest. The values of private variables are never returned.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/variables/{var_name}/versions [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"the project ID"
+//	@Param			var_name	path		string	true	"the variable name"
+//	@Success		200			{array}		model.APIProjectVarVersion
+func (h *projectVarVersionsGetHandler) Factory() gimlet.RouteHandler {
+	return &projectVarVersionsGetHandler{isRepo: h.isRepo}
+}
+
+func (h *projectVarVersionsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.identifier = vars["project_id"]
+	if h.isRepo {
+		h.identifier = vars["repo_id"]
+	}
+	h.varName = vars["var_name"]
+	return nil
+}
+
+func (h *projectVarVersionsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	id, err := getVarOwnerId(h.identifier, h.isRepo)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	versions, err := data.GetProjectVarVersions(id, h.varName)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting versions of variable '%s'", h.varName))
+	}
+	resource := "projects"
+	if h.isRepo {
+		resource = "repos"
+	}
+	logVariablesReadAuditEvent(ctx, fmt.Sprintf("/rest/v2/%s/%s/variables/%s/versions", resource, h.identifier, h.varName), id)
+	return gimlet.NewJSONResponse(versions)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/projects/{project_id}/variables/{var_name}/rollback
+// POST /rest/v2/repos/{repo_id}/variables/{var_name}/rollback
+
+type projectVarRollbackHandler struct {
+	isRepo     bool
+	identifier string
+	varName    string
+	opts       projectVarRollbackOptions
+}
+
+type projectVarRollbackOptions struct {
+	// Required. The version to roll the variable back to.
+	Version int `json:"version"`
+}
+
+func makeRollbackProjectVar(isRepo bool) gimlet.RouteHandler {
+	return &projectVarRollbackHandler{isRepo: isRepo}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Roll back a project variable
+//	@Description	Restricted to project admins. Sets a project or repo variable back to the value and options it had at the given version. The rollback is recorded as a new version.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/variables/{var_name}/rollback [post]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string						true	"the project ID"
+//	@Param			var_name	path	string						true	"the variable name"
+//	@Param			{object}	body	projectVarRollbackOptions	true	"parameters"
+//	@Success		200
+func (h *projectVarRollbackHandler) Factory() gimlet.RouteHandler {
+	return &projectVarRollbackHandler{isRepo: h.isRepo}
+}
+
+func (h *projectVarRollbackHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.identifier = vars["project_id"]
+	if h.isRepo {
+		h.identifier = vars["repo_id"]
+	}
+	h.varName = vars["var_name"]
+	if err := utility.ReadJSON(r.Body, &h.opts); err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		}
+	}
+	if h.opts.Version <= 0 {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must provide a positive version to roll back to",
+		}
+	}
+	return nil
+}
+
+func (h *projectVarRollbackHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	id, err := getVarOwnerId(h.identifier, h.isRepo)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(err)
+	}
+	if err = data.RollbackProjectVar(id, h.varName, h.opts.Version, h.isRepo, u.Username()); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "rolling back variable '%s' to version %d", h.varName, h.opts.Version))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
@@ -0,0 +1,205 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	// rateLimitConfigRefreshInterval is how often the rate limiter reloads
+	// its configuration and reports throttled requests.
+	rateLimitConfigRefreshInterval = time.Minute
+	// rateLimitBucketTTL is how long a user's token bucket is kept after
+	// their last request.
+	rateLimitBucketTTL = 10 * time.Minute
+)
+
+// rateLimitedPathPrefixes are the paths of the APIs that are rate limited.
+// Other paths, such as the UI's pages and static files, are not.
+var rateLimitedPathPrefixes = []string{"/rest/", "/api/", "/graphql"}
+
+// defaultRateLimiter is shared by every app so that a user has a single
+// budget no matter which app serves their requests.
+var defaultRateLimiter = newRateLimiter(loadRateLimitConfig)
+
+func loadRateLimitConfig(ctx context.Context) (*evergreen.RateLimitConfig, error) {
+	conf := &evergreen.RateLimitConfig{}
+	if err := conf.Get(ctx); err != nil {
+		return nil, errors.Wrap(err, "getting rate limit config")
+	}
+	if err := conf.ValidateAndDefault(); err != nil {
+		return nil, errors.Wrap(err, "invalid rate limit config")
+	}
+	return conf, nil
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// take removes a token from the bucket after refilling it for the time that
+// has passed. If there are no tokens left, it returns how long until there
+// will be.
+func (b *tokenBucket) take(group evergreen.RateLimitRouteGroup, now time.Time) (bool, time.Duration) {
+	perSecond := float64(group.RequestsPerMinute) / 60
+	b.tokens = math.Min(float64(group.Burst), b.tokens+now.Sub(b.lastRefill).Seconds()*perSecond)
+	b.lastRefill = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / perSecond * float64(time.Second))
+}
+
+// rateLimiter keeps a token bucket for each user and route group.
+type rateLimiter struct {
+	mu           sync.Mutex
+	loadConfig   func(context.Context) (*evergreen.RateLimitConfig, error)
+	conf         *evergreen.RateLimitConfig
+	confLoadedAt time.Time
+	buckets      map[string]*tokenBucket
+	throttled    map[string]int
+}
+
+func newRateLimiter(loadConfig func(context.Context) (*evergreen.RateLimitConfig, error)) *rateLimiter {
+	return &rateLimiter{
+		loadConfig: loadConfig,
+		buckets:    map[string]*tokenBucket{},
+		throttled:  map[string]int{},
+	}
+}
+
+// config returns the current rate limit configuration. Periodically, it
+// reloads the configuration, removes idle buckets and reports how many
+// requests were throttled since the last time.
+func (l *rateLimiter) config(ctx context.Context, now time.Time) *evergreen.RateLimitConfig {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.confLoadedAt) < rateLimitConfigRefreshInterval {
+		return l.conf
+	}
+	l.confLoadedAt = now
+
+	conf, err := l.loadConfig(ctx)
+	grip.Error(message.WrapError(err, message.Fields{
+		"message": "could not reload rate limit config, continuing to use the previous config",
+	}))
+	if err == nil {
+		l.conf = conf
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastRefill) > rateLimitBucketTTL {
+			delete(l.buckets, key)
+		}
+	}
+	if len(l.throttled) > 0 {
+		grip.Info(message.Fields{
+			"message":   "throttled requests",
+			"operation": "rate limit",
+			"throttled": l.throttled,
+		})
+		l.throttled = map[string]int{}
+	}
+
+	return l.conf
+}
+
+// allow returns whether the user can make a request to the route group. If
+// not, it returns how long until they can.
+func (l *rateLimiter) allow(userID string, group evergreen.RateLimitRouteGroup, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := userID + "/" + group.Name
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(group.Burst), lastRefill: now}
+		l.buckets[key] = b
+	}
+	allowed, retryAfter := b.take(group, now)
+	if !allowed {
+		l.throttled[key]++
+	}
+	return allowed, retryAfter
+}
+
+type rateLimitMiddleware struct {
+	limiter *rateLimiter
+}
+
+// NewRateLimitMiddleware returns a middleware that limits how often each user
+// can make requests to the REST API and GraphQL, according to the rate limit
+// config. Requests without a user, such as those from agents, and exempt users
+// are not limited.
+func NewRateLimitMiddleware() gimlet.Middleware {
+	return &rateLimitMiddleware{limiter: defaultRateLimiter}
+}
+
+func (m *rateLimitMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if !isRateLimitedPath(r.URL.Path) {
+		next(rw, r)
+		return
+	}
+	usr, ok := gimlet.GetUser(r.Context()).(*user.DBUser)
+	if !ok || usr == nil {
+		next(rw, r)
+		return
+	}
+
+	now := time.Now()
+	conf := m.limiter.config(r.Context(), now)
+	if conf == nil || !conf.Enabled || conf.IsExempt(usr.Id, usr.OnlyAPI) {
+		next(rw, r)
+		return
+	}
+
+	group := conf.RouteGroup(r.URL.Path)
+	allowed, retryAfter := m.limiter.allow(usr.Id, group, now)
+	if !allowed {
+		retryAfterSecs := int(math.Ceil(retryAfter.Seconds()))
+		grip.Debug(message.Fields{
+			"message":          "throttled request",
+			"operation":        "rate limit",
+			"user":             usr.Id,
+			"service_user":     usr.OnlyAPI,
+			"route_group":      group.Name,
+			"method":           r.Method,
+			"path":             r.URL.Path,
+			"retry_after_secs": retryAfterSecs,
+			"request":          gimlet.GetRequestID(r.Context()),
+		})
+		rw.Header().Set("Retry-After", strconv.Itoa(retryAfterSecs))
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusTooManyRequests,
+			Message:    fmt.Sprintf("user '%s' has exceeded the rate limit of %d requests per minute for route group '%s'", usr.Id, group.RequestsPerMinute, group.Name),
+		}))
+		return
+	}
+
+	next(rw, r)
+}
+
+func isRateLimitedPath(path string) bool {
+	for _, prefix := range rateLimitedPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
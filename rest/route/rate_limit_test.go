@@ -0,0 +1,136 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	group := evergreen.RateLimitRouteGroup{Name: "g", RequestsPerMinute: 60, Burst: 2}
+	now := time.Now()
+	b := &tokenBucket{tokens: float64(group.Burst), lastRefill: now}
+
+	for i := 0; i < group.Burst; i++ {
+		allowed, _ := b.take(group, now)
+		assert.True(t, allowed, "request %d should be within the burst", i)
+	}
+	allowed, retryAfter := b.take(group, now)
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	allowed, _ = b.take(group, now.Add(time.Second))
+	assert.True(t, allowed, "bucket should refill one token per second")
+
+	allowed, _ = b.take(group, now.Add(time.Hour))
+	assert.True(t, allowed)
+	assert.InDelta(t, float64(group.Burst-1), b.tokens, 0.001, "bucket should not refill past the burst")
+}
+
+func TestRateLimiter(t *testing.T) {
+	conf := &evergreen.RateLimitConfig{
+		Enabled:           true,
+		RequestsPerMinute: 60,
+		Burst:             1,
+		RouteGroups: []evergreen.RateLimitRouteGroup{
+			{Name: "graphql", PathPrefixes: []string{"/graphql"}, RequestsPerMinute: 60, Burst: 2},
+		},
+	}
+	loads := 0
+	l := newRateLimiter(func(context.Context) (*evergreen.RateLimitConfig, error) {
+		loads++
+		if loads > 1 {
+			return nil, errors.New("database is down")
+		}
+		return conf, nil
+	})
+	ctx := context.Background()
+	now := time.Now()
+
+	assert.Equal(t, conf, l.config(ctx, now))
+	assert.Equal(t, conf, l.config(ctx, now.Add(time.Second)))
+	assert.Equal(t, 1, loads, "config should be cached")
+	assert.Equal(t, conf, l.config(ctx, now.Add(rateLimitConfigRefreshInterval)), "previous config should be used when reloading fails")
+	assert.Equal(t, 2, loads)
+
+	group := conf.RouteGroup("/rest/v2/tasks")
+	allowed, _ := l.allow("user0", group, now)
+	assert.True(t, allowed)
+	allowed, _ = l.allow("user0", group, now)
+	assert.False(t, allowed)
+	allowed, _ = l.allow("user1", group, now)
+	assert.True(t, allowed, "users should have separate buckets")
+	allowed, _ = l.allow("user0", conf.RouteGroup("/graphql/query"), now)
+	assert.True(t, allowed, "route groups should have separate buckets")
+	assert.Equal(t, 1, l.throttled["user0/"+evergreen.DefaultRateLimitRouteGroup])
+
+	l.config(ctx, now.Add(rateLimitBucketTTL+time.Minute))
+	assert.Empty(t, l.buckets, "idle buckets should be removed")
+	assert.Empty(t, l.throttled, "throttled counts should be reset after being reported")
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	newMiddleware := func(conf evergreen.RateLimitConfig) gimlet.Middleware {
+		require.NoError(t, conf.ValidateAndDefault())
+		return &rateLimitMiddleware{limiter: newRateLimiter(func(context.Context) (*evergreen.RateLimitConfig, error) {
+			return &conf, nil
+		})}
+	}
+	newRequest := func(path string, usr *user.DBUser) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if usr != nil {
+			r = r.WithContext(gimlet.AttachUser(r.Context(), usr))
+		}
+		return r
+	}
+	serve := func(m gimlet.Middleware, r *http.Request) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		m.ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+		})
+		return rw
+	}
+	usr := &user.DBUser{Id: "me"}
+
+	t.Run("ThrottlesUserOverLimit", func(t *testing.T) {
+		m := newMiddleware(evergreen.RateLimitConfig{Enabled: true, RequestsPerMinute: 1})
+		assert.Equal(t, http.StatusOK, serve(m, newRequest("/rest/v2/tasks/t1", usr)).Code)
+		rw := serve(m, newRequest("/rest/v2/tasks/t1", usr))
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+		assert.NotEmpty(t, rw.Header().Get("Retry-After"))
+	})
+	t.Run("DoesNotThrottleWhenDisabled", func(t *testing.T) {
+		m := newMiddleware(evergreen.RateLimitConfig{RequestsPerMinute: 1})
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, serve(m, newRequest("/rest/v2/tasks/t1", usr)).Code)
+		}
+	})
+	t.Run("DoesNotThrottleRequestsWithoutUser", func(t *testing.T) {
+		m := newMiddleware(evergreen.RateLimitConfig{Enabled: true, RequestsPerMinute: 1})
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, serve(m, newRequest("/rest/v2/task/t1/start", nil)).Code)
+		}
+	})
+	t.Run("DoesNotThrottleExemptServiceUsers", func(t *testing.T) {
+		m := newMiddleware(evergreen.RateLimitConfig{Enabled: true, RequestsPerMinute: 1, ExemptServiceUsers: true})
+		serviceUser := &user.DBUser{Id: "bot", OnlyAPI: true}
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, serve(m, newRequest("/rest/v2/tasks/t1", serviceUser)).Code)
+		}
+	})
+	t.Run("DoesNotThrottleUIPages", func(t *testing.T) {
+		m := newMiddleware(evergreen.RateLimitConfig{Enabled: true, RequestsPerMinute: 1})
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, serve(m, newRequest("/waterfall", usr)).Code)
+		}
+	})
+}
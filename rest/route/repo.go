@@ -3,6 +3,7 @@ package route
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -57,6 +58,7 @@ func (h *repoIDGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding project vars for repo '%s'", h.repoName))
 	}
 	repoModel.Variables = *repoVars
+	logVariablesReadAuditEvent(ctx, fmt.Sprintf("/rest/v2/repos/%s", h.repoName), repo.Id)
 
 	if repoModel.Aliases, err = data.FindMergedProjectAliases("", repo.Id, nil, false); err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding project aliases for repo '%s'", h.repoName))
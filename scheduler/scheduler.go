@@ -266,8 +266,20 @@ func SpawnHosts(ctx context.Context, d distro.Distro, newHostsNeeded int, pool *
 			"duration_secs":      time.Since(startTime).Seconds(),
 		})
 	} else { // create intent documents for regular hosts
+		rollout, err := distro.FindActiveImageRollout(ctx, d.Id)
+		grip.Error(message.WrapError(err, message.Fields{
+			"message": "could not find image rollout for distro, so all new hosts will use the distro's current image",
+			"runner":  RunnerName,
+			"distro":  d.Id,
+		}))
+		needsCandidate, err := needsCandidateHost(ctx, rollout)
+		grip.Error(message.WrapError(err, message.Fields{
+			"message": "could not check whether image rollout needs a candidate host for its canary tasks",
+			"runner":  RunnerName,
+			"distro":  d.Id,
+		}))
 		for i := 0; i < numHostsToSpawn; i++ {
-			intent, err := generateIntentHost(d, pool)
+			intent, err := generateIntentHostWithRollout(d, pool, rollout, needsCandidate && i == 0)
 			if err != nil {
 				return nil, errors.Wrap(err, "generating intent host")
 			}
@@ -319,6 +331,38 @@ func generateIntentHost(d distro.Distro, pool *evergreen.ContainerPool) (*host.H
 	return host.NewIntent(hostOptions), nil
 }
 
+// needsCandidateHost returns whether the image rollout has configured canary
+// tasks but no candidate hosts that are up to run them.
+func needsCandidateHost(ctx context.Context, rollout *distro.ImageRollout) (bool, error) {
+	if rollout == nil || len(rollout.CanaryTaskIDs) == 0 {
+		return false, nil
+	}
+	numCandidates, err := host.Count(ctx, host.UpByImageRolloutID(rollout.ID))
+	if err != nil {
+		return false, errors.Wrapf(err, "counting candidate hosts for image rollout '%s'", rollout.ID)
+	}
+	return numCandidates == 0, nil
+}
+
+// generateIntentHostWithRollout creates a host intent document for a regular
+// host. If the distro has an image rollout in progress, the host may use the
+// rollout's candidate image, and it always does if useCandidate is set.
+func generateIntentHostWithRollout(d distro.Distro, pool *evergreen.ContainerPool, rollout *distro.ImageRollout, useCandidate bool) (*host.Host, error) {
+	if rollout == nil || (!useCandidate && !rollout.ShouldUseCandidate()) {
+		return generateIntentHost(d, pool)
+	}
+	candidate, err := rollout.ApplyCandidate(d)
+	if err != nil {
+		return nil, errors.Wrapf(err, "applying candidate image from image rollout '%s'", rollout.ID)
+	}
+	intent, err := generateIntentHost(candidate, pool)
+	if err != nil {
+		return nil, err
+	}
+	intent.ImageRolloutID = rollout.ID
+	return intent, nil
+}
+
 // pass the empty string to unschedule all distros.
 func underwaterUnschedule(ctx context.Context, distroID string) error {
 	num, err := task.UnscheduleStaleUnderwaterHostTasks(ctx, distroID)
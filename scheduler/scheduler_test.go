@@ -71,6 +71,37 @@ func TestSpawnHosts(t *testing.T) {
 	})
 }
 
+func (s *SchedulerSuite) TestGenerateIntentHostWithRollout() {
+	d := distro.Distro{
+		Id:       "distro",
+		Provider: evergreen.ProviderNameEc2Fleet,
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", "old_ami"),
+		)},
+	}
+	rollout := &distro.ImageRollout{
+		ID:             "rollout",
+		Region:         evergreen.DefaultEC2Region,
+		CandidateAMI:   "new_ami",
+		HostPercentage: 0,
+	}
+
+	intent, err := generateIntentHostWithRollout(d, nil, rollout, false)
+	s.Require().NoError(err)
+	s.Empty(intent.ImageRolloutID)
+	s.Equal("old_ami", intent.GetAMI())
+
+	intent, err = generateIntentHostWithRollout(d, nil, rollout, true)
+	s.Require().NoError(err)
+	s.Equal("rollout", intent.ImageRolloutID)
+	s.Equal("new_ami", intent.GetAMI())
+
+	intent, err = generateIntentHostWithRollout(d, nil, nil, true)
+	s.Require().NoError(err)
+	s.Empty(intent.ImageRolloutID)
+}
+
 func (s *SchedulerSuite) TestSpawnHostsParents() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
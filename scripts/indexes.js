@@ -155,10 +155,19 @@ db.patches.ensureIndex({
 db.patches.ensureIndex({
     "version": 1
 })
+db.patches.ensureIndex({
+    "patches.patch_set.patch_file_id": 1
+})
 db.patches.ensureIndex({
     "author": 1,
     "create_time": 1
 })
+db.patches.ensureIndex({
+    "branch": 1,
+    "author": 1,
+    "stack.id": 1,
+    "stack.revision": -1
+})
 db.patches.ensureIndex({
     "github_patch_data.pr_number": 1,
     "github_patch_data.base_repo": 1,
@@ -404,6 +413,15 @@ db.testresults.ensureIndex({
     "task_execution": 1
 })
 
+//======project_var_versions======//
+db.project_var_versions.createIndex({
+    "project_id": 1,
+    "name": 1,
+    "version": 1
+}, {
+    "unique": true
+})
+
 //======project_aliases======//
 db.project_aliases.ensureIndex({
     "project_id": 1,
@@ -249,6 +249,9 @@ func (as *APIServer) validateProjectConfig(w http.ResponseWriter, r *http.Reques
 		} else {
 			isConfigDefined := projectConfig != nil
 			errs = append(errs, validator.CheckProjectSettings(ctx, evergreen.GetEnvironment().Settings(), project, projectRef, isConfigDefined)...)
+			if !input.Quiet {
+				errs = append(errs, validator.CheckPathFilterMatches(ctx, project, projectRef)...)
+			}
 		}
 	} else {
 		validationErr = validator.ValidationError{
@@ -341,6 +344,7 @@ func (as *APIServer) GetServiceApp() *gimlet.APIApp {
 	app.SetPrefix("/api")
 	app.NoVersions = true
 	app.SimpleVersions = true
+	app.AddWrapper(route.NewAuditMiddleware())
 
 	// Project lookup and validation routes
 	app.AddRoute("/ref/{projectId}").Wrap(requireUser).Handler(as.fetchLimitedProjectRef).Get()
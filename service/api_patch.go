@@ -4,11 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
-	"github.com/evergreen-ci/evergreen/db"
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -104,6 +102,7 @@ type patchData struct {
 	Project           string             `json:"project"`
 	BackportInfo      patch.BackportInfo `json:"backport_info"`
 	GitMetadata       *patch.GitMetadata `json:"git_metadata"`
+	Stack             patch.StackInfo    `json:"stack"`
 	PatchBytes        []byte             `json:"patch_bytes"`
 	Githash           string             `json:"githash"`
 	Parameters        []patch.Parameter  `json:"parameters"`
@@ -206,6 +205,7 @@ func (as *APIServer) submitPatch(w http.ResponseWriter, r *http.Request) {
 		TriggerAliases:   data.TriggerAliases,
 		BackportOf:       data.BackportInfo,
 		GitInfo:          data.GitMetadata,
+		Stack:            data.Stack,
 		RepeatDefinition: data.RepeatDefinition,
 		RepeatFailed:     data.RepeatFailed,
 		RepeatPatchId:    data.RepeatPatchId,
@@ -329,11 +329,9 @@ func (as *APIServer) updatePatchModule(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// write the patch content into a GridFS file under a new ObjectId.
-	patchFileId := mgobson.NewObjectId().Hex()
-	err = db.WriteGridFile(patch.GridFSPrefix, patchFileId, strings.NewReader(patchContent))
+	patchFileId, err := patch.StorePatchDiff(patchContent)
 	if err != nil {
-		as.LoggedError(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to write patch file to db"))
+		as.LoggedError(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to store patch diff"))
 		return
 	}
 
@@ -249,6 +249,12 @@ func (uis *UIServer) modifyDistro(w http.ResponseWriter, r *http.Request) {
 		event.LogDistroAMIModified(id, u.Username())
 	}
 	event.LogDistroModified(id, u.Username(), oldDistro.DistroData(), newDistro.DistroData())
+	if err = model.UpdateProjectDistros(r.Context(), oldDistro, &newDistro, u.Username()); err != nil {
+		message := fmt.Sprintf("error updating project distros derived from distro: %v", err)
+		PushFlash(uis.CookieStore, r, w, NewErrorFlash(message))
+		http.Error(w, message, http.StatusInternalServerError)
+		return
+	}
 
 	message := fmt.Sprintf("Distro %v successfully updated.", id)
 	if shouldDeco {
@@ -125,7 +125,7 @@ func (uis *UIServer) diffPage(w http.ResponseWriter, r *http.Request) {
 			http.StatusInternalServerError)
 		return
 	}
-	if err = fullPatch.FetchPatchFiles(false); err != nil {
+	if err = fullPatch.FetchPatchFiles(r.Context(), false); err != nil {
 		http.Error(w, fmt.Sprintf("finding patch files: %s", err.Error()),
 			http.StatusInternalServerError)
 		return
@@ -145,7 +145,7 @@ func (uis *UIServer) fileDiffPage(w http.ResponseWriter, r *http.Request) {
 			http.StatusInternalServerError)
 		return
 	}
-	if err = fullPatch.FetchPatchFiles(false); err != nil {
+	if err = fullPatch.FetchPatchFiles(r.Context(), false); err != nil {
 		http.Error(w, fmt.Sprintf("error finding patch: %s", err.Error()),
 			http.StatusInternalServerError)
 	}
@@ -170,7 +170,7 @@ func (uis *UIServer) rawDiffPage(w http.ResponseWriter, r *http.Request) {
 			http.StatusInternalServerError)
 		return
 	}
-	if err = fullPatch.FetchPatchFiles(true); err != nil {
+	if err = fullPatch.FetchPatchFiles(r.Context(), true); err != nil {
 		http.Error(w, fmt.Sprintf("error fetching patch files: %s", err.Error()),
 			http.StatusInternalServerError)
 		return
@@ -44,9 +44,11 @@ func GetServer(addr string, n http.Handler) *http.Server {
 func GetRouter(as *APIServer, uis *UIServer) (http.Handler, error) {
 	app := gimlet.NewApp()
 	app.AddMiddleware(gimlet.MakeRecoveryLogger())
+	app.AddMiddleware(route.NewAccessTokenMiddleware())
 	app.AddMiddleware(gimlet.UserMiddleware(uis.env.UserManager(), uis.umconf))
 	app.AddMiddleware(gimlet.NewAuthenticationHandler(gimlet.NewBasicAuthenticator(nil, nil), uis.env.UserManager()))
 	app.AddMiddleware(gimlet.NewStatic("", http.Dir(filepath.Join(uis.Home, "public"))))
+	app.AddMiddleware(route.NewRateLimitMiddleware())
 
 	clients := gimlet.NewApp()
 	clients.AddMiddleware(gimlet.NewGzipDefault())
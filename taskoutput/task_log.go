@@ -140,7 +140,7 @@ func (o TaskLogOutput) getLogName(taskOpts TaskOptions, logType TaskLogType) str
 }
 
 func (o TaskLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
-	b, err := newBucket(ctx, o.BucketConfig)
+	b, err := NewBucket(ctx, o.BucketConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (o TestLogOutput) getLogNames(taskOpts TaskOptions, logPaths []string) []st
 }
 
 func (o TestLogOutput) getLogService(ctx context.Context) (log.LogService, error) {
-	b, err := newBucket(ctx, o.BucketConfig)
+	b, err := NewBucket(ctx, o.BucketConfig)
 	if err != nil {
 		return nil, err
 	}
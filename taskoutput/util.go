@@ -10,7 +10,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func newBucket(ctx context.Context, config evergreen.BucketConfig) (pail.Bucket, error) {
+// NewBucket returns a pail bucket for the given bucket configuration.
+func NewBucket(ctx context.Context, config evergreen.BucketConfig) (pail.Bucket, error) {
 	switch config.Type {
 	case evergreen.BucketTypeS3:
 		return pail.NewS3Bucket(pail.S3Options{
@@ -150,6 +150,17 @@ func MockConfig() *evergreen.Settings {
 				UserGroup:          "group",
 				ExpireAfterMinutes: 60,
 			},
+			OIDC: &evergreen.OIDCConfig{
+				Issuer:             "https://oidc.example.com",
+				ClientID:           "oidc_id",
+				ClientSecret:       "oidc_secret",
+				Scopes:             []string{"openid", "email", "profile", "offline_access"},
+				UsernameClaim:      "email",
+				EmailDomain:        "example.com",
+				GroupsClaim:        "groups",
+				GroupRoles:         []evergreen.OIDCGroupRoles{{Group: "admins", Roles: []string{"superuser"}}},
+				ExpireAfterMinutes: 60,
+			},
 			Naive: &evergreen.NaiveAuthConfig{
 				Users: []evergreen.AuthUser{{Username: "user", Password: "pw"}},
 			},
@@ -174,6 +185,15 @@ func MockConfig() *evergreen.Settings {
 				Name: "logs",
 				Type: evergreen.BucketTypeS3,
 			},
+			ArtifactBucket: evergreen.BucketConfig{
+				Name: "artifacts",
+				Type: evergreen.BucketTypeS3,
+			},
+			ArtifactProjectDailyQuotaMB: 1024,
+			PatchDiffBucket: evergreen.BucketConfig{
+				Name: "patch-diffs",
+				Type: evergreen.BucketTypeS3,
+			},
 		},
 		Cedar: evergreen.CedarConfig{
 			BaseURL: "url.com",
@@ -186,6 +206,8 @@ func MockConfig() *evergreen.Settings {
 			MergeTaskDistro: "distro",
 			CommitterName:   "Evergreen Commit Queue",
 			CommitterEmail:  "evergreen@mongodb.com",
+			BatchSize:       4,
+			BatchMode:       true,
 		},
 		ConfigDir: "cfg_dir",
 		ContainerPools: evergreen.ContainerPoolsConfig{
@@ -373,6 +395,7 @@ func MockConfig() *evergreen.Settings {
 			NumNewRepoRevisionsToFetch: 10,
 			MaxRepoRevisionsToSearch:   20,
 			MaxConcurrentRequests:      30,
+			AllowLocalGitRemotes:       true,
 		},
 		Scheduler: evergreen.SchedulerConfig{
 			TaskFinder: "legacy",
@@ -451,6 +474,46 @@ func MockConfig() *evergreen.Settings {
 		GitHubCheckRun: evergreen.GitHubCheckRunConfig{
 			CheckRunLimit: 0,
 		},
+		Gitlab: evergreen.GitlabConfig{
+			URL:           "https://gitlab.example.com",
+			Token:         "gitlab_token",
+			WebhookSecret: "gitlab_secret",
+		},
+		RateLimit: evergreen.RateLimitConfig{
+			Enabled:            true,
+			RequestsPerMinute:  600,
+			Burst:              100,
+			ExemptServiceUsers: true,
+			ExemptUsers:        []string{"exempt_user"},
+			RouteGroups: []evergreen.RateLimitRouteGroup{
+				{
+					Name:              "tasks",
+					PathPrefixes:      []string{"/rest/v2/tasks"},
+					RequestsPerMinute: 60,
+					Burst:             10,
+				},
+			},
+		},
+		ProjectVars: evergreen.ProjectVarsConfig{
+			VersionHistoryLimit: 5,
+			EncryptionKey:       "project_vars_key",
+		},
+		SecretProviders: evergreen.SecretProvidersConfig{
+			Vault: evergreen.VaultSecretProviderConfig{
+				URL:       "https://vault.example.com",
+				Token:     "vault_token",
+				Namespace: "evergreen",
+				KVMounts:  []string{"secret"},
+			},
+			AWSSecretsManager: evergreen.AWSSecretsManagerProviderConfig{
+				Enabled: true,
+				Region:  "us-east-1",
+				Role:    "secrets_role",
+			},
+			ProjectPathPrefixes: []evergreen.ProjectSecretPathPrefix{
+				{ProjectID: "project", PathPrefix: "secret/data/project"},
+			},
+		},
 		ShutdownWaitSeconds: 15,
 	}
 }
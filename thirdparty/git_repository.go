@@ -0,0 +1,226 @@
+package thirdparty
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The helpers in this file run plain git commands, so they work with any git
+// host (or a repository on the local filesystem) rather than only GitHub.
+
+// gitMirrorsDir is the directory containing the local bare mirrors of remote
+// repositories.
+var gitMirrorsDir = filepath.Join(os.TempDir(), "evergreen-git-mirrors")
+
+// GitMirrorDir returns the directory of the local bare mirror with the given
+// name.
+func GitMirrorDir(name string) string {
+	return filepath.Join(gitMirrorsDir, name+".git")
+}
+
+// GitCommit is a commit read from a local git repository.
+type GitCommit struct {
+	SHA         string
+	AuthorName  string
+	AuthorEmail string
+	Message     string
+	CommitTime  time.Time
+}
+
+const (
+	// gitLogFieldSeparator and gitLogRecordSeparator delimit the fields of
+	// each commit and the commits themselves in formatted git log output.
+	gitLogFieldSeparator  = "\x00"
+	gitLogRecordSeparator = "\x1e"
+	gitLogFormat          = "--format=%H%x00%an%x00%ae%x00%ct%x00%B%x1e"
+)
+
+// runGit runs a git command and returns its standard output. The arguments
+// are deliberately left out of the error, since remote URLs may contain
+// credentials.
+func runGit(ctx context.Context, gitDir string, args ...string) ([]byte, error) {
+	if gitDir != "" {
+		args = append([]string{"--git-dir", gitDir}, args...)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Never prompt for credentials, since there is no one to answer.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if err := cmd.Run(); err != nil {
+		subcommand := args[0]
+		if gitDir != "" {
+			subcommand = args[2]
+		}
+		return nil, errors.Wrapf(err, "running 'git %s': %s", subcommand, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// GitLsRemoteBranch returns the revision at the head of the branch in the
+// remote repository.
+func GitLsRemoteBranch(ctx context.Context, remoteURL, branch string) (string, error) {
+	out, err := runGit(ctx, "", "ls-remote", "--heads", "--", remoteURL, "refs/heads/"+branch)
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) < 2 {
+		return "", errors.Errorf("branch '%s' not found in remote repository", branch)
+	}
+	return fields[0], nil
+}
+
+// GitFetchBranch fetches the branch from the remote repository into the bare
+// repository in gitDir, creating it if it does not exist yet. The fetch is
+// skipped if the local branch is already up to date with the remote.
+func GitFetchBranch(ctx context.Context, gitDir, remoteURL, branch string) error {
+	head, err := GitLsRemoteBranch(ctx, remoteURL, branch)
+	if err != nil {
+		return errors.Wrap(err, "getting remote branch head")
+	}
+
+	ref := "refs/heads/" + branch
+	if local, err := runGit(ctx, gitDir, "rev-parse", "--verify", "--quiet", ref); err == nil && strings.TrimSpace(string(local)) == head {
+		return nil
+	}
+
+	return errors.Wrap(GitFetchRefs(ctx, gitDir, remoteURL, ref), "fetching branch")
+}
+
+// GitFetchRefs fetches the refs from the remote repository into the same refs
+// in the bare repository in gitDir, creating it if it does not exist yet.
+func GitFetchRefs(ctx context.Context, gitDir, remoteURL string, refs ...string) error {
+	if _, err := os.Stat(filepath.Join(gitDir, "HEAD")); os.IsNotExist(err) {
+		if err = os.MkdirAll(gitDir, 0755); err != nil {
+			return errors.Wrapf(err, "making git directory '%s'", gitDir)
+		}
+		if _, err = runGit(ctx, "", "init", "--bare", gitDir); err != nil {
+			return errors.Wrap(err, "initializing bare repository")
+		}
+	} else if err != nil {
+		return errors.Wrapf(err, "checking git directory '%s'", gitDir)
+	}
+
+	// The "--" keeps git from interpreting a remote URL that begins with a
+	// dash as an option.
+	args := []string{"fetch", "--force", "--no-tags", "--", remoteURL}
+	for _, ref := range refs {
+		args = append(args, "+"+ref+":"+ref)
+	}
+	_, err := runGit(ctx, gitDir, args...)
+	return err
+}
+
+// GitHasCommit returns whether the revision exists in the repository in
+// gitDir.
+func GitHasCommit(ctx context.Context, gitDir, revision string) bool {
+	_, err := runGit(ctx, gitDir, "cat-file", "-e", revision+"^{commit}")
+	return err == nil
+}
+
+// GitShowFile returns the contents of the file at the given path as of the
+// revision. It returns a FileNotFoundError if the file does not exist at that
+// revision.
+func GitShowFile(ctx context.Context, gitDir, revision, path string) ([]byte, error) {
+	if !GitHasCommit(ctx, gitDir, revision) {
+		return nil, errors.Errorf("revision '%s' not found", revision)
+	}
+	object := revision + ":" + strings.TrimPrefix(path, "/")
+	if _, err := runGit(ctx, gitDir, "cat-file", "-e", object); err != nil {
+		return nil, FileNotFoundError{filepath: path}
+	}
+	return runGit(ctx, gitDir, "cat-file", "blob", object)
+}
+
+// GitChangedFiles returns the paths of the files that the revision modified.
+// For merge commits, files are compared against the first parent.
+func GitChangedFiles(ctx context.Context, gitDir, revision string) ([]string, error) {
+	out, err := runGit(ctx, gitDir, "diff-tree", "-r", "-z", "--root", "-m", "--first-parent", "--no-commit-id", "--name-only", revision)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, f := range strings.Split(string(out), "\x00") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// GitDiff returns the diff from the base revision to the head revision in a
+// format that git apply accepts, including binary changes.
+func GitDiff(ctx context.Context, gitDir, base, head string) (string, error) {
+	out, err := runGit(ctx, gitDir, "diff", "--binary", "--no-color", "--no-ext-diff", base, head, "--")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// GitLog returns at most maxCount commits reachable from ref, with the most
+// recent commit first.
+func GitLog(ctx context.Context, gitDir, ref string, maxCount int) ([]GitCommit, error) {
+	out, err := runGit(ctx, gitDir, "log", "--first-parent", "--max-count="+strconv.Itoa(maxCount), gitLogFormat, ref, "--")
+	if err != nil {
+		return nil, err
+	}
+	return parseGitLog(string(out))
+}
+
+// GitMergeBase returns the best common ancestor of the two revisions.
+func GitMergeBase(ctx context.Context, gitDir, revision1, revision2 string) (string, error) {
+	out, err := runGit(ctx, gitDir, "merge-base", revision1, revision2)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GitGetCommit returns a single commit from the repository in gitDir.
+func GitGetCommit(ctx context.Context, gitDir, revision string) (*GitCommit, error) {
+	commits, err := GitLog(ctx, gitDir, revision, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 {
+		return nil, errors.Errorf("revision '%s' not found", revision)
+	}
+	return &commits[0], nil
+}
+
+func parseGitLog(out string) ([]GitCommit, error) {
+	commits := []GitCommit{}
+	for _, record := range strings.Split(out, gitLogRecordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, gitLogFieldSeparator, 5)
+		if len(fields) != 5 {
+			return nil, errors.Errorf("git log record has %d fields, expected 5", len(fields))
+		}
+		commitTime, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing commit time for revision '%s'", fields[0])
+		}
+		commits = append(commits, GitCommit{
+			SHA:         fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			CommitTime:  time.Unix(commitTime, 0),
+			Message:     strings.TrimSpace(fields[4]),
+		})
+	}
+	return commits, nil
+}
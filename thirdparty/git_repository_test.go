@@ -0,0 +1,163 @@
+package thirdparty
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeTestGitRepo creates a git repository with a main branch and returns its
+// path.
+func makeTestGitRepo(t *testing.T) string {
+	dir := t.TempDir()
+	runTestGit(t, dir, "init", "--initial-branch=main")
+	runTestGit(t, dir, "config", "user.name", "Evergreen Tester")
+	runTestGit(t, dir, "config", "user.email", "tester@example.com")
+	return dir
+}
+
+// commitTestGitFiles writes the files to the repository and commits them,
+// returning the new revision.
+func commitTestGitFiles(t *testing.T, dir, msg string, files map[string]string) string {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	runTestGit(t, dir, "add", "-A")
+	runTestGit(t, dir, "commit", "-m", msg)
+	return runTestGit(t, dir, "rev-parse", "HEAD")
+}
+
+func runTestGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestGitRepository(t *testing.T) {
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, remote, gitDir string){
+		"LsRemoteBranchReturnsHead": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			head := commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+
+			rev, err := GitLsRemoteBranch(ctx, remote, "main")
+			require.NoError(t, err)
+			assert.Equal(t, head, rev)
+		},
+		"LsRemoteBranchFailsForNonexistentBranch": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+
+			_, err := GitLsRemoteBranch(ctx, remote, "nonexistent")
+			assert.Error(t, err)
+		},
+		"RemoteURLIsNotParsedAsOption": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+			marker := filepath.Join(t.TempDir(), "pwned")
+			malicious := "--upload-pack=touch " + marker + ";"
+
+			_, err := GitLsRemoteBranch(ctx, malicious, "main")
+			assert.Error(t, err)
+			assert.Error(t, GitFetchRefs(ctx, gitDir, malicious, "refs/heads/main"))
+			assert.NoFileExists(t, marker)
+		},
+		"FetchBranchCreatesMirrorAndFetchesNewCommits": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			first := commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+			assert.True(t, GitHasCommit(ctx, gitDir, first))
+
+			second := commitTestGitFiles(t, remote, "second", map[string]string{"b.txt": "b"})
+			assert.False(t, GitHasCommit(ctx, gitDir, second))
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+			assert.True(t, GitHasCommit(ctx, gitDir, second))
+		},
+		"LogReturnsMostRecentCommitsFirst": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			first := commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+			second := commitTestGitFiles(t, remote, "second commit\n\nwith a body", map[string]string{"b.txt": "b"})
+			third := commitTestGitFiles(t, remote, "third", map[string]string{"c.txt": "c"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+
+			commits, err := GitLog(ctx, gitDir, "refs/heads/main", 2)
+			require.NoError(t, err)
+			require.Len(t, commits, 2)
+			assert.Equal(t, third, commits[0].SHA)
+			assert.Equal(t, second, commits[1].SHA)
+			assert.Equal(t, "second commit\n\nwith a body", commits[1].Message)
+			assert.Equal(t, "Evergreen Tester", commits[1].AuthorName)
+			assert.Equal(t, "tester@example.com", commits[1].AuthorEmail)
+			assert.False(t, commits[1].CommitTime.IsZero())
+
+			commit, err := GitGetCommit(ctx, gitDir, first)
+			require.NoError(t, err)
+			assert.Equal(t, first, commit.SHA)
+			assert.Equal(t, "first", commit.Message)
+		},
+		"ShowFileReturnsContentsAtRevision": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			first := commitTestGitFiles(t, remote, "first", map[string]string{"dir/config.yml": "v1"})
+			second := commitTestGitFiles(t, remote, "second", map[string]string{"dir/config.yml": "v2"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+
+			contents, err := GitShowFile(ctx, gitDir, first, "dir/config.yml")
+			require.NoError(t, err)
+			assert.Equal(t, "v1", string(contents))
+			contents, err = GitShowFile(ctx, gitDir, second, "dir/config.yml")
+			require.NoError(t, err)
+			assert.Equal(t, "v2", string(contents))
+		},
+		"ShowFileReturnsFileNotFoundForMissingFile": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			rev := commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+
+			_, err := GitShowFile(ctx, gitDir, rev, "nonexistent.yml")
+			assert.True(t, IsFileNotFound(err))
+		},
+		"ShowFileFailsForMissingRevision": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+
+			_, err := GitShowFile(ctx, gitDir, strings.Repeat("0", 40), "a.txt")
+			assert.Error(t, err)
+			assert.False(t, IsFileNotFound(err))
+		},
+		"ChangedFilesReturnsFilesModifiedByRevision": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			first := commitTestGitFiles(t, remote, "first", map[string]string{"a.txt": "a", "dir/b.txt": "b"})
+			second := commitTestGitFiles(t, remote, "second", map[string]string{"dir/b.txt": "b2", "file with spaces.txt": "c"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+
+			files, err := GitChangedFiles(ctx, gitDir, first)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"a.txt", "dir/b.txt"}, files)
+
+			files, err = GitChangedFiles(ctx, gitDir, second)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"dir/b.txt", "file with spaces.txt"}, files)
+		},
+		"MergeBaseReturnsCommonAncestor": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			base := commitTestGitFiles(t, remote, "base", map[string]string{"a.txt": "a"})
+			runTestGit(t, remote, "checkout", "-b", "other")
+			other := commitTestGitFiles(t, remote, "other", map[string]string{"b.txt": "b"})
+			runTestGit(t, remote, "checkout", "main")
+			commitTestGitFiles(t, remote, "main", map[string]string{"c.txt": "c"})
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "main"))
+			require.NoError(t, GitFetchBranch(ctx, gitDir, remote, "other"))
+
+			mergeBase, err := GitMergeBase(ctx, gitDir, other, "refs/heads/main")
+			require.NoError(t, err)
+			assert.Equal(t, base, mergeBase)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			tCase(ctx, t, makeTestGitRepo(t), filepath.Join(t.TempDir(), "mirror.git"))
+		})
+	}
+}
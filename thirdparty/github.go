@@ -502,6 +502,53 @@ func getFile(ctx context.Context, token, owner, repo, path, ref string) (*github
 	return file, nil
 }
 
+// GetGithubRepoFiles returns the paths of all the files in the repository at
+// the given ref. It returns an error if GitHub truncated the list of files
+// because the repository is too large.
+func GetGithubRepoFiles(ctx context.Context, owner, repo, ref string) ([]string, error) {
+	caller := "GetGithubRepoFiles"
+	ctx, span := tracer.Start(ctx, caller, trace.WithAttributes(
+		attribute.String(githubEndpointAttribute, caller),
+		attribute.String(githubOwnerAttribute, owner),
+		attribute.String(githubRepoAttribute, repo),
+		attribute.String(githubRefAttribute, ref),
+	))
+	defer span.End()
+
+	token, err := getInstallationToken(ctx, owner, repo, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting installation token")
+	}
+	githubClient := getGithubClient(token, caller, retryConfig{retry: true})
+
+	tree, resp, err := githubClient.Git.GetTree(ctx, owner, repo, ref, true)
+	if resp != nil {
+		defer resp.Body.Close()
+		span.SetAttributes(attribute.Bool(githubCachedAttribute, respFromCache(resp.Response)))
+		if err != nil {
+			return nil, parseGithubErrorResponse(resp)
+		}
+	} else {
+		errMsg := fmt.Sprintf("nil response from github for '%s/%s' tree at ref '%s': %v", owner, repo, ref, err)
+		grip.Error(errMsg)
+		return nil, APIResponseError{errMsg}
+	}
+	if tree == nil {
+		return nil, APIRequestError{Message: "tree is nil"}
+	}
+	if tree.GetTruncated() {
+		return nil, errors.Errorf("file tree for '%s/%s' at ref '%s' is too large to list", owner, repo, ref)
+	}
+
+	files := make([]string, 0, len(tree.Entries))
+	for _, entry := range tree.Entries {
+		if entry.GetType() == "blob" {
+			files = append(files, entry.GetPath())
+		}
+	}
+	return files, nil
+}
+
 // SendPendingStatusToGithub sends a pending status to a Github PR patch
 // associated with a given version.
 func SendPendingStatusToGithub(ctx context.Context, input SendGithubStatusInput, urlBase string) error {
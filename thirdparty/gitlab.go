@@ -0,0 +1,161 @@
+package thirdparty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+const (
+	// gitlabDeveloperAccessLevel is the lowest GitLab project access level
+	// that can push to the project's branches.
+	gitlabDeveloperAccessLevel = 30
+	gitlabMemberStateActive    = "active"
+)
+
+// GitlabMergeRequest contains the information about a GitLab merge request
+// that a patch tests.
+type GitlabMergeRequest struct {
+	// ProjectPath is the full path of the GitLab project that the merge
+	// request targets, e.g. "group/subgroup/project".
+	ProjectPath string `bson:"project_path"`
+	// IID is the merge request's number within its project.
+	IID          int    `bson:"iid"`
+	Title        string `bson:"title"`
+	URL          string `bson:"url"`
+	SourceBranch string `bson:"source_branch"`
+	TargetBranch string `bson:"target_branch"`
+	// HeadSHA is the most recent commit on the merge request.
+	HeadSHA string `bson:"head_sha"`
+	// BaseSHA is the commit on the target branch that the merge request is
+	// diffed against. It is set once the merge request has been fetched.
+	BaseSHA string `bson:"base_sha,omitempty"`
+	// AuthorID is the GitLab user ID of the merge request's author. This is
+	// not necessarily the user whose action triggered the webhook.
+	AuthorID int `bson:"author_id"`
+	// Author is the GitLab username of the merge request's author. It is
+	// looked up from AuthorID when the patch is created.
+	Author string `bson:"author,omitempty"`
+}
+
+// Ref returns the ref under which GitLab keeps the head of the merge request.
+// Unlike the source branch, this ref is in the target project even when the
+// merge request comes from a fork.
+func (mr GitlabMergeRequest) Ref() string {
+	return fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)
+}
+
+// FetchGitlabMergeRequest fetches the head of the merge request and its
+// target branch from the remote repository into the bare mirror in gitDir.
+func FetchGitlabMergeRequest(ctx context.Context, gitDir, cloneURL string, mr GitlabMergeRequest) error {
+	if err := GitFetchRefs(ctx, gitDir, cloneURL, mr.Ref(), "refs/heads/"+mr.TargetBranch); err != nil {
+		return errors.Wrapf(err, "fetching merge request '%s!%d'", mr.ProjectPath, mr.IID)
+	}
+	if !GitHasCommit(ctx, gitDir, mr.HeadSHA) {
+		return errors.Errorf("merge request '%s!%d' no longer has head commit '%s'", mr.ProjectPath, mr.IID, mr.HeadSHA)
+	}
+	return nil
+}
+
+// GetGitlabMergeRequestDiff returns the merge request's changes relative to
+// the point where it branched from the target branch, along with the commit
+// on the target branch that it is diffed against. The merge request must
+// already be fetched into gitDir.
+func GetGitlabMergeRequestDiff(ctx context.Context, gitDir string, mr GitlabMergeRequest) (string, []Summary, string, error) {
+	base, err := GitMergeBase(ctx, gitDir, mr.HeadSHA, "refs/heads/"+mr.TargetBranch)
+	if err != nil {
+		return "", nil, "", errors.Wrapf(err, "finding merge base of merge request '%s!%d'", mr.ProjectPath, mr.IID)
+	}
+	diff, err := GitDiff(ctx, gitDir, base, mr.HeadSHA)
+	if err != nil {
+		return "", nil, "", errors.Wrapf(err, "getting diff of merge request '%s!%d'", mr.ProjectPath, mr.IID)
+	}
+	summaries, err := GetPatchSummaries(diff)
+	if err != nil {
+		return "", nil, "", errors.Wrapf(err, "getting summaries of merge request '%s!%d'", mr.ProjectPath, mr.IID)
+	}
+	return diff, summaries, base, nil
+}
+
+// gitlabMember is the subset of a GitLab project member that Evergreen uses.
+type gitlabMember struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	AccessLevel int    `json:"access_level"`
+	State       string `json:"state"`
+}
+
+// gitlabUser is the subset of a GitLab user that Evergreen uses.
+type gitlabUser struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// gitlabAPIGet makes a GET request to the API of the GitLab instance at
+// baseURL and decodes the JSON response into out. It returns whether the
+// resource was found.
+func gitlabAPIGet(ctx context.Context, baseURL, token, path string, out interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(baseURL, "/")+"/api/v4/"+path, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "creating GitLab API request")
+	}
+	req.Header.Set("PRIVATE-TOKEN", token)
+
+	client := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(client)
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "making GitLab API request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("GitLab API returned HTTP status %d", resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, errors.Wrap(err, "decoding GitLab API response")
+	}
+	return true, nil
+}
+
+// GitlabUserCanPushToProject returns whether the GitLab user with the given ID
+// is an active member of the project, directly or through a group, with at
+// least developer access. It uses the API of the GitLab instance at baseURL.
+func GitlabUserCanPushToProject(ctx context.Context, baseURL, token, projectPath string, userID int) (bool, error) {
+	if userID <= 0 {
+		return false, nil
+	}
+	// The project path is escaped as a single path segment, which is how the
+	// GitLab API accepts it in place of the numeric project ID.
+	member := gitlabMember{}
+	found, err := gitlabAPIGet(ctx, baseURL, token, fmt.Sprintf("projects/%s/members/all/%d", url.PathEscape(projectPath), userID), &member)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting member %d of GitLab project '%s'", userID, projectPath)
+	}
+	if !found {
+		return false, nil
+	}
+	return member.ID == userID && member.State == gitlabMemberStateActive && member.AccessLevel >= gitlabDeveloperAccessLevel, nil
+}
+
+// GetGitlabUsername returns the username of the GitLab user with the given ID.
+// It uses the API of the GitLab instance at baseURL.
+func GetGitlabUsername(ctx context.Context, baseURL, token string, userID int) (string, error) {
+	u := gitlabUser{}
+	found, err := gitlabAPIGet(ctx, baseURL, token, fmt.Sprintf("users/%d", userID), &u)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting GitLab user %d", userID)
+	}
+	if !found || u.Username == "" {
+		return "", errors.Errorf("GitLab user %d not found", userID)
+	}
+	return u.Username, nil
+}
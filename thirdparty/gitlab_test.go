@@ -0,0 +1,150 @@
+package thirdparty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitlabMergeRequest(t *testing.T) {
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, remote, gitDir string){
+		"FetchesMergeRequestAndTargetBranch": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			base := commitTestGitFiles(t, remote, "base", map[string]string{"a.txt": "a"})
+			runTestGit(t, remote, "checkout", "-b", "feature")
+			head := commitTestGitFiles(t, remote, "feature", map[string]string{"b.txt": "b"})
+			runTestGit(t, remote, "update-ref", "refs/merge-requests/1/head", head)
+			runTestGit(t, remote, "checkout", "main")
+
+			mr := GitlabMergeRequest{ProjectPath: "group/project", IID: 1, TargetBranch: "main", HeadSHA: head}
+			require.NoError(t, FetchGitlabMergeRequest(ctx, gitDir, remote, mr))
+			assert.True(t, GitHasCommit(ctx, gitDir, head))
+			assert.True(t, GitHasCommit(ctx, gitDir, base))
+		},
+		"FetchFailsWhenHeadIsNoLongerOnMergeRequest": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			commitTestGitFiles(t, remote, "base", map[string]string{"a.txt": "a"})
+			runTestGit(t, remote, "checkout", "-b", "feature")
+			head := commitTestGitFiles(t, remote, "feature", map[string]string{"b.txt": "b"})
+			runTestGit(t, remote, "update-ref", "refs/merge-requests/1/head", head)
+			runTestGit(t, remote, "checkout", "main")
+
+			mr := GitlabMergeRequest{ProjectPath: "group/project", IID: 1, TargetBranch: "main", HeadSHA: strings.Repeat("0", 40)}
+			assert.Error(t, FetchGitlabMergeRequest(ctx, gitDir, remote, mr))
+		},
+		"FetchFailsForNonexistentMergeRequest": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			head := commitTestGitFiles(t, remote, "base", map[string]string{"a.txt": "a"})
+
+			mr := GitlabMergeRequest{ProjectPath: "group/project", IID: 2, TargetBranch: "main", HeadSHA: head}
+			assert.Error(t, FetchGitlabMergeRequest(ctx, gitDir, remote, mr))
+		},
+		"DiffIsRelativeToMergeBase": func(ctx context.Context, t *testing.T, remote, gitDir string) {
+			base := commitTestGitFiles(t, remote, "base", map[string]string{"a.txt": "a\n"})
+			runTestGit(t, remote, "checkout", "-b", "feature")
+			head := commitTestGitFiles(t, remote, "feature", map[string]string{"a.txt": "a\nb\n", "dir/new.txt": "new\n"})
+			runTestGit(t, remote, "update-ref", "refs/merge-requests/1/head", head)
+			runTestGit(t, remote, "checkout", "main")
+			// Changes to the target branch after the merge request branched
+			// should not appear in its diff.
+			commitTestGitFiles(t, remote, "main", map[string]string{"c.txt": "c\n"})
+
+			mr := GitlabMergeRequest{ProjectPath: "group/project", IID: 1, TargetBranch: "main", HeadSHA: head}
+			require.NoError(t, FetchGitlabMergeRequest(ctx, gitDir, remote, mr))
+
+			diff, summaries, baseSHA, err := GetGitlabMergeRequestDiff(ctx, gitDir, mr)
+			require.NoError(t, err)
+			assert.Equal(t, base, baseSHA)
+			assert.Contains(t, diff, "+b")
+			assert.NotContains(t, diff, "c.txt")
+
+			require.Len(t, summaries, 2)
+			summaryByName := map[string]Summary{}
+			for _, s := range summaries {
+				summaryByName[s.Name] = s
+			}
+			assert.Equal(t, 1, summaryByName["a.txt"].Additions)
+			assert.Equal(t, 1, summaryByName["dir/new.txt"].Additions)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			remote := makeTestGitRepo(t)
+			gitDir := filepath.Join(t.TempDir(), "mirror")
+
+			tCase(ctx, t, remote, gitDir)
+		})
+	}
+}
+
+func TestGitlabUserCanPushToProject(t *testing.T) {
+	members := map[string]string{
+		"1": `{"id": 1, "username": "developer", "access_level": 30, "state": "active"}`,
+		"2": `{"id": 2, "username": "maintainer", "access_level": 40, "state": "active"}`,
+		"3": `{"id": 3, "username": "reporter", "access_level": 20, "state": "active"}`,
+		"4": `{"id": 4, "username": "blocked", "access_level": 40, "state": "blocked"}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		const prefix = "/api/v4/projects/group%2Fproject/members/all/"
+		member, ok := members[strings.TrimPrefix(r.URL.EscapedPath(), prefix)]
+		if !strings.HasPrefix(r.URL.EscapedPath(), prefix) || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(member))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for userID, expected := range map[int]bool{
+		1: true,
+		2: true,
+		3: false,
+		4: false,
+		5: false,
+		0: false,
+	} {
+		t.Run(strconv.Itoa(userID), func(t *testing.T) {
+			canPush, err := GitlabUserCanPushToProject(ctx, srv.URL, "token", "group/project", userID)
+			require.NoError(t, err)
+			assert.Equal(t, expected, canPush)
+		})
+	}
+	t.Run("FailsWithInvalidToken", func(t *testing.T) {
+		_, err := GitlabUserCanPushToProject(ctx, srv.URL, "wrong", "group/project", 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetGitlabUsername(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "token" || r.URL.Path != "/api/v4/users/5" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 5, "username": "author"}`))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	username, err := GetGitlabUsername(ctx, srv.URL, "token", 5)
+	require.NoError(t, err)
+	assert.Equal(t, "author", username)
+
+	_, err = GetGitlabUsername(ctx, srv.URL, "token", 6)
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/evergreen-ci/cocoa"
+	"github.com/evergreen-ci/cocoa/awsutil"
+	"github.com/evergreen-ci/cocoa/secret"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+type awsSecretsManagerProvider struct {
+	client cocoa.SecretsManagerClient
+}
+
+// NewAWSSecretsManagerProvider returns a provider that reads secrets from AWS
+// Secrets Manager using the given client. Paths are secret names or ARNs.
+func NewAWSSecretsManagerProvider(c cocoa.SecretsManagerClient) Provider {
+	return &awsSecretsManagerProvider{client: c}
+}
+
+// NewAWSSecretsManagerProviderFromConfig returns a provider that reads secrets
+// from AWS Secrets Manager with a client created from the config.
+func NewAWSSecretsManagerProviderFromConfig(ctx context.Context, conf evergreen.AWSSecretsManagerProviderConfig) (Provider, error) {
+	opts := awsutil.NewClientOptions()
+	if conf.Region != "" {
+		opts.SetRegion(conf.Region)
+	}
+	if conf.Role != "" {
+		opts.SetRole(conf.Role)
+	}
+	c, err := secret.NewBasicSecretsManagerClient(ctx, *opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Secrets Manager client")
+	}
+	return NewAWSSecretsManagerProvider(c), nil
+}
+
+// GetSecret returns the secret's string value. If a key is given, the secret
+// must be a JSON object and the value of that key is returned.
+func (p *awsSecretsManagerProvider) GetSecret(ctx context.Context, path, key string) (string, error) {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(path),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "getting secret value from Secrets Manager")
+	}
+	if out == nil || out.SecretString == nil {
+		return "", errors.Errorf("secret '%s' does not have a string value", path)
+	}
+	if key == "" {
+		return *out.SecretString, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(*out.SecretString), &fields); err != nil {
+		return "", errors.Errorf("secret '%s' must be a JSON object to be read by key", path)
+	}
+	val, ok := fields[key]
+	if !ok {
+		return "", errors.Errorf("key '%s' not found in secret '%s'", key, path)
+	}
+	return stringifySecretValue(val)
+}
+
+func (p *awsSecretsManagerProvider) Close(ctx context.Context) error {
+	return p.client.Close(ctx)
+}
@@ -0,0 +1,46 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+type fileProvider struct {
+	secrets map[string]map[string]string
+}
+
+// NewFileProvider returns a provider that reads secrets from a local YAML
+// file, which maps each secret's path to its keys and values. For example:
+//
+//	app/db:
+//	  username: admin
+//	  password: hunter2
+//
+// This is intended for local development and tests.
+func NewFileProvider(filePath string) (Provider, error) {
+	secrets := map[string]map[string]string{}
+	if err := utility.ReadYAMLFile(filePath, &secrets); err != nil {
+		return nil, errors.Wrapf(err, "reading secrets file '%s'", filePath)
+	}
+	return NewStaticProvider(secrets), nil
+}
+
+// NewStaticProvider returns a provider that reads secrets from the given map
+// of each secret's path to its keys and values.
+func NewStaticProvider(secrets map[string]map[string]string) Provider {
+	return &fileProvider{secrets: secrets}
+}
+
+func (p *fileProvider) GetSecret(_ context.Context, path, key string) (string, error) {
+	secret, ok := p.secrets[path]
+	if !ok {
+		return "", errors.Errorf("secret '%s' not found", path)
+	}
+	val, ok := secret[key]
+	if !ok {
+		return "", errors.Errorf("key '%s' not found in secret '%s'", key, path)
+	}
+	return val, nil
+}
@@ -0,0 +1,227 @@
+// Package secrets resolves references to secrets stored in external secret
+// stores, such as HashiCorp Vault and AWS Secrets Manager.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProviderVault is the name of the Vault KV provider.
+	ProviderVault = "vault"
+	// ProviderAWSSecretsManager is the name of the AWS Secrets Manager
+	// provider.
+	ProviderAWSSecretsManager = "aws-secrets-manager"
+	// ProviderFile is the name of the local file provider.
+	ProviderFile = "file"
+)
+
+// Provider reads secrets from a secret store.
+type Provider interface {
+	// GetSecret returns the value of the key in the secret at the given
+	// path. If the key is empty, it returns the entire secret, if the store
+	// supports that.
+	GetSecret(ctx context.Context, path, key string) (string, error)
+}
+
+// Reference identifies a secret in an external secret store. Its string form
+// is "<provider>:<path>#<key>", where the key is optional for providers that
+// can return an entire secret.
+type Reference struct {
+	Provider string
+	Path     string
+	Key      string
+}
+
+// String returns the reference in its string form.
+func (r Reference) String() string {
+	if r.Key == "" {
+		return fmt.Sprintf("%s:%s", r.Provider, r.Path)
+	}
+	return fmt.Sprintf("%s:%s#%s", r.Provider, r.Path, r.Key)
+}
+
+// ParseReference parses a reference of the form "<provider>:<path>#<key>".
+func ParseReference(ref string) (Reference, error) {
+	provider, rest, ok := strings.Cut(strings.TrimSpace(ref), ":")
+	if !ok {
+		return Reference{}, errors.Errorf("secret reference '%s' must be of the form '<provider>:<path>#<key>'", ref)
+	}
+	path, key, _ := strings.Cut(rest, "#")
+	res := Reference{
+		Provider: provider,
+		Path:     strings.Trim(path, "/"),
+		Key:      key,
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(!isValidProvider(res.Provider), "secret reference '%s' has unrecognized provider '%s'", ref, res.Provider)
+	catcher.ErrorfWhen(res.Path == "", "secret reference '%s' must have a path", ref)
+	catcher.ErrorfWhen(res.Key == "" && res.Provider != ProviderAWSSecretsManager, "secret reference '%s' must have a key", ref)
+	catcher.ErrorfWhen(res.Path != "" && !hasValidPathSegments(res.Path), "secret reference '%s' cannot have empty, '.' or '..' path segments", ref)
+
+	return res, catcher.Resolve()
+}
+
+func hasValidPathSegments(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPathPrefix returns whether the path is under the prefix. The prefix
+// only matches whole path segments.
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return false
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// CheckAllowed returns an error if the reference's path is not under any of
+// the allowed path prefixes.
+func (r Reference) CheckAllowed(allowedPathPrefixes []string) error {
+	for _, prefix := range allowedPathPrefixes {
+		if hasPathPrefix(r.Path, prefix) {
+			return nil
+		}
+	}
+	return errors.Errorf("secret path '%s' is not under any of the project's allowed secret path prefixes", r.Path)
+}
+
+// ValidateReference parses the reference and checks that the project is
+// allowed to read it using the configured secret stores.
+func ValidateReference(conf evergreen.SecretProvidersConfig, ref string, allowedPathPrefixes []string) error {
+	parsed, err := ParseReference(ref)
+	if err != nil {
+		return err
+	}
+	if err := parsed.CheckAllowed(allowedPathPrefixes); err != nil {
+		return err
+	}
+	if parsed.Provider == ProviderVault {
+		return validateVaultPath(conf.Vault.KVMounts, parsed.Path)
+	}
+	return nil
+}
+
+func isValidProvider(name string) bool {
+	switch name {
+	case ProviderVault, ProviderAWSSecretsManager, ProviderFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// Resolver resolves secret references using the providers it's configured
+// with. It only resolves references to paths under its allowed path prefixes.
+type Resolver struct {
+	providers           map[string]Provider
+	allowedPathPrefixes []string
+}
+
+// NewResolver returns a resolver that uses the given providers, keyed by
+// provider name, to read secrets under the allowed path prefixes.
+func NewResolver(providers map[string]Provider, allowedPathPrefixes []string) *Resolver {
+	if providers == nil {
+		providers = map[string]Provider{}
+	}
+	return &Resolver{providers: providers, allowedPathPrefixes: allowedPathPrefixes}
+}
+
+// NewResolverFromConfig returns a resolver with a provider for each secret
+// store that is configured, which reads secrets under the allowed path
+// prefixes. Callers must close the resolver when they are done with it.
+func NewResolverFromConfig(ctx context.Context, conf evergreen.SecretProvidersConfig, allowedPathPrefixes []string) (*Resolver, error) {
+	providers := map[string]Provider{}
+	if conf.Vault.IsConfigured() {
+		providers[ProviderVault] = NewVaultProvider(conf.Vault)
+	}
+	if conf.AWSSecretsManager.Enabled {
+		p, err := NewAWSSecretsManagerProviderFromConfig(ctx, conf.AWSSecretsManager)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating AWS Secrets Manager provider")
+		}
+		providers[ProviderAWSSecretsManager] = p
+	}
+	if conf.FilePath != "" {
+		p, err := NewFileProvider(conf.FilePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating file provider")
+		}
+		providers[ProviderFile] = p
+	}
+	return NewResolver(providers, allowedPathPrefixes), nil
+}
+
+// Resolve returns the value of the secret that the reference identifies.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	parsed, err := ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	if err := parsed.CheckAllowed(r.allowedPathPrefixes); err != nil {
+		return "", err
+	}
+	p, ok := r.providers[parsed.Provider]
+	if !ok {
+		return "", errors.Errorf("secret provider '%s' is not configured", parsed.Provider)
+	}
+	val, err := p.GetSecret(ctx, parsed.Path, parsed.Key)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting secret '%s'", parsed.String())
+	}
+	return val, nil
+}
+
+// ResolveVars replaces the value of each variable that is marked external with
+// the secret it references. The values of the variables are never included in
+// the returned error.
+func (r *Resolver) ResolveVars(ctx context.Context, vars map[string]string, external map[string]bool) error {
+	names := make([]string, 0, len(external))
+	for name, isExternal := range external {
+		if _, ok := vars[name]; ok && isExternal {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	catcher := grip.NewBasicCatcher()
+	for _, name := range names {
+		val, err := r.Resolve(ctx, vars[name])
+		if err != nil {
+			catcher.Wrapf(err, "resolving variable '%s'", name)
+			continue
+		}
+		vars[name] = val
+	}
+	return catcher.Resolve()
+}
+
+// closer is a provider that holds resources that must be released.
+type closer interface {
+	Close(ctx context.Context) error
+}
+
+// Close releases the resources held by the resolver's providers.
+func (r *Resolver) Close(ctx context.Context) error {
+	catcher := grip.NewBasicCatcher()
+	for name, p := range r.providers {
+		if c, ok := p.(closer); ok {
+			catcher.Wrapf(c.Close(ctx), "closing secret provider '%s'", name)
+		}
+	}
+	return catcher.Resolve()
+}
@@ -0,0 +1,252 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	cocoaMock "github.com/evergreen-ci/cocoa/mock"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		for ref, expected := range map[string]Reference{
+			"vault:secret/data/app#password":       {Provider: ProviderVault, Path: "secret/data/app", Key: "password"},
+			"vault:/secret/data/app/#password":     {Provider: ProviderVault, Path: "secret/data/app", Key: "password"},
+			"aws-secrets-manager:prod/db":          {Provider: ProviderAWSSecretsManager, Path: "prod/db"},
+			"aws-secrets-manager:prod/db#password": {Provider: ProviderAWSSecretsManager, Path: "prod/db", Key: "password"},
+			"file:app#token":                       {Provider: ProviderFile, Path: "app", Key: "token"},
+		} {
+			parsed, err := ParseReference(ref)
+			require.NoError(t, err, ref)
+			assert.Equal(t, expected, parsed, ref)
+		}
+	})
+	t.Run("Fails", func(t *testing.T) {
+		for _, ref := range []string{
+			"",
+			"secret/data/app#password",
+			"unknown:secret/data/app#password",
+			"vault:#password",
+			"vault:secret/data/app",
+			"file:app",
+			"vault:secret/data/../../auth/token/lookup-self#id",
+			"vault:secret//data/app#password",
+			"file:./app#token",
+		} {
+			_, err := ParseReference(ref)
+			assert.Error(t, err, ref)
+		}
+	})
+}
+
+func TestValidateReference(t *testing.T) {
+	conf := evergreen.SecretProvidersConfig{
+		Vault: evergreen.VaultSecretProviderConfig{KVMounts: []string{"secret", "team/kv"}},
+	}
+	allowed := []string{"secret/data/app", "team/kv/app", "prod/app"}
+	t.Run("Succeeds", func(t *testing.T) {
+		for _, ref := range []string{
+			"vault:secret/data/app#password",
+			"vault:secret/data/app/db#password",
+			"vault:team/kv/app#password",
+			"aws-secrets-manager:prod/app/db#password",
+		} {
+			assert.NoError(t, ValidateReference(conf, ref, allowed), ref)
+		}
+	})
+	t.Run("FailsOutsideAllowedPathPrefixes", func(t *testing.T) {
+		for _, ref := range []string{
+			"vault:secret/data/other-team#password",
+			"vault:secret/data/app2#password",
+			"aws-secrets-manager:prod/other#password",
+		} {
+			assert.Error(t, ValidateReference(conf, ref, allowed), ref)
+		}
+		assert.Error(t, ValidateReference(conf, "vault:secret/data/app#password", nil), "projects without path prefixes can't reference secrets")
+	})
+	t.Run("FailsOutsideVaultKVMounts", func(t *testing.T) {
+		assert.Error(t, ValidateReference(conf, "vault:auth/token/lookup-self#id", []string{"auth"}))
+		assert.Error(t, ValidateReference(conf, "vault:sys/mounts#secret", []string{"sys"}))
+		assert.Error(t, ValidateReference(conf, "vault:secret/data/../../auth/token/lookup-self#id", []string{"secret"}))
+	})
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	r := NewResolver(map[string]Provider{
+		ProviderFile: NewStaticProvider(map[string]map[string]string{
+			"app":   {"token": "abc123", "password": "hunter2"},
+			"other": {"token": "other-token"},
+		}),
+	}, []string{"app"})
+
+	t.Run("Resolve", func(t *testing.T) {
+		val, err := r.Resolve(ctx, "file:app#token")
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", val)
+
+		_, err = r.Resolve(ctx, "file:app#missing")
+		assert.Error(t, err)
+		_, err = r.Resolve(ctx, "vault:secret/data/app#token")
+		assert.Error(t, err, "unconfigured providers should error")
+		_, err = r.Resolve(ctx, "file:other#token")
+		assert.Error(t, err, "secrets outside the allowed path prefixes should error")
+	})
+	t.Run("ResolveVars", func(t *testing.T) {
+		vars := map[string]string{
+			"token":    "file:app#token",
+			"password": "file:app#password",
+			"plain":    "file:app#token",
+		}
+		require.NoError(t, r.ResolveVars(ctx, vars, map[string]bool{"token": true, "password": true, "plain": false, "deleted": true}))
+		assert.Equal(t, map[string]string{
+			"token":    "abc123",
+			"password": "hunter2",
+			"plain":    "file:app#token",
+		}, vars)
+	})
+	t.Run("ResolveVarsErrorsWithoutLeakingValues", func(t *testing.T) {
+		vars := map[string]string{"bad": "file:app#missing"}
+		err := r.ResolveVars(ctx, vars, map[string]bool{"bad": true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bad")
+		assert.NotContains(t, err.Error(), "hunter2")
+	})
+}
+
+func TestFileProvider(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "secrets.yml")
+	require.NoError(t, os.WriteFile(filePath, []byte("app/db:\n  password: hunter2\n"), 0600))
+
+	r, err := NewResolverFromConfig(ctx, evergreen.SecretProvidersConfig{FilePath: filePath}, []string{"app"})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, r.Close(ctx))
+	}()
+
+	val, err := r.Resolve(ctx, "file:app/db#password")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", val)
+
+	_, err = NewFileProvider(filepath.Join(t.TempDir(), "nonexistent.yml"))
+	assert.Error(t, err)
+}
+
+func TestVaultProvider(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" || r.Header.Get("X-Vault-Namespace") != "ns" {
+			rw.WriteHeader(http.StatusForbidden)
+			_, _ = rw.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var body interface{}
+		switch r.URL.Path {
+		case "/v1/kv/app":
+			body = map[string]interface{}{
+				"data": map[string]interface{}{"password": "v1-password", "port": 5432},
+			}
+		case "/v1/secret/data/app":
+			body = map[string]interface{}{
+				"data": map[string]interface{}{
+					"data":     map[string]interface{}{"password": "v2-password"},
+					"metadata": map[string]interface{}{"version": 3},
+				},
+			}
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(rw).Encode(body))
+	}))
+	defer srv.Close()
+
+	conf := evergreen.VaultSecretProviderConfig{URL: srv.URL, Token: "token", Namespace: "ns", KVMounts: []string{"kv", "secret"}}
+	p := NewVaultProvider(conf)
+
+	t.Run("KVVersion1", func(t *testing.T) {
+		val, err := p.GetSecret(ctx, "kv/app", "password")
+		require.NoError(t, err)
+		assert.Equal(t, "v1-password", val)
+
+		val, err = p.GetSecret(ctx, "kv/app", "port")
+		require.NoError(t, err)
+		assert.Equal(t, "5432", val, "non-string values should be JSON encoded")
+	})
+	t.Run("KVVersion2", func(t *testing.T) {
+		val, err := p.GetSecret(ctx, "secret/data/app", "password")
+		require.NoError(t, err)
+		assert.Equal(t, "v2-password", val)
+	})
+	t.Run("MissingKey", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "secret/data/app", "username")
+		assert.Error(t, err)
+	})
+	t.Run("MissingSecret", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "secret/data/nonexistent", "password")
+		assert.Error(t, err)
+	})
+	t.Run("EscapesPath", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "secret/data/app?list=true", "password")
+		assert.Error(t, err)
+	})
+	t.Run("NonKVMount", func(t *testing.T) {
+		_, err := p.GetSecret(ctx, "auth/token/lookup-self", "id")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "KV secrets engine mount")
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		conf := conf
+		conf.Token = "wrong"
+		_, err := NewVaultProvider(conf).GetSecret(ctx, "secret/data/app", "password")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "403")
+	})
+}
+
+func TestAWSSecretsManagerProvider(t *testing.T) {
+	ctx := context.Background()
+	cocoaMock.ResetGlobalSecretCache()
+	defer cocoaMock.ResetGlobalSecretCache()
+
+	c := &cocoaMock.SecretsManagerClient{}
+	_, err := c.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         utility.ToStringPtr("prod/token"),
+		SecretString: utility.ToStringPtr("abc123"),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         utility.ToStringPtr("prod/db"),
+		SecretString: utility.ToStringPtr(`{"username":"admin","password":"hunter2"}`),
+	})
+	require.NoError(t, err)
+
+	r := NewResolver(map[string]Provider{ProviderAWSSecretsManager: NewAWSSecretsManagerProvider(c)}, []string{"prod"})
+	defer func() {
+		assert.NoError(t, r.Close(ctx))
+	}()
+
+	val, err := r.Resolve(ctx, "aws-secrets-manager:prod/token")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", val)
+
+	val, err = r.Resolve(ctx, "aws-secrets-manager:prod/db#password")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", val)
+
+	_, err = r.Resolve(ctx, "aws-secrets-manager:prod/token#password")
+	assert.Error(t, err, "secrets that aren't JSON objects can't be read by key")
+	_, err = r.Resolve(ctx, "aws-secrets-manager:prod/nonexistent")
+	assert.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+type vaultProvider struct {
+	url       string
+	token     string
+	namespace string
+	kvMounts  []string
+}
+
+// NewVaultProvider returns a provider that reads secrets from the configured
+// Vault KV secrets engines. Paths are the API paths under /v1, so a secret in
+// a version 2 KV engine mounted at "secret" is read from "secret/data/<name>".
+func NewVaultProvider(conf evergreen.VaultSecretProviderConfig) Provider {
+	return &vaultProvider{
+		url:       conf.URL,
+		token:     conf.Token,
+		namespace: conf.Namespace,
+		kvMounts:  conf.KVMounts,
+	}
+}
+
+// validateVaultPath checks that the path is in one of the KV mounts, so that
+// the Vault token can't be used to read from other Vault APIs (such as
+// auth/token/lookup-self).
+func validateVaultPath(kvMounts []string, path string) error {
+	if len(kvMounts) == 0 {
+		kvMounts = []string{evergreen.DefaultVaultKVMount}
+	}
+	if !hasValidPathSegments(path) {
+		return errors.Errorf("Vault path '%s' cannot have empty, '.' or '..' segments", path)
+	}
+	for _, mount := range kvMounts {
+		if path != strings.Trim(mount, "/") && hasPathPrefix(path, mount) {
+			return nil
+		}
+	}
+	return errors.Errorf("Vault path '%s' is not in a KV secrets engine mount", path)
+}
+
+// vaultURL returns the URL of the Vault API path with each path segment
+// escaped.
+func (p *vaultProvider) vaultURL(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return fmt.Sprintf("%s/v1/%s", p.url, strings.Join(segments, "/"))
+}
+
+// vaultResponse is the response to reading a secret from either version of
+// the KV secrets engine. Version 1 returns the secret's keys directly in data,
+// whereas version 2 nests them in data.data alongside data.metadata.
+type vaultResponse struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+func (p *vaultProvider) GetSecret(ctx context.Context, path, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("Vault secrets must be read by key")
+	}
+	if err := validateVaultPath(p.kvMounts, path); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.vaultURL(path), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+
+	c := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(c)
+	resp, err := c.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "making request to Vault")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", errors.Errorf("secret '%s' not found in Vault", path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// Vault's error responses only contain error messages, never
+		// secrets, so it's safe to include them.
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", errors.Errorf("Vault returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var vaultResp vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&vaultResp); err != nil {
+		return "", errors.Wrap(err, "decoding Vault response")
+	}
+	data := vaultResp.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+
+	val, ok := data[key]
+	if !ok {
+		return "", errors.Errorf("key '%s' not found in secret '%s'", key, path)
+	}
+	return stringifySecretValue(val)
+}
+
+// stringifySecretValue returns string values as-is and encodes any other
+// value as JSON.
+func stringifySecretValue(val interface{}) (string, error) {
+	if s, ok := val.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return "", errors.Wrap(err, "encoding secret value")
+	}
+	return string(b), nil
+}
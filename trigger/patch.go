@@ -44,6 +44,16 @@ func makePatchTriggers() eventHandler {
 	return t
 }
 
+func (t *patchTriggers) Process(sub *event.Subscription) (*notification.Notification, error) {
+	// The required tasks of a merge queue patch finishing only updates the
+	// patch's GitHub merge check.
+	if t.event.EventType == event.PatchMergeQueueRequiredTasksFinished &&
+		(sub.Trigger != event.TriggerOutcome || sub.Subscriber.Type != event.GithubMergeSubscriberType) {
+		return nil, nil
+	}
+	return t.base.Process(sub)
+}
+
 func (t *patchTriggers) Fetch(ctx context.Context, e *event.EventLogEntry) error {
 	var err error
 	if err = t.uiConfig.Get(ctx); err != nil {
@@ -214,6 +224,16 @@ func (t *patchTriggers) makeData(sub *event.Subscription) (*commonTemplateData,
 		"subscription":            sub.ID,
 	})
 
+	// The merge check for GitHub merge queue patches only reflects the
+	// patch's required tasks.
+	if t.patch.IsGithubMergePatch() {
+		var err error
+		collectiveStatus, err = model.GetMergeQueueRequiredStatus(t.patch, collectiveStatus)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting required tasks status for merge queue patch '%s'", t.patch.Id)
+		}
+	}
+
 	data := commonTemplateData{
 		ID:                t.patch.Id.Hex(),
 		EventID:           t.event.ID,
@@ -269,6 +289,9 @@ func (t *patchTriggers) makeData(sub *event.Subscription) (*commonTemplateData,
 		data.githubState = message.GithubStateFailure
 		data.githubDescription = fmt.Sprintf("patch finished in %s", finishTime.Sub(t.patch.StartTime).String())
 	}
+	if t.event.EventType == event.PatchMergeQueueRequiredTasksFinished {
+		data.githubDescription = fmt.Sprintf("required tasks finished in %s", time.Since(t.patch.StartTime).String())
+	}
 
 	if t.patch.IsGithubPRPatch() {
 		data.slack = append(data.slack, message.SlackAttachment{
@@ -13,7 +13,9 @@ import (
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/grip/message"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -229,6 +231,43 @@ func (s *patchSuite) TestPatchOutcome() {
 	s.NotNil(n)
 }
 
+func (s *patchSuite) TestMergeQueueRequiredTasksFinished() {
+	s.NoError(db.ClearCollections(task.Collection))
+	s.patch.GithubMergeData = thirdparty.GithubMergeGroup{
+		Org:        "evergreen-ci",
+		Repo:       "evergreen",
+		BaseBranch: "main",
+		HeadSHA:    "776f608b5b12cd27b8d931c8ee4ca0c13f857299",
+	}
+	s.patch.Status = evergreen.VersionStarted
+	s.patch.RequiredVariantsTasks = []patch.VariantTasks{{Variant: "ubuntu", Tasks: []string{"unit"}}}
+	s.NoError(db.Update(patch.Collection, bson.M{"_id": s.patch.Id}, &s.patch))
+	s.NoError((&task.Task{Id: "unit", Version: s.patch.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "unit", Status: evergreen.TaskSucceeded, Activated: true}).Insert())
+	s.NoError((&task.Task{Id: "optional", Version: s.patch.Id.Hex(), BuildVariant: "ubuntu", DisplayName: "optional", Status: evergreen.TaskStarted, Activated: true}).Insert())
+
+	s.event.EventType = event.PatchMergeQueueRequiredTasksFinished
+	s.data.Status = evergreen.VersionSucceeded
+
+	for i := range s.subs {
+		n, err := s.t.Process(&s.subs[i])
+		s.NoError(err)
+		s.Nil(n, "only the GitHub merge check should be notified")
+	}
+
+	ghSub := event.NewExpiringPatchOutcomeSubscription(s.patch.Id.Hex(), event.NewGithubMergeAPISubscriber(event.GithubMergeSubscriber{
+		Owner: "evergreen-ci",
+		Repo:  "evergreen",
+		Ref:   "776f608b5b12cd27b8d931c8ee4ca0c13f857299",
+	}))
+	n, err := s.t.Process(&ghSub)
+	s.NoError(err)
+	s.Require().NotNil(n)
+	payload, ok := n.Payload.(*message.GithubStatus)
+	s.Require().True(ok)
+	s.Equal(message.GithubStateSuccess, payload.State)
+	s.Contains(payload.Description, "required tasks finished")
+}
+
 func (s *patchSuite) TestRunChildrenOnPatchOutcome() {
 	childPatchId := "5aab4514f27e4f9984646d97"
 	childPatchSubSuccess := event.Subscriber{
@@ -412,9 +412,10 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 	}
 
 	switch sub.Subscriber.Type {
-	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
+	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType,
+		event.GitlabCommitStatusSubscriberType:
 		if len(data.githubDescription) == 0 {
-			return nil, errors.Errorf("GitHub subscriber not supported for trigger '%s'", sub.Trigger)
+			return nil, errors.Errorf("commit status subscriber not supported for trigger '%s'", sub.Trigger)
 		}
 		msg := &message.GithubStatus{
 			Context:     data.githubContext,
@@ -18,6 +18,7 @@ var registry = triggerRegistry{
 func init() {
 	registry.registerEventHandler(event.ResourceTypePatch, event.PatchStateChange, makePatchTriggers)
 	registry.registerEventHandler(event.ResourceTypePatch, event.PatchChildrenCompletion, makePatchTriggers)
+	registry.registerEventHandler(event.ResourceTypePatch, event.PatchMergeQueueRequiredTasksFinished, makePatchTriggers)
 }
 
 type registryKey struct {
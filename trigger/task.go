@@ -32,6 +32,7 @@ func init() {
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStarted, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskFinished, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskBlocked, makeTaskTriggers)
+	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStepbackCulpritIdentified, makeTaskTriggers)
 }
 
 const (
@@ -56,6 +57,7 @@ func makeTaskTriggers() eventHandler {
 		event.TriggerRegression:                  t.taskRegression,
 		event.TriggerTaskFirstFailureInVersion:   t.taskFirstFailureInVersion,
 		event.TriggerTaskStarted:                 t.taskStarted,
+		event.TriggerCulpritIdentified:           t.taskCulpritIdentified,
 		triggerTaskFirstFailureInBuild:           t.taskFirstFailureInBuild,
 		triggerTaskFirstFailureInVersionWithName: t.taskFirstFailureInVersionWithName,
 		triggerTaskRegressionByTest:              t.taskRegressionByTest,
@@ -154,6 +156,11 @@ func (t *taskTriggers) Process(sub *event.Subscription) (*notification.Notificat
 	if t.task.Aborted {
 		return nil, nil
 	}
+	// Culprit events only notify culprit subscriptions, and culprit
+	// subscriptions are only notified by culprit events.
+	if (t.event.EventType == event.TaskStepbackCulpritIdentified) != (sub.Trigger == event.TriggerCulpritIdentified) {
+		return nil, nil
+	}
 	return t.base.Process(sub)
 }
 
@@ -473,6 +480,14 @@ func (t *taskTriggers) taskStarted(sub *event.Subscription) (*notification.Notif
 	return t.generate(sub, "", "")
 }
 
+func (t *taskTriggers) taskCulpritIdentified(sub *event.Subscription) (*notification.Notification, error) {
+	if t.task.IsPartOfDisplay() || t.task.StepbackCulprit == nil {
+		return nil, nil
+	}
+
+	return t.generate(sub, "been identified by stepback as the first failing commit", t.task.StepbackCulprit.TestName)
+}
+
 func (t *taskTriggers) taskFailedOrBlocked(sub *event.Subscription) (*notification.Notification, error) {
 	if t.task.IsPartOfDisplay() {
 		return nil, nil
@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/alertrecord"
 	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
@@ -383,6 +384,41 @@ func (s *taskSuite) TestTriggerEvent() {
 	s.Len(n, 1)
 }
 
+func (s *taskSuite) TestCulpritIdentified() {
+	s.NoError(db.ClearCollections(event.SubscriptionsCollection))
+	// The version author opts in through their stepback culprit preference.
+	sub := event.NewStepbackCulpritSubscriptionByOwner("me", event.Subscriber{
+		Type:   event.SlackSubscriberType,
+		Target: "@me",
+	})
+	s.NoError(sub.Upsert())
+
+	s.task.Status = evergreen.TaskFailed
+	s.data.Status = evergreen.TaskFailed
+	s.NoError(db.Update(task.Collection, bson.M{"_id": s.task.Id}, &s.task))
+
+	n, err := NotificationsFromEvent(s.ctx, &s.event)
+	s.NoError(err)
+	s.Empty(n, "task finishing should not notify culprit subscriptions")
+
+	s.task.StepbackCulprit = &task.StepbackCulprit{
+		TaskId:    s.task.Id,
+		VersionId: s.task.Version,
+		TestName:  "test_file",
+	}
+	s.NoError(db.Update(task.Collection, bson.M{"_id": s.task.Id}, &s.task))
+	s.event.EventType = event.TaskStepbackCulpritIdentified
+	s.event.Data = &event.TaskEventData{}
+
+	n, err = NotificationsFromEvent(s.ctx, &s.event)
+	s.NoError(err)
+	s.Require().Len(n, 1)
+	payload, ok := n[0].Payload.(*notification.SlackPayload)
+	s.Require().True(ok)
+	s.Contains(payload.Body, "test-display-name (test_file)")
+	s.Contains(payload.Body, "first failing commit")
+}
+
 func (s *taskSuite) TestGithubPREvent() {
 	s.NoError(db.ClearCollections(task.Collection, event.SubscriptionsCollection))
 
@@ -136,6 +136,7 @@ func (j *commitQueueJob) Run(ctx context.Context) {
 		j.AddError(errors.Wrap(err, "getting global GitHub OAuth token"))
 		return
 	}
+	j.AddError(j.retipOrphanedBatches(ctx, cq))
 	j.TryUnstick(ctx, cq, projectRef, githubToken)
 
 	if cq.Processing() {
@@ -209,9 +210,64 @@ func (j *commitQueueJob) Run(ctx context.Context) {
 		"message":              "finished processing batch of commit queue items",
 		"processing_time_secs": time.Since(beginBatchProcessingTime).Seconds(),
 	})
+	if conf.CommitQueue.BatchMode {
+		j.AddError(j.setBatchTip(ctx, cq, nextItems))
+	}
 	j.AddError(j.addMergeTaskDependencies(*cq))
 }
 
+// setBatchTip makes the last of the processed items test the whole batch, so
+// only one version's tasks run for the batch.
+func (j *commitQueueJob) setBatchTip(ctx context.Context, cq *commitqueue.CommitQueue, processed []commitqueue.CommitQueueItem) error {
+	var batch []commitqueue.CommitQueueItem
+	for _, item := range cq.Queue {
+		for _, processedItem := range processed {
+			// Items that failed to process have already been dequeued.
+			if item.Issue == processedItem.Issue && item.Version != "" {
+				batch = append(batch, item)
+			}
+		}
+	}
+	if len(batch) < 2 {
+		return nil
+	}
+	return errors.Wrap(model.SetCommitQueueBatchTip(ctx, cq, batch, evergreen.CommitQueueBatchActivator), "setting batch tip")
+}
+
+// retipOrphanedBatches finds batches whose tip is no longer in the queue, for
+// example because it was removed, and makes the last remaining item in each
+// such batch its new tip. Otherwise, the items' merge tasks would wait on a
+// version that will never merge.
+func (j *commitQueueJob) retipOrphanedBatches(ctx context.Context, cq *commitqueue.CommitQueue) error {
+	versions := map[string]bool{}
+	for _, item := range cq.Queue {
+		if item.Version != "" {
+			versions[item.Version] = true
+		}
+	}
+	var orphanedTips []string
+	for _, item := range cq.Queue {
+		if item.BatchTip != "" && !versions[item.BatchTip] && !utility.StringSliceContains(orphanedTips, item.BatchTip) {
+			orphanedTips = append(orphanedTips, item.BatchTip)
+		}
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, tip := range orphanedTips {
+		batch := cq.Batch(tip)
+		grip.Info(message.Fields{
+			"source":     "commit queue",
+			"job_id":     j.ID(),
+			"project_id": cq.ProjectID,
+			"old_tip":    tip,
+			"new_tip":    batch[len(batch)-1].Version,
+			"message":    "batch tip is no longer in the queue, setting new tip",
+		})
+		catcher.Wrapf(model.SetCommitQueueBatchTip(ctx, cq, batch, evergreen.CommitQueueBatchActivator), "setting new tip for batch orphaned by '%s'", tip)
+	}
+	return catcher.Resolve()
+}
+
 func (j *commitQueueJob) addMergeTaskDependencies(cq commitqueue.CommitQueue) error {
 	var prevMergeTask string
 	for i, currentItem := range cq.Queue {
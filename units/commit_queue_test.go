@@ -12,6 +12,7 @@ import (
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/mock"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -206,6 +207,85 @@ func (s *commitQueueSuite) TestTryUnstickFixesBlockedMergeTask() {
 	s.Empty(dbCQ.Queue, "commit queue should be blocked due to merge task")
 }
 
+func (s *commitQueueSuite) TestRetipOrphanedBatchesTestsRemainingItems() {
+	j := commitQueueJob{}
+	s.Require().NoError(db.ClearCollections(task.Collection, patch.Collection, model.VersionCollection, build.Collection, commitqueue.Collection))
+
+	p := &patch.Patch{
+		Id:     mgobson.NewObjectId(),
+		Status: evergreen.VersionStarted,
+	}
+	s.Require().NoError(p.Insert())
+	v := model.Version{
+		Id:        p.Id.Hex(),
+		Requester: evergreen.MergeTestRequester,
+	}
+	s.Require().NoError(v.Insert())
+	b := build.Build{
+		Id:      "build",
+		Version: v.Id,
+	}
+	s.Require().NoError(b.Insert())
+	testTask := task.Task{
+		Id:        "test_task",
+		Version:   v.Id,
+		BuildId:   b.Id,
+		Status:    evergreen.TaskUndispatched,
+		Requester: evergreen.MergeTestRequester,
+	}
+	s.Require().NoError(testTask.Insert())
+	mergeTask := task.Task{
+		Id:               "merge_task",
+		Activated:        true,
+		CommitQueueMerge: true,
+		Version:          v.Id,
+		BuildId:          b.Id,
+		Status:           evergreen.TaskUndispatched,
+		Requester:        evergreen.MergeTestRequester,
+		DependsOn: []task.Dependency{
+			{
+				TaskId:       "removed_tip_task",
+				Status:       evergreen.TaskSucceeded,
+				Unattainable: true,
+			},
+		},
+	}
+	s.Require().NoError(mergeTask.Insert())
+	s.Require().NoError((&task.Task{Id: "removed_tip_task", Version: "removed_tip", Status: evergreen.TaskFailed}).Insert())
+
+	cq := &commitqueue.CommitQueue{
+		ProjectID: s.projectRef.Id,
+		Queue: []commitqueue.CommitQueueItem{
+			{
+				Issue:    p.Id.Hex(),
+				Source:   commitqueue.SourceDiff,
+				Version:  v.Id,
+				BatchTip: "removed_tip",
+			},
+		},
+	}
+	s.Require().NoError(commitqueue.InsertQueue(cq))
+
+	s.NoError(j.retipOrphanedBatches(s.ctx, cq))
+
+	dbCQ, err := commitqueue.FindOneId(cq.ProjectID)
+	s.Require().NoError(err)
+	s.Require().Len(dbCQ.Queue, 1)
+	s.Equal(v.Id, dbCQ.Queue[0].BatchTip)
+
+	dbMergeTask, err := task.FindOneId(mergeTask.Id)
+	s.Require().NoError(err)
+	s.Require().NotZero(dbMergeTask)
+	s.False(dbMergeTask.Blocked())
+	s.Require().Len(dbMergeTask.DependsOn, 1)
+	s.Equal(testTask.Id, dbMergeTask.DependsOn[0].TaskId)
+
+	dbTestTask, err := task.FindOneId(testTask.Id)
+	s.Require().NoError(err)
+	s.Require().NotZero(dbTestTask)
+	s.True(dbTestTask.Activated)
+}
+
 func (s *commitQueueSuite) TestTryUnstickDoesNotUnstickMergeTaskBlockedByResettingDependencies() {
 	j := commitQueueJob{}
 	s.Require().NoError(db.ClearCollections(task.Collection, patch.Collection, model.VersionCollection, commitqueue.Collection))
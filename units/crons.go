@@ -909,6 +909,55 @@ func PopulateSpawnhostExpirationCheckJob() amboy.QueueOperation {
 	}
 }
 
+// PopulateSleepScheduleJobs enqueues jobs to stop and start unexpirable spawn
+// hosts whose sleep schedule is due to put them to sleep or wake them up.
+// Hosts are only stopped and started when the schedule changes, so hosts that
+// users manually stop or start in between are left alone.
+func PopulateSleepScheduleJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		now := time.Now()
+		hosts, err := host.FindHostsWithSleepScheduleDue(ctx, now)
+		if err != nil {
+			return errors.Wrap(err, "finding hosts with sleep schedules due")
+		}
+
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(5).Format(TSFormat)
+		for i := range hosts {
+			h := &hosts[i]
+			shouldSleep := h.SleepSchedule.ShouldBeAsleep(now) && !h.SleepSchedule.IsExempt(now)
+			startDue := !h.SleepSchedule.NextStartTime.IsZero() && !h.SleepSchedule.NextStartTime.After(now)
+			if shouldSleep && h.Status == evergreen.HostRunning {
+				catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewSpawnhostStopJob(h, evergreen.User, ts)), "enqueueing sleep schedule stop job for host '%s'", h.Id)
+			} else if !shouldSleep && startDue && h.Status == evergreen.HostStopped {
+				catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewSpawnhostStartJob(h, evergreen.User, ts)), "enqueueing sleep schedule start job for host '%s'", h.Id)
+			}
+			catcher.Wrapf(h.UpdateSleepScheduleTransitions(ctx, now), "updating next sleep schedule stop and start for host '%s'", h.Id)
+		}
+
+		return errors.Wrap(catcher.Resolve(), "populating sleep schedule jobs")
+	}
+}
+
+// PopulateDistroImageRolloutJobs enqueues jobs to promote or roll back the
+// in-progress distro image rollouts.
+func PopulateDistroImageRolloutJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		rollouts, err := distro.FindActiveImageRollouts(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding in-progress distro image rollouts")
+		}
+
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(5).Format(TSFormat)
+		for _, r := range rollouts {
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewDistroImageRolloutCheckJob(ts, r.ID)), "enqueueing image rollout check job for rollout '%s'", r.ID)
+		}
+
+		return errors.Wrap(catcher.Resolve(), "populating distro image rollout jobs")
+	}
+}
+
 // PopulateCloudCleanupJob returns a QueueOperation to enqueue a CloudCleanup job for Fleet in the default EC2 region.
 func PopulateCloudCleanupJob(env evergreen.Environment) amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
@@ -922,6 +971,20 @@ func PopulateCloudCleanupJob(env evergreen.Environment) amboy.QueueOperation {
 
 }
 
+// PopulatePatchDiffMigrationJob enqueues a job to move patch diffs stored in
+// the database into the patch diff bucket, if one is configured.
+func PopulatePatchDiffMigrationJob(env evergreen.Environment) amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		if env.Settings().Buckets.PatchDiffBucket.Name == "" {
+			return nil
+		}
+
+		return errors.Wrap(
+			amboy.EnqueueUniqueJob(ctx, queue, NewPatchDiffMigrationJob(env, utility.RoundPartOfHour(5).Format(TSFormat))),
+			"enqueueing patch diff migration job")
+	}
+}
+
 func PopulateVolumeExpirationCheckJob() amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
 		volumes, err := host.FindVolumesWithNoExpirationToExtend()
@@ -956,6 +1019,25 @@ func PopulateVolumeExpirationJob() amboy.QueueOperation {
 	}
 }
 
+// PopulateSnapshotExpirationJob enqueues jobs to delete snapshots that have
+// expired.
+func PopulateSnapshotExpirationJob() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		snapshots, err := host.FindSnapshotsToDelete(time.Now())
+		if err != nil {
+			return errors.Wrap(err, "finding snapshots to delete")
+		}
+
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+		for i := range snapshots {
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewSnapshotDeletionJob(ts, &snapshots[i])), "enqueueing snapshot deletion job for snapshot '%s'", snapshots[i].ID)
+		}
+
+		return errors.Wrap(catcher.Resolve(), "populating expire snapshot jobs")
+	}
+}
+
 // PopulateUnstickVolumesJob looks for volumes that are marked as attached to terminated hosts in our DB,
 // and enqueues jobs to mark them unattached.
 func PopulateUnstickVolumesJob() amboy.QueueOperation {
@@ -49,6 +49,9 @@ func (j *cronsRemoteFiveMinuteJob) Run(ctx context.Context) {
 		PopulateTaskMonitoring(5),
 		PopulatePodHealthCheckJobs(),
 		PopulateActivationJobs(10),
+		PopulatePatchDiffMigrationJob(j.env),
+		PopulateSleepScheduleJobs(),
+		PopulateDistroImageRolloutJobs(),
 	}
 
 	queue := j.env.RemoteQueue()
@@ -0,0 +1,107 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/mongodb/amboy/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateSleepScheduleJobs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = testutil.TestSpan(ctx, t)
+
+	defer func() {
+		assert.NoError(t, db.ClearCollections(host.Collection))
+	}()
+
+	// The host is scheduled to be asleep for a few hours around the current
+	// time, so whether it's stopped or started depends only on which of its
+	// stop or start is due.
+	now := time.Now().UTC()
+	makeHost := func(id, status string) host.Host {
+		return host.Host{
+			Id:           id,
+			UserHost:     true,
+			NoExpiration: true,
+			Status:       status,
+			SleepSchedule: host.SleepScheduleInfo{
+				DailyStopTime:  now.Add(-time.Hour).Format("15:04"),
+				DailyStartTime: now.Add(2 * time.Hour).Format("15:04"),
+			},
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, h host.Host) int{
+		"StopsRunningHostWhenStopIsDue": func(t *testing.T, h host.Host) int {
+			h.Status = evergreen.HostRunning
+			h.SleepSchedule.NextStopTime = time.Now().Add(-time.Minute)
+			h.SleepSchedule.NextStartTime = time.Now().Add(time.Hour)
+			require.NoError(t, h.Insert(ctx))
+			return 1
+		},
+		"DoesNotStopExemptHost": func(t *testing.T, h host.Host) int {
+			h.Status = evergreen.HostRunning
+			h.SleepSchedule.NextStopTime = time.Now().Add(-time.Minute)
+			h.SleepSchedule.TemporarilyExemptUntil = time.Now().Add(time.Hour)
+			require.NoError(t, h.Insert(ctx))
+			return 0
+		},
+		"DoesNotStartManuallyStoppedHostWhenStopIsDue": func(t *testing.T, h host.Host) int {
+			h.Status = evergreen.HostStopped
+			h.SleepSchedule.NextStopTime = time.Now().Add(-time.Minute)
+			h.SleepSchedule.NextStartTime = time.Now().Add(time.Hour)
+			require.NoError(t, h.Insert(ctx))
+			return 0
+		},
+		"IgnoresHostWithNothingDue": func(t *testing.T, h host.Host) int {
+			h.Status = evergreen.HostRunning
+			h.SleepSchedule.NextStopTime = time.Now().Add(time.Hour)
+			h.SleepSchedule.NextStartTime = time.Now().Add(time.Hour)
+			require.NoError(t, h.Insert(ctx))
+			return 0
+		},
+		"IgnoresExpirableHost": func(t *testing.T, h host.Host) int {
+			h.Status = evergreen.HostRunning
+			h.NoExpiration = false
+			h.SleepSchedule.NextStopTime = time.Now().Add(-time.Minute)
+			require.NoError(t, h.Insert(ctx))
+			return 0
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(host.Collection))
+			q := queue.NewLocalLimitedSize(1, 100)
+
+			expectedJobs := tCase(t, makeHost("h0", evergreen.HostRunning))
+
+			require.NoError(t, PopulateSleepScheduleJobs()(ctx, q))
+			assert.Equal(t, expectedJobs, q.Stats(ctx).Total)
+		})
+	}
+
+	t.Run("UpdatesNextStopAndStart", func(t *testing.T) {
+		require.NoError(t, db.ClearCollections(host.Collection))
+		q := queue.NewLocalLimitedSize(1, 100)
+
+		h := makeHost("h0", evergreen.HostRunning)
+		h.SleepSchedule.NextStopTime = time.Now().Add(-time.Minute)
+		require.NoError(t, h.Insert(ctx))
+
+		require.NoError(t, PopulateSleepScheduleJobs()(ctx, q))
+
+		dbHost, err := host.FindOneId(ctx, h.Id)
+		require.NoError(t, err)
+		require.NotZero(t, dbHost)
+		assert.True(t, dbHost.SleepSchedule.NextStopTime.After(time.Now()))
+		assert.True(t, dbHost.SleepSchedule.NextStartTime.After(time.Now()))
+	})
+}
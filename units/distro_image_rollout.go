@@ -0,0 +1,87 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	distroImageRolloutCheckJobName = "distro-image-rollout-check"
+)
+
+func init() {
+	registry.AddJobType(distroImageRolloutCheckJobName,
+		func() amboy.Job { return makeDistroImageRolloutCheckJob() })
+}
+
+type distroImageRolloutCheckJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	RolloutID string `bson:"rollout_id" json:"rollout_id" yaml:"rollout_id"`
+}
+
+func makeDistroImageRolloutCheckJob() *distroImageRolloutCheckJob {
+	j := &distroImageRolloutCheckJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    distroImageRolloutCheckJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewDistroImageRolloutCheckJob returns a job that promotes or rolls back the
+// in-progress distro image rollout once its candidate hosts and canary tasks
+// have finished.
+func NewDistroImageRolloutCheckJob(ts string, rolloutID string) amboy.Job {
+	j := makeDistroImageRolloutCheckJob()
+	j.SetID(fmt.Sprintf("%s.%s.%s", distroImageRolloutCheckJobName, rolloutID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", distroImageRolloutCheckJobName, rolloutID)})
+	j.SetEnqueueAllScopes(true)
+	j.RolloutID = rolloutID
+	return j
+}
+
+func (j *distroImageRolloutCheckJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	r, err := distro.FindImageRolloutByID(ctx, j.RolloutID)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding image rollout '%s'", j.RolloutID))
+		return
+	}
+	if r == nil {
+		j.AddError(errors.Errorf("image rollout '%s' not found", j.RolloutID))
+		return
+	}
+	if r.Status != distro.ImageRolloutStatusInProgress {
+		return
+	}
+
+	status, err := model.CheckImageRollout(ctx, r)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "checking image rollout '%s'", j.RolloutID))
+		return
+	}
+	if status != "" {
+		grip.Info(message.Fields{
+			"message":       "finished distro image rollout",
+			"job":           j.ID(),
+			"rollout":       r.ID,
+			"distro":        r.DistroID,
+			"candidate_ami": r.CandidateAMI,
+			"status":        status,
+			"reason":        r.Reason,
+		})
+	}
+}
@@ -155,6 +155,9 @@ func (j *eventSendJob) checkDegradedMode(n *notification.Notification) error {
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		return checkFlag(j.flags.GithubStatusAPIDisabled)
 
+	case event.GitlabCommitStatusSubscriberType:
+		return nil
+
 	case event.SlackSubscriberType:
 		return checkFlag(j.flags.SlackNotificationsDisabled)
 
@@ -0,0 +1,102 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	patchDiffMigrationJobName = "patch-diff-migration"
+
+	// patchDiffMigrationBatchSize is the maximum number of patches whose
+	// diffs are migrated in a single job.
+	patchDiffMigrationBatchSize = 100
+)
+
+func init() {
+	registry.AddJobType(patchDiffMigrationJobName,
+		func() amboy.Job { return makePatchDiffMigrationJob() })
+}
+
+type patchDiffMigrationJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makePatchDiffMigrationJob() *patchDiffMigrationJob {
+	j := &patchDiffMigrationJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    patchDiffMigrationJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewPatchDiffMigrationJob returns a job that moves a batch of patch diffs
+// stored inline in patches or in GridFS into the patch diff bucket.
+func NewPatchDiffMigrationJob(env evergreen.Environment, ts string) amboy.Job {
+	j := makePatchDiffMigrationJob()
+	j.SetID(fmt.Sprintf("%s.%s", patchDiffMigrationJobName, ts))
+	j.SetScopes([]string{patchDiffMigrationJobName})
+	j.SetEnqueueAllScopes(true)
+
+	j.env = env
+
+	return j
+}
+
+func (j *patchDiffMigrationJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	bucket, err := patch.NewPatchDiffBucket(ctx, j.env.Settings())
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if bucket == nil {
+		return
+	}
+
+	patches, err := patch.FindWithDatabasePatchDiffs(patchDiffMigrationBatchSize)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding patches with diffs stored in the database"))
+		return
+	}
+
+	numMigrated := 0
+	for _, p := range patches {
+		if err := ctx.Err(); err != nil {
+			j.AddError(err)
+			break
+		}
+		if err := p.MigratePatchDiffs(ctx, bucket); err != nil {
+			j.AddError(errors.Wrapf(err, "migrating diffs for patch '%s'", p.Id.Hex()))
+			continue
+		}
+		numMigrated++
+	}
+
+	grip.Info(message.Fields{
+		"message":     "migrated patch diffs to the patch diff bucket",
+		"job_id":      j.ID(),
+		"num_patches": len(patches),
+		"num_success": numMigrated,
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/evergreen/validator"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/amboy"
@@ -146,6 +147,9 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 			}
 			grip.Error(message.WrapError(err, msg))
 		}
+		if j.IntentType == patch.GitlabIntentType {
+			j.sendGitlabStatus(patchDoc, util.GitlabStateFailed, "failed to create patch")
+		}
 		j.AddError(err)
 		return
 	}
@@ -202,6 +206,9 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		if err := j.buildGithubMergeDoc(ctx, patchDoc); err != nil {
 			catcher.Wrap(err, "building GitHub merge queue patch document")
 		}
+	case patch.GitlabIntentType:
+		canFinalize, err = j.buildGitlabPatchDoc(ctx, patchDoc)
+		catcher.Wrap(err, "building GitLab merge request patch document")
 	case patch.TriggerIntentType:
 		patchedProject, patchedParserProject, err = j.buildTriggerPatchDoc(patchDoc)
 		catcher.Wrap(err, "building trigger patch document")
@@ -325,6 +332,11 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 	if err = j.buildTasksAndVariants(patchDoc, patchedProject); err != nil {
 		return err
 	}
+	if patchDoc.IsGithubMergePatch() {
+		if err = patchedProject.AddMergeQueueRequiredTasks(patchDoc, pref.CommitQueue); err != nil {
+			return errors.Wrap(err, "adding required tasks for merge queue target branch")
+		}
+	}
 
 	if (j.intent.ShouldFinalizePatch() || patchDoc.IsCommitQueuePatch()) &&
 		len(patchDoc.VariantsTasks) == 0 {
@@ -396,6 +408,14 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 	if patchDoc.IsGithubMergePatch() {
 		catcher.Wrap(j.createGitHubMergeSubscription(ctx, patchDoc), "creating GitHub merge queue subscriptions")
 	}
+	if patchDoc.IsGitlabMRPatch() {
+		catcher.Wrap(j.createGitlabSubscriptions(patchDoc), "creating GitLab merge request patch subscriptions")
+		if canFinalize {
+			j.sendGitlabStatus(patchDoc, util.GitlabStatePending, "patch created")
+		} else {
+			j.sendGitlabStatus(patchDoc, util.GitlabStatePending, "patch must be scheduled in Evergreen by a project member")
+		}
+	}
 	if patchDoc.IsBackport() {
 		backportSubscription := event.NewExpiringPatchSuccessSubscription(j.PatchID.Hex(), event.NewEnqueuePatchSubscriber())
 		if err = backportSubscription.Upsert(); err != nil {
@@ -559,6 +579,44 @@ func (j *patchIntentProcessor) createGitHubMergeSubscription(ctx context.Context
 	return catcher.Resolve()
 }
 
+// createGitlabSubscriptions creates subscriptions that set commit statuses on
+// the head of the merge request for a GitLab merge request patch.
+func (j *patchIntentProcessor) createGitlabSubscriptions(p *patch.Patch) error {
+	catcher := grip.NewBasicCatcher()
+	sub := event.NewGitlabCommitStatusSubscriber(event.GitlabCommitStatusSubscriber{
+		ProjectPath: p.GitlabPatchData.ProjectPath,
+		Ref:         p.GitlabPatchData.HeadSHA,
+	})
+	patchSub := event.NewExpiringPatchOutcomeSubscription(j.PatchID.Hex(), sub)
+	catcher.Wrap(patchSub.Upsert(), "inserting patch subscription for GitLab merge request")
+	buildSub := event.NewExpiringBuildOutcomeSubscriptionByVersion(j.PatchID.Hex(), sub)
+	catcher.Wrap(buildSub.Upsert(), "inserting build subscription for GitLab merge request")
+	return catcher.Resolve()
+}
+
+// sendGitlabStatus sets the overall Evergreen commit status on the head of the
+// merge request for a GitLab merge request patch.
+func (j *patchIntentProcessor) sendGitlabStatus(p *patch.Patch, state, description string) {
+	sender, err := j.env.GetSender(evergreen.SenderGitlabStatus)
+	if err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"message":  "could not get GitLab status sender",
+			"job":      j.ID(),
+			"patch_id": j.PatchID,
+			"source":   "patch intents",
+		}))
+		return
+	}
+	sender.Send(util.NewGitlabStatusMessage(util.GitlabStatus{
+		ProjectPath: p.GitlabPatchData.ProjectPath,
+		Ref:         p.GitlabPatchData.HeadSHA,
+		State:       state,
+		Name:        "evergreen",
+		Description: description,
+		URL:         fmt.Sprintf("%s/version/%s", j.env.Settings().Ui.Url, j.PatchID.Hex()),
+	}))
+}
+
 func (j *patchIntentProcessor) buildTasksAndVariants(patchDoc *patch.Patch, project *model.Project) error {
 	var previousPatchStatus string
 	var err error
@@ -799,6 +857,10 @@ func (j *patchIntentProcessor) buildCliPatchDoc(ctx context.Context, patchDoc *p
 		return errors.Errorf("project ref '%s' not found", patchDoc.Project)
 	}
 
+	if err = patchDoc.ValidateStack(); err != nil {
+		return errors.Wrap(err, "validating patch stack")
+	}
+
 	if patchDoc.IsBackport() {
 		return j.buildBackportPatchDoc(ctx, projectRef, patchDoc)
 	}
@@ -819,7 +881,7 @@ func (j *patchIntentProcessor) buildCliPatchDoc(ctx context.Context, patchDoc *p
 	}
 
 	if len(patchDoc.Patches) > 0 {
-		if patchDoc.Patches[0], err = getModulePatch(patchDoc.Patches[0]); err != nil {
+		if patchDoc.Patches[0], err = getModulePatch(ctx, patchDoc.Patches[0]); err != nil {
 			return errors.Wrap(err, "getting module patch from GridFS")
 		}
 	}
@@ -829,8 +891,8 @@ func (j *patchIntentProcessor) buildCliPatchDoc(ctx context.Context, patchDoc *p
 
 // getModulePatch reads the patch from GridFS, processes it, and
 // stores the resulting summaries in the returned ModulePatch
-func getModulePatch(modulePatch patch.ModulePatch) (patch.ModulePatch, error) {
-	patchContents, err := patch.FetchPatchContents(modulePatch.PatchSet.PatchFileId)
+func getModulePatch(ctx context.Context, modulePatch patch.ModulePatch) (patch.ModulePatch, error) {
+	patchContents, err := patch.FetchPatchContents(ctx, modulePatch.PatchSet.PatchFileId)
 	if err != nil {
 		return modulePatch, errors.Wrap(err, "fetching patch contents")
 	}
@@ -962,7 +1024,10 @@ func (j *patchIntentProcessor) buildGithubPatchDoc(ctx context.Context, patchDoc
 		return isMember, err
 	}
 
-	patchFileID := fmt.Sprintf("%s_%s", patchDoc.Id.Hex(), patchDoc.Githash)
+	patchFileID, err := patch.StorePatchDiff(patchContent)
+	if err != nil {
+		return isMember, errors.Wrap(err, "storing patch diff")
+	}
 	patchDoc.Patches = append(patchDoc.Patches, patch.ModulePatch{
 		ModuleName: "",
 		Githash:    patchDoc.Githash,
@@ -973,10 +1038,6 @@ func (j *patchIntentProcessor) buildGithubPatchDoc(ctx context.Context, patchDoc
 	})
 	patchDoc.Project = projectRef.Id
 
-	if err = db.WriteGridFile(patch.GridFSPrefix, patchFileID, strings.NewReader(patchContent)); err != nil {
-		return isMember, errors.Wrap(err, "writing patch file to DB")
-	}
-
 	j.user, err = findEvergreenUserForPR(patchDoc.GithubPatchData.AuthorUID)
 	if err != nil {
 		return isMember, errors.Wrapf(err, "finding user associated with GitHub UID '%d'", patchDoc.GithubPatchData.AuthorUID)
@@ -1019,6 +1080,115 @@ func (j *patchIntentProcessor) buildGithubMergeDoc(ctx context.Context, patchDoc
 	return nil
 }
 
+// buildGitlabPatchDoc builds the patch for a GitLab merge request. It returns
+// whether the patch can be finalized automatically, which is only the case if
+// the merge request's author can push to the GitLab project. Otherwise, the
+// patch must be scheduled by someone with access to the Evergreen project.
+func (j *patchIntentProcessor) buildGitlabPatchDoc(ctx context.Context, patchDoc *patch.Patch) (bool, error) {
+	defer func() {
+		grip.Error(message.WrapError(j.intent.SetProcessed(), message.Fields{
+			"message":     "could not mark patch intent as processed",
+			"intent_id":   j.IntentID,
+			"intent_type": j.IntentType,
+			"patch_id":    j.PatchID,
+			"source":      "patch intents",
+			"job":         j.ID(),
+		}))
+	}()
+
+	settings := j.env.Settings()
+	if !settings.Gitlab.IsConfigured() {
+		return false, errors.New("GitLab is not configured")
+	}
+
+	mr := patchDoc.GitlabPatchData
+	projectRef, err := model.FindOneGitlabProjectRefWithPRTesting(mr.ProjectPath, mr.TargetBranch, j.intent.GetCalledBy())
+	if err != nil {
+		return false, errors.Wrapf(err, "fetching project ref for GitLab project '%s' with branch '%s'", mr.ProjectPath, mr.TargetBranch)
+	}
+	if projectRef == nil {
+		return false, errors.Errorf("project ref for GitLab project '%s' with branch '%s' not found", mr.ProjectPath, mr.TargetBranch)
+	}
+
+	canPush, err := j.isGitlabUserAuthorized(ctx, mr)
+	if err != nil {
+		return false, err
+	}
+	author, err := thirdparty.GetGitlabUsername(ctx, settings.Gitlab.URL, settings.Gitlab.Token, mr.AuthorID)
+	if err != nil {
+		return false, errors.Wrap(err, "getting merge request author")
+	}
+	patchDoc.GitlabPatchData.Author = author
+	patchDoc.Description = patch.GitlabMergeRequestDescription(patchDoc.GitlabPatchData)
+
+	gitDir := thirdparty.GitMirrorDir(projectRef.Id)
+	if err = thirdparty.FetchGitlabMergeRequest(ctx, gitDir, settings.Gitlab.CloneURL(mr.ProjectPath), mr); err != nil {
+		return canPush, errors.Wrap(err, "fetching merge request")
+	}
+	patchContent, summaries, baseSHA, err := thirdparty.GetGitlabMergeRequestDiff(ctx, gitDir, mr)
+	if err != nil {
+		return canPush, err
+	}
+
+	patchDoc.Githash = baseSHA
+	patchDoc.GitlabPatchData.BaseSHA = baseSHA
+	patchFileID, err := patch.StorePatchDiff(patchContent)
+	if err != nil {
+		return canPush, errors.Wrap(err, "storing patch diff")
+	}
+	patchDoc.Patches = append(patchDoc.Patches, patch.ModulePatch{
+		ModuleName: "",
+		Githash:    patchDoc.Githash,
+		PatchSet: patch.PatchSet{
+			PatchFileId: patchFileID,
+			Summary:     summaries,
+		},
+	})
+	patchDoc.Project = projectRef.Id
+
+	j.user, err = findEvergreenUserForGitlabMR()
+	if err != nil {
+		return canPush, errors.Wrap(err, "finding GitLab merge request user")
+	}
+	patchDoc.Author = j.user.Id
+
+	return canPush, nil
+}
+
+// isGitlabUserAuthorized returns whether the author of the GitLab merge
+// request can push to the GitLab project, so that merge requests from
+// outside contributors, such as ones from forks, are not tested
+// automatically.
+func (j *patchIntentProcessor) isGitlabUserAuthorized(ctx context.Context, mr thirdparty.GitlabMergeRequest) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	settings := j.env.Settings()
+	canPush, err := thirdparty.GitlabUserCanPushToProject(ctx, settings.Gitlab.URL, settings.Gitlab.Token, mr.ProjectPath, mr.AuthorID)
+	if err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"job":          j.ID(),
+			"message":      "failed to authenticate GitLab merge request",
+			"source":       "patch intents",
+			"author_id":    mr.AuthorID,
+			"project_path": mr.ProjectPath,
+			"iid":          mr.IID,
+		}))
+		return false, err
+	}
+	if !canPush {
+		grip.Info(message.Fields{
+			"job":          j.ID(),
+			"message":      "GitLab merge request author cannot push to project, so patch must be scheduled manually",
+			"source":       "patch intents",
+			"author_id":    mr.AuthorID,
+			"project_path": mr.ProjectPath,
+			"iid":          mr.IID,
+		})
+	}
+	return canPush, nil
+}
+
 func (j *patchIntentProcessor) buildTriggerPatchDoc(patchDoc *patch.Patch) (*model.Project, *model.ParserProject, error) {
 	defer func() {
 		grip.Error(message.WrapError(j.intent.SetProcessed(), message.Fields{
@@ -1129,6 +1299,26 @@ func findEvergreenUserForPR(githubUID int) (*user.DBUser, error) {
 	return u, err
 }
 
+// findEvergreenUserForGitlabMR returns the user that GitLab merge request
+// patches are created as, creating it if it does not exist.
+func findEvergreenUserForGitlabMR() (*user.DBUser, error) {
+	u, err := user.FindOne(user.ById(evergreen.GitlabPatchUser))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding GitLab patch user")
+	}
+	if u == nil {
+		u = &user.DBUser{
+			Id:       evergreen.GitlabPatchUser,
+			DispName: "GitLab Merge Requests",
+			APIKey:   utility.RandomString(),
+		}
+		if err = u.Insert(); err != nil {
+			return nil, errors.Wrap(err, "inserting GitLab patch user")
+		}
+	}
+	return u, nil
+}
+
 func findEvergreenUserForGithubMergeGroup(githubUID int) (*user.DBUser, error) {
 	u, err := user.FindOne(user.ById(evergreen.GithubMergeUser))
 	if err != nil {
@@ -1238,7 +1238,7 @@ func (s *PatchIntentUnitsSuite) verifyVersionDoc(patchDoc *patch.Patch, expected
 }
 
 func (s *PatchIntentUnitsSuite) gridFSFileExists(patchFileID string) {
-	patchContents, err := patch.FetchPatchContents(patchFileID)
+	patchContents, err := patch.FetchPatchContents(s.ctx, patchFileID)
 	s.Require().NoError(err)
 	s.NotEmpty(patchContents)
 }
@@ -1323,7 +1323,7 @@ index ca20f6c..224168e 100644
 
 	modulePatch := patch.ModulePatch{}
 	modulePatch.PatchSet.PatchFileId = "testPatch"
-	modulePatch, err := getModulePatch(modulePatch)
+	modulePatch, err := getModulePatch(s.ctx, modulePatch)
 	s.NotEmpty(modulePatch.PatchSet.Summary)
 	s.NoError(err)
 }
@@ -82,12 +82,6 @@ func (j *repotrackerJob) Run(ctx context.Context) {
 		j.AddError(errors.New("settings is empty"))
 		return
 	}
-	token, err := settings.GetGithubOauthToken()
-	if err != nil {
-		j.AddError(errors.New("GitHub OAuth token is missing"))
-		return
-	}
-
 	ref, err := model.FindMergedProjectRef(j.ProjectID, "", true)
 	if err != nil {
 		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
@@ -98,9 +92,18 @@ func (j *repotrackerJob) Run(ctx context.Context) {
 		return
 	}
 
-	if !repotracker.CheckGithubAPIResources(ctx, token) {
-		j.AddError(errors.Errorf("skipping repotracker run for project '%s' because of GitHub API limit issues", j.ProjectID))
-		return
+	// Projects hosted on GitLab or with a git remote URL are polled with
+	// plain git, so they do not depend on the GitHub API.
+	if ref.GitRemoteURL == "" && !ref.IsGitlabProject() {
+		token, err := settings.GetGithubOauthToken()
+		if err != nil {
+			j.AddError(errors.New("GitHub OAuth token is missing"))
+			return
+		}
+		if !repotracker.CheckGithubAPIResources(ctx, token) {
+			j.AddError(errors.Errorf("skipping repotracker run for project '%s' because of GitHub API limit issues", j.ProjectID))
+			return
+		}
 	}
 
 	if err = repotracker.CollectRevisionsForProject(ctx, settings, *ref); err != nil {
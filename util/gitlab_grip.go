@@ -0,0 +1,182 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+const (
+	gitlabStatusTimeout  = 10 * time.Second
+	gitlabStatusAttempts = 3
+	gitlabTokenHeader    = "PRIVATE-TOKEN"
+)
+
+// GitLab commit status states.
+const (
+	GitlabStatePending  = "pending"
+	GitlabStateRunning  = "running"
+	GitlabStateSuccess  = "success"
+	GitlabStateFailed   = "failed"
+	GitlabStateCanceled = "canceled"
+)
+
+var validGitlabStates = []string{
+	GitlabStatePending,
+	GitlabStateRunning,
+	GitlabStateSuccess,
+	GitlabStateFailed,
+	GitlabStateCanceled,
+}
+
+// GitlabStatus is a commit status to set on a commit in a GitLab project.
+type GitlabStatus struct {
+	// ProjectPath is the full path of the GitLab project, e.g.
+	// "group/subgroup/project".
+	ProjectPath string `bson:"project_path"`
+	Ref         string `bson:"ref"`
+	State       string `bson:"state"`
+	// Name distinguishes this status from other statuses on the commit, like
+	// the context of a GitHub status.
+	Name        string `bson:"name"`
+	Description string `bson:"description"`
+	URL         string `bson:"url"`
+}
+
+type gitlabStatusMessage struct {
+	raw GitlabStatus
+
+	message.Base
+}
+
+// NewGitlabStatusMessage returns a composer for a GitLab commit status.
+func NewGitlabStatusMessage(raw GitlabStatus) message.Composer {
+	return &gitlabStatusMessage{
+		raw: raw,
+	}
+}
+
+func (m *gitlabStatusMessage) Loggable() bool {
+	if m.raw.ProjectPath == "" || m.raw.Ref == "" || m.raw.Name == "" {
+		return false
+	}
+	if !utility.StringSliceContains(validGitlabStates, m.raw.State) {
+		return false
+	}
+	if m.raw.URL != "" {
+		if _, err := url.Parse(m.raw.URL); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *gitlabStatusMessage) Raw() interface{} {
+	return &m.raw
+}
+
+func (m *gitlabStatusMessage) String() string {
+	str := fmt.Sprintf("%s@%s %s %s", m.raw.ProjectPath, m.raw.Ref, m.raw.Name, m.raw.State)
+	if m.raw.Description != "" {
+		str += " " + m.raw.Description
+	}
+	if m.raw.URL != "" {
+		str += " " + m.raw.URL
+	}
+	return str
+}
+
+type gitlabStatusLogger struct {
+	baseURL string
+	token   string
+	client  *http.Client
+	*send.Base
+}
+
+// NewGitlabStatusLogger returns a sender that sets commit statuses using the
+// API of the GitLab instance at baseURL.
+func NewGitlabStatusLogger(baseURL, token string) (send.Sender, error) {
+	if baseURL == "" {
+		return nil, errors.New("GitLab URL cannot be empty")
+	}
+	if token == "" {
+		return nil, errors.New("GitLab token cannot be empty")
+	}
+	return &gitlabStatusLogger{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		Base:    send.NewBase("evergreen"),
+	}, nil
+}
+
+func (s *gitlabStatusLogger) Send(m message.Composer) {
+	if s.Level().ShouldLog(m) {
+		if err := s.send(m); err != nil {
+			s.ErrorHandler()(err, m)
+		}
+	}
+}
+
+func (s *gitlabStatusLogger) send(m message.Composer) error {
+	raw, ok := m.Raw().(*GitlabStatus)
+	if !ok {
+		return errors.Errorf("received unexpected composer %T", m.Raw())
+	}
+
+	form := url.Values{}
+	form.Set("state", raw.State)
+	form.Set("name", raw.Name)
+	if raw.Description != "" {
+		form.Set("description", raw.Description)
+	}
+	if raw.URL != "" {
+		form.Set("target_url", raw.URL)
+	}
+	// The project path is escaped as a single path segment, which is how the
+	// GitLab API accepts it in place of the numeric project ID.
+	statusURL := fmt.Sprintf("%s/api/v4/projects/%s/statuses/%s", s.baseURL, url.PathEscape(raw.ProjectPath), url.PathEscape(raw.Ref))
+
+	client := s.client
+	return utility.Retry(context.Background(), func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), gitlabStatusTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, statusURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return false, errors.Wrap(err, "creating GitLab status request")
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set(gitlabTokenHeader, s.token)
+
+		if client == nil {
+			client = utility.GetHTTPClient()
+			defer utility.PutHTTPClient(client)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return true, errors.Wrap(err, "sending GitLab status")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			// Client errors other than rate limiting will not succeed on
+			// retry.
+			retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+			return retry, errors.Errorf("response was %d (%s)", resp.StatusCode, http.StatusText(resp.StatusCode))
+		}
+		return false, nil
+	}, utility.RetryOptions{
+		MaxAttempts: gitlabStatusAttempts,
+		MinDelay:    defaultMinDelay,
+	})
+}
+
+func (s *gitlabStatusLogger) Flush(_ context.Context) error { return nil }
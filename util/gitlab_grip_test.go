@@ -0,0 +1,118 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitlabStatusComposer(t *testing.T) {
+	status := GitlabStatus{
+		ProjectPath: "group/project",
+		Ref:         "abcdef",
+		State:       GitlabStateSuccess,
+		Name:        "evergreen",
+		Description: "tasks succeeded",
+		URL:         "https://evergreen.example.com/version/v1",
+	}
+	assert.True(t, NewGitlabStatusMessage(status).Loggable())
+
+	invalid := status
+	invalid.State = "failure"
+	assert.False(t, NewGitlabStatusMessage(invalid).Loggable())
+
+	invalid = status
+	invalid.ProjectPath = ""
+	assert.False(t, NewGitlabStatusMessage(invalid).Loggable())
+
+	invalid = status
+	invalid.Name = ""
+	assert.False(t, NewGitlabStatusMessage(invalid).Loggable())
+
+	assert.False(t, NewGitlabStatusMessage(GitlabStatus{}).Loggable())
+}
+
+func TestGitlabStatusSender(t *testing.T) {
+	_, err := NewGitlabStatusLogger("", "token")
+	assert.Error(t, err)
+	_, err = NewGitlabStatusLogger("https://gitlab.example.com", "")
+	assert.Error(t, err)
+
+	status := GitlabStatus{
+		ProjectPath: "group/subgroup/project",
+		Ref:         "abcdef",
+		State:       GitlabStateFailed,
+		Name:        "evergreen/ubuntu",
+		Description: "1 task failed",
+		URL:         "https://evergreen.example.com/build/b1",
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, s *gitlabStatusLogger, requests *[]*http.Request, statusCode *int){
+		"SendsStatusToProjectCommit": func(t *testing.T, s *gitlabStatusLogger, requests *[]*http.Request, statusCode *int) {
+			require.NoError(t, s.SetErrorHandler(func(err error, _ message.Composer) {
+				t.Errorf("unexpected error: %s", err)
+			}))
+			s.Send(NewGitlabStatusMessage(status))
+
+			require.Len(t, *requests, 1)
+			req := (*requests)[0]
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/api/v4/projects/group%2Fsubgroup%2Fproject/statuses/abcdef", req.URL.EscapedPath())
+			assert.Equal(t, "token", req.Header.Get(gitlabTokenHeader))
+			assert.Equal(t, GitlabStateFailed, req.PostForm.Get("state"))
+			assert.Equal(t, "evergreen/ubuntu", req.PostForm.Get("name"))
+			assert.Equal(t, "1 task failed", req.PostForm.Get("description"))
+			assert.Equal(t, "https://evergreen.example.com/build/b1", req.PostForm.Get("target_url"))
+		},
+		"DoesNotRetryClientErrors": func(t *testing.T, s *gitlabStatusLogger, requests *[]*http.Request, statusCode *int) {
+			*statusCode = http.StatusNotFound
+			var sendErr error
+			require.NoError(t, s.SetErrorHandler(func(err error, _ message.Composer) {
+				sendErr = err
+			}))
+			s.Send(NewGitlabStatusMessage(status))
+
+			assert.Len(t, *requests, 1)
+			require.Error(t, sendErr)
+			assert.Contains(t, sendErr.Error(), "404")
+		},
+		"RetriesServerErrors": func(t *testing.T, s *gitlabStatusLogger, requests *[]*http.Request, statusCode *int) {
+			*statusCode = http.StatusBadGateway
+			var sendErr error
+			require.NoError(t, s.SetErrorHandler(func(err error, _ message.Composer) {
+				sendErr = err
+			}))
+			s.Send(NewGitlabStatusMessage(status))
+
+			assert.Len(t, *requests, gitlabStatusAttempts)
+			assert.Error(t, sendErr)
+		},
+		"IgnoresUnloggableMessages": func(t *testing.T, s *gitlabStatusLogger, requests *[]*http.Request, statusCode *int) {
+			s.Send(NewGitlabStatusMessage(GitlabStatus{}))
+			assert.Empty(t, *requests)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			var requests []*http.Request
+			statusCode := http.StatusCreated
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				requests = append(requests, r)
+				w.WriteHeader(statusCode)
+			}))
+			defer srv.Close()
+
+			sender, err := NewGitlabStatusLogger(srv.URL+"/", "token")
+			require.NoError(t, err)
+			s, ok := sender.(*gitlabStatusLogger)
+			require.True(t, ok)
+			s.client = srv.Client()
+
+			tCase(t, s, &requests, &statusCode)
+		})
+	}
+}
@@ -40,6 +40,7 @@ var distroSyntaxValidators = []distroValidator{
 	ensureHasValidFinderSettings,
 	ensureHasValidDispatcherSettings,
 	ensureHasValidVirtualWorkstationSettings,
+	ensureValidProjectDistroSettings,
 }
 
 // CheckDistro checks if the distro configuration syntax is valid. Returns
@@ -564,6 +565,127 @@ func ensureHasValidVirtualWorkstationSettings(ctx context.Context, d *distro.Dis
 	return errs
 }
 
+// ensureValidProjectDistroSettings checks that a base distro's project distro
+// settings are valid and that a project distro only differs from its base
+// distro in the fields that project admins are allowed to override.
+func ensureValidProjectDistroSettings(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
+	if !d.IsProjectDistro() {
+		var errs ValidationErrors
+		if len(d.ProjectDistroSettings.AllowedInstanceTypes) > 0 && !evergreen.IsEc2Provider(d.Provider) {
+			errs = append(errs, ValidationError{
+				Message: "project distros can only use other instance types if the distro's provider is EC2",
+				Level:   Error,
+			})
+		}
+		if d.ProjectDistroSettings.MaxHosts < 0 || d.ProjectDistroSettings.MaxDistrosPerProject < 0 {
+			errs = append(errs, ValidationError{
+				Message: "project distro host and distro limits cannot be negative",
+				Level:   Error,
+			})
+		}
+		return errs
+	}
+
+	var errs ValidationErrors
+	if d.ProjectID == "" {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' must belong to a project", d.Id),
+			Level:   Error,
+		})
+	}
+	if len(d.ValidProjects) != 1 || d.ValidProjects[0] != d.ProjectID {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' can only be used by its own project '%s'", d.Id, d.ProjectID),
+			Level:   Error,
+		})
+	}
+	if len(d.Aliases) > 0 {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' cannot have aliases", d.Id),
+			Level:   Error,
+		})
+	}
+	if d.SpawnAllowed || d.IsVirtualWorkstation {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' cannot be used for spawn hosts or virtual workstations", d.Id),
+			Level:   Error,
+		})
+	}
+	if d.ProjectDistroSettings.Allowed {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' cannot be used as a base distro", d.Id),
+			Level:   Error,
+		})
+	}
+
+	base, err := distro.FindOneId(ctx, d.BaseDistroID)
+	if err != nil {
+		return append(errs, ValidationError{
+			Message: fmt.Sprintf("finding base distro '%s': %s", d.BaseDistroID, err.Error()),
+			Level:   Error,
+		})
+	}
+	if base == nil {
+		return append(errs, ValidationError{
+			Message: fmt.Sprintf("base distro '%s' not found", d.BaseDistroID),
+			Level:   Error,
+		})
+	}
+	if base.IsProjectDistro() || !base.ProjectDistroSettings.Allowed {
+		return append(errs, ValidationError{
+			Message: fmt.Sprintf("distro '%s' does not allow project distros to be derived from it", base.Id),
+			Level:   Error,
+		})
+	}
+
+	if d.Provider != base.Provider || d.Arch != base.Arch {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' must have the same provider and arch as its base distro '%s'", d.Id, base.Id),
+			Level:   Error,
+		})
+	}
+	if len(d.ProviderSettingsList) != len(base.ProviderSettingsList) {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' must have the same regions as its base distro '%s'", d.Id, base.Id),
+			Level:   Error,
+		})
+	}
+	for i, doc := range d.ProviderSettingsList {
+		if i >= len(base.ProviderSettingsList) {
+			break
+		}
+		baseDoc := base.ProviderSettingsList[i]
+		region, _ := doc.Lookup("region").StringValueOK()
+		baseRegion, _ := baseDoc.Lookup("region").StringValueOK()
+		ami, _ := doc.Lookup("ami").StringValueOK()
+		baseAMI, _ := baseDoc.Lookup("ami").StringValueOK()
+		if region != baseRegion || ami != baseAMI {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("project distro '%s' must use the same image as its base distro '%s' in each region", d.Id, base.Id),
+				Level:   Error,
+			})
+			break
+		}
+	}
+	if maxHosts := distro.ProjectDistroMaxHosts(base); d.HostAllocatorSettings.MaximumHosts <= 0 || d.HostAllocatorSettings.MaximumHosts > maxHosts {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("project distro '%s' can run at most %d hosts", d.Id, maxHosts),
+			Level:   Error,
+		})
+	}
+	baseInstanceTypes := base.GetInstanceTypes()
+	for _, instanceType := range d.GetInstanceTypes() {
+		if !utility.StringSliceContains(baseInstanceTypes, instanceType) && !utility.StringSliceContains(base.ProjectDistroSettings.AllowedInstanceTypes, instanceType) {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("instance type '%s' is not allowed for project distros derived from distro '%s'", instanceType, base.Id),
+				Level:   Error,
+			})
+		}
+	}
+
+	return errs
+}
+
 func validateAliases(d *distro.Distro, allDistroAliases []string) ValidationErrors {
 	var validationErrs ValidationErrors
 	// Parent and container distros do not support aliases.
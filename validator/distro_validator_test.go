@@ -779,3 +779,80 @@ func TestValidateAliases(t *testing.T) {
 		Aliases:       []string{"alias_1", "alias_2"},
 	}, []string{}))
 }
+
+func TestEnsureValidProjectDistroSettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, db.Clear(distro.Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(distro.Collection))
+	}()
+
+	settings := &evergreen.Settings{}
+	base := distro.Distro{
+		Id:       "base",
+		Provider: evergreen.ProviderNameEc2Fleet,
+		Arch:     evergreen.ArchLinuxAmd64,
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("region", evergreen.DefaultEC2Region),
+			birch.EC.String("ami", "ami-base"),
+			birch.EC.String("instance_type", "m5.large"),
+		)},
+		ProjectDistroSettings: distro.ProjectDistroSettings{
+			Allowed:              true,
+			AllowedInstanceTypes: []string{"m5.xlarge"},
+		},
+	}
+	assert.NoError(t, base.Insert(ctx))
+	assert.Nil(t, ensureValidProjectDistroSettings(ctx, &base, settings))
+
+	newProjectDistro := func(instanceType string) *distro.Distro {
+		d, err := distro.NewProjectDistro(&base, "project_distro", "project", distro.ProjectDistroOptions{
+			Setup:        "echo hello",
+			InstanceType: instanceType,
+		})
+		assert.NoError(t, err)
+		return d
+	}
+
+	assert.Nil(t, ensureValidProjectDistroSettings(ctx, newProjectDistro(""), settings))
+	assert.Nil(t, ensureValidProjectDistroSettings(ctx, newProjectDistro("m5.xlarge"), settings))
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, newProjectDistro("m5.24xlarge"), settings), "instance type should be in the allowed list")
+
+	d := newProjectDistro("")
+	d.ProviderSettingsList[0].Set(birch.EC.String("ami", "ami-other"))
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "image should match the base distro")
+
+	d = newProjectDistro("")
+	d.ValidProjects = nil
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "project distro should only be valid for its project")
+
+	d = newProjectDistro("")
+	d.Aliases = []string{"alias"}
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "project distro should not have aliases")
+
+	d = newProjectDistro("")
+	d.HostAllocatorSettings.MaximumHosts = distro.DefaultProjectDistroMaxHosts + 1
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "project distro should not run more hosts than allowed")
+
+	d = newProjectDistro("")
+	d.HostAllocatorSettings.MaximumHosts = 0
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "project distro should have a host limit")
+
+	d = newProjectDistro("")
+	d.BaseDistroID = "nonexistent"
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, d, settings), "base distro should exist")
+
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, &distro.Distro{
+		Id:                    "static",
+		Provider:              evergreen.ProviderNameStatic,
+		ProjectDistroSettings: distro.ProjectDistroSettings{Allowed: true, AllowedInstanceTypes: []string{"m5.xlarge"}},
+	}, settings), "allowed instance types should only be set for EC2 distros")
+
+	assert.NotNil(t, ensureValidProjectDistroSettings(ctx, &distro.Distro{
+		Id:                    "negative",
+		Provider:              evergreen.ProviderNameEc2Fleet,
+		ProjectDistroSettings: distro.ProjectDistroSettings{Allowed: true, MaxHosts: -1},
+	}, settings), "project distro limits should not be negative")
+}
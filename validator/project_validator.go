@@ -21,7 +21,9 @@ import (
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
+	ignore "github.com/sabhiram/go-gitignore"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -129,6 +131,7 @@ var projectErrorValidators = []projectValidator{
 	validateTaskNames,
 	validateBVNames,
 	validateBVBatchTimes,
+	validateBVPaths,
 	validateDisplayTaskNames,
 	validateBVTaskNames,
 	validateAllDependenciesSpec,
@@ -1128,6 +1131,106 @@ func validateBVTaskNames(project *model.Project) ValidationErrors {
 	return errs
 }
 
+// pathFilter is a path filter field of a build variant or build variant task.
+type pathFilter struct {
+	// owner describes the build variant or build variant task.
+	owner string
+	// field is the name of the YAML field.
+	field string
+	globs []string
+}
+
+func getPathFilters(project *model.Project) []pathFilter {
+	var filters []pathFilter
+	for _, bv := range project.BuildVariants {
+		owner := fmt.Sprintf("buildvariant '%s'", bv.Name)
+		filters = append(filters,
+			pathFilter{owner: owner, field: "paths", globs: bv.Paths},
+			pathFilter{owner: owner, field: "ignore_paths", globs: bv.IgnorePaths},
+		)
+		for _, bvt := range bv.Tasks {
+			if sameGlobs(bvt.Paths, bv.Paths) && sameGlobs(bvt.IgnorePaths, bv.IgnorePaths) {
+				// The task inherits the build variant's path filters.
+				continue
+			}
+			owner := fmt.Sprintf("task '%s' in buildvariant '%s'", bvt.Name, bv.Name)
+			filters = append(filters,
+				pathFilter{owner: owner, field: "paths", globs: bvt.Paths},
+				pathFilter{owner: owner, field: "ignore_paths", globs: bvt.IgnorePaths},
+			)
+		}
+	}
+	return filters
+}
+
+func sameGlobs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// validateBVPaths checks that the build variants' and tasks' path filters are
+// well formed.
+func validateBVPaths(project *model.Project) ValidationErrors {
+	errs := ValidationErrors{}
+	for _, f := range getPathFilters(project) {
+		for _, glob := range f.globs {
+			if strings.TrimSpace(glob) == "" {
+				errs = append(errs, ValidationError{
+					Level:   Error,
+					Message: fmt.Sprintf("%s for %s cannot contain an empty glob", f.field, f.owner),
+				})
+			}
+		}
+	}
+	return errs
+}
+
+// CheckPathFilters checks that every glob in the build variants' and tasks'
+// path filters matches at least one of the given files in the repository.
+// Globs that match nothing usually mean the task will never (or always) run.
+func CheckPathFilters(project *model.Project, repoFiles []string) ValidationErrors {
+	errs := ValidationErrors{}
+	if len(repoFiles) == 0 {
+		return errs
+	}
+
+	checked := map[string]bool{}
+	for _, f := range getPathFilters(project) {
+		for _, glob := range f.globs {
+			// Negated globs only exclude files matched by other globs.
+			if glob == "" || strings.HasPrefix(glob, "!") || checked[glob] {
+				continue
+			}
+			checked[glob] = true
+
+			if !globMatchesAnyFile(glob, repoFiles) {
+				errs = append(errs, ValidationError{
+					Level:   Warning,
+					Message: fmt.Sprintf("%s glob '%s' for %s does not match any files in the repository", f.field, glob, f.owner),
+				})
+			}
+		}
+	}
+	return errs
+}
+
+func globMatchesAnyFile(glob string, files []string) bool {
+	matcher := ignore.CompileIgnoreLines(glob)
+	for _, f := range files {
+		if matcher.MatchesPath(f) {
+			return true
+		}
+	}
+	return false
+}
+
 func validateBVBatchTimes(project *model.Project) ValidationErrors {
 	errs := ValidationErrors{}
 	for _, buildVariant := range project.BuildVariants {
@@ -1934,6 +2037,38 @@ func validateVersionControl(_ context.Context, _ *evergreen.Settings, _ *model.P
 	return errs
 }
 
+// CheckPathFilterMatches checks that the build variants' and tasks' path
+// filters match files in the head of the project's tracked branch on GitHub.
+// It makes a GitHub API call and the tracked branch may not yet have files
+// that a patch or commit adds, so it's only run when explicitly validating a
+// project configuration rather than for every version.
+func CheckPathFilterMatches(ctx context.Context, p *model.Project, ref *model.ProjectRef) ValidationErrors {
+	if !p.HasPathFilters() {
+		return nil
+	}
+	if ref.IsGitlabProject() || ref.GitRemoteURL != "" || ref.Owner == "" || ref.Repo == "" || ref.Branch == "" {
+		return ValidationErrors{{
+			Level:   Warning,
+			Message: "path filters can only be checked against the tracked branch of projects on GitHub",
+		}}
+	}
+	repoFiles, err := thirdparty.GetGithubRepoFiles(ctx, ref.Owner, ref.Repo, ref.Branch)
+	if err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "could not get repository files to check path filters",
+			"project": ref.Id,
+			"owner":   ref.Owner,
+			"repo":    ref.Repo,
+			"branch":  ref.Branch,
+		}))
+		return ValidationErrors{{
+			Level:   Warning,
+			Message: fmt.Sprintf("could not check path filters against the files in branch '%s': %s", ref.Branch, err.Error()),
+		}}
+	}
+	return CheckPathFilters(p, repoFiles)
+}
+
 // bvsWithTasksThatCallCommand creates a mapping from build variants to tasks
 // that run the given command cmd, including the list of matching commands for
 // each task. Returns the total number of commands in the map.
@@ -3777,6 +3777,93 @@ func TestValidateParameters(t *testing.T) {
 	assert.Len(t, validateParameters(p), 0)
 }
 
+func TestValidateBVPaths(t *testing.T) {
+	p := &model.Project{
+		BuildVariants: []model.BuildVariant{
+			{
+				Name:  "bv",
+				Paths: []string{"src/"},
+				Tasks: []model.BuildVariantTaskUnit{
+					{Name: "t1", Paths: []string{"src/"}},
+					{Name: "t2", Paths: []string{"docs/"}, IgnorePaths: []string{"*.md"}},
+				},
+			},
+		},
+	}
+	assert.Len(t, validateBVPaths(p), 0)
+
+	p.BuildVariants[0].Paths = []string{"src/", " "}
+	p.BuildVariants[0].Tasks[0].Paths = p.BuildVariants[0].Paths
+	errs := validateBVPaths(p)
+	require.Len(t, errs, 1, "task that inherits the build variant's paths should not be reported separately")
+	assert.Equal(t, Error, errs[0].Level)
+	assert.Contains(t, errs[0].Message, "buildvariant 'bv'")
+
+	p.BuildVariants[0].Tasks[1].IgnorePaths = []string{""}
+	errs = validateBVPaths(p)
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[1].Message, "ignore_paths for task 't2'")
+}
+
+func TestCheckPathFilters(t *testing.T) {
+	repoFiles := []string{
+		"src/server/main.go",
+		"src/client/app.js",
+		"README.md",
+	}
+	p := &model.Project{
+		BuildVariants: []model.BuildVariant{
+			{
+				Name:        "bv",
+				Paths:       []string{"src/"},
+				IgnorePaths: []string{"*.md"},
+				Tasks: []model.BuildVariantTaskUnit{
+					{Name: "t1", Paths: []string{"src/"}, IgnorePaths: []string{"*.md"}},
+					{Name: "t2", Paths: []string{"src/server/", "src/mobile/"}, IgnorePaths: []string{"!src/server/main.go"}},
+				},
+			},
+		},
+	}
+
+	t.Run("WarnsForGlobsThatMatchNothing", func(t *testing.T) {
+		errs := CheckPathFilters(p, repoFiles)
+		require.Len(t, errs, 1)
+		assert.Equal(t, Warning, errs[0].Level)
+		assert.Contains(t, errs[0].Message, "'src/mobile/'")
+		assert.Contains(t, errs[0].Message, "task 't2' in buildvariant 'bv'")
+	})
+	t.Run("SkipsCheckWithoutRepoFiles", func(t *testing.T) {
+		assert.Empty(t, CheckPathFilters(p, nil))
+	})
+	t.Run("NoWarningsWhenAllGlobsMatch", func(t *testing.T) {
+		assert.Empty(t, CheckPathFilters(p, append(repoFiles, "src/mobile/app.swift")))
+	})
+}
+
+func TestCheckPathFilterMatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := &model.Project{
+		BuildVariants: []model.BuildVariant{
+			{Name: "bv", Paths: []string{"src/"}},
+		},
+	}
+	t.Run("SkipsProjectsWithoutPathFilters", func(t *testing.T) {
+		assert.Empty(t, CheckPathFilterMatches(ctx, &model.Project{}, &model.ProjectRef{CodeHost: model.CodeHostGitlab}))
+	})
+	t.Run("WarnsForProjectsNotOnGitHub", func(t *testing.T) {
+		for _, ref := range []model.ProjectRef{
+			{Owner: "group", Repo: "repo", Branch: "main", CodeHost: model.CodeHostGitlab},
+			{Owner: "owner", Repo: "repo", Branch: "main", GitRemoteURL: "https://git.example.com/owner/repo.git"},
+		} {
+			errs := CheckPathFilterMatches(ctx, p, &ref)
+			require.Len(t, errs, 1)
+			assert.Equal(t, Warning, errs[0].Level)
+		}
+	})
+}
+
 func TestDuplicateTaskInBV(t *testing.T) {
 	assert := assert.New(t)
 